@@ -15,6 +15,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -540,6 +541,7 @@ func initAppModules(keepers *keeperCache, bApp *bam.BaseApp, encodingConfig axel
 			getKeeper[stakingkeeper.Keeper](keepers),
 			getKeeper[axelarnetKeeper.Keeper](keepers),
 			getKeeper[rewardKeeper.Keeper](keepers),
+			getKeeper[permissionKeeper.Keeper](keepers),
 		),
 		evm.NewAppModule(
 			getKeeper[evmKeeper.BaseKeeper](keepers),
@@ -935,6 +937,12 @@ func (app *AxelarApp) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
 }
 
+// RegisterNodeService implements the ApplicationQueryService.RegisterNodeService method.
+// It exposes the node's minimum gas price, which vald uses to price its txs.
+func (app *AxelarApp) RegisterNodeService(clientCtx client.Context) {
+	nodeservice.RegisterNodeService(clientCtx, app.BaseApp.GRPCQueryRouter())
+}
+
 // GetModuleBasics initializes the module BasicManager is in charge of setting up basic,
 // non-dependant module elements, such as codec registration and genesis verification.
 // Initialization is dependent on whether wasm is enabled.
@@ -7,25 +7,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// backlog queues broadcast tasks by priority and deadline
 type backlog struct {
-	tail chan broadcastTask
-	head broadcastTask
+	tasks *priorityQueue[broadcastTask]
+}
+
+func newBacklog(capacity int) backlog {
+	return backlog{tasks: newPriorityQueue[broadcastTask](capacity)}
 }
 
 func (bl *backlog) Pop() broadcastTask {
-	bl.loadHead()
+	next := bl.tasks.Pop()
+	queueDepth.Set(float64(bl.Len()))
 
-	next := bl.head
-	bl.head = broadcastTask{}
 	return next
 }
 
-func (bl *backlog) loadHead() {
-	if len(bl.head.Msgs) == 0 {
-		bl.head = <-bl.tail
-	}
-}
-
 func (bl *backlog) Push(task broadcastTask) <-chan struct{} {
 	done := make(chan struct{})
 
@@ -37,26 +34,19 @@ func (bl *backlog) Push(task broadcastTask) <-chan struct{} {
 			}
 			return
 		}
-		bl.tail <- task
+		bl.tasks.Push(task.Ctx, task)
+		queueDepth.Set(float64(bl.Len()))
 	}()
 
 	return done
 }
 
 func (bl *backlog) Peek() broadcastTask {
-	bl.loadHead()
-
-	return bl.head
+	return bl.tasks.Peek()
 }
 
 func (bl *backlog) Len() int {
-	// do not block in this function because it might be used to inform other calls like Peek()
-	if len(bl.head.Msgs) == 0 {
-		// head is not currently loaded
-		return len(bl.tail)
-	}
-
-	return 1 + len(bl.tail)
+	return bl.tasks.Len()
 }
 
 type broadcastTask struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -25,8 +27,8 @@ import (
 
 //go:generate moq -pkg mock -out mock/broadcast.go . Broadcaster
 
-// PrepareTx returns a marshalled tx that can be broadcast to the blockchain
-func PrepareTx(ctx sdkClient.Context, txf tx.Factory, msgs ...sdk.Msg) ([]byte, error) {
+// PrepareTx returns a marshalled tx that can be broadcast to the blockchain. The tx is signed by the given signer with the key ctx.GetFromName()
+func PrepareTx(ctx sdkClient.Context, txf tx.Factory, signer TxSigner, msgs ...sdk.Msg) ([]byte, error) {
 	if len(msgs) == 0 {
 		return nil, fmt.Errorf("call broadcast with at least one message")
 	}
@@ -55,7 +57,7 @@ func PrepareTx(ctx sdkClient.Context, txf tx.Factory, msgs ...sdk.Msg) ([]byte,
 	}
 
 	txBuilder.SetFeeGranter(ctx.GetFeeGranterAddress())
-	err = tx.Sign(txf, ctx.GetFromName(), txBuilder, true)
+	err = signTx(ctx, txf, signer, ctx.GetFromName(), txBuilder)
 	if err != nil {
 		return nil, err
 	}
@@ -135,33 +137,57 @@ type Broadcaster interface {
 type statefulBroadcaster struct {
 	clientCtx sdkClient.Context
 	txf       tx.Factory
+	signer    TxSigner
+	fees      *FeeOracle
 	options   []BroadcasterOption
 }
 
-// WithStateManager tracks sequence numbers, so it can be used to broadcast consecutive txs
+// WithStateManager tracks sequence numbers, so it can be used to broadcast consecutive txs.
+// Txs are signed with the factory's keyring unless a different signer is set with WithSigner,
+// and they are priced with the factory's gas prices unless a fee oracle is set with WithFeeOracle.
 func WithStateManager(clientCtx sdkClient.Context, txf tx.Factory, options ...BroadcasterOption) Broadcaster {
+	params := broadcastParams{}
+	for _, option := range options {
+		params = option(params)
+	}
+
+	signer := params.Signer
+	if signer == nil {
+		signer = KeyringSigner(txf.Keybase())
+	}
+
 	return &statefulBroadcaster{
 		clientCtx: clientCtx,
 		txf:       txf,
+		signer:    signer,
+		fees:      params.FeeOracle,
 		options:   options,
 	}
 }
 
 // Broadcast broadcasts the given msgs to the blockchain, keeps track of the sender's sequence number
-func (b *statefulBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+func (b *statefulBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (response *sdk.TxResponse, err error) {
+	defer func() { observeBroadcast(err) }()
+
 	if len(msgs) == 0 {
 		return nil, fmt.Errorf("no messages to broadcast")
 	}
 
 	log.FromCtx(ctx).Debug("starting to broadcast message batch")
 
-	var err error
 	b.txf, err = prepareFactory(b.clientCtx, b.txf)
 	if err != nil {
 		return nil, err
 	}
 
-	bz, err := PrepareTx(b.clientCtx, b.txf, msgs...)
+	txf := b.txf
+	var quote FeeQuote
+	if b.fees != nil {
+		quote = b.fees.Quote(ctx, msgs...)
+		txf = txf.WithGasPrices(quote.GasPrice.String()).WithGasAdjustment(quote.GasAdjustment)
+	}
+
+	bz, err := PrepareTx(b.clientCtx, txf, b.signer, msgs...)
 	if sdkerrors.ErrWrongSequence.Is(err) {
 		b.txf = b.txf.
 			WithAccountNumber(0).
@@ -170,7 +196,22 @@ func (b *statefulBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "tx preparation failed")
 	}
-	response, err := Broadcast(b.clientCtx, bz, b.options...)
+
+	var fees sdk.Coins
+	if b.fees != nil {
+		if fees, err = txFees(b.clientCtx, bz); err != nil {
+			return nil, err
+		}
+
+		if err := b.fees.CheckBudget(fees, quote); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err = Broadcast(b.clientCtx, bz, b.options...)
+	if b.fees != nil {
+		b.fees.Record(fees, quote, err)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "broadcast failed")
 	}
@@ -222,6 +263,8 @@ type BroadcasterOption func(broadcaster broadcastParams) broadcastParams
 type broadcastParams struct {
 	PollingInterval time.Duration
 	Timeout         time.Duration
+	Signer          TxSigner
+	FeeOracle       *FeeOracle
 }
 
 // WithResponseTimeout sets the time to wait for a tx response
@@ -240,16 +283,33 @@ func WithPollingInterval(interval time.Duration) BroadcasterOption {
 	}
 }
 
+// WithSigner sets the backend that signs the broadcast txs
+func WithSigner(signer TxSigner) BroadcasterOption {
+	return func(params broadcastParams) broadcastParams {
+		params.Signer = signer
+		return params
+	}
+}
+
+// WithFeeOracle sets the oracle that prices the broadcast txs
+func WithFeeOracle(oracle *FeeOracle) BroadcasterOption {
+	return func(params broadcastParams) broadcastParams {
+		params.FeeOracle = oracle
+		return params
+	}
+}
+
 type pipelinedBroadcaster struct {
 	retryPipeline *retryPipeline
 	broadcaster   Broadcaster
 }
 
-// WithRetry returns a broadcaster that retries the broadcast up to the given number of times if the broadcast fails
-func WithRetry(broadcaster Broadcaster, maxRetries int, minSleep time.Duration) Broadcaster {
+// WithRetry returns a broadcaster that retries the broadcast up to the given number of times if the broadcast fails.
+// If latestHeight is not nil, the broadcast is given up once the height reaches its deadline.
+func WithRetry(broadcaster Broadcaster, maxRetries int, minSleep time.Duration, latestHeight func() int64) Broadcaster {
 	b := &pipelinedBroadcaster{
 		broadcaster:   broadcaster,
-		retryPipeline: newPipelineWithRetry(10000, maxRetries, utils.LinearBackOff(minSleep)),
+		retryPipeline: newPipelineWithRetry(10000, maxRetries, utils.LinearBackOff(minSleep), latestHeight),
 	}
 
 	return b
@@ -311,15 +371,18 @@ type batchedBroadcaster struct {
 	backlog        backlog
 	batchThreshold int
 	batchSizeLimit int
+	latestHeight   func() int64
 }
 
-// Batched returns a broadcaster that batches msgs together if there is high traffic to increase throughput
-func Batched(broadcaster Broadcaster, batchThreshold, batchSizeLimit int) Broadcaster {
+// Batched returns a broadcaster that batches msgs together if there is high traffic to increase throughput.
+// Queued msgs are sent in order of their priority and deadline. If latestHeight is not nil, msgs are dropped once the height reaches their deadline.
+func Batched(broadcaster Broadcaster, batchThreshold, batchSizeLimit int, latestHeight func() int64) Broadcaster {
 	b := &batchedBroadcaster{
 		broadcaster:    broadcaster,
-		backlog:        backlog{tail: make(chan broadcastTask, 10000)},
+		backlog:        newBacklog(10000),
 		batchThreshold: batchThreshold,
 		batchSizeLimit: batchSizeLimit,
+		latestHeight:   latestHeight,
 	}
 
 	go b.processBacklog()
@@ -354,6 +417,9 @@ func (b *batchedBroadcaster) processBacklog() {
 		// do not batch if there is no backlog pressure to minimize the risk of broadcast errors (and subsequent retries)
 		if b.backlog.Len() < b.batchThreshold {
 			task := b.backlog.Pop()
+			if b.dropIfExpired(task) {
+				continue
+			}
 
 			ctx := log.Append(task.Ctx, "batch_size", len(task.Msgs))
 			log.FromCtx(ctx).Debug("low traffic; no batch merging")
@@ -366,7 +432,7 @@ func (b *batchedBroadcaster) processBacklog() {
 		}
 
 		var (
-			ctx       context.Context
+			tasks     []broadcastTask
 			msgs      []sdk.Msg
 			callbacks []chan<- broadcastResult
 		)
@@ -383,20 +449,23 @@ func (b *batchedBroadcaster) processBacklog() {
 
 			if task.Ctx.Err() != nil {
 				log.FromCtx(task.Ctx).Debug("context expired, discarding msgs")
-				continue
+			} else if !b.dropIfExpired(task) {
+				tasks = append(tasks, task)
+				msgs = append(msgs, task.Msgs...)
+				callbacks = append(callbacks, task.Callback)
 			}
 
-			ctx = task.Ctx
-			msgs = append(msgs, task.Msgs...)
-			callbacks = append(callbacks, task.Callback)
-
 			// if there are no new tasks in the backlog, stop filling up the batch
 			if b.backlog.Len() == 0 {
 				break
 			}
 		}
 
-		ctx = log.Append(ctx, "batch_size", len(msgs))
+		if len(tasks) == 0 {
+			continue
+		}
+
+		ctx := log.Append(batchContext(tasks), "batch_size", len(msgs))
 		log.FromCtx(ctx).Debug("high traffic; merging batches")
 
 		response, err := b.broadcaster.Broadcast(ctx, msgs...)
@@ -411,14 +480,65 @@ func (b *batchedBroadcaster) processBacklog() {
 	}
 }
 
+// dropIfExpired reports ErrDeadlineExceeded to the caller if the task's deadline has passed
+func (b *batchedBroadcaster) dropIfExpired(task broadcastTask) bool {
+	if !isExpired(task.Ctx, b.latestHeight) {
+		return false
+	}
+
+	deadline, _ := DeadlineOf(task.Ctx)
+	log.FromCtx(task.Ctx).Infof("dropping %d msgs with deadline %d", len(task.Msgs), deadline)
+	droppedCount.Add(float64(len(task.Msgs)))
+
+	task.Callback <- broadcastResult{Err: sdkerrors.Wrapf(ErrDeadlineExceeded, "deadline %d", deadline)}
+	return true
+}
+
+// batchContext returns the context of the last task with the highest priority of all tasks,
+// and a deadline that only expires once the deadlines of all tasks have passed
+func batchContext(tasks []broadcastTask) context.Context {
+	ctx := tasks[len(tasks)-1].Ctx
+
+	priority := PriorityLow
+	var deadline int64
+	for _, task := range tasks {
+		if p, _ := PriorityOf(task.Ctx); p > priority {
+			priority = p
+		}
+
+		d, ok := DeadlineOf(task.Ctx)
+		if !ok {
+			deadline = math.MaxInt64
+		} else if d > deadline {
+			deadline = d
+		}
+	}
+
+	ctx = WithPriority(ctx, priority)
+	if deadline == math.MaxInt64 {
+		return WithDeadline(ctx, 0)
+	}
+
+	return WithDeadline(ctx, deadline)
+}
+
 type refundableBroadcaster struct {
 	broadcaster Broadcaster
+	registry    codectypes.InterfaceRegistry
 }
 
-// Broadcast wraps all given msgs into RefundMsgRequest msgs before broadcasting them
+// Broadcast wraps all given msgs into RefundMsgRequest msgs before broadcasting them.
+// A tx is rejected if it mixes RefundMsgRequests with other msgs, so msgs are only wrapped if all of them are refundable.
 func (b *refundableBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	refundable := refundableTypes(b.registry)
+
 	var refundables []sdk.Msg
 	for _, msg := range msgs {
+		if !refundable[sdk.MsgTypeURL(msg)] {
+			log.FromCtx(ctx).Debugf("msg %s is not refundable, broadcasting the tx without refund", sdk.MsgTypeURL(msg))
+			return b.broadcaster.Broadcast(ctx, msgs...)
+		}
+
 		if len(msg.GetSigners()) > 0 {
 			refundables = append(refundables, types.NewRefundMsgRequest(msg.GetSigners()[0], msg))
 		}
@@ -426,9 +546,9 @@ func (b *refundableBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg)
 	return b.broadcaster.Broadcast(ctx, refundables...)
 }
 
-// WithRefund wraps a broadcaster into a refundableBroadcaster
-func WithRefund(b Broadcaster) Broadcaster {
-	return &refundableBroadcaster{broadcaster: b}
+// WithRefund wraps a broadcaster into a refundableBroadcaster. The registry determines which msgs are refundable.
+func WithRefund(b Broadcaster, registry codectypes.InterfaceRegistry) Broadcaster {
+	return &refundableBroadcaster{broadcaster: b, registry: registry}
 }
 
 type suppressorBroadcaster struct {
@@ -34,6 +34,7 @@ import (
 	errors2 "github.com/axelarnetwork/axelar-core/utils/errors"
 	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
 	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 	"github.com/axelarnetwork/utils/test/rand"
@@ -232,7 +233,7 @@ func TestWithRefund(t *testing.T) {
 
 	Given("a refunding broadcaster", func() {
 		broadcaster = &mock2.BroadcasterMock{}
-		refunder = broadcast.WithRefund(broadcaster)
+		refunder = broadcast.WithRefund(broadcaster, app.MakeEncodingConfig().InterfaceRegistry)
 	}).
 		When("the response contains the msgs of the tx", func() {
 			broadcaster.BroadcastFunc = func(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
@@ -240,13 +241,21 @@ func TestWithRefund(t *testing.T) {
 				return &sdk.TxResponse{Tx: anyTx}, nil
 			}
 		}).
-		Then("all messages are of type RefundMsgRequest", func(t *testing.T) {
-			res, err := refunder.Broadcast(context.Background(), randomMsgs(3)...)
-			assert.NoError(t, err)
-			for _, msg := range res.Tx.GetCachedValue().(sdk.Tx).GetMsgs() {
-				assert.IsType(t, &types.RefundMsgRequest{}, msg)
-			}
-		}).Run(t)
+		Branch(
+			Then("all refundable messages are of type RefundMsgRequest", func(t *testing.T) {
+				res, err := refunder.Broadcast(context.Background(), refundableMsgs(3)...)
+				assert.NoError(t, err)
+				for _, msg := range res.Tx.GetCachedValue().(sdk.Tx).GetMsgs() {
+					assert.IsType(t, &types.RefundMsgRequest{}, msg)
+				}
+			}),
+			Then("messages are not wrapped if any of them is not refundable", func(t *testing.T) {
+				msgs := append(refundableMsgs(2), randomMsgs(1)...)
+				res, err := refunder.Broadcast(context.Background(), msgs...)
+				assert.NoError(t, err)
+				assert.Equal(t, msgs, res.Tx.GetCachedValue().(sdk.Tx).GetMsgs())
+			}),
+		).Run(t)
 }
 
 func TestInBatches(t *testing.T) {
@@ -260,7 +269,7 @@ func TestInBatches(t *testing.T) {
 
 	Given("a batched broadcaster", func() {
 		broadcaster = &mock2.BroadcasterMock{}
-		batched = broadcast.Batched(broadcaster, 1, 5)
+		batched = broadcast.Batched(broadcaster, 1, 5, nil)
 	}).Branch(
 		When("trying to broadcast 0 msgs", func() {
 			msgs = []sdk.Msg{}
@@ -337,7 +346,7 @@ func TestWithRetry(t *testing.T) {
 
 	Given("a retry broadcaster", func() {
 		broadcaster = &mock2.BroadcasterMock{}
-		retry = broadcast.WithRetry(broadcaster, 3, 1*time.Nanosecond)
+		retry = broadcast.WithRetry(broadcaster, 3, 1*time.Nanosecond, nil)
 	}).Branch(
 		When("one of the msgs fails", func() {
 			once := &sync.Once{}
@@ -416,6 +425,15 @@ func unsafePack(value sdk.Msg) *codectypes.Any {
 	return codectypes.UnsafePackAny(value)
 }
 
+func refundableMsgs(count int) []sdk.Msg {
+	var msgs []sdk.Msg
+	sender := rand2.AccAddr()
+	for i := 0; i < count; i++ {
+		msgs = append(msgs, tss.NewHeartBeatRequest(sender, nil))
+	}
+	return msgs
+}
+
 func randomMsgs(count int) []sdk.Msg {
 	var msgs []sdk.Msg
 	sender := rand2.AccAddr()
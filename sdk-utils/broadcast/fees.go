@@ -0,0 +1,398 @@
+package broadcast
+
+import (
+	"context"
+	goerrors "errors"
+	"math"
+	"sync"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+	"github.com/axelarnetwork/utils/log"
+)
+
+//go:generate moq -pkg mock -out mock/fees.go . FeeSource
+
+// ErrBudgetExceeded is returned for txs that are not broadcast because their fees would exceed the fee budget
+var ErrBudgetExceeded = goerrors.New("fee budget exceeded")
+
+// refundableInterface is the interface all msgs must implement to have their fees refunded by the reward module
+const refundableInterface = "reward.v1beta1.Refundable"
+
+// IsRefundable returns true if the reward module refunds the fees of a tx with the given msgs,
+// i.e. all msgs are RefundMsgRequests that wrap refundable msgs
+func IsRefundable(registry codectypes.InterfaceRegistry, msgs ...sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	refundable := refundableTypes(registry)
+	for _, msg := range msgs {
+		req, ok := msg.(*types.RefundMsgRequest)
+		if !ok || req.InnerMessage == nil || !refundable[req.InnerMessage.TypeUrl] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func refundableTypes(registry codectypes.InterfaceRegistry) map[string]bool {
+	refundable := make(map[string]bool)
+	for _, url := range registry.ListImplementations(refundableInterface) {
+		refundable[url] = true
+	}
+
+	return refundable
+}
+
+// FeeSource provides the state of the chain's fee market
+type FeeSource interface {
+	// MinGasPrices returns the minimum gas prices the node accepts
+	MinGasPrices(ctx context.Context) (sdk.DecCoins, error)
+	// BlockFullness returns the average fullness of the given number of latest blocks, between 0 and 1
+	BlockFullness(ctx context.Context, blocks int64) (float64, error)
+}
+
+type nodeFeeSource struct {
+	clientCtx sdkClient.Context
+
+	lock sync.Mutex
+	// gas used per block height, so the results of each block are only queried once
+	gasUsed map[int64]int64
+}
+
+// NodeFeeSource returns a fee source that queries the node of the given client context
+func NodeFeeSource(clientCtx sdkClient.Context) FeeSource {
+	return &nodeFeeSource{clientCtx: clientCtx, gasUsed: make(map[int64]int64)}
+}
+
+// MinGasPrices implements the FeeSource interface
+func (s *nodeFeeSource) MinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	res, err := node.NewServiceClient(s.clientCtx).Config(ctx, &node.ConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ParseDecCoins(res.MinimumGasPrice)
+}
+
+// BlockFullness implements the FeeSource interface. The fullness of a block is the larger of its gas and size utilization.
+func (s *nodeFeeSource) BlockFullness(ctx context.Context, blocks int64) (float64, error) {
+	status, err := s.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	params, err := s.clientCtx.Client.ConsensusParams(ctx, &latest)
+	if err != nil {
+		return 0, err
+	}
+
+	first := max(1, latest-blocks+1)
+	info, err := s.clientCtx.Client.BlockchainInfo(ctx, first, latest)
+	if err != nil {
+		return 0, err
+	}
+	s.pruneGasUsed(first)
+
+	if len(info.BlockMetas) == 0 {
+		return 0, nil
+	}
+
+	var total float64
+	for _, meta := range info.BlockMetas {
+		fullness := ratio(int64(meta.BlockSize), params.ConsensusParams.Block.MaxBytes)
+
+		if params.ConsensusParams.Block.MaxGas > 0 && meta.NumTxs > 0 {
+			gasUsed, err := s.blockGasUsed(ctx, meta.Header.Height)
+			if err != nil {
+				return 0, err
+			}
+
+			fullness = math.Max(fullness, ratio(gasUsed, params.ConsensusParams.Block.MaxGas))
+		}
+
+		total += fullness
+	}
+
+	return total / float64(len(info.BlockMetas)), nil
+}
+
+// blockGasUsed returns the gas used by all txs of the block at the given height
+func (s *nodeFeeSource) blockGasUsed(ctx context.Context, height int64) (int64, error) {
+	s.lock.Lock()
+	gasUsed, ok := s.gasUsed[height]
+	s.lock.Unlock()
+
+	if ok {
+		return gasUsed, nil
+	}
+
+	results, err := s.clientCtx.Client.BlockResults(ctx, &height)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, res := range results.TxsResults {
+		gasUsed += res.GasUsed
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.gasUsed[height] = gasUsed
+
+	return gasUsed, nil
+}
+
+// pruneGasUsed drops the gas used by blocks below the given height, because they left the block window
+func (s *nodeFeeSource) pruneGasUsed(height int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for h := range s.gasUsed {
+		if h < height {
+			delete(s.gasUsed, h)
+		}
+	}
+}
+
+func ratio(used, limit int64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+
+	return math.Min(1, float64(used)/float64(limit))
+}
+
+// FeeParams configures how the fee oracle prices txs
+type FeeParams struct {
+	// MaxGasPrice caps the gas price, unless the node requires a higher price. There is no cap if it is zero
+	MaxGasPrice sdk.Dec
+	// CongestionPremium is the relative gas price increase when the latest blocks are full
+	CongestionPremium sdk.Dec
+	// MinGasAdjustment and MaxGasAdjustment bound the gas adjustment, which increases with block fullness
+	MinGasAdjustment float64
+	MaxGasAdjustment float64
+	// BlockWindow is the number of latest blocks the block fullness is averaged over
+	BlockWindow int64
+	// RefreshInterval is the time after which the fee market state is queried again
+	RefreshInterval time.Duration
+	// Budget is the amount of fees that may be spent without refund per budget period. There is no budget if it is zero
+	Budget       sdk.Int
+	BudgetPeriod time.Duration
+}
+
+// FeeQuote is the gas price and gas adjustment a tx is broadcast with
+type FeeQuote struct {
+	GasPrice      sdk.DecCoin
+	GasAdjustment float64
+	Refundable    bool
+}
+
+type spending struct {
+	at     time.Time
+	amount sdk.Int
+}
+
+// FeeOracle chooses the gas price and gas adjustment of each tx based on the node's minimum gas price and the fullness of recent blocks,
+// and keeps the fees that are not refunded within a budget
+type FeeOracle struct {
+	source   FeeSource
+	registry codectypes.InterfaceRegistry
+	floor    sdk.DecCoin
+	params   FeeParams
+
+	lock        sync.Mutex
+	nodeMin     sdk.Dec
+	fullness    float64
+	refreshedAt time.Time
+	bump        sdk.Dec
+	spent       []spending
+}
+
+// NewFeeOracle returns a fee oracle that never prices txs below the given gas price. Fees are paid in the denom of that gas price.
+func NewFeeOracle(source FeeSource, registry codectypes.InterfaceRegistry, floor sdk.DecCoin, params FeeParams) *FeeOracle {
+	if params.MaxGasPrice.IsNil() {
+		params.MaxGasPrice = sdk.ZeroDec()
+	}
+
+	if params.CongestionPremium.IsNil() {
+		params.CongestionPremium = sdk.ZeroDec()
+	}
+
+	if params.Budget.IsNil() {
+		params.Budget = sdk.ZeroInt()
+	}
+
+	return &FeeOracle{
+		source:   source,
+		registry: registry,
+		floor:    floor,
+		params:   params,
+		nodeMin:  sdk.ZeroDec(),
+		bump:     sdk.OneDec(),
+	}
+}
+
+// Quote returns the gas price and gas adjustment for a tx with the given msgs.
+// Refundable txs are priced to get included quickly, because their fees are refunded.
+// Other txs drop the congestion premium once half of the budget has been spent.
+func (o *FeeOracle) Quote(ctx context.Context, msgs ...sdk.Msg) FeeQuote {
+	o.refresh(ctx)
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	refundable := IsRefundable(o.registry, msgs...)
+
+	base := sdk.MaxDec(o.floor.Amount, o.nodeMin)
+	price := base.Mul(o.bump)
+	adjustment := o.params.MaxGasAdjustment
+
+	if refundable || !o.budgetHalfSpent() {
+		premium := o.params.CongestionPremium.Mul(sdk.NewDecWithPrec(int64(o.fullness*1e6), 6))
+		price = price.Mul(sdk.OneDec().Add(premium))
+	}
+
+	if !refundable {
+		adjustment = o.params.MinGasAdjustment + (o.params.MaxGasAdjustment-o.params.MinGasAdjustment)*o.fullness
+	}
+
+	if o.params.MaxGasPrice.IsPositive() && price.GT(o.params.MaxGasPrice) {
+		price = sdk.MaxDec(base, o.params.MaxGasPrice)
+	}
+
+	gasPrice.Set(price.MustFloat64())
+
+	return FeeQuote{
+		GasPrice:      sdk.NewDecCoinFromDec(o.floor.Denom, price),
+		GasAdjustment: math.Max(1, adjustment),
+		Refundable:    refundable,
+	}
+}
+
+// CheckBudget returns ErrBudgetExceeded if paying the given fees for a tx without refund would exceed the budget
+func (o *FeeOracle) CheckBudget(fees sdk.Coins, quote FeeQuote) error {
+	if quote.Refundable || o.params.Budget.IsZero() {
+		return nil
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	spent := o.spentInPeriod()
+	if spent.Add(fees.AmountOf(o.floor.Denom)).GT(o.params.Budget) {
+		return sdkerrors.Wrapf(ErrBudgetExceeded, "spent %s%s of %s%s", spent, o.floor.Denom, o.params.Budget, o.floor.Denom)
+	}
+
+	return nil
+}
+
+// Record tracks the fees of a broadcast tx. Fees count against the budget unless the tx is refunded.
+// If the tx was rejected for insufficient fees, the gas price is raised until a tx succeeds again.
+func (o *FeeOracle) Record(fees sdk.Coins, quote FeeQuote, err error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	switch {
+	case goerrors.Is(err, sdkerrors.ErrInsufficientFee):
+		o.bump = sdk.MinDec(o.bump.Mul(sdk.NewDecWithPrec(15, 1)), sdk.NewDec(4))
+		log.Infof("tx rejected for insufficient fees, raising gas price by %sx", o.bump)
+		return
+	case err == nil:
+		o.bump = sdk.MaxDec(sdk.OneDec(), o.bump.Mul(sdk.NewDecWithPrec(9, 1)))
+	}
+
+	refunded := quote.Refundable && err == nil
+	amount := fees.AmountOf(o.floor.Denom)
+	feesPaid.WithLabelValues(refundLabel(refunded)).Add(amount.ToDec().MustFloat64())
+
+	if !refunded && amount.IsPositive() {
+		o.spent = append(o.spent, spending{at: time.Now(), amount: amount})
+	}
+}
+
+func (o *FeeOracle) refresh(ctx context.Context) {
+	o.lock.Lock()
+	stale := time.Since(o.refreshedAt) > o.params.RefreshInterval
+	o.lock.Unlock()
+
+	if !stale {
+		return
+	}
+
+	nodeMin := sdk.ZeroDec()
+	if prices, err := o.source.MinGasPrices(ctx); err != nil {
+		log.FromCtx(ctx).Debugf("failed to query the node's minimum gas prices: %s", err.Error())
+	} else {
+		nodeMin = prices.AmountOf(o.floor.Denom)
+	}
+
+	fullness, err := o.source.BlockFullness(ctx, o.params.BlockWindow)
+	if err != nil {
+		log.FromCtx(ctx).Debugf("failed to query block fullness: %s", err.Error())
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.nodeMin = nodeMin
+	if err == nil {
+		o.fullness = math.Max(0, math.Min(1, fullness))
+		blockFullness.Set(o.fullness)
+	}
+	o.refreshedAt = time.Now()
+}
+
+func (o *FeeOracle) budgetHalfSpent() bool {
+	if o.params.Budget.IsZero() {
+		return false
+	}
+
+	return o.spentInPeriod().MulRaw(2).GTE(o.params.Budget)
+}
+
+func (o *FeeOracle) spentInPeriod() sdk.Int {
+	cutoff := time.Now().Add(-o.params.BudgetPeriod)
+	for len(o.spent) > 0 && o.spent[0].at.Before(cutoff) {
+		o.spent = o.spent[1:]
+	}
+
+	total := sdk.ZeroInt()
+	for _, s := range o.spent {
+		total = total.Add(s.amount)
+	}
+
+	return total
+}
+
+// txFees returns the fees of the given marshalled tx
+func txFees(ctx sdkClient.Context, txBytes []byte) (sdk.Coins, error) {
+	decoded, err := ctx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	feeTx, ok := decoded.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	return feeTx.GetFee(), nil
+}
+
+func refundLabel(refunded bool) string {
+	if refunded {
+		return "refunded"
+	}
+
+	return "paid"
+}
@@ -0,0 +1,159 @@
+package broadcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	client2 "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tm "github.com/tendermint/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+func TestFeeOracle(t *testing.T) {
+	registry := app.MakeEncodingConfig().InterfaceRegistry
+	floor := sdk.NewDecCoinFromDec("uaxl", sdk.MustNewDecFromStr("0.007"))
+	params := broadcast.FeeParams{
+		CongestionPremium: sdk.OneDec(),
+		MinGasAdjustment:  1.5,
+		MaxGasAdjustment:  4,
+		BlockWindow:       10,
+		RefreshInterval:   time.Hour,
+		Budget:            sdk.NewInt(1000),
+		BudgetPeriod:      time.Hour,
+	}
+
+	newSource := func(minGasPrice string, fullness float64) *mock2.FeeSourceMock {
+		return &mock2.FeeSourceMock{
+			MinGasPricesFunc:  func(context.Context) (sdk.DecCoins, error) { return sdk.ParseDecCoins(minGasPrice) },
+			BlockFullnessFunc: func(context.Context, int64) (float64, error) { return fullness, nil },
+		}
+	}
+
+	refundable := func() []sdk.Msg {
+		return []sdk.Msg{types.NewRefundMsgRequest(refundableMsgs(1)[0].GetSigners()[0], refundableMsgs(1)[0])}
+	}
+
+	t.Run("prices txs at the node's minimum gas price without congestion", func(t *testing.T) {
+		oracle := broadcast.NewFeeOracle(newSource("0.01uaxl", 0), registry, floor, params)
+
+		quote := oracle.Quote(context.Background(), randomMsgs(1)...)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.01"), quote.GasPrice.Amount)
+		assert.Equal(t, "uaxl", quote.GasPrice.Denom)
+		assert.Equal(t, 1.5, quote.GasAdjustment)
+		assert.False(t, quote.Refundable)
+	})
+
+	t.Run("never prices txs below the floor", func(t *testing.T) {
+		source := &mock2.FeeSourceMock{
+			MinGasPricesFunc:  func(context.Context) (sdk.DecCoins, error) { return nil, errors.New("node unavailable") },
+			BlockFullnessFunc: func(context.Context, int64) (float64, error) { return 0, errors.New("node unavailable") },
+		}
+		oracle := broadcast.NewFeeOracle(source, registry, floor, params)
+
+		assert.Equal(t, floor, oracle.Quote(context.Background(), randomMsgs(1)...).GasPrice)
+	})
+
+	t.Run("raises price and gas adjustment with block fullness", func(t *testing.T) {
+		oracle := broadcast.NewFeeOracle(newSource("0.01uaxl", 0.5), registry, floor, params)
+
+		quote := oracle.Quote(context.Background(), randomMsgs(1)...)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.015"), quote.GasPrice.Amount)
+		assert.Equal(t, 2.75, quote.GasAdjustment)
+
+		quote = oracle.Quote(context.Background(), refundable()...)
+		assert.True(t, quote.Refundable)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.015"), quote.GasPrice.Amount)
+		assert.Equal(t, 4.0, quote.GasAdjustment)
+	})
+
+	t.Run("caps the gas price unless the node requires more", func(t *testing.T) {
+		capped := params
+		capped.MaxGasPrice = sdk.MustNewDecFromStr("0.012")
+
+		oracle := broadcast.NewFeeOracle(newSource("0.01uaxl", 1), registry, floor, capped)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.012"), oracle.Quote(context.Background(), randomMsgs(1)...).GasPrice.Amount)
+
+		oracle = broadcast.NewFeeOracle(newSource("0.02uaxl", 1), registry, floor, capped)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.02"), oracle.Quote(context.Background(), randomMsgs(1)...).GasPrice.Amount)
+	})
+
+	t.Run("raises the price after txs are rejected for insufficient fees", func(t *testing.T) {
+		oracle := broadcast.NewFeeOracle(newSource("0.01uaxl", 0), registry, floor, params)
+
+		quote := oracle.Quote(context.Background(), randomMsgs(1)...)
+		oracle.Record(sdk.NewCoins(sdk.NewInt64Coin("uaxl", 10)), quote, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "insufficient fees"))
+		assert.Equal(t, sdk.MustNewDecFromStr("0.015"), oracle.Quote(context.Background(), randomMsgs(1)...).GasPrice.Amount)
+	})
+
+	t.Run("keeps fees without refund within the budget", func(t *testing.T) {
+		oracle := broadcast.NewFeeOracle(newSource("0.01uaxl", 1), registry, floor, params)
+		fees := sdk.NewCoins(sdk.NewInt64Coin("uaxl", 400))
+
+		refundableQuote := oracle.Quote(context.Background(), refundable()...)
+		assert.NoError(t, oracle.CheckBudget(fees, refundableQuote))
+		oracle.Record(fees, refundableQuote, nil)
+
+		quote := oracle.Quote(context.Background(), randomMsgs(1)...)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.02"), quote.GasPrice.Amount)
+		assert.NoError(t, oracle.CheckBudget(fees, quote))
+		oracle.Record(fees, quote, nil)
+
+		// failed refundable txs are not refunded
+		oracle.Record(fees, refundableQuote, errors.New("execution failed"))
+
+		// the congestion premium is dropped once half of the budget is spent
+		quote = oracle.Quote(context.Background(), randomMsgs(1)...)
+		assert.Equal(t, sdk.MustNewDecFromStr("0.01"), quote.GasPrice.Amount)
+		assert.ErrorIs(t, oracle.CheckBudget(fees, quote), broadcast.ErrBudgetExceeded)
+		assert.NoError(t, oracle.CheckBudget(sdk.NewCoins(sdk.NewInt64Coin("uaxl", 200)), quote))
+		assert.NoError(t, oracle.CheckBudget(fees, refundableQuote))
+	})
+}
+
+func TestNodeFeeSource_BlockFullness(t *testing.T) {
+	latest := int64(10)
+	client := &mock2.ClientMock{
+		StatusFunc: func(context.Context) (*coretypes.ResultStatus, error) {
+			return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: latest}}, nil
+		},
+		ConsensusParamsFunc: func(context.Context, *int64) (*coretypes.ResultConsensusParams, error) {
+			return &coretypes.ResultConsensusParams{ConsensusParams: tmproto.ConsensusParams{Block: tmproto.BlockParams{MaxBytes: 1000, MaxGas: 100}}}, nil
+		},
+		BlockchainInfoFunc: func(_ context.Context, minHeight int64, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+			var metas []*tm.BlockMeta
+			for height := maxHeight; height >= minHeight; height-- {
+				metas = append(metas, &tm.BlockMeta{Header: tm.Header{Height: height}, BlockSize: 100, NumTxs: 1})
+			}
+			return &coretypes.ResultBlockchainInfo{BlockMetas: metas}, nil
+		},
+		BlockResultsFunc: func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+			return &coretypes.ResultBlockResults{Height: *height, TxsResults: []*abci.ResponseDeliverTx{{GasUsed: 50}}}, nil
+		},
+	}
+	source := broadcast.NodeFeeSource(client2.Context{Client: client})
+
+	fullness, err := source.BlockFullness(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, fullness)
+	assert.Len(t, client.BlockResultsCalls(), 5)
+
+	// only the results of new blocks are queried
+	latest = 12
+	fullness, err = source.BlockFullness(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, fullness)
+	assert.Len(t, client.BlockResultsCalls(), 7)
+	assert.ElementsMatch(t, []int64{11, 12}, []int64{*client.BlockResultsCalls()[5].Height, *client.BlockResultsCalls()[6].Height})
+}
@@ -0,0 +1,110 @@
+package broadcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/axelarnetwork/utils/log"
+)
+
+// SenderSetter is implemented by msgs whose sender can be replaced, so they can be broadcast from any lane
+type SenderSetter interface {
+	sdk.Msg
+	SetSender(sender sdk.AccAddress)
+}
+
+// Lane is a broadcaster with its own account, and thus its own sequence number
+type Lane struct {
+	Sender      sdk.AccAddress
+	Broadcaster Broadcaster
+}
+
+type lane struct {
+	Lane
+	inFlight int
+}
+
+type laneBroadcaster struct {
+	lock  sync.Mutex
+	lanes []*lane
+	next  int
+}
+
+// WithLanes returns a broadcaster that load-balances msgs across the given lanes.
+// Each broadcast is assigned to the lane with the fewest broadcasts in flight, and the sender of all its msgs is replaced with the lane's account,
+// so a stuck sequence number only stalls the broadcasts of a single lane.
+func WithLanes(lanes ...Lane) Broadcaster {
+	if len(lanes) == 0 {
+		panic("at least one broadcast lane is required")
+	}
+
+	b := &laneBroadcaster{}
+	for _, l := range lanes {
+		b.lanes = append(b.lanes, &lane{Lane: l})
+		laneInFlight.WithLabelValues(l.Sender.String()).Set(0)
+	}
+
+	return b
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *laneBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	l := b.acquire()
+	defer b.release(l)
+
+	laneMsgs := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		laneMsg, err := withSender(msg, l.Sender)
+		if err != nil {
+			return nil, err
+		}
+
+		laneMsgs = append(laneMsgs, laneMsg)
+	}
+
+	ctx = log.Append(ctx, "lane", l.Sender.String())
+	return l.Broadcaster.Broadcast(ctx, laneMsgs...)
+}
+
+func (b *laneBroadcaster) acquire() *lane {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// start the search at a rotating offset, so lanes with the same load are used in turn
+	var selected *lane
+	for i := range b.lanes {
+		l := b.lanes[(b.next+i)%len(b.lanes)]
+		if selected == nil || l.inFlight < selected.inFlight {
+			selected = l
+		}
+	}
+	b.next = (b.next + 1) % len(b.lanes)
+
+	selected.inFlight++
+	laneInFlight.WithLabelValues(selected.Sender.String()).Inc()
+
+	return selected
+}
+
+func (b *laneBroadcaster) release(l *lane) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	l.inFlight--
+	laneInFlight.WithLabelValues(l.Sender.String()).Dec()
+}
+
+// withSender returns a copy of the msg with its sender replaced by the given address
+func withSender(msg sdk.Msg, sender sdk.AccAddress) (sdk.Msg, error) {
+	clone, ok := proto.Clone(msg).(SenderSetter)
+	if !ok {
+		return nil, fmt.Errorf("message %s has no sender that can be assigned to a broadcast lane", sdk.MsgTypeURL(msg))
+	}
+
+	clone.SetSender(sender)
+	return clone, nil
+}
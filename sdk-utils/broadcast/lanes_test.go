@@ -0,0 +1,106 @@
+package broadcast_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	rand2 "github.com/axelarnetwork/axelar-core/testutils/rand"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	votetypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+func TestWithLanes(t *testing.T) {
+	proxy := rand2.AccAddr()
+	newVote := func() *votetypes.VoteRequest {
+		return votetypes.NewVoteRequest(proxy, vote.PollID(1), evm.NewVoteEvents("ethereum"))
+	}
+
+	t.Run("signs msgs with the lane account", func(t *testing.T) {
+		laneAddr := rand2.AccAddr()
+		inner := &mock.BroadcasterMock{BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }}
+		b := broadcast.WithLanes(broadcast.Lane{Sender: laneAddr, Broadcaster: inner})
+
+		msg := newVote()
+		_, err := b.Broadcast(context.Background(), msg)
+		assert.NoError(t, err)
+
+		sent := inner.BroadcastCalls()[0].Msgs[0].(*votetypes.VoteRequest)
+		assert.Equal(t, laneAddr, sent.Sender)
+		assert.Equal(t, []sdk.AccAddress{laneAddr}, sent.GetSigners())
+		assert.NoError(t, sent.ValidateBasic())
+		assert.Equal(t, msg.Vote.Value, sent.Vote.Value)
+		assert.Equal(t, proxy, msg.Sender, "the original msg must not be modified")
+	})
+
+	t.Run("signs refunded msgs with the lane account", func(t *testing.T) {
+		laneAddr := rand2.AccAddr()
+		inner := &mock.BroadcasterMock{BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }}
+		b := broadcast.WithLanes(broadcast.Lane{Sender: laneAddr, Broadcaster: inner})
+
+		msg := rewardtypes.NewRefundMsgRequest(proxy, tsstypes.NewHeartBeatRequest(proxy, nil))
+		_, err := b.Broadcast(context.Background(), msg)
+		assert.NoError(t, err)
+
+		sent := inner.BroadcastCalls()[0].Msgs[0].(*rewardtypes.RefundMsgRequest)
+		assert.Equal(t, laneAddr, sent.Sender)
+		assert.Equal(t, []sdk.AccAddress{laneAddr}, sent.GetInnerMessage().GetSigners())
+
+		var encoded tsstypes.HeartBeatRequest
+		assert.NoError(t, encoded.Unmarshal(sent.InnerMessage.Value))
+		assert.Equal(t, laneAddr, encoded.Sender)
+
+		assert.Equal(t, []sdk.AccAddress{proxy}, msg.GetInnerMessage().GetSigners(), "the original msg must not be modified")
+	})
+
+	t.Run("balances broadcasts across lanes", func(t *testing.T) {
+		release := make(chan struct{})
+		started := make(chan sdk.AccAddress, 3)
+
+		var lanes []broadcast.Lane
+		for i := 0; i < 2; i++ {
+			laneAddr := rand2.AccAddr()
+			lanes = append(lanes, broadcast.Lane{Sender: laneAddr, Broadcaster: &mock.BroadcasterMock{
+				BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+					started <- laneAddr
+					<-release
+					return &sdk.TxResponse{}, nil
+				},
+			}})
+		}
+		b := broadcast.WithLanes(lanes...)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := b.Broadcast(context.Background(), newVote())
+				assert.NoError(t, err)
+			}()
+		}
+
+		// a busy lane does not block the other one
+		assert.ElementsMatch(t, []sdk.AccAddress{lanes[0].Sender, lanes[1].Sender}, []sdk.AccAddress{<-started, <-started})
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("rejects msgs without sender", func(t *testing.T) {
+		inner := &mock.BroadcasterMock{}
+		b := broadcast.WithLanes(broadcast.Lane{Sender: rand2.AccAddr(), Broadcaster: inner})
+
+		_, err := b.Broadcast(context.Background(), banktypes.NewMsgSend(proxy, rand2.AccAddr(), sdk.NewCoins()))
+		assert.Error(t, err)
+		assert.Len(t, inner.BroadcastCalls(), 0)
+	})
+}
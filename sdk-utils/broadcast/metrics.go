@@ -0,0 +1,76 @@
+package broadcast
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	queueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "queue_depth",
+		Help:      "Number of broadcast tasks waiting in the backlog",
+	})
+	retryCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "retries_total",
+		Help:      "Number of times a broadcast has been retried after a failure",
+	})
+	broadcastCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "txs_total",
+		Help:      "Number of broadcast txs by result",
+	}, []string{"result"})
+	droppedCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "expired_msgs_total",
+		Help:      "Number of msgs dropped because their deadline passed before they were broadcast",
+	})
+	laneInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "lane_in_flight",
+		Help:      "Number of broadcasts in flight per broadcast lane",
+	}, []string{"lane"})
+	gasPrice = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "gas_price",
+		Help:      "Gas price of the latest tx",
+	})
+	blockFullness = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "block_fullness",
+		Help:      "Average fullness of the latest blocks, between 0 and 1",
+	})
+	feesPaid = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "fees_total",
+		Help:      "Fees paid for broadcast txs, by whether they are refunded",
+	}, []string{"refund"})
+)
+
+// RegisterMetrics registers all broadcaster metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{queueDepth, retryCount, broadcastCount, droppedCount, laneInFlight, gasPrice, blockFullness, feesPaid} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func observeBroadcast(err error) {
+	if err != nil {
+		broadcastCount.WithLabelValues("failure").Inc()
+		return
+	}
+
+	broadcastCount.WithLabelValues("success").Inc()
+}
@@ -0,0 +1,98 @@
+package broadcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMetrics(t *testing.T) {
+	var (
+		registry    *prometheus.Registry
+		broadcaster *mock2.BroadcasterMock
+	)
+
+	value := func(t *testing.T, name string, labels map[string]string) float64 {
+		return testutils.MetricValue(t, registry, name, labels)
+	}
+
+	Given("a registry with the broadcast metrics", func() {
+		registry = prometheus.NewRegistry()
+		assert.NoError(t, broadcast.RegisterMetrics(registry))
+	}).
+		Given("a broadcaster", func() {
+			broadcaster = &mock2.BroadcasterMock{}
+		}).
+		Branch(
+			When("a broadcast fails", func() {}).
+				Then("count the failed broadcast", func(t *testing.T) {
+					failures := value(t, "vald_broadcast_txs_total", map[string]string{"result": "failure"})
+
+					_, err := broadcast.WithStateManager(client.Context{}, tx.Factory{}, broadcast.WithSigner(&mock2.TxSignerMock{})).
+						Broadcast(context.Background())
+					assert.Error(t, err)
+
+					assert.Equal(t, failures+1, value(t, "vald_broadcast_txs_total", map[string]string{"result": "failure"}))
+				}),
+
+			When("the broadcast keeps failing", func() {
+				broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+					return nil, errors.New("some error")
+				}
+			}).
+				Then("count retries", func(t *testing.T) {
+					retries := value(t, "vald_broadcast_retries_total", nil)
+
+					_, err := broadcast.WithRetry(broadcaster, 3, 1*time.Nanosecond, nil).Broadcast(context.Background(), randomMsgs(1)...)
+					assert.Error(t, err)
+
+					assert.Equal(t, retries+3, value(t, "vald_broadcast_retries_total", nil))
+				}),
+
+			When("msgs expire in the backlog", func() {}).
+				Then("count the dropped msgs", func(t *testing.T) {
+					expired := value(t, "vald_broadcast_expired_msgs_total", nil)
+
+					batched := broadcast.Batched(broadcaster, 1, 5, func() int64 { return 10 })
+					_, err := batched.Broadcast(broadcast.WithDeadline(context.Background(), 5), randomMsgs(3)...)
+					assert.ErrorIs(t, err, broadcast.ErrDeadlineExceeded)
+
+					assert.Equal(t, expired+3, value(t, "vald_broadcast_expired_msgs_total", nil))
+					assert.Len(t, broadcaster.BroadcastCalls(), 0)
+				}),
+
+			When("the broadcast is blocked", func() {
+				broadcastCalled := make(chan struct{}, 1)
+				broadcaster.BroadcastFunc = func(ctx context.Context, _ ...sdk.Msg) (*sdk.TxResponse, error) {
+					broadcastCalled <- struct{}{}
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}
+			}).
+				Then("track the backlog depth", func(t *testing.T) {
+					ctx, cancel := context.WithCancel(context.Background())
+					defer cancel()
+
+					batched := broadcast.Batched(broadcaster, 100, 5, nil)
+					for i := 0; i < 4; i++ {
+						go func() { _, _ = batched.Broadcast(ctx, randomMsgs(1)...) }()
+					}
+
+					assert.Eventually(t, func() bool {
+						return len(broadcaster.BroadcastCalls()) == 1 && value(t, "vald_broadcast_queue_depth", nil) == 3
+					}, time.Second, 10*time.Millisecond)
+				}),
+		).Run(t)
+}
@@ -11,24 +11,28 @@ import (
 	"github.com/axelarnetwork/utils/log"
 )
 
-// retryPipeline manages serialized execution of functions with retry on error
+// retryPipeline manages serialized execution of functions with retry on error.
+// Queued functions are executed in order of the priority and deadline of their context.
 type retryPipeline struct {
-	c          chan func()
-	backOff    utils.BackOff
-	maxRetries int
+	queue        *priorityQueue[func()]
+	backOff      utils.BackOff
+	maxRetries   int
+	latestHeight func() int64
 }
 
-// newPipelineWithRetry returns a retryPipeline with the given configuration
-func newPipelineWithRetry(cap int, maxRetries int, backOffStrategy utils.BackOff) *retryPipeline {
+// newPipelineWithRetry returns a retryPipeline with the given configuration.
+// If latestHeight is not nil, functions are no longer executed once the height reaches the deadline of their context.
+func newPipelineWithRetry(cap int, maxRetries int, backOffStrategy utils.BackOff, latestHeight func() int64) *retryPipeline {
 	p := &retryPipeline{
-		c:          make(chan func(), cap),
-		backOff:    backOffStrategy,
-		maxRetries: maxRetries,
+		queue:        newPriorityQueue[func()](cap),
+		backOff:      backOffStrategy,
+		maxRetries:   maxRetries,
+		latestHeight: latestHeight,
 	}
 
 	go func() {
-		for f := range p.c {
-			f()
+		for {
+			p.queue.Pop()()
 		}
 	}()
 
@@ -38,13 +42,19 @@ func newPipelineWithRetry(cap int, maxRetries int, backOffStrategy utils.BackOff
 // Push adds the given function to the serialized execution retryPipeline
 func (p *retryPipeline) Push(ctx context.Context, f func(context.Context) error, retryOnError func(error) bool) error {
 	e := make(chan error, 1)
-	p.c <- func() { e <- p.retry(ctx, f, retryOnError) }
+	p.queue.Push(ctx, func() { e <- p.retry(ctx, f, retryOnError) })
 	return <-e
 }
 
 func (p retryPipeline) retry(ctx context.Context, f func(context.Context) error, retryOnError func(error) bool) error {
 	var err error
 	for i := 0; i <= p.maxRetries; i++ {
+		if isExpired(ctx, p.latestHeight) {
+			deadline, _ := DeadlineOf(ctx)
+			droppedCount.Inc()
+			return sdkerrors.Wrapf(ErrDeadlineExceeded, "deadline %d after %d attempts", deadline, i)
+		}
+
 		ctx = log.Append(ctx, "num_attempts", i+1)
 		err = f(ctx)
 		if err == nil {
@@ -59,6 +69,7 @@ func (p retryPipeline) retry(ctx context.Context, f func(context.Context) error,
 		}
 
 		if i < p.maxRetries {
+			retryCount.Inc()
 			timeout := p.backOff(i)
 			log.FromCtx(ctx).Infof("backing off (retry in %v )", timeout)
 			time.Sleep(timeout)
@@ -66,8 +77,3 @@ func (p retryPipeline) retry(ctx context.Context, f func(context.Context) error,
 	}
 	return sdkerrors.Wrap(err, fmt.Sprintf("aborting after %d retries", p.maxRetries))
 }
-
-// Close closes the retryPipeline
-func (p *retryPipeline) Close() {
-	close(p.c)
-}
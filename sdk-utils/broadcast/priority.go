@@ -0,0 +1,173 @@
+package broadcast
+
+import (
+	"container/heap"
+	"context"
+	goerrors "errors"
+	"math"
+	"sync"
+)
+
+// ErrDeadlineExceeded is returned for broadcasts that are dropped because the block height reached their deadline before they were sent
+var ErrDeadlineExceeded = goerrors.New("broadcast deadline exceeded")
+
+// Priority determines the order in which queued broadcasts are sent
+type Priority int
+
+// Broadcasts with higher priority are sent first. Broadcasts without priority have normal priority.
+const (
+	PriorityLow Priority = iota - 1
+	PriorityNormal
+	PriorityHigh
+)
+
+type priorityKey struct{}
+type deadlineKey struct{}
+
+// WithPriority returns a context that broadcasts its msgs with the given priority
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityOf returns the broadcast priority of the context and whether it was set explicitly
+func PriorityOf(ctx context.Context) (Priority, bool) {
+	priority, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok {
+		return PriorityNormal, false
+	}
+
+	return priority, true
+}
+
+// WithDeadline returns a context that drops its msgs instead of broadcasting them once the latest block height reaches the given height
+func WithDeadline(ctx context.Context, height int64) context.Context {
+	return context.WithValue(ctx, deadlineKey{}, height)
+}
+
+// DeadlineOf returns the broadcast deadline of the context and whether it is set
+func DeadlineOf(ctx context.Context) (int64, bool) {
+	deadline, ok := ctx.Value(deadlineKey{}).(int64)
+	return deadline, ok && deadline > 0
+}
+
+// isExpired returns true if the latest block height has reached the deadline of the context.
+// Contexts never expire if no height source is given.
+func isExpired(ctx context.Context, latestHeight func() int64) bool {
+	if latestHeight == nil {
+		return false
+	}
+
+	deadline, ok := DeadlineOf(ctx)
+	return ok && latestHeight() >= deadline
+}
+
+// priorityQueue is a blocking queue that returns items by priority, then by deadline (earliest first), then in insertion order
+type priorityQueue[T any] struct {
+	lock     sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	capacity int
+	items    queuedItems[T]
+	seq      uint64
+}
+
+func newPriorityQueue[T any](capacity int) *priorityQueue[T] {
+	q := &priorityQueue[T]{capacity: capacity}
+	q.notEmpty = sync.NewCond(&q.lock)
+	q.notFull = sync.NewCond(&q.lock)
+
+	return q
+}
+
+// Push adds an item to the queue, ordered by the priority and deadline of the given context. Blocks while the queue is full.
+func (q *priorityQueue[T]) Push(ctx context.Context, item T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for len(q.items) >= q.capacity {
+		q.notFull.Wait()
+	}
+
+	priority, _ := PriorityOf(ctx)
+	deadline, ok := DeadlineOf(ctx)
+	if !ok {
+		deadline = math.MaxInt64
+	}
+
+	q.seq++
+	heap.Push(&q.items, &queuedItem[T]{item: item, priority: priority, deadline: deadline, seq: q.seq})
+	q.notEmpty.Signal()
+}
+
+// Pop removes and returns the next item. Blocks while the queue is empty.
+func (q *priorityQueue[T]) Pop() T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for len(q.items) == 0 {
+		q.notEmpty.Wait()
+	}
+
+	next := heap.Pop(&q.items).(*queuedItem[T])
+	q.notFull.Signal()
+
+	return next.item
+}
+
+// Peek returns the next item without removing it. Blocks while the queue is empty.
+func (q *priorityQueue[T]) Peek() T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for len(q.items) == 0 {
+		q.notEmpty.Wait()
+	}
+
+	return q.items[0].item
+}
+
+// Len returns the number of queued items
+func (q *priorityQueue[T]) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return len(q.items)
+}
+
+type queuedItem[T any] struct {
+	item     T
+	priority Priority
+	deadline int64
+	seq      uint64
+}
+
+// queuedItems implements heap.Interface
+type queuedItems[T any] []*queuedItem[T]
+
+func (q queuedItems[T]) Len() int { return len(q) }
+
+func (q queuedItems[T]) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+
+	if q[i].deadline != q[j].deadline {
+		return q[i].deadline < q[j].deadline
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q queuedItems[T]) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queuedItems[T]) Push(x interface{}) { *q = append(*q, x.(*queuedItem[T])) }
+
+func (q *queuedItems[T]) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+
+	return item
+}
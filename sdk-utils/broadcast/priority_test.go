@@ -0,0 +1,106 @@
+package broadcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+)
+
+func TestBatched_Priorities(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var (
+		lock sync.Mutex
+		sent []sdk.Msg
+	)
+	broadcaster := &mock2.BroadcasterMock{
+		BroadcastFunc: func(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+			lock.Lock()
+			sent = append(sent, msgs...)
+			first := len(sent) == 1
+			lock.Unlock()
+
+			if first {
+				close(started)
+				<-release
+			}
+			return &sdk.TxResponse{}, nil
+		},
+	}
+	batched := broadcast.Batched(broadcaster, 100, 5, nil)
+
+	var wg sync.WaitGroup
+	broadcastAsync := func(ctx context.Context, msg sdk.Msg) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := batched.Broadcast(ctx, msg)
+			assert.NoError(t, err)
+		}()
+	}
+
+	// block the backlog with a first msg
+	broadcastAsync(context.Background(), randomMsgs(1)[0])
+	<-started
+
+	msgs := randomMsgs(5)
+	queued := []context.Context{
+		broadcast.WithPriority(context.Background(), broadcast.PriorityLow),
+		context.Background(),
+		broadcast.WithDeadline(context.Background(), 20),
+		broadcast.WithPriority(context.Background(), broadcast.PriorityHigh),
+		broadcast.WithDeadline(context.Background(), 10),
+	}
+	for i, ctx := range queued {
+		broadcastAsync(ctx, msgs[i])
+		// make sure the msgs are queued in the given order
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, []sdk.Msg{msgs[3], msgs[4], msgs[2], msgs[1], msgs[0]}, sent[1:])
+}
+
+func TestBatched_Deadlines(t *testing.T) {
+	broadcaster := &mock2.BroadcasterMock{
+		BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+	}
+	batched := broadcast.Batched(broadcaster, 100, 5, func() int64 { return 10 })
+
+	_, err := batched.Broadcast(broadcast.WithDeadline(context.Background(), 10), randomMsgs(1)...)
+	assert.ErrorIs(t, err, broadcast.ErrDeadlineExceeded)
+	assert.Len(t, broadcaster.BroadcastCalls(), 0)
+
+	_, err = batched.Broadcast(broadcast.WithDeadline(context.Background(), 11), randomMsgs(1)...)
+	assert.NoError(t, err)
+	_, err = batched.Broadcast(context.Background(), randomMsgs(1)...)
+	assert.NoError(t, err)
+	assert.Len(t, broadcaster.BroadcastCalls(), 2)
+}
+
+func TestWithRetry_Deadlines(t *testing.T) {
+	var height int64 = 10
+	broadcaster := &mock2.BroadcasterMock{
+		BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+			atomic.AddInt64(&height, 1)
+			return nil, errors.New("some error")
+		},
+	}
+	retry := broadcast.WithRetry(broadcaster, 5, time.Nanosecond, func() int64 { return atomic.LoadInt64(&height) })
+
+	_, err := retry.Broadcast(broadcast.WithDeadline(context.Background(), 12), randomMsgs(1)...)
+	assert.ErrorIs(t, err, broadcast.ErrDeadlineExceeded)
+	assert.Len(t, broadcaster.BroadcastCalls(), 2)
+}
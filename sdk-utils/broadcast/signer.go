@@ -0,0 +1,103 @@
+package broadcast
+
+import (
+	"context"
+	"fmt"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+//go:generate moq -pkg mock -out mock/signer.go . TxSigner
+
+// TxSigner is the backend that signs txs on behalf of a broadcaster account
+type TxSigner interface {
+	// PubKey returns the public key of the key with the given name
+	PubKey(ctx context.Context, keyName string) (cryptotypes.PubKey, error)
+	// Sign signs the given sign bytes, created in the given sign mode, with the key of the given name
+	Sign(ctx context.Context, keyName string, signMode signing.SignMode, signBytes []byte) ([]byte, error)
+}
+
+type keyringSigner struct {
+	keyring keyring.Keyring
+}
+
+// KeyringSigner returns a tx signer that signs with the keys of the given keyring
+func KeyringSigner(kr keyring.Keyring) TxSigner {
+	return keyringSigner{keyring: kr}
+}
+
+// PubKey implements the TxSigner interface
+func (s keyringSigner) PubKey(_ context.Context, keyName string) (cryptotypes.PubKey, error) {
+	if s.keyring == nil {
+		return nil, fmt.Errorf("keyring must be set prior to signing a transaction")
+	}
+
+	info, err := s.keyring.Key(keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.GetPubKey(), nil
+}
+
+// Sign implements the TxSigner interface
+func (s keyringSigner) Sign(_ context.Context, keyName string, _ signing.SignMode, signBytes []byte) ([]byte, error) {
+	if s.keyring == nil {
+		return nil, fmt.Errorf("keyring must be set prior to signing a transaction")
+	}
+
+	sig, _, err := s.keyring.Sign(keyName, signBytes)
+	return sig, err
+}
+
+// signTx signs the tx with the given signer and overwrites all previous signatures.
+// It mirrors tx.Sign, but obtains the public key and signature from the signer instead of the factory's keyring.
+func signTx(ctx sdkClient.Context, txf tx.Factory, signer TxSigner, keyName string, txBuilder sdkClient.TxBuilder) error {
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = ctx.TxConfig.SignModeHandler().DefaultMode()
+	}
+
+	if signMode == signing.SignMode_SIGN_MODE_DIRECT && len(txBuilder.GetTx().GetSigners()) > 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "signing in DIRECT mode is only supported for transactions with one signer only")
+	}
+
+	pubKey, err := signer.PubKey(context.Background(), keyName)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to get public key of %s", keyName)
+	}
+
+	// the signer infos are part of the sign bytes, so the signature must be set before the sign bytes can be generated
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+	}
+	signBytes, err := ctx.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	sigBytes, err := signer.Sign(context.Background(), keyName, signMode, signBytes)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to sign with %s", keyName)
+	}
+
+	sig.Data = &signing.SingleSignatureData{SignMode: signMode, Signature: sigBytes}
+	return txBuilder.SetSignatures(sig)
+}
@@ -0,0 +1,56 @@
+package broadcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func TestPrepareTx_Signer(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{TxConfig: encCfg.TxConfig}.WithFromName("broadcaster")
+	txf := tx.Factory{}.
+		WithChainID("axelar").
+		WithAccountNumber(3).
+		WithSequence(7).
+		WithGas(100000).
+		WithTxConfig(encCfg.TxConfig)
+
+	privKey := secp256k1.GenPrivKey()
+	signer := &mock2.TxSignerMock{
+		PubKeyFunc: func(context.Context, string) (cryptotypes.PubKey, error) { return privKey.PubKey(), nil },
+		SignFunc: func(_ context.Context, _ string, _ signing.SignMode, signBytes []byte) ([]byte, error) {
+			return privKey.Sign(signBytes)
+		},
+	}
+
+	bz, err := broadcast.PrepareTx(clientCtx, txf, signer, randomMsgs(2)...)
+	assert.NoError(t, err)
+
+	assert.Len(t, signer.PubKeyCalls(), 1)
+	assert.Equal(t, "broadcaster", signer.PubKeyCalls()[0].KeyName)
+	assert.Len(t, signer.SignCalls(), 1)
+	assert.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, signer.SignCalls()[0].SignMode)
+
+	decoded := funcs.Must(encCfg.TxConfig.TxDecoder()(bz)).(authsigning.Tx)
+	sigs := funcs.Must(decoded.GetSignaturesV2())
+	assert.Len(t, sigs, 1)
+	assert.Equal(t, privKey.PubKey(), sigs[0].PubKey)
+	assert.EqualValues(t, 7, sigs[0].Sequence)
+
+	signBytes := funcs.Must(encCfg.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{ChainID: "axelar", AccountNumber: 3, Sequence: 7}, decoded))
+	assert.True(t, privKey.PubKey().VerifySignature(signBytes, sigs[0].Data.(*signing.SingleSignatureData).Signature))
+}
@@ -0,0 +1,78 @@
+package broadcast
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PendingTask is a broadcast that has been requested but has not completed yet
+type PendingTask struct {
+	Msgs  []string  `json:"msgs"`
+	Since time.Time `json:"since"`
+}
+
+// TrackingBroadcaster keeps track of all broadcasts that have not completed yet
+type TrackingBroadcaster struct {
+	broadcaster Broadcaster
+
+	lock    sync.Mutex
+	nextID  uint64
+	pending map[uint64]PendingTask
+}
+
+// WithTracking wraps a broadcaster into a TrackingBroadcaster
+func WithTracking(broadcaster Broadcaster) *TrackingBroadcaster {
+	return &TrackingBroadcaster{
+		broadcaster: broadcaster,
+		pending:     make(map[uint64]PendingTask),
+	}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *TrackingBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	id := b.track(msgs)
+	defer b.untrack(id)
+
+	return b.broadcaster.Broadcast(ctx, msgs...)
+}
+
+// Pending returns all broadcasts that have not completed yet, oldest first
+func (b *TrackingBroadcaster) Pending() []PendingTask {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	tasks := make([]PendingTask, 0, len(b.pending))
+	for _, task := range b.pending {
+		tasks = append(tasks, task)
+	}
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].Since.Before(tasks[j].Since) })
+
+	return tasks
+}
+
+func (b *TrackingBroadcaster) track(msgs []sdk.Msg) uint64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	msgTypes := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(msg))
+	}
+
+	id := b.nextID
+	b.nextID++
+	b.pending[id] = PendingTask{Msgs: msgTypes, Since: time.Now()}
+
+	return id
+}
+
+func (b *TrackingBroadcaster) untrack(id uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.pending, id)
+}
@@ -0,0 +1,41 @@
+package testutils
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+// MetricValue gathers all metrics from the given gatherer and returns the summed value of the samples of the named metric that carry all given labels.
+// Counters and gauges contribute their value, histograms and summaries their sample count.
+func MetricValue(t *testing.T, gatherer prometheus.Gatherer, name string, labels map[string]string) float64 {
+	families, err := gatherer.Gather()
+	assert.NoError(t, err)
+
+	var value float64
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			matches := 0
+			for _, label := range metric.GetLabel() {
+				if v, ok := labels[label.GetName()]; ok && v == label.GetValue() {
+					matches++
+				}
+			}
+			if matches != len(labels) {
+				continue
+			}
+
+			value += metric.GetCounter().GetValue() +
+				metric.GetGauge().GetValue() +
+				float64(metric.GetHistogram().GetSampleCount()) +
+				float64(metric.GetSummary().GetSampleCount())
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,258 @@
+package vald
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const (
+	// recentErrorsLimit is the number of most recent errors that are kept per job
+	recentErrorsLimit = 20
+	// adminTokenHeader is the header that carries the admin API token
+	adminTokenHeader = "X-Vald-Admin-Token"
+)
+
+// jobStatuses collects the status of all jobs for the admin API
+var jobStatuses = newJobStatusTracker()
+
+// JobError is an error returned by a job while processing an event
+type JobError struct {
+	Time  time.Time `json:"time"`
+	Error string    `json:"error"`
+}
+
+// JobStatus is the processing status of a single job
+type JobStatus struct {
+	Name            string     `json:"name"`
+	EventsProcessed uint64     `json:"events_processed"`
+	Errors          uint64     `json:"errors"`
+	LastProcessed   *time.Time `json:"last_processed,omitempty"`
+	RecentErrors    []JobError `json:"recent_errors"`
+}
+
+type jobStatusTracker struct {
+	lock     sync.Mutex
+	statuses map[string]*JobStatus
+}
+
+func newJobStatusTracker() *jobStatusTracker {
+	return &jobStatusTracker{statuses: make(map[string]*JobStatus)}
+}
+
+func (t *jobStatusTracker) status(job string) *JobStatus {
+	status, ok := t.statuses[job]
+	if !ok {
+		status = &JobStatus{Name: job, RecentErrors: []JobError{}}
+		t.statuses[job] = status
+	}
+
+	return status
+}
+
+// register makes the job known to the tracker before it processes its first event
+func (t *jobStatusTracker) register(job string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.status(job)
+}
+
+func (t *jobStatusTracker) observe(job string, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	status := t.status(job)
+	status.EventsProcessed++
+	status.LastProcessed = &now
+
+	if err == nil {
+		return
+	}
+
+	status.Errors++
+	status.RecentErrors = append(status.RecentErrors, JobError{Time: now, Error: err.Error()})
+	if len(status.RecentErrors) > recentErrorsLimit {
+		status.RecentErrors = status.RecentErrors[len(status.RecentErrors)-recentErrorsLimit:]
+	}
+}
+
+// all returns a copy of all job statuses sorted by job name
+func (t *jobStatusTracker) all() []JobStatus {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	statuses := make([]JobStatus, 0, len(t.statuses))
+	for _, status := range t.statuses {
+		s := *status
+		s.RecentErrors = append([]JobError{}, status.RecentErrors...)
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	return statuses
+}
+
+// EVMChainStatus is the status of vald's connection to an EVM chain
+type EVMChainStatus struct {
+	Chain                string   `json:"chain"`
+	RPCEndpoints         []string `json:"rpc_endpoints"`
+	LatestFinalizedBlock string   `json:"latest_finalized_block"`
+	Paused               bool     `json:"paused"`
+}
+
+// adminServer serves runtime information about vald and allows operators to pause vote processing per chain
+type adminServer struct {
+	evmMgr      *evm.Mgr
+	evmConfigs  *evmConfigReloader
+	broadcaster *broadcast.TrackingBroadcaster
+	token       string
+}
+
+func (s adminServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", s.getOnly(s.listJobs))
+	mux.HandleFunc("/evm/chains", s.getOnly(s.listEVMChains))
+	mux.HandleFunc("/evm/chains/", s.updateEVMChain)
+	mux.HandleFunc("/broadcaster/pending", s.getOnly(s.listPendingBroadcasts))
+
+	return s.authorized(mux)
+}
+
+// authorized rejects all requests that do not carry the admin token if one is set.
+// Browsers cannot attach custom headers to cross-site requests without a preflight, so this also prevents cross-site request forgery.
+func (s adminServer) authorized(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(adminTokenHeader)), []byte(s.token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (s adminServer) getOnly(handle http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		handle(w, r)
+	}
+}
+
+func (s adminServer) listJobs(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, jobStatuses.all())
+}
+
+func (s adminServer) listEVMChains(w http.ResponseWriter, _ *http.Request) {
+	paused := make(map[string]bool)
+	for _, chain := range s.evmMgr.PausedChains() {
+		paused[chain] = true
+	}
+
+	rpcChains := s.evmMgr.RPCChains()
+	chains := make([]EVMChainStatus, 0, len(rpcChains))
+	for _, chain := range rpcChains {
+		chains = append(chains, EVMChainStatus{
+			Chain:                chain,
+			RPCEndpoints:         s.evmConfigs.RPCEndpoints(nexus.ChainName(chain)),
+			LatestFinalizedBlock: s.evmMgr.LatestFinalizedBlockNumber(nexus.ChainName(chain)).String(),
+			Paused:               paused[chain],
+		})
+	}
+
+	writeJSON(w, chains)
+}
+
+// updateEVMChain handles POST /evm/chains/{chain}/pause and POST /evm/chains/{chain}/resume
+func (s adminServer) updateEVMChain(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/evm/chains/"), "/")
+	if len(parts) != 2 || parts[0] == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	chain := nexus.ChainName(parts[0])
+	var changed bool
+	switch parts[1] {
+	case "pause":
+		changed = s.evmMgr.Pause(chain)
+	case "resume":
+		changed = s.evmMgr.Resume(chain)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, map[string]bool{"changed": changed})
+}
+
+func (s adminServer) listPendingBroadcasts(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, s.broadcaster.Pending())
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("failed to write admin API response: %s", err.Error())
+	}
+}
+
+// createAdminServer returns a job that serves the admin API until the context is canceled.
+// Unless the API is served on a unix socket, requests must be authorized with the configured token.
+func createAdminServer(cfg config.AdminConfig, s adminServer) jobs.Job {
+	return func(ctx context.Context) error {
+		if !strings.HasPrefix(cfg.ListenAddr, "unix://") && cfg.Token == "" {
+			return fmt.Errorf("admin API listening on %s requires a token, or listen on a unix socket instead", cfg.ListenAddr)
+		}
+
+		listener, err := listenOn(cfg.ListenAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", cfg.ListenAddr, err)
+		}
+
+		s.token = cfg.Token
+		server := &http.Server{Handler: s.handler(), ReadHeaderTimeout: 5 * time.Second}
+
+		go func() {
+			<-ctx.Done()
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				log.Errorf("failed to shut down admin server: %s", err.Error())
+			}
+		}()
+
+		log.Infof("serving admin API on %s", cfg.ListenAddr)
+		if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	}
+}
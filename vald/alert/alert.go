@@ -0,0 +1,46 @@
+package alert
+
+import (
+	"context"
+	"time"
+)
+
+// Condition is a critical condition of vald that operators are alerted about
+type Condition string
+
+// Conditions vald alerts on
+const (
+	// NoNewBlocks fires if vald has not received a new block from the node for a while
+	NoNewBlocks Condition = "no_new_blocks"
+	// LowBalance fires if the balance of the broadcaster account is below the configured minimum
+	LowBalance Condition = "low_balance"
+	// TofndUnavailable fires if no tofnd endpoint is healthy
+	TofndUnavailable Condition = "tofnd_unavailable"
+	// KeygenOptOut fires if the validator is opted out of future keygens
+	KeygenOptOut Condition = "keygen_opt_out"
+)
+
+// Status is the status of an alert
+type Status string
+
+// Alert statuses
+const (
+	Firing   Status = "firing"
+	Resolved Status = "resolved"
+)
+
+// Alert notifies operators that a condition started or stopped
+type Alert struct {
+	Condition Condition `json:"condition"`
+	Status    Status    `json:"status"`
+	Validator string    `json:"validator"`
+	Message   string    `json:"message"`
+	// Since is the time the condition was first observed
+	Since time.Time `json:"since"`
+	Time  time.Time `json:"time"`
+}
+
+// Sink delivers alerts to operators
+type Sink interface {
+	Send(ctx context.Context, alert Alert) error
+}
@@ -0,0 +1,128 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/axelarnetwork/utils/log"
+)
+
+// queueSize is the number of alerts that can wait to be sent before new ones are dropped
+const queueSize = 100
+
+type conditionState struct {
+	failures int
+	since    time.Time
+	firing   bool
+	lastSent time.Time
+}
+
+// Manager turns observations of conditions into alerts. A condition fires once it has been observed as failing
+// the threshold number of times in a row. Firing alerts are repeated at most once per repeat interval,
+// and a resolved alert is sent once a firing condition is observed as ok again.
+type Manager struct {
+	validator      string
+	sinks          []Sink
+	thresholds     map[Condition]int
+	repeatInterval time.Duration
+	sendTimeout    time.Duration
+	now            func() time.Time
+
+	lock   sync.Mutex
+	states map[Condition]*conditionState
+	queue  chan Alert
+}
+
+// NewManager returns a new alert manager. Conditions without a threshold fire on the first failing observation.
+func NewManager(validator string, sinks []Sink, thresholds map[Condition]int, repeatInterval time.Duration, sendTimeout time.Duration) *Manager {
+	return &Manager{
+		validator:      validator,
+		sinks:          sinks,
+		thresholds:     thresholds,
+		repeatInterval: repeatInterval,
+		sendTimeout:    sendTimeout,
+		now:            time.Now,
+		states:         make(map[Condition]*conditionState),
+		queue:          make(chan Alert, queueSize),
+	}
+}
+
+// Observe records the current state of the condition. A nil error means the condition is ok.
+func (m *Manager) Observe(condition Condition, err error) {
+	if len(m.sinks) == 0 {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	state, ok := m.states[condition]
+	if !ok {
+		state = &conditionState{}
+		m.states[condition] = state
+	}
+
+	now := m.now()
+	if err == nil {
+		if state.firing {
+			m.enqueue(Alert{Condition: condition, Status: Resolved, Message: "condition resolved", Since: state.since, Time: now})
+		}
+
+		*state = conditionState{}
+		return
+	}
+
+	if state.failures == 0 {
+		state.since = now
+	}
+	state.failures++
+
+	if state.failures < max(m.thresholds[condition], 1) {
+		return
+	}
+
+	// duplicates of a firing alert are only sent again after the repeat interval
+	if state.firing && now.Sub(state.lastSent) < m.repeatInterval {
+		return
+	}
+
+	state.firing = true
+	state.lastSent = now
+	m.enqueue(Alert{Condition: condition, Status: Firing, Message: err.Error(), Since: state.since, Time: now})
+}
+
+func (m *Manager) enqueue(alert Alert) {
+	alert.Validator = m.validator
+
+	select {
+	case m.queue <- alert:
+	default:
+		log.Errorf("alert queue is full, dropping %s alert for condition %s", alert.Status, alert.Condition)
+	}
+}
+
+// Run sends queued alerts to all sinks until the context is done
+func (m *Manager) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case alert := <-m.queue:
+			m.send(ctx, alert)
+		}
+	}
+}
+
+func (m *Manager) send(ctx context.Context, alert Alert) {
+	logger := log.WithKeyVals("condition", alert.Condition, "status", alert.Status)
+	logger.Infof("sending alert: %s", alert.Message)
+
+	for _, sink := range m.sinks {
+		sendCtx, cancel := context.WithTimeout(ctx, m.sendTimeout)
+		if err := sink.Send(sendCtx, alert); err != nil {
+			logger.Errorf("failed to send alert: %s", err.Error())
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,144 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/axelarnetwork/utils/test"
+)
+
+type recordingSink struct {
+	lock   sync.Mutex
+	alerts []Alert
+}
+
+func (s *recordingSink) Send(_ context.Context, alert Alert) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.alerts = append(s.alerts, alert)
+	return nil
+}
+
+func (s *recordingSink) Alerts() []Alert {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]Alert{}, s.alerts...)
+}
+
+func TestManager(t *testing.T) {
+	var (
+		manager *Manager
+		now     time.Time
+	)
+
+	failure := errors.New("tofnd is down")
+
+	queued := func() []Alert {
+		var alerts []Alert
+		for {
+			select {
+			case alert := <-manager.queue:
+				alerts = append(alerts, alert)
+			default:
+				return alerts
+			}
+		}
+	}
+
+	observeFailures := func(condition Condition, n int) {
+		for i := 0; i < n; i++ {
+			manager.Observe(condition, failure)
+			now = now.Add(time.Minute)
+		}
+	}
+
+	givenManager := Given("an alert manager", func() {
+		now = time.Unix(1000, 0)
+		manager = NewManager("validator", []Sink{&recordingSink{}}, map[Condition]int{TofndUnavailable: 3}, time.Hour, time.Second)
+		manager.now = func() time.Time { return now }
+	})
+
+	givenManager.
+		When("a condition fails less often in a row than its threshold", func() {
+			observeFailures(TofndUnavailable, 2)
+			manager.Observe(TofndUnavailable, nil)
+			observeFailures(TofndUnavailable, 2)
+		}).
+		Then("should not fire", func(t *testing.T) {
+			assert.Empty(t, queued())
+		}).
+		Run(t)
+
+	givenManager.
+		When("a condition fails as often in a row as its threshold", func() {
+			observeFailures(TofndUnavailable, 3)
+		}).
+		Then("should fire once since the first failure", func(t *testing.T) {
+			alerts := queued()
+			assert.Len(t, alerts, 1)
+			assert.Equal(t, Alert{
+				Condition: TofndUnavailable,
+				Status:    Firing,
+				Validator: "validator",
+				Message:   failure.Error(),
+				Since:     time.Unix(1000, 0),
+				Time:      time.Unix(1000, 0).Add(2 * time.Minute),
+			}, alerts[0])
+		}).
+		Run(t)
+
+	givenManager.
+		When("a condition without threshold keeps failing", func() {
+			observeFailures(LowBalance, 61)
+		}).
+		Then("should only repeat the alert after the repeat interval", func(t *testing.T) {
+			alerts := queued()
+			assert.Len(t, alerts, 2)
+			assert.Equal(t, time.Hour, alerts[1].Time.Sub(alerts[0].Time))
+		}).
+		Run(t)
+
+	givenManager.
+		When("a firing condition is resolved", func() {
+			observeFailures(LowBalance, 1)
+			manager.Observe(LowBalance, nil)
+			manager.Observe(LowBalance, nil)
+		}).
+		Then("should send a single resolved alert", func(t *testing.T) {
+			alerts := queued()
+			assert.Len(t, alerts, 2)
+			assert.Equal(t, Resolved, alerts[1].Status)
+			assert.Equal(t, alerts[0].Since, alerts[1].Since)
+		}).
+		Run(t)
+
+	t.Run("sends queued alerts to all sinks", func(t *testing.T) {
+		sinks := []*recordingSink{{}, {}}
+		manager := NewManager("validator", []Sink{sinks[0], sinks[1]}, nil, time.Hour, time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			assert.NoError(t, manager.Run(ctx))
+			close(done)
+		}()
+
+		manager.Observe(KeygenOptOut, errors.New("opted out"))
+		assert.Eventually(t, func() bool { return len(sinks[0].Alerts()) == 1 && len(sinks[1].Alerts()) == 1 }, time.Second, 10*time.Millisecond)
+
+		cancel()
+		<-done
+	})
+
+	t.Run("ignores observations without sinks", func(t *testing.T) {
+		manager := NewManager("validator", nil, nil, time.Hour, time.Second)
+		manager.Observe(NoNewBlocks, failure)
+		assert.Empty(t, manager.queue)
+	})
+}
@@ -0,0 +1,118 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WebhookSink posts alerts as JSON to a URL
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a sink that posts alerts to the given URL
+func NewWebhookSink(url string) WebhookSink {
+	return WebhookSink{url: url, client: http.DefaultClient}
+}
+
+// Send implements the Sink interface
+func (s WebhookSink) Send(ctx context.Context, alert Alert) error {
+	bz, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return sdkerrors.Wrap(err, "webhook request failed")
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %s", res.Status)
+	}
+
+	return nil
+}
+
+// ExecSink runs a local command for every alert. The alert is passed as JSON on stdin
+// and its condition, status and message as the ALERT_CONDITION, ALERT_STATUS and ALERT_MESSAGE environment variables.
+type ExecSink struct {
+	command string
+	args    []string
+}
+
+// NewExecSink returns a sink that runs the given command
+func NewExecSink(command string, args ...string) ExecSink {
+	return ExecSink{command: command, args: args}
+}
+
+// Send implements the Sink interface
+func (s ExecSink) Send(ctx context.Context, alert Alert) error {
+	bz, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Stdin = bytes.NewReader(bz)
+	cmd.Env = append(os.Environ(),
+		"ALERT_CONDITION="+string(alert.Condition),
+		"ALERT_STATUS="+string(alert.Status),
+		"ALERT_MESSAGE="+alert.Message,
+	)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return sdkerrors.Wrapf(err, "alert command failed: %s", string(out))
+	}
+
+	return nil
+}
+
+// FileSink appends alerts as JSON lines to a file. It is mostly useful for testing alerting setups
+type FileSink struct {
+	path string
+	lock *sync.Mutex
+}
+
+// NewFileSink returns a sink that writes to the file at the given path
+func NewFileSink(path string) FileSink {
+	return FileSink{path: path, lock: &sync.Mutex{}}
+}
+
+// Send implements the Sink interface
+func (s FileSink) Send(_ context.Context, alert Alert) error {
+	bz, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(bz, '\n'))
+	return err
+}
@@ -0,0 +1,78 @@
+package alert_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/alert"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func newAlert() alert.Alert {
+	return alert.Alert{
+		Condition: alert.LowBalance,
+		Status:    alert.Firing,
+		Validator: "validator",
+		Message:   "balance too low",
+		Since:     time.Unix(1000, 0).UTC(),
+		Time:      time.Unix(2000, 0).UTC(),
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received alert.Alert
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.Unmarshal(funcs.Must(io.ReadAll(r.Body)), &received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := alert.NewWebhookSink(server.URL)
+	assert.NoError(t, sink.Send(context.Background(), newAlert()))
+	assert.Equal(t, newAlert(), received)
+
+	status = http.StatusInternalServerError
+	assert.Error(t, sink.Send(context.Background(), newAlert()))
+}
+
+func TestExecSink(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+
+	sink := alert.NewExecSink("sh", "-c", `cat > "$0"; echo "$ALERT_CONDITION $ALERT_STATUS" > "$0.env"`, out)
+	assert.NoError(t, sink.Send(context.Background(), newAlert()))
+
+	var received alert.Alert
+	assert.NoError(t, json.Unmarshal(funcs.Must(os.ReadFile(out)), &received))
+	assert.Equal(t, newAlert(), received)
+	assert.Equal(t, "low_balance firing\n", string(funcs.Must(os.ReadFile(out+".env"))))
+
+	assert.Error(t, alert.NewExecSink("sh", "-c", "exit 1").Send(context.Background(), newAlert()))
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+
+	sink := alert.NewFileSink(path)
+	assert.NoError(t, sink.Send(context.Background(), newAlert()))
+	assert.NoError(t, sink.Send(context.Background(), newAlert()))
+
+	lines := strings.Split(strings.TrimSpace(string(funcs.Must(os.ReadFile(path)))), "\n")
+	assert.Len(t, lines, 2)
+	for _, line := range lines {
+		var received alert.Alert
+		assert.NoError(t, json.Unmarshal([]byte(line), &received))
+		assert.Equal(t, newAlert(), received)
+	}
+}
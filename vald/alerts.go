@@ -0,0 +1,150 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/axelarnetwork/axelar-core/vald/alert"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// alertCheckInterval is how often vald checks if it still receives new blocks
+const alertCheckInterval = 10 * time.Second
+
+// createAlertManager returns the manager of vald alerts, or nil if alerting is disabled
+func createAlertManager(valdHome string, valAddr sdk.ValAddress, cfg config.AlertingConfig) (*alert.Manager, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var sinks []alert.Sink
+	for _, sinkCfg := range cfg.Sinks {
+		switch sinkCfg.Type {
+		case "webhook":
+			sinks = append(sinks, alert.NewWebhookSink(sinkCfg.URL))
+		case "exec":
+			sinks = append(sinks, alert.NewExecSink(sinkCfg.Command, sinkCfg.Args...))
+		case "file":
+			path := sinkCfg.Path
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(valdHome, path)
+			}
+			sinks = append(sinks, alert.NewFileSink(path))
+		default:
+			return nil, fmt.Errorf("unknown alert sink type '%s'", sinkCfg.Type)
+		}
+	}
+
+	if len(sinks) == 0 {
+		log.Info("alerting is enabled, but no alert sinks are configured")
+	}
+
+	thresholds := map[alert.Condition]int{alert.TofndUnavailable: cfg.TofndFailures}
+	return alert.NewManager(valAddr.String(), sinks, thresholds, cfg.RepeatInterval, cfg.SendTimeout), nil
+}
+
+// watchBlocks returns a job that alerts if no new block has been received for the given duration.
+// The alert only fires once the first block has been received.
+func watchBlocks(alerts *alert.Manager, lastBlockTime func() time.Time, after time.Duration) jobs.Job {
+	return runEvery(alertCheckInterval, func(_ context.Context) {
+		lastBlock := lastBlockTime()
+		if lastBlock.IsZero() {
+			return
+		}
+
+		if elapsed := time.Since(lastBlock); elapsed > after {
+			alerts.Observe(alert.NoNewBlocks, fmt.Errorf("no new blocks received from the node for %s", elapsed.Truncate(time.Second)))
+			return
+		}
+
+		alerts.Observe(alert.NoNewBlocks, nil)
+	})
+}
+
+// watchBalance returns a job that alerts if the balance of the broadcaster drops below the given minimum
+func watchBalance(alerts *alert.Manager, bank bankTypes.QueryClient, broadcaster sdk.AccAddress, minBalance int64, interval time.Duration) jobs.Job {
+	return runEvery(interval, func(ctx context.Context) {
+		queryCtx, cancel := context.WithTimeout(ctx, interval)
+		defer cancel()
+
+		res, err := bank.Balance(queryCtx, bankTypes.NewQueryBalanceRequest(broadcaster, axelarnet.NativeAsset))
+		if err != nil {
+			log.Errorf("failed to query the broadcaster balance: %s", err.Error())
+			return
+		}
+
+		if res.Balance == nil || res.Balance.Amount.LT(sdk.NewInt(minBalance)) {
+			alerts.Observe(alert.LowBalance, fmt.Errorf("broadcaster %s has a balance of %s, below the minimum of %d%s",
+				broadcaster.String(), res.Balance, minBalance, axelarnet.NativeAsset))
+			return
+		}
+
+		alerts.Observe(alert.LowBalance, nil)
+	})
+}
+
+// watchTofnd returns a job that alerts if no tofnd endpoint is healthy
+func watchTofnd(alerts *alert.Manager, healthy func() bool, interval time.Duration) jobs.Job {
+	return runEvery(interval, func(_ context.Context) {
+		if !healthy() {
+			alerts.Observe(alert.TofndUnavailable, fmt.Errorf("no tofnd endpoint is healthy"))
+			return
+		}
+
+		alerts.Observe(alert.TofndUnavailable, nil)
+	})
+}
+
+// isKeygenOptOutOrIn returns true if the event changes the keygen participation of any account
+func isKeygenOptOutOrIn(e tmEvents.ABCIEventWithHeight) bool {
+	return slices.Any([]func(tmEvents.ABCIEventWithHeight) bool{
+		tmEvents.Filter[*multisigTypes.KeygenOptOut](),
+		tmEvents.Filter[*multisigTypes.KeygenOptIn](),
+	}, func(filter func(tmEvents.ABCIEventWithHeight) bool) bool { return filter(e) })
+}
+
+// observeKeygenOptOut alerts while the broadcaster is opted out of keygens
+func observeKeygenOptOut(alerts *alert.Manager, broadcaster sdk.AccAddress) func(event proto.Message) error {
+	return func(event proto.Message) error {
+		switch event := event.(type) {
+		case *multisigTypes.KeygenOptOut:
+			if event.Participant.Equals(broadcaster) {
+				alerts.Observe(alert.KeygenOptOut, fmt.Errorf("broadcaster %s opted out of keygens", broadcaster.String()))
+			}
+		case *multisigTypes.KeygenOptIn:
+			if event.Participant.Equals(broadcaster) {
+				alerts.Observe(alert.KeygenOptOut, nil)
+			}
+		}
+
+		return nil
+	}
+}
+
+func runEvery(interval time.Duration, check func(ctx context.Context)) jobs.Job {
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				check(ctx)
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package vald
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func TestCreateAlertManager(t *testing.T) {
+	cfg := config.DefaultAlertingConfig()
+	assert.Nil(t, funcs.Must(createAlertManager(t.TempDir(), rand.ValAddr(), cfg)))
+
+	cfg.Enabled = true
+	cfg.Sinks = []config.AlertSinkConfig{{Type: "pager"}}
+	_, err := createAlertManager(t.TempDir(), rand.ValAddr(), cfg)
+	assert.Error(t, err)
+}
+
+func TestObserveKeygenOptOut(t *testing.T) {
+	valdHome := t.TempDir()
+	cfg := config.DefaultAlertingConfig()
+	cfg.Enabled = true
+	cfg.Sinks = []config.AlertSinkConfig{{Type: "file", Path: "alerts.jsonl"}}
+	alerts := funcs.Must(createAlertManager(valdHome, rand.ValAddr(), cfg))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = alerts.Run(ctx) }()
+
+	broadcaster := rand.AccAddr()
+	observe := observeKeygenOptOut(alerts, broadcaster)
+	assert.NoError(t, observe(&multisigTypes.KeygenOptOut{Participant: rand.AccAddr()}))
+	assert.NoError(t, observe(&multisigTypes.KeygenOptOut{Participant: broadcaster}))
+	assert.NoError(t, observe(&multisigTypes.KeygenOptIn{Participant: broadcaster}))
+
+	alertLines := func() []string {
+		bz, err := os.ReadFile(filepath.Join(valdHome, "alerts.jsonl"))
+		if err != nil {
+			return nil
+		}
+
+		return strings.Split(strings.TrimSpace(string(bz)), "\n")
+	}
+
+	assert.Eventually(t, func() bool { return len(alertLines()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Contains(t, alertLines()[0], `"status":"firing"`)
+	assert.Contains(t, alertLines()[1], `"status":"resolved"`)
+}
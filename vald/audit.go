@@ -0,0 +1,137 @@
+package vald
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const (
+	flagPollID = "poll-id"
+	flagSigID  = "sig-id"
+	flagChain  = "chain"
+	flagFrom   = "from-time"
+	flagTo     = "to-time"
+)
+
+// auditLogPath returns the path of the audit log, resolving relative paths against the vald home directory
+func auditLogPath(valdHome string, cfg config.AuditConfig) string {
+	if filepath.IsAbs(cfg.Path) {
+		return cfg.Path
+	}
+
+	return filepath.Join(valdHome, cfg.Path)
+}
+
+// openAuditLog opens the audit log, or returns nil if it is disabled
+func openAuditLog(valdHome string, cfg config.AuditConfig) (*audit.Log, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	path := auditLogPath(valdHome, cfg)
+	auditLog, err := audit.Open(path, cfg.MaxSizeMB*1024*1024, cfg.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("writing audit records to %s", path)
+	return auditLog, nil
+}
+
+// GetAuditCommand returns the command to query the audit log of the votes, signatures and public keys vald submitted
+func GetAuditCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-audit",
+		Short: "Print the audit records of the votes, signatures and public keys vald submitted",
+		Long: "Print the audit records of the votes, signatures and public keys vald submitted as JSON lines, oldest first. " +
+			"Rotated logs are included. Records can be filtered by poll, signing session, chain and time range.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			valdCfg := config.DefaultValdConfig()
+			if err := serverCtx.Viper.Unmarshal(&valdCfg, config.AddDecodeHooks); err != nil {
+				return err
+			}
+
+			filter, err := auditFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			valdHome := filepath.Join(client.GetClientContextFromCmd(cmd).HomeDir, "vald")
+			records, err := audit.Read(auditLogPath(valdHome, valdCfg.Audit), valdCfg.Audit.MaxBackups, filter)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			for _, record := range records {
+				if err := encoder.Encode(record); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPollID, "", "only print votes on the given poll")
+	cmd.Flags().String(flagSigID, "", "only print signatures for the given signing session")
+	cmd.Flags().String(flagChain, "", "only print records for the given chain")
+	cmd.Flags().String(flagFrom, "", "only print records written at or after the given time (RFC3339)")
+	cmd.Flags().String(flagTo, "", "only print records written before the given time (RFC3339)")
+
+	return cmd
+}
+
+func auditFilter(cmd *cobra.Command) (audit.Filter, error) {
+	var filter audit.Filter
+	var err error
+
+	if filter.PollID, err = cmd.Flags().GetString(flagPollID); err != nil {
+		return audit.Filter{}, err
+	}
+
+	if filter.SigID, err = cmd.Flags().GetString(flagSigID); err != nil {
+		return audit.Filter{}, err
+	}
+
+	if filter.Chain, err = cmd.Flags().GetString(flagChain); err != nil {
+		return audit.Filter{}, err
+	}
+
+	if filter.From, err = timeFlag(cmd, flagFrom); err != nil {
+		return audit.Filter{}, err
+	}
+
+	if filter.To, err = timeFlag(cmd, flagTo); err != nil {
+		return audit.Filter{}, err
+	}
+
+	return filter, nil
+}
+
+func timeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, sdkerrors.Wrapf(err, "invalid %s", name)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/log"
+)
+
+type auditedBroadcaster struct {
+	broadcaster broadcast.Broadcaster
+	log         *Log
+	endpoints   func(chain nexus.ChainName) []string
+}
+
+// WithAudit returns a broadcaster that writes a record of every vote, signature and public key it broadcasts to the audit log.
+// The endpoints function returns the RPC endpoints of a chain that votes on it are attributed to.
+func WithAudit(broadcaster broadcast.Broadcaster, log *Log, endpoints func(chain nexus.ChainName) []string) broadcast.Broadcaster {
+	return auditedBroadcaster{broadcaster: broadcaster, log: log, endpoints: endpoints}
+}
+
+// Broadcast implements the broadcast.Broadcaster interface
+func (b auditedBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := b.broadcaster.Broadcast(ctx, msgs...)
+
+	d := detailsOf(ctx)
+	for _, msg := range msgs {
+		record, ok := b.recordOf(d, msg)
+		if !ok {
+			continue
+		}
+
+		record.Time = time.Now().UTC()
+		if res != nil {
+			record.TxHash = res.TxHash
+			record.Height = res.Height
+			record.Code = res.Code
+		}
+		if err != nil {
+			record.Error = err.Error()
+		}
+
+		if err := b.log.Write(record); err != nil {
+			log.Errorf("failed to write audit record: %s", err.Error())
+		}
+	}
+
+	return res, err
+}
+
+func (b auditedBroadcaster) recordOf(d details, msg sdk.Msg) (Record, bool) {
+	switch msg := msg.(type) {
+	case *voteTypes.VoteRequest:
+		record := Record{Kind: Vote, PollID: msg.PollID.String()}
+		if poll, ok := d.polls[msg.PollID]; ok {
+			record.Chain = poll.chain.String()
+			record.TxIDs = poll.txIDs
+			record.RPCEndpoints = b.endpoints(poll.chain)
+		}
+
+		if payload, ok := msg.Vote.GetCachedValue().(proto.Message); ok {
+			events, err := codec.ProtoMarshalJSON(payload, nil)
+			if err != nil {
+				log.Errorf("failed to encode vote for poll %s for the audit log: %s", msg.PollID.String(), err.Error())
+			} else {
+				record.Events = events
+			}
+		}
+
+		return record, true
+	case *multisigTypes.SubmitSignatureRequest:
+		record := Record{Kind: Signature, SigID: strconv.FormatUint(msg.SigID, 10)}
+		if signing, ok := d.signings[msg.SigID]; ok {
+			record.KeyID = signing.keyID
+			record.PayloadHash = hex.EncodeToString(signing.payloadHash)
+		}
+
+		return record, true
+	case *multisigTypes.SubmitPubKeyRequest:
+		return Record{Kind: PubKey, KeyID: msg.KeyID.String()}, true
+	default:
+		return Record{}, false
+	}
+}
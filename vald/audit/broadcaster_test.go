@@ -0,0 +1,104 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigtestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	votetypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestWithAudit(t *testing.T) {
+	var (
+		path        string
+		auditLog    *audit.Log
+		inner       *mock.BroadcasterMock
+		broadcaster broadcast.Broadcaster
+		chain       nexus.ChainName
+	)
+
+	endpoints := func(nexus.ChainName) []string { return []string{"https://rpc.example.com"} }
+
+	givenBroadcaster := Given("an audited broadcaster", func() {
+		path = filepath.Join(t.TempDir(), "audit.jsonl")
+		auditLog = funcs.Must(audit.Open(path, 0, 0))
+		chain = nexus.ChainName(rand.HexStr(10))
+		inner = &mock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				return &sdk.TxResponse{TxHash: "ABCD", Height: 10}, nil
+			},
+		}
+		broadcaster = audit.WithAudit(inner, auditLog, endpoints)
+	})
+
+	read := func() []audit.Record {
+		assert.NoError(t, auditLog.Close())
+		return funcs.Must(audit.Read(path, 0, audit.Filter{}))
+	}
+
+	givenBroadcaster.
+		When("votes are broadcast", func() {}).
+		Then("should record the votes with their polls", func(t *testing.T) {
+			pollID := vote.PollID(rand.PosI64())
+			txID := rand.Str(10)
+			ctx := audit.WithPoll(context.Background(), pollID, chain, txID)
+
+			_, err := broadcaster.Broadcast(ctx, votetypes.NewVoteRequest(rand.AccAddr(), pollID, evmtypes.NewVoteEvents(chain)), tsstypes.NewHeartBeatRequest(rand.AccAddr(), nil))
+			assert.NoError(t, err)
+
+			records := read()
+			assert.Len(t, records, 1)
+			assert.Equal(t, audit.Vote, records[0].Kind)
+			assert.Equal(t, pollID.String(), records[0].PollID)
+			assert.Equal(t, chain.String(), records[0].Chain)
+			assert.Equal(t, []string{txID}, records[0].TxIDs)
+			assert.Equal(t, endpoints(chain), records[0].RPCEndpoints)
+			assert.Contains(t, string(records[0].Events), chain.String())
+			assert.Equal(t, "ABCD", records[0].TxHash)
+			assert.EqualValues(t, 10, records[0].Height)
+		}).
+		Run(t)
+
+	givenBroadcaster.
+		When("the broadcast of a signature fails", func() {
+			inner.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				return nil, fmt.Errorf("out of gas")
+			}
+		}).
+		Then("should record the signature with the error", func(t *testing.T) {
+			sigID := uint64(rand.PosI64())
+			keyID := multisigtestutils.KeyID()
+			payloadHash := rand.Bytes(32)
+			ctx := audit.WithSigning(context.Background(), sigID, keyID.String(), payloadHash)
+
+			_, err := broadcaster.Broadcast(ctx, multisigtypes.NewSubmitSignatureRequest(rand.AccAddr(), sigID, rand.Bytes(64)))
+			assert.Error(t, err)
+
+			records := read()
+			assert.Len(t, records, 1)
+			assert.Equal(t, audit.Signature, records[0].Kind)
+			assert.Equal(t, strconv.FormatUint(sigID, 10), records[0].SigID)
+			assert.Equal(t, keyID.String(), records[0].KeyID)
+			assert.Equal(t, hex.EncodeToString(payloadHash), records[0].PayloadHash)
+			assert.Equal(t, "out of gas", records[0].Error)
+		}).
+		Run(t)
+}
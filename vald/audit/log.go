@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxRecordSize is the largest record that is read back from the log
+const maxRecordSize = 16 * 1024 * 1024
+
+// Log is an append-only audit log of JSON encoded records, one per line.
+// Once the file exceeds its max size, it is rotated to <path>.1 and older rotations are shifted, keeping the given number of backups.
+type Log struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// Open opens the audit log at the given path, creating it if it does not exist. The log is not rotated if maxSize is zero
+func Open(path string, maxSize int64, maxBackups int) (*Log, error) {
+	l := &Log{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *Log) open() error {
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to open audit log %s", l.path)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return sdkerrors.Wrapf(err, "failed to open audit log %s", l.path)
+	}
+
+	l.file = file
+	l.size = info.Size()
+
+	return nil
+}
+
+// Write appends the record to the log
+func (l *Log) Write(record Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.maxSize > 0 && l.size > 0 && l.size+int64(len(bz)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(bz)
+	l.size += int64(n)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to write to audit log %s", l.path)
+	}
+
+	return nil
+}
+
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return sdkerrors.Wrapf(err, "failed to close audit log %s", l.path)
+	}
+
+	if l.maxBackups > 0 {
+		for i := l.maxBackups - 1; i > 0; i-- {
+			if err := os.Rename(backupPath(l.path, i), backupPath(l.path, i+1)); err != nil && !os.IsNotExist(err) {
+				return sdkerrors.Wrapf(err, "failed to rotate audit log %s", l.path)
+			}
+		}
+
+		if err := os.Rename(l.path, backupPath(l.path, 1)); err != nil {
+			return sdkerrors.Wrapf(err, "failed to rotate audit log %s", l.path)
+		}
+	} else if err := os.Remove(l.path); err != nil {
+		return sdkerrors.Wrapf(err, "failed to rotate audit log %s", l.path)
+	}
+
+	return l.open()
+}
+
+// Close closes the log
+func (l *Log) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.file.Close()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+// Read returns the records of the audit log at the given path and all its backups that pass the filter, oldest first
+func Read(path string, maxBackups int, filter Filter) ([]Record, error) {
+	var records []Record
+	for i := maxBackups; i >= 0; i-- {
+		p := path
+		if i > 0 {
+			p = backupPath(path, i)
+		}
+
+		fileRecords, err := readFile(p, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, fileRecords...)
+	}
+
+	return records, nil
+}
+
+func readFile(path string, filter Filter) ([]Record, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to open audit log %s", path)
+	}
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid record in line %d of audit log %s", line, path)
+		}
+
+		if filter.Match(record) {
+			records = append(records, record)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to read audit log %s", path)
+	}
+
+	return records, nil
+}
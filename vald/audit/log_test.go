@@ -0,0 +1,100 @@
+package audit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestLog(t *testing.T) {
+	var (
+		path     string
+		auditLog *audit.Log
+		records  []audit.Record
+	)
+
+	write := func(n int) {
+		for i := 0; i < n; i++ {
+			record := audit.Record{
+				Time:   time.Unix(int64(len(records)), 0).UTC(),
+				Kind:   audit.Vote,
+				Chain:  "Ethereum",
+				PollID: rand.Str(10),
+				TxIDs:  []string{rand.Str(20)},
+			}
+			assert.NoError(t, auditLog.Write(record))
+			records = append(records, record)
+		}
+	}
+
+	givenLog := Given("an audit log", func() {
+		path = filepath.Join(t.TempDir(), "audit.jsonl")
+		records = nil
+	})
+
+	givenLog.
+		When("it is not rotated", func() {
+			auditLog = funcs.Must(audit.Open(path, 0, 0))
+		}).
+		When("records are written", func() { write(10) }).
+		Then("should read all records back in order", func(t *testing.T) {
+			assert.NoError(t, auditLog.Close())
+			assert.Equal(t, records, funcs.Must(audit.Read(path, 0, audit.Filter{})))
+		}).
+		Run(t)
+
+	givenLog.
+		When("it is rotated after a few records", func() {
+			auditLog = funcs.Must(audit.Open(path, 500, 2))
+		}).
+		When("many records are written", func() { write(20) }).
+		Then("should only keep the latest records", func(t *testing.T) {
+			assert.NoError(t, auditLog.Close())
+
+			_, err := os.Stat(path + ".3")
+			assert.True(t, os.IsNotExist(err))
+
+			read := funcs.Must(audit.Read(path, 2, audit.Filter{}))
+			assert.NotEmpty(t, read)
+			assert.Less(t, len(read), len(records))
+			assert.Equal(t, records[len(records)-len(read):], read)
+		}).
+		Run(t)
+
+	givenLog.
+		When("records are written", func() {
+			auditLog = funcs.Must(audit.Open(path, 0, 0))
+			write(10)
+		}).
+		When("the log is reopened", func() {
+			assert.NoError(t, auditLog.Close())
+			auditLog = funcs.Must(audit.Open(path, 0, 0))
+			write(1)
+		}).
+		Then("should append to the existing records", func(t *testing.T) {
+			assert.NoError(t, auditLog.Close())
+			assert.Equal(t, records, funcs.Must(audit.Read(path, 0, audit.Filter{})))
+		}).
+		Run(t)
+}
+
+func TestFilter_Match(t *testing.T) {
+	record := audit.Record{Time: time.Unix(100, 0), Kind: audit.Vote, Chain: "Ethereum", PollID: "5"}
+
+	assert.True(t, audit.Filter{}.Match(record))
+	assert.True(t, audit.Filter{PollID: "5", Chain: "ethereum"}.Match(record))
+	assert.False(t, audit.Filter{PollID: "6"}.Match(record))
+	assert.False(t, audit.Filter{SigID: "5"}.Match(record))
+	assert.False(t, audit.Filter{Chain: "Avalanche"}.Match(record))
+	assert.True(t, audit.Filter{From: time.Unix(100, 0), To: time.Unix(101, 0)}.Match(record))
+	assert.False(t, audit.Filter{From: time.Unix(101, 0)}.Match(record))
+	assert.False(t, audit.Filter{To: time.Unix(100, 0)}.Match(record))
+}
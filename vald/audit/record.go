@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// Kind is the kind of msg an audit record is written for
+type Kind string
+
+// Kinds of audited msgs
+const (
+	Vote      Kind = "vote"
+	Signature Kind = "signature"
+	PubKey    Kind = "pub_key"
+)
+
+// Record is a single entry of the audit log. It describes one vote, signature or public key vald submitted and the outcome of its broadcast
+type Record struct {
+	Time  time.Time `json:"time"`
+	Kind  Kind      `json:"kind"`
+	Chain string    `json:"chain,omitempty"`
+	// PollID is set for votes
+	PollID string `json:"poll_id,omitempty"`
+	// SigID is set for signatures
+	SigID string `json:"sig_id,omitempty"`
+	KeyID string `json:"key_id,omitempty"`
+	// TxIDs are the source chain transactions the vote is about
+	TxIDs []string `json:"tx_ids,omitempty"`
+	// Events is the JSON encoded vote
+	Events      json.RawMessage `json:"events,omitempty"`
+	PayloadHash string          `json:"payload_hash,omitempty"`
+	// RPCEndpoints are the endpoints vald was configured to query the chain with, without credentials
+	RPCEndpoints []string `json:"rpc_endpoints,omitempty"`
+	TxHash       string   `json:"tx_hash,omitempty"`
+	Height       int64    `json:"height,omitempty"`
+	Code         uint32   `json:"code"`
+	Error        string   `json:"error,omitempty"`
+}
+
+// Filter selects audit records. Empty fields match every record
+type Filter struct {
+	PollID string
+	SigID  string
+	Chain  string
+	From   time.Time
+	To     time.Time
+}
+
+// Match returns true if the record passes the filter
+func (f Filter) Match(record Record) bool {
+	switch {
+	case f.PollID != "" && f.PollID != record.PollID:
+		return false
+	case f.SigID != "" && f.SigID != record.SigID:
+		return false
+	case f.Chain != "" && !strings.EqualFold(f.Chain, record.Chain):
+		return false
+	case !f.From.IsZero() && record.Time.Before(f.From):
+		return false
+	case !f.To.IsZero() && !record.Time.Before(f.To):
+		return false
+	default:
+		return true
+	}
+}
+
+type pollDetails struct {
+	chain nexus.ChainName
+	txIDs []string
+}
+
+type signingDetails struct {
+	keyID       string
+	payloadHash []byte
+}
+
+// details holds what is known about audited msgs beyond their content
+type details struct {
+	polls    map[vote.PollID]pollDetails
+	signings map[uint64]signingDetails
+}
+
+type detailsKey struct{}
+
+func detailsOf(ctx context.Context) details {
+	d, ok := ctx.Value(detailsKey{}).(details)
+	if !ok {
+		return details{}
+	}
+
+	return d
+}
+
+// WithPoll returns a context that attributes the vote on the given poll to the given chain and source chain transactions
+func WithPoll(ctx context.Context, pollID vote.PollID, chain nexus.ChainName, txIDs ...string) context.Context {
+	d := detailsOf(ctx)
+
+	polls := make(map[vote.PollID]pollDetails, len(d.polls)+1)
+	for id, poll := range d.polls {
+		polls[id] = poll
+	}
+	polls[pollID] = pollDetails{chain: chain, txIDs: txIDs}
+
+	return context.WithValue(ctx, detailsKey{}, details{polls: polls, signings: d.signings})
+}
+
+// WithSigning returns a context that attributes the signature for the given signing session to the given key and payload hash
+func WithSigning(ctx context.Context, sigID uint64, keyID string, payloadHash []byte) context.Context {
+	d := detailsOf(ctx)
+
+	signings := make(map[uint64]signingDetails, len(d.signings)+1)
+	for id, signing := range d.signings {
+		signings[id] = signing
+	}
+	signings[sigID] = signingDetails{keyID: keyID, payloadHash: payloadHash}
+
+	return context.WithValue(ctx, detailsKey{}, details{polls: d.polls, signings: signings})
+}
@@ -0,0 +1,282 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/log"
+)
+
+// catchUpQueryTimeout is the timeout of the query that checks if a session is still open
+const catchUpQueryTimeout = 10 * time.Second
+
+// catchUpRange is the range of block heights [From, To) that vald skipped on start because it was too far behind
+type catchUpRange struct {
+	From int64
+	To   int64
+}
+
+// Empty returns true if there are no blocks to catch up on
+func (r catchUpRange) Empty() bool {
+	return r.From >= r.To
+}
+
+// Return the range of skipped blocks that are scanned for open sessions.
+// Blocks are only skipped if the stored height is too old, in which case getStartBlock returns 0.
+func getCatchUpRange(cfg config.ValdConfig, stateStore StateStore, startBlock int64, nodeHeight int64) catchUpRange {
+	if !cfg.CatchUp.Enabled || startBlock != 0 {
+		return catchUpRange{}
+	}
+
+	storedHeight, err := stateStore.GetState()
+	if err != nil || storedHeight == 0 {
+		return catchUpRange{}
+	}
+
+	return catchUpRange{From: max(storedHeight+1, nodeHeight-cfg.CatchUp.MaxBlocks), To: nodeHeight}
+}
+
+type catchUpSubscription struct {
+	filter func(tmEvents.ABCIEventWithHeight) bool
+	events chan tmEvents.ABCIEventWithHeight
+}
+
+// catchUpScanner publishes the events of past blocks to its subscribers, in the same order as the event bus does for new blocks
+type catchUpScanner struct {
+	client  tmEvents.BlockResultClient
+	blocks  catchUpRange
+	retries int
+	backOff time.Duration
+
+	subs []catchUpSubscription
+}
+
+func newCatchUpScanner(client tmEvents.BlockResultClient, blocks catchUpRange, retries int, backOff time.Duration) *catchUpScanner {
+	return &catchUpScanner{client: client, blocks: blocks, retries: retries, backOff: backOff}
+}
+
+// Subscribe returns a channel of all scanned events that pass the filter. The channel is closed once the scan is done.
+// All subscriptions must be made before the scan starts.
+func (s *catchUpScanner) Subscribe(filter func(tmEvents.ABCIEventWithHeight) bool) <-chan tmEvents.ABCIEventWithHeight {
+	sub := catchUpSubscription{filter: filter, events: make(chan tmEvents.ABCIEventWithHeight)}
+	s.subs = append(s.subs, sub)
+
+	return sub.events
+}
+
+// Scan publishes the events of all blocks in the catch-up range. Blocks whose results cannot be fetched are skipped.
+func (s *catchUpScanner) Scan(ctx context.Context) error {
+	defer func() {
+		for _, sub := range s.subs {
+			close(sub.events)
+		}
+	}()
+
+	log.Infof("catching up on sessions started in skipped blocks %d to %d", s.blocks.From, s.blocks.To-1)
+
+	for height := s.blocks.From; height < s.blocks.To; height++ {
+		results, err := s.blockResults(ctx, height)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			log.Errorf("failed to catch up on block %d: %s", height, err.Error())
+			continue
+		}
+
+		events := append(results.BeginBlockEvents, results.EndBlockEvents...)
+		for _, txRes := range results.TxsResults {
+			events = append(events, txRes.Events...)
+		}
+
+		for _, event := range events {
+			if !s.publish(ctx, tmEvents.ABCIEventWithHeight{Height: height, Event: event}) {
+				return nil
+			}
+		}
+	}
+
+	log.Info("caught up on skipped blocks")
+	return nil
+}
+
+func (s *catchUpScanner) blockResults(ctx context.Context, height int64) (results *coretypes.ResultBlockResults, err error) {
+	for i := 0; i <= s.retries; i++ {
+		results, err = s.client.BlockResults(ctx, &height)
+		if err == nil {
+			return results, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.backOff):
+		}
+	}
+
+	return nil, err
+}
+
+func (s *catchUpScanner) publish(ctx context.Context, e tmEvents.ABCIEventWithHeight) bool {
+	for _, sub := range s.subs {
+		if !sub.filter(e) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case sub.events <- e:
+		}
+	}
+
+	return true
+}
+
+// sessionChecker checks if a session is still open on chain
+type sessionChecker interface {
+	IsOpen(ctx context.Context, kind journal.Kind, id string) (bool, error)
+}
+
+type chainSessions struct {
+	vote     voteTypes.QueryServiceClient
+	multisig multisigTypes.QueryServiceClient
+}
+
+// IsOpen implements the sessionChecker interface. Sessions that cannot be found anymore are closed.
+func (c chainSessions) IsOpen(ctx context.Context, kind journal.Kind, id string) (bool, error) {
+	var (
+		open bool
+		err  error
+	)
+
+	switch kind {
+	case journal.Poll:
+		var pollID uint64
+		if pollID, err = strconv.ParseUint(id, 10, 64); err != nil {
+			return false, err
+		}
+
+		var res *voteTypes.PollResponse
+		if res, err = c.vote.Poll(ctx, &voteTypes.PollRequest{PollID: pollID}); err == nil {
+			open = res.State == vote.Pending
+		}
+	case journal.Signing:
+		var sigID uint64
+		if sigID, err = strconv.ParseUint(id, 10, 64); err != nil {
+			return false, err
+		}
+
+		var res *multisigTypes.SigningSessionResponse
+		if res, err = c.multisig.SigningSession(ctx, &multisigTypes.SigningSessionRequest{SigID: sigID}); err == nil {
+			open = res.State == multisig.Pending
+		}
+	case journal.Keygen:
+		var res *multisigTypes.KeygenSessionResponse
+		if res, err = c.multisig.KeygenSession(ctx, &multisigTypes.KeygenSessionRequest{KeyID: multisig.KeyID(id)}); err == nil {
+			open = res.State == multisig.Pending
+		}
+	default:
+		return false, fmt.Errorf("unknown session kind %s", kind)
+	}
+
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+
+	return open, err
+}
+
+// catchUp provides the events of sessions that started in skipped blocks and are still open
+type catchUp struct {
+	scanner  *catchUpScanner
+	journal  *journal.Journal
+	sessions sessionChecker
+}
+
+// catchUpEvents returns the events of a job that started sessions in the skipped blocks which are neither journaled nor closed on chain yet.
+// It returns nil if there is nothing to catch up on.
+func catchUpEvents[T proto.Message](ctx context.Context, c *catchUp, kind journal.Kind, filter func(tmEvents.ABCIEventWithHeight) bool, sessionIDs func(event T) []string) <-chan tmEvents.ABCIEventWithHeight {
+	if c == nil {
+		return nil
+	}
+
+	scanned := c.scanner.Subscribe(filter)
+	events := make(chan tmEvents.ABCIEventWithHeight)
+	go func() {
+		defer close(events)
+
+		for e := range scanned {
+			if !hasOpenSessions(ctx, c, kind, e, sessionIDs) {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case events <- e:
+			}
+		}
+	}()
+
+	return events
+}
+
+// hasOpenSessions returns true if the event started a session of the validator that has not been journaled and is still open on chain
+func hasOpenSessions[T proto.Message](ctx context.Context, c *catchUp, kind journal.Kind, e tmEvents.ABCIEventWithHeight, sessionIDs func(event T) []string) bool {
+	parsed, err := sdk.ParseTypedEvent(e.Event)
+	if err != nil {
+		log.Errorf("failed to parse event %s at height %d: %s", e.Event.Type, e.Height, err.Error())
+		return false
+	}
+
+	event, ok := parsed.(T)
+	if !ok {
+		return false
+	}
+
+	for _, id := range sessionIDs(event) {
+		logger := log.WithKeyVals("kind", kind, "id", id, "height", e.Height)
+
+		// journaled sessions have already been handled or are replayed from the journal
+		_, journaled, err := c.journal.Get(kind, id)
+		if err != nil {
+			logger.Errorf("failed to look up session in journal: %s", err.Error())
+			continue
+		}
+		if journaled {
+			continue
+		}
+
+		queryCtx, cancel := context.WithTimeout(ctx, catchUpQueryTimeout)
+		open, err := c.sessions.IsOpen(queryCtx, kind, id)
+		cancel()
+		if err != nil {
+			logger.Errorf("failed to check if session is still open: %s", err.Error())
+			continue
+		}
+
+		if open {
+			logger.Info("catching up on session started in a skipped block")
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	tmmock "github.com/axelarnetwork/tm-events/events/mock"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+type openSessions map[string]bool
+
+func (s openSessions) IsOpen(_ context.Context, _ journal.Kind, id string) (bool, error) {
+	open, ok := s[id]
+	if !ok {
+		return false, fmt.Errorf("unknown session %s", id)
+	}
+
+	return open, nil
+}
+
+func TestGetCatchUpRange(t *testing.T) {
+	cfg := config.DefaultValdConfig()
+	cfg.CatchUp.MaxBlocks = 100
+
+	storeWithHeight := func(height int64) StateStore {
+		store := NewStateStore(NewRWFile(filepath.Join(t.TempDir(), "state.json")))
+		funcs.MustNoErr(store.SetState(height))
+
+		return store
+	}
+
+	assert.Equal(t, catchUpRange{From: 951, To: 1000}, getCatchUpRange(cfg, storeWithHeight(950), 0, 1000))
+	assert.Equal(t, catchUpRange{From: 900, To: 1000}, getCatchUpRange(cfg, storeWithHeight(10), 0, 1000))
+	assert.True(t, getCatchUpRange(cfg, storeWithHeight(995), 996, 1000).Empty(), "no blocks are skipped if vald starts from the stored height")
+	assert.True(t, getCatchUpRange(cfg, storeWithHeight(0), 0, 1000).Empty(), "nothing to catch up on without a stored height")
+
+	cfg.CatchUp.Enabled = false
+	assert.True(t, getCatchUpRange(cfg, storeWithHeight(950), 0, 1000).Empty())
+}
+
+func TestCatchUpEvents(t *testing.T) {
+	valAddr := rand.ValAddr()
+	j := journal.New(dbm.NewMemDB())
+
+	signingStarted := func(sigID uint64, participant sdk.ValAddress) abci.Event {
+		event := &multisigTypes.SigningStarted{SigID: sigID, PubKeys: map[string]multisig.PublicKey{participant.String(): rand.Bytes(33)}}
+		return abci.Event(funcs.Must(sdk.TypedEventToEvent(event)))
+	}
+
+	blocks := map[int64]*coretypes.ResultBlockResults{
+		10: {Height: 10, EndBlockEvents: []abci.Event{signingStarted(1, valAddr), signingStarted(2, rand.ValAddr())}},
+		11: {Height: 11, TxsResults: []*abci.ResponseDeliverTx{{Events: []abci.Event{signingStarted(3, valAddr)}}}},
+		12: {Height: 12, EndBlockEvents: []abci.Event{signingStarted(4, valAddr), signingStarted(5, valAddr)}},
+	}
+	client := &tmmock.BlockResultClientMock{BlockResultsFunc: func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+		return blocks[*height], nil
+	}}
+
+	// session 5 has already been handled before the restart
+	funcs.MustNoErr(j.Start(journal.Session{Kind: journal.Signing, ID: "5", Job: "multisig_signing", Height: 12}))
+
+	c := &catchUp{
+		scanner:  newCatchUpScanner(client, catchUpRange{From: 10, To: 13}, 0, time.Millisecond),
+		journal:  j,
+		sessions: openSessions{"1": true, "3": false, "4": true},
+	}
+
+	events := catchUpEvents(context.Background(), c, journal.Signing, tmEvents.Filter[*multisigTypes.SigningStarted](), signingSessions(valAddr))
+	go func() { assert.NoError(t, c.scanner.Scan(context.Background())) }()
+
+	var sigIDs []string
+	for e := range events {
+		event := funcs.Must(sdk.ParseTypedEvent(e.Event)).(*multisigTypes.SigningStarted)
+		sigIDs = append(sigIDs, strconv.FormatUint(event.SigID, 10))
+	}
+
+	assert.Equal(t, []string{"1", "4"}, sigIDs)
+	assert.Nil(t, catchUpEvents(context.Background(), nil, journal.Signing, tmEvents.Filter[*multisigTypes.SigningStarted](), signingSessions(valAddr)))
+}
+
+func TestMergeEvents(t *testing.T) {
+	replayed := []tmEvents.ABCIEventWithHeight{{Height: 1}}
+	sub := make(chan tmEvents.ABCIEventWithHeight)
+	catchUp := make(chan tmEvents.ABCIEventWithHeight)
+
+	merged := mergeEvents(context.Background(), replayed, sub, catchUp)
+	assert.Equal(t, int64(1), (<-merged).Height)
+
+	catchUp <- tmEvents.ABCIEventWithHeight{Height: 2}
+	assert.Equal(t, int64(2), (<-merged).Height)
+	close(catchUp)
+
+	sub <- tmEvents.ABCIEventWithHeight{Height: 10}
+	assert.Equal(t, int64(10), (<-merged).Height)
+	close(sub)
+
+	_, ok := <-merged
+	assert.False(t, ok)
+}
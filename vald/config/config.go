@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +23,19 @@ type ValdConfig struct {
 	NoNewBlockPanicTimeout       time.Duration `mapstructure:"no_new_blocks_timeout"` // At times vald stalls completely. Until the bug is found it is better to panic and allow users to restart the process instead of doing nothing. Once at least one block has been seen vald will panic if it does not see another before the timout expires.
 
 	EVMConfig []evm.EVMConfig `mapstructure:"axelar_bridge_evm"`
+	EVMRPC    EVMRPCConfig    `mapstructure:"evm_rpc"`
+
+	PollWorkers PollWorkersConfig `mapstructure:"poll_workers"`
+
+	Metrics MetricsConfig `mapstructure:"metrics"`
+	Journal JournalConfig `mapstructure:"journal"`
+	CatchUp CatchUpConfig `mapstructure:"catch_up"`
+	Admin   AdminConfig   `mapstructure:"admin"`
+
+	SigningPolicy SigningPolicyConfig `mapstructure:"signing_policy"`
+	Presign       PresignConfig       `mapstructure:"presign"`
+	Audit         AuditConfig         `mapstructure:"audit"`
+	Alerting      AlertingConfig      `mapstructure:"alerting"`
 }
 
 // DefaultValdConfig returns a configurations populated with default values
@@ -34,18 +48,75 @@ func DefaultValdConfig() ValdConfig {
 		MaxBlocksBehindLatest:        10, // Max voting/sign/heartbeats periods are under 10 blocks
 		MaxLatestBlockAge:            15 * time.Second,
 		EVMConfig:                    evm.DefaultConfig(),
+		EVMRPC:                       DefaultEVMRPCConfig(),
+		PollWorkers:                  DefaultPollWorkersConfig(),
 		EventNotificationsMaxRetries: 3,
 		EventNotificationsBackOff:    1 * time.Second,
 		NoNewBlockPanicTimeout:       2 * time.Minute,
+		Metrics:                      DefaultMetricsConfig(),
+		Journal:                      DefaultJournalConfig(),
+		CatchUp:                      DefaultCatchUpConfig(),
+		Admin:                        DefaultAdminConfig(),
+		SigningPolicy:                DefaultSigningPolicyConfig(),
+		Presign:                      DefaultPresignConfig(),
+		Audit:                        DefaultAuditConfig(),
+		Alerting:                     DefaultAlertingConfig(),
 	}
 }
 
 // BroadcastConfig is the configuration for transaction broadcasting
 type BroadcastConfig struct {
-	MaxRetries          int            `mapstructure:"max_retries"`
-	MinSleepBeforeRetry time.Duration  `mapstructure:"min_sleep_before_retry"`
-	MaxTimeout          time.Duration  `mapstructure:"max_timeout"`
-	FeeGranter          sdk.AccAddress `mapstructure:"fee_granter"`
+	MaxRetries          int                `mapstructure:"max_retries"`
+	MinSleepBeforeRetry time.Duration      `mapstructure:"min_sleep_before_retry"`
+	MaxTimeout          time.Duration      `mapstructure:"max_timeout"`
+	FeeGranter          sdk.AccAddress     `mapstructure:"fee_granter"`
+	Lanes               []string           `mapstructure:"lanes"` // Key names of lane proxy accounts that broadcast in parallel to the proxy account
+	RemoteSigner        RemoteSignerConfig `mapstructure:"remote_signer"`
+	Fees                FeeConfig          `mapstructure:"fees"`
+}
+
+// FeeConfig is the configuration of the gas price oracle that prices broadcast txs.
+// Prices are given in the denom of the configured gas prices, which are the lowest price vald pays.
+type FeeConfig struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	MaxGasPrice       float64       `mapstructure:"max_gas_price"`      // Upper bound of the gas price, unless the node requires more. No bound if zero
+	CongestionPremium float64       `mapstructure:"congestion_premium"` // Relative gas price increase when the latest blocks are full
+	MinGasAdjustment  float64       `mapstructure:"min_gas_adjustment"`
+	MaxGasAdjustment  float64       `mapstructure:"max_gas_adjustment"` // Used for txs whose fees are refunded, and for all txs when blocks are full
+	BlockWindow       int64         `mapstructure:"block_window"`       // Number of latest blocks the block fullness is averaged over
+	RefreshInterval   time.Duration `mapstructure:"refresh_interval"`
+	Budget            int64         `mapstructure:"budget"` // Fees that may be spent on txs without refund per budget period. No budget if zero
+	BudgetPeriod      time.Duration `mapstructure:"budget_period"`
+}
+
+// DefaultFeeConfig returns a configurations populated with default values
+func DefaultFeeConfig() FeeConfig {
+	return FeeConfig{
+		Enabled:           true,
+		CongestionPremium: 1,
+		MinGasAdjustment:  2,
+		MaxGasAdjustment:  4,
+		BlockWindow:       10,
+		RefreshInterval:   30 * time.Second,
+		BudgetPeriod:      24 * time.Hour,
+	}
+}
+
+// RemoteSignerConfig is the configuration for signing txs with a remote signer instead of the local keyring
+type RemoteSignerConfig struct {
+	Address     string        `mapstructure:"address"`     // unix:///path/to/socket or host:port of the remote signer. Txs are signed with the local keyring if empty
+	Token       string        `mapstructure:"token"`       // shared token of the remote signer, required unless it listens on a unix socket
+	TLSCAFile   string        `mapstructure:"tls_ca_file"` // CA certificate of the remote signer, required unless it listens on a unix socket or loopback address
+	DialTimeout time.Duration `mapstructure:"dial_timeout"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultRemoteSignerConfig returns a configurations populated with default values
+func DefaultRemoteSignerConfig() RemoteSignerConfig {
+	return RemoteSignerConfig{
+		DialTimeout: 15 * time.Second,
+		Timeout:     10 * time.Second,
+	}
 }
 
 // DefaultBroadcastConfig returns a configurations populated with default values
@@ -54,5 +125,220 @@ func DefaultBroadcastConfig() BroadcastConfig {
 		MaxRetries:          3,
 		MinSleepBeforeRetry: 5 * time.Second,
 		MaxTimeout:          15 * time.Second,
+		RemoteSigner:        DefaultRemoteSignerConfig(),
+		Fees:                DefaultFeeConfig(),
+	}
+}
+
+// EVMRPCConfig is the configuration for requests to the RPC endpoints of EVM chains
+type EVMRPCConfig struct {
+	BatchSize        int           `mapstructure:"batch_size"`         // Max number of receipts requested in a single JSON-RPC batch
+	MaxRetries       int           `mapstructure:"max_retries"`        // Number of times a rate limited request is retried
+	MinBackoff       time.Duration `mapstructure:"min_backoff"`        // Delay after the first rate limited request, doubling with each consecutive one
+	MaxBackoff       time.Duration `mapstructure:"max_backoff"`        // Upper bound of the delay between requests to a rate limiting endpoint
+	ReceiptCacheSize int           `mapstructure:"receipt_cache_size"` // Max number of receipts cached per chain
+	ReceiptCacheTTL  time.Duration `mapstructure:"receipt_cache_ttl"`  // How long a fetched receipt is served from the cache
+	// How long vald waits before it checks a transaction again whose block was reorganized, until the block is stable or the poll closes
+	ReorgRecheckInterval time.Duration `mapstructure:"reorg_recheck_interval"`
+}
+
+// DefaultEVMRPCConfig returns a configurations populated with default values
+func DefaultEVMRPCConfig() EVMRPCConfig {
+	return EVMRPCConfig{
+		BatchSize:        100,
+		MaxRetries:       5,
+		MinBackoff:       500 * time.Millisecond,
+		MaxBackoff:       30 * time.Second,
+		ReceiptCacheSize: 10000,
+		ReceiptCacheTTL:  time.Minute,
+
+		ReorgRecheckInterval: 10 * time.Second,
+	}
+}
+
+// PollWorkersConfig is the configuration for the worker pools that process the polls of each chain
+type PollWorkersConfig struct {
+	Concurrency      int            `mapstructure:"concurrency"`       // Max number of polls processed at the same time per chain
+	ChainConcurrency map[string]int `mapstructure:"chain_concurrency"` // Overrides the concurrency for individual chains
+}
+
+// DefaultPollWorkersConfig returns a configurations populated with default values
+func DefaultPollWorkersConfig() PollWorkersConfig {
+	return PollWorkersConfig{
+		Concurrency: 10,
+	}
+}
+
+// ConcurrencyOf returns the max number of polls of the given chain that are processed at the same time
+func (c PollWorkersConfig) ConcurrencyOf(chain string) int {
+	for name, concurrency := range c.ChainConcurrency {
+		if strings.EqualFold(name, chain) {
+			return concurrency
+		}
+	}
+
+	return c.Concurrency
+}
+
+// MetricsConfig is the configuration for the prometheus metrics server
+type MetricsConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	ListenAddr string `mapstructure:"listen_addr"`
+}
+
+// DefaultMetricsConfig returns a configurations populated with default values
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		Enabled:    false,
+		ListenAddr: "127.0.0.1:9191",
+	}
+}
+
+// JournalConfig is the configuration for the journal of sessions processed by vald
+type JournalConfig struct {
+	ReplayWindow int64 `mapstructure:"replay_window"` // Unhandled sessions that started at most this many blocks before the start block are replayed on restart
+	Retention    int64 `mapstructure:"retention"`     // Sessions older than this many blocks are pruned from the journal on restart
+}
+
+// DefaultJournalConfig returns a configurations populated with default values
+func DefaultJournalConfig() JournalConfig {
+	return JournalConfig{
+		ReplayWindow: 100,
+		Retention:    100000,
+	}
+}
+
+// CatchUpConfig is the configuration for catching up on sessions that started in blocks vald skipped
+// because its stored height was more than max_blocks_behind_latest blocks behind the node
+type CatchUpConfig struct {
+	Enabled   bool  `mapstructure:"enabled"`
+	MaxBlocks int64 `mapstructure:"max_blocks"` // At most this many of the latest skipped blocks are scanned
+}
+
+// DefaultCatchUpConfig returns a configurations populated with default values
+func DefaultCatchUpConfig() CatchUpConfig {
+	return CatchUpConfig{
+		Enabled:   true,
+		MaxBlocks: 1000,
+	}
+}
+
+// AdminConfig is the configuration for the local admin API.
+// The API can only be served over TCP if a token is set, which clients must then send in the X-Vald-Admin-Token header.
+type AdminConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	ListenAddr string `mapstructure:"listen_addr"` // host:port or unix:///path/to/socket
+	Token      string `mapstructure:"token"`
+}
+
+// DefaultAdminConfig returns a configurations populated with default values
+func DefaultAdminConfig() AdminConfig {
+	return AdminConfig{
+		Enabled:    false,
+		ListenAddr: "127.0.0.1:9192",
+	}
+}
+
+// SigningPolicyConfig is the local operator policy that the payloads of signing sessions are checked against before vald signs them.
+// If enabled, vald only signs EVM command batches it can reconstruct and verify.
+// The policy relies on the SigningStarted event carrying the requesting module and its module metadata,
+// so on chains that emit the event without metadata it refuses every session unless SignWithoutMetadata is set.
+type SigningPolicyConfig struct {
+	Enabled             bool                `mapstructure:"enabled"`
+	Chains              []ChainPolicyConfig `mapstructure:"chains"` // Chains vald signs command batches for. All chains if empty
+	MintCaps            []MintCapConfig     `mapstructure:"mint_caps"`
+	Timeout             time.Duration       `mapstructure:"timeout"`               // Timeout of the query for the command batch to verify
+	SignWithoutMetadata bool                `mapstructure:"sign_without_metadata"` // Sign sessions without module metadata with a warning instead of refusing them
+	SignOtherModules    bool                `mapstructure:"sign_other_modules"`    // Sign sessions requested by modules other than evm with a warning instead of refusing them
+}
+
+// ChainPolicyConfig restricts what vald signs for a single chain
+type ChainPolicyConfig struct {
+	Name      string   `mapstructure:"name"`
+	Contracts []string `mapstructure:"contracts"` // Destination contracts that contract calls may be approved for. Any contract if empty
+}
+
+// MintCapConfig limits the amount of an asset a single command batch may mint
+type MintCapConfig struct {
+	Chain     string `mapstructure:"chain"` // All chains if empty
+	Symbol    string `mapstructure:"symbol"`
+	MaxAmount string `mapstructure:"max_amount"` // In the smallest unit of the asset
+}
+
+// DefaultSigningPolicyConfig returns a configurations populated with default values
+func DefaultSigningPolicyConfig() SigningPolicyConfig {
+	return SigningPolicyConfig{
+		Enabled: false,
+		Timeout: 10 * time.Second,
+	}
+}
+
+// PresignConfig is the configuration for signing predicted EVM command batches before their signing sessions start.
+// Pre-signed signatures are only submitted once the chain requests a signature for the same payload.
+type PresignConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"` // How often the pending commands of all chains are queried
+	TTL      time.Duration `mapstructure:"ttl"`      // How long a pre-signed signature is kept
+}
+
+// DefaultPresignConfig returns a configurations populated with default values
+func DefaultPresignConfig() PresignConfig {
+	return PresignConfig{
+		Enabled:  true,
+		Interval: 5 * time.Second,
+		TTL:      10 * time.Minute,
+	}
+}
+
+// AuditConfig is the configuration for the audit log of every vote, signature and public key vald submits
+type AuditConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Path       string `mapstructure:"path"`        // Relative paths are resolved against the vald home directory
+	MaxSizeMB  int64  `mapstructure:"max_size_mb"` // The log is rotated once it exceeds this size. No rotation if zero
+	MaxBackups int    `mapstructure:"max_backups"` // Number of rotated logs that are kept
+}
+
+// DefaultAuditConfig returns a configurations populated with default values
+func DefaultAuditConfig() AuditConfig {
+	return AuditConfig{
+		Enabled:    true,
+		Path:       "audit.jsonl",
+		MaxSizeMB:  100,
+		MaxBackups: 5,
+	}
+}
+
+// AlertingConfig is the configuration for alerts about conditions that get the validator penalized if they persist,
+// e.g. a stalled node, a low broadcaster balance, an unavailable tofnd or being opted out of keygens
+type AlertingConfig struct {
+	Enabled              bool              `mapstructure:"enabled"`
+	Sinks                []AlertSinkConfig `mapstructure:"sinks"`
+	RepeatInterval       time.Duration     `mapstructure:"repeat_interval"`        // A firing alert is sent again at most once per interval
+	SendTimeout          time.Duration     `mapstructure:"send_timeout"`           // Timeout of sending an alert to a single sink
+	NoNewBlocksAfter     time.Duration     `mapstructure:"no_new_blocks_after"`    // Should be lower than no_new_blocks_timeout, so the alert fires before vald panics
+	MinBalance           int64             `mapstructure:"min_balance"`            // Broadcaster balance in the native asset below which an alert fires
+	BalanceCheckInterval time.Duration     `mapstructure:"balance_check_interval"` // How often the broadcaster balance is queried
+	TofndFailures        int               `mapstructure:"tofnd_failures"`         // Number of consecutive failed tofnd health checks before an alert fires
+}
+
+// AlertSinkConfig is the configuration of a single destination of alerts
+type AlertSinkConfig struct {
+	Type    string   `mapstructure:"type"`    // One of webhook, exec or file
+	URL     string   `mapstructure:"url"`     // URL the alerts are posted to by a webhook sink
+	Command string   `mapstructure:"command"` // Command run by an exec sink
+	Args    []string `mapstructure:"args"`    // Arguments of the command run by an exec sink
+	Path    string   `mapstructure:"path"`    // File a file sink appends to. Relative paths are resolved against the vald home directory
+}
+
+// DefaultAlertingConfig returns a configurations populated with default values
+func DefaultAlertingConfig() AlertingConfig {
+	return AlertingConfig{
+		Enabled:              false,
+		RepeatInterval:       time.Hour,
+		SendTimeout:          10 * time.Second,
+		NoNewBlocksAfter:     time.Minute,
+		MinBalance:           5000000,
+		BalanceCheckInterval: 5 * time.Minute,
+		TofndFailures:        3,
 	}
 }
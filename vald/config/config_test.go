@@ -29,9 +29,36 @@ func TestConfigAlias(t *testing.T) {
 
 	assert.Equal(t, 99*time.Hour, conf.MaxTimeout)
 	assert.Equal(t, 1*time.Nanosecond, conf.MinSleepBeforeRetry)
+	assert.Equal(t, "unix:///var/run/vald-signer.sock", conf.RemoteSigner.Address)
+	assert.Equal(t, 0.05, conf.Fees.MaxGasPrice)
+	assert.Equal(t, int64(1000000), conf.Fees.Budget)
 	assert.Len(t, conf.EVMConfig, 2)
 	assert.Equal(t, rpc.Confirmation, conf.EVMConfig[0].FinalityOverride)
 	assert.Equal(t, rpc.NoOverride, conf.EVMConfig[1].FinalityOverride)
+	assert.Empty(t, conf.EVMConfig[0].FallbackRPCAddrs)
+	assert.Equal(t, []string{"https://localhost:7476", "https://localhost:7477", "https://localhost:7478"}, conf.EVMConfig[1].RPCAddrs())
+	assert.Equal(t, 2, conf.EVMConfig[1].RPCQuorum)
+	assert.Equal(t, 20, conf.EVMRPC.BatchSize)
+	assert.Equal(t, time.Minute, conf.EVMRPC.MaxBackoff)
+	assert.Equal(t, 2, conf.PollWorkers.ConcurrencyOf("ethereum"))
+	assert.Equal(t, 4, conf.PollWorkers.ConcurrencyOf("avalanche"))
+	assert.True(t, conf.SigningPolicy.Enabled)
+	assert.True(t, conf.SigningPolicy.SignWithoutMetadata)
+	assert.False(t, conf.SigningPolicy.SignOtherModules)
+	assert.Equal(t, []ChainPolicyConfig{{Name: "Ethereum", Contracts: []string{"0x4F4495243837681061C4743b74B3eEdf548D56A5"}}}, conf.SigningPolicy.Chains)
+	assert.Equal(t, []MintCapConfig{{Symbol: "USDC", MaxAmount: "1000000000000"}}, conf.SigningPolicy.MintCaps)
+	assert.Equal(t, CatchUpConfig{Enabled: true, MaxBlocks: 500}, conf.CatchUp)
+	assert.Equal(t, PresignConfig{Enabled: false, Interval: 2 * time.Second, TTL: 10 * time.Minute}, conf.Presign)
+	assert.Equal(t, AuditConfig{Enabled: true, Path: "/var/log/vald/audit.jsonl", MaxSizeMB: 50, MaxBackups: 3}, conf.Audit)
+
+	assert.True(t, conf.Alerting.Enabled)
+	assert.Equal(t, 30*time.Minute, conf.Alerting.RepeatInterval)
+	assert.Equal(t, int64(10000000), conf.Alerting.MinBalance)
+	assert.Equal(t, 5, conf.Alerting.TofndFailures)
+	assert.Equal(t, []AlertSinkConfig{
+		{Type: "webhook", URL: "https://alerts.example.com/vald"},
+		{Type: "exec", Command: "/usr/local/bin/page", Args: []string{"--team", "validators"}},
+	}, conf.Alerting.Sinks)
 }
 
 func buildTestdataFilePath() (string, error) {
@@ -0,0 +1,342 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const (
+	flagGatewayAddress     = "gateway-address"
+	flagConfirmationHeight = "confirmation-height"
+)
+
+// dryRunValidator stands in for the validator, so vald processes every poll as a participant
+var dryRunValidator = sdk.ValAddress(make([]byte, 20))
+
+// GetDryRunCommand returns the command to print the votes vald would cast for an EVM poll or transaction, without broadcasting them
+func GetDryRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-dry-run [poll-id] | [chain] [tx-hash]",
+		Short: "Print the events vald would vote for in the given poll, or for the given gateway transaction, without broadcasting the vote",
+		Long: "Print the events vald would vote for in the given poll, or for the given gateway transaction, without broadcasting the vote. " +
+			"The poll is looked up in the transactions indexed by the node. " +
+			"The transaction is decoded as a gateway transaction, with the gateway address and confirmation height queried from the node unless given by flags. " +
+			"Receipts are fetched from the RPC endpoints configured for vald.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			log.Setup(serverCtx.Logger.With("module", "vald"))
+
+			valdCfg := config.DefaultValdConfig()
+			if err := serverCtx.Viper.Unmarshal(&valdCfg, config.AddDecodeHooks); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var event proto.Message
+			if len(args) == 1 {
+				pollID, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid poll ID")
+				}
+
+				event, err = findPollEvent(cmd.Context(), clientCtx.Client, vote.PollID(pollID))
+				if err != nil {
+					return err
+				}
+			} else {
+				event, err = gatewayTxEvent(cmd, clientCtx, nexus.ChainName(args[0]), args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			chain, ok := pollChain(event)
+			if !ok {
+				return fmt.Errorf("unexpected poll event %T", event)
+			}
+
+			mgr, recorder, err := createDryRunMgr(valdCfg, chain)
+			if err != nil {
+				return err
+			}
+			defer mgr.Close()
+
+			votes, err := dryRun(mgr, recorder, event)
+			if err != nil {
+				return err
+			}
+
+			for _, v := range votes {
+				if err := clientCtx.PrintProto(v); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGatewayAddress, "", "the gateway address to decode the transaction against, queried from the node if not set")
+	cmd.Flags().Uint64(flagConfirmationHeight, 0, "the confirmation height of the chain, queried from the node if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// voteRecorder is a broadcaster that records votes instead of broadcasting them
+type voteRecorder struct {
+	votes []*voteTypes.VoteRequest
+}
+
+// Broadcast implements the broadcast.Broadcaster interface
+func (r *voteRecorder) Broadcast(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	for _, msg := range msgs {
+		if voteReq, ok := msg.(*voteTypes.VoteRequest); ok {
+			r.votes = append(r.votes, voteReq)
+		}
+	}
+
+	return &sdk.TxResponse{}, nil
+}
+
+func createDryRunMgr(valdCfg config.ValdConfig, chain nexus.ChainName) (*evm.Mgr, *voteRecorder, error) {
+	for _, chainCfg := range valdCfg.EVMConfig {
+		if !strings.EqualFold(chainCfg.Name, chain.String()) {
+			continue
+		}
+
+		client, err := evmChainConnector(valdCfg.EVMRPC)(chainCfg)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "failed to create an RPC connection for EVM chain %s", chain)
+		}
+
+		recorder := &voteRecorder{}
+		mgr := evm.NewMgr(map[string]evmRPC.Client{strings.ToLower(chain.String()): client}, recorder, dryRunValidator, sdk.AccAddress(dryRunValidator), evm.NewLatestFinalizedBlockCache())
+		mgr.SetReorgCheckDepth(chain, chainCfg.ReorgCheckDepth)
+
+		return mgr, recorder, nil
+	}
+
+	return nil, nil, fmt.Errorf("no RPC endpoint configured for EVM chain %s", chain)
+}
+
+// dryRun processes the poll event with the same subscriptions vald uses and returns the votes it would broadcast
+func dryRun(mgr *evm.Mgr, recorder *voteRecorder, event proto.Message) ([]*evmTypes.VoteEvents, error) {
+	abciEvent, err := sdk.TypedEventToEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sub := range mgr.Subscriptions() {
+		if sub.Kind != journal.Poll || !sub.Filter(tmEvents.ABCIEventWithHeight{Event: abci.Event(abciEvent)}) {
+			continue
+		}
+
+		if err := sub.Process(asParticipant(event, dryRunValidator)); err != nil {
+			return nil, sdkerrors.Wrap(err, "vald would not vote")
+		}
+
+		if len(recorder.votes) == 0 {
+			return nil, fmt.Errorf("vald would not vote, check the logs for details")
+		}
+
+		votes := make([]*evmTypes.VoteEvents, 0, len(recorder.votes))
+		for _, voteReq := range recorder.votes {
+			voteEvents, ok := voteReq.Vote.GetCachedValue().(*evmTypes.VoteEvents)
+			if !ok {
+				return nil, fmt.Errorf("unexpected vote %T", voteReq.Vote.GetCachedValue())
+			}
+			votes = append(votes, voteEvents)
+		}
+
+		return votes, nil
+	}
+
+	return nil, fmt.Errorf("vald does not vote on %s events", proto.MessageName(event))
+}
+
+// pollEvents are the events that start the EVM polls vald votes on
+var pollEvents = []proto.Message{
+	&evmTypes.ConfirmDepositStarted{},
+	&evmTypes.ConfirmTokenStarted{},
+	&evmTypes.ConfirmKeyTransferStarted{},
+	&evmTypes.ConfirmGatewayTxStarted{},
+	&evmTypes.ConfirmGatewayTxsStarted{},
+}
+
+type txSearcher interface {
+	TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error)
+}
+
+// findPollEvent searches the transactions indexed by the node for the event that started the given poll.
+// Events that start multiple polls are narrowed down to the given poll.
+func findPollEvent(ctx context.Context, client txSearcher, pollID vote.PollID) (proto.Message, error) {
+	pollIDAttr := fmt.Sprintf(`"poll_id":"%s"`, pollID.String())
+
+	for _, pollEvent := range pollEvents {
+		eventType := proto.MessageName(pollEvent)
+		attribute := "participants"
+		if _, ok := pollEvent.(*evmTypes.ConfirmGatewayTxsStarted); ok {
+			attribute = "poll_mappings"
+		}
+
+		query := fmt.Sprintf("%s.%s CONTAINS '%s'", eventType, attribute, pollIDAttr)
+		res, err := client.TxSearch(ctx, query, false, nil, nil, "")
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to search for poll %s, the node must index transactions", pollID.String())
+		}
+
+		for _, tx := range res.Txs {
+			for _, e := range tx.TxResult.Events {
+				if e.Type != eventType {
+					continue
+				}
+
+				event, err := sdk.ParseTypedEvent(e)
+				if err != nil {
+					return nil, err
+				}
+
+				if event, ok := narrowToPoll(event, pollID); ok {
+					return event, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("poll %s not found", pollID.String())
+}
+
+func narrowToPoll(event proto.Message, pollID vote.PollID) (proto.Message, bool) {
+	switch event := event.(type) {
+	case *evmTypes.ConfirmGatewayTxsStarted:
+		for _, mapping := range event.PollMappings {
+			if mapping.PollID == pollID {
+				event.PollMappings = []evmTypes.PollMapping{mapping}
+				return event, true
+			}
+		}
+
+		return nil, false
+	default:
+		participants, ok := pollParticipants(event)
+		return event, ok && participants.PollID == pollID
+	}
+}
+
+func pollParticipants(event proto.Message) (*vote.PollParticipants, bool) {
+	switch event := event.(type) {
+	case *evmTypes.ConfirmDepositStarted:
+		return &event.PollParticipants, true
+	case *evmTypes.ConfirmTokenStarted:
+		return &event.PollParticipants, true
+	case *evmTypes.ConfirmKeyTransferStarted:
+		return &event.PollParticipants, true
+	case *evmTypes.ConfirmGatewayTxStarted:
+		return &event.PollParticipants, true
+	default:
+		return nil, false
+	}
+}
+
+// asParticipant makes the given validator the only participant of the poll
+func asParticipant(event proto.Message, validator sdk.ValAddress) proto.Message {
+	if event, ok := event.(*evmTypes.ConfirmGatewayTxsStarted); ok {
+		event.Participants = []sdk.ValAddress{validator}
+		return event
+	}
+
+	if participants, ok := pollParticipants(event); ok {
+		participants.Participants = []sdk.ValAddress{validator}
+	}
+
+	return event
+}
+
+func pollChain(event proto.Message) (nexus.ChainName, bool) {
+	switch event := event.(type) {
+	case *evmTypes.ConfirmDepositStarted:
+		return event.Chain, true
+	case *evmTypes.ConfirmTokenStarted:
+		return event.Chain, true
+	case *evmTypes.ConfirmKeyTransferStarted:
+		return event.Chain, true
+	case *evmTypes.ConfirmGatewayTxStarted:
+		return event.Chain, true
+	case *evmTypes.ConfirmGatewayTxsStarted:
+		return event.Chain, true
+	default:
+		return "", false
+	}
+}
+
+// gatewayTxEvent returns a poll event to decode the given transaction as a gateway transaction
+func gatewayTxEvent(cmd *cobra.Command, clientCtx client.Context, chain nexus.ChainName, txHash string) (*evmTypes.ConfirmGatewayTxStarted, error) {
+	txHashRaw, err := utils.HexDecode(txHash)
+	if err != nil || len(txHashRaw) != common.HashLength {
+		return nil, fmt.Errorf("invalid tx hash %s", txHash)
+	}
+	txID := evmTypes.Hash(common.BytesToHash(txHashRaw))
+
+	queryClient := evmTypes.NewQueryServiceClient(clientCtx)
+
+	gatewayAddr := funcs.Must(cmd.Flags().GetString(flagGatewayAddress))
+	if gatewayAddr == "" {
+		res, err := queryClient.GatewayAddress(cmd.Context(), &evmTypes.GatewayAddressRequest{Chain: chain.String()})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to query the gateway address of chain %s", chain)
+		}
+		gatewayAddr = res.Address
+	}
+
+	if !common.IsHexAddress(gatewayAddr) {
+		return nil, fmt.Errorf("invalid gateway address %s", gatewayAddr)
+	}
+
+	confHeight := funcs.Must(cmd.Flags().GetUint64(flagConfirmationHeight))
+	if confHeight == 0 {
+		res, err := queryClient.ConfirmationHeight(cmd.Context(), &evmTypes.ConfirmationHeightRequest{Chain: chain.String()})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to query the confirmation height of chain %s", chain)
+		}
+		confHeight = res.Height
+	}
+
+	return &evmTypes.ConfirmGatewayTxStarted{
+		TxID:               txID,
+		Chain:              chain,
+		GatewayAddress:     evmTypes.Address(common.HexToAddress(gatewayAddr)),
+		ConfirmationHeight: confHeight,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package vald
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+type txSearcherFunc func(query string) []proto.Message
+
+func (f txSearcherFunc) TxSearch(_ context.Context, query string, _ bool, _, _ *int, _ string) (*coretypes.ResultTxSearch, error) {
+	var events []abci.Event
+	for _, event := range f(query) {
+		events = append(events, abci.Event(funcs.Must(sdk.TypedEventToEvent(event))))
+	}
+
+	return &coretypes.ResultTxSearch{Txs: []*coretypes.ResultTx{{TxResult: abci.ResponseDeliverTx{Events: events}}}}, nil
+}
+
+func TestFindPollEvent(t *testing.T) {
+	deposit := &evmTypes.ConfirmDepositStarted{Chain: "ethereum", PollParticipants: vote.PollParticipants{PollID: 7, Participants: []sdk.ValAddress{rand.ValAddr()}}}
+	gatewayTxs := &evmTypes.ConfirmGatewayTxsStarted{Chain: "ethereum", PollMappings: []evmTypes.PollMapping{
+		{TxID: evmTypes.Hash(common.BytesToHash(rand.Bytes(common.HashLength))), PollID: 8},
+		{TxID: evmTypes.Hash(common.BytesToHash(rand.Bytes(common.HashLength))), PollID: 9},
+	}}
+
+	var queries []string
+	searcher := txSearcherFunc(func(query string) []proto.Message {
+		queries = append(queries, query)
+		switch query {
+		case `axelar.evm.v1beta1.ConfirmDepositStarted.participants CONTAINS '"poll_id":"7"'`:
+			return []proto.Message{deposit}
+		case `axelar.evm.v1beta1.ConfirmGatewayTxsStarted.poll_mappings CONTAINS '"poll_id":"9"'`:
+			return []proto.Message{gatewayTxs}
+		default:
+			return nil
+		}
+	})
+
+	event, err := findPollEvent(context.Background(), searcher, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, deposit, event)
+
+	event, err = findPollEvent(context.Background(), searcher, 9)
+	assert.NoError(t, err)
+	assert.Equal(t, []evmTypes.PollMapping{gatewayTxs.PollMappings[1]}, event.(*evmTypes.ConfirmGatewayTxsStarted).PollMappings)
+
+	queries = nil
+	_, err = findPollEvent(context.Background(), searcher, 10)
+	assert.Error(t, err)
+	assert.Len(t, queries, len(pollEvents))
+}
+
+func TestDryRun(t *testing.T) {
+	txID := common.BytesToHash(rand.Bytes(common.HashLength))
+	client := &mock.ClientMock{
+		TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) { return nil, ethereum.NotFound },
+	}
+
+	recorder := &voteRecorder{}
+	mgr := evm.NewMgr(map[string]evmRPC.Client{"ethereum": client}, recorder, dryRunValidator, rand.AccAddr(), evm.NewLatestFinalizedBlockCache())
+
+	t.Run("returns the vote for polls the validator does not participate in", func(t *testing.T) {
+		votes, err := dryRun(mgr, recorder, &evmTypes.ConfirmGatewayTxStarted{
+			TxID:             evmTypes.Hash(txID),
+			Chain:            "ethereum",
+			PollParticipants: vote.PollParticipants{PollID: 1, Participants: []sdk.ValAddress{rand.ValAddr()}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*evmTypes.VoteEvents{evmTypes.NewVoteEvents("ethereum")}, votes)
+	})
+
+	t.Run("fails for events vald does not vote on", func(t *testing.T) {
+		_, err := dryRun(mgr, &voteRecorder{}, &evmTypes.ChainAdded{Chain: "ethereum"})
+		assert.Error(t, err)
+	})
+}
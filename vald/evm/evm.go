@@ -6,7 +6,6 @@ import (
 	goerrors "errors"
 	"fmt"
 	"math/big"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +17,7 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
 	"github.com/axelarnetwork/axelar-core/utils/errors"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
 	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
@@ -44,21 +44,25 @@ var NotFinalized = goerrors.New("not finalized")
 
 // Mgr manages all communication with Ethereum
 type Mgr struct {
-	rpcs                      map[string]rpc.Client
+	rpcs                      *rpcClients
 	broadcaster               broadcast.Broadcaster
 	validator                 sdk.ValAddress
 	proxy                     sdk.AccAddress
 	latestFinalizedBlockCache LatestFinalizedBlockCache
+	pauses                    *chainPauses
+	reorgs                    *reorgGuard
 }
 
 // NewMgr returns a new Mgr instance
 func NewMgr(rpcs map[string]rpc.Client, broadcaster broadcast.Broadcaster, valAddr sdk.ValAddress, proxy sdk.AccAddress, latestFinalizedBlockCache LatestFinalizedBlockCache) *Mgr {
 	return &Mgr{
-		rpcs:                      rpcs,
+		rpcs:                      newRPCClients(rpcs),
 		proxy:                     proxy,
 		broadcaster:               broadcaster,
 		validator:                 valAddr,
 		latestFinalizedBlockCache: latestFinalizedBlockCache,
+		pauses:                    newChainPauses(),
+		reorgs:                    newReorgGuard(),
 	}
 }
 
@@ -67,9 +71,83 @@ func (mgr Mgr) logger(keyvals ...any) log.Logger {
 	return log.WithKeyVals(keyvals...)
 }
 
-// ProcessNewChain notifies the operator that vald needs to be restarted/udpated for a new chain
+// SetRPCClient registers the RPC client for the given chain. If a client for the chain already exists,
+// it is replaced and closed once all requests that are still using it have completed.
+func (mgr Mgr) SetRPCClient(chain nexus.ChainName, client rpc.Client) {
+	mgr.rpcs.set(chain, client)
+}
+
+// RemoveRPCClient deregisters the RPC client for the given chain and closes it once it is no longer in use.
+// Returns false if no client was registered for the chain.
+func (mgr Mgr) RemoveRPCClient(chain nexus.ChainName) bool {
+	return mgr.rpcs.remove(chain)
+}
+
+// RPCChains returns the names of all chains with a registered RPC client
+func (mgr Mgr) RPCChains() []string {
+	return mgr.rpcs.chains()
+}
+
+// LatestFinalizedBlockNumber returns the latest finalized block number vald has observed for the given chain
+func (mgr Mgr) LatestFinalizedBlockNumber(chain nexus.ChainName) *big.Int {
+	return mgr.latestFinalizedBlockCache.Get(chain)
+}
+
+// Pause holds back vote processing for the given chain until it is resumed.
+// Returns false if the chain is already paused.
+func (mgr Mgr) Pause(chain nexus.ChainName) bool {
+	paused := mgr.pauses.pause(chain)
+	if paused {
+		mgr.logger("chain", chain.String()).Info("paused vote processing")
+	}
+
+	return paused
+}
+
+// Resume continues vote processing for the given chain, including all polls that were held back while it was paused.
+// Returns false if the chain is not paused.
+func (mgr Mgr) Resume(chain nexus.ChainName) bool {
+	resumed := mgr.pauses.resume(chain)
+	if resumed {
+		mgr.logger("chain", chain.String()).Info("resumed vote processing")
+	}
+
+	return resumed
+}
+
+// PausedChains returns the names of all chains whose vote processing is paused
+func (mgr Mgr) PausedChains() []string {
+	return mgr.pauses.chains()
+}
+
+// waitUntilResumed blocks while vote processing for the given chain is paused
+func (mgr Mgr) waitUntilResumed(chain nexus.ChainName, pollIDs ...vote.PollID) {
+	resumed := mgr.pauses.resumed(chain)
+	select {
+	case <-resumed:
+		return
+	default:
+		mgr.logger("chain", chain.String(), "poll_ids", pollIDs).Info("vote processing is paused, holding back poll")
+		<-resumed
+	}
+}
+
+// Close closes all registered RPC clients
+func (mgr Mgr) Close() {
+	mgr.rpcs.close()
+}
+
+// ProcessNewChain notifies the operator if vald needs to be configured for a new chain
 func (mgr Mgr) ProcessNewChain(event *types.ChainAdded) (err error) {
-	mgr.logger().Info(fmt.Sprintf("VALD needs to be updated and restarted for new chain %s", event.Chain.String()))
+	_, release, ok := mgr.rpcs.acquire(event.Chain)
+	defer release()
+
+	if ok {
+		mgr.logger("chain", event.Chain.String()).Info("new chain added, RPC client is already configured")
+		return nil
+	}
+
+	mgr.logger("chain", event.Chain.String()).Info(fmt.Sprintf("new chain %s added, add it to the axelar_bridge_evm configuration to start voting (no restart required)", event.Chain.String()))
 	return nil
 }
 
@@ -80,13 +158,15 @@ func (mgr Mgr) ProcessDepositConfirmation(event *types.ConfirmDepositStarted) er
 		return nil
 	}
 
-	txReceipt, err := mgr.GetTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	mgr.waitUntilResumed(event.Chain, event.PollID)
+
+	txReceipt, err := mgr.getStableTxReceipt(event.Chain, event.PollID, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger().Infof("broadcasting empty vote for poll %s", event.PollID.String())
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
+		_, err := mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
 
 		return err
 	}
@@ -127,7 +207,7 @@ func (mgr Mgr) ProcessDepositConfirmation(event *types.ConfirmDepositStarted) er
 	}
 
 	mgr.logger().Infof("broadcasting vote %v for poll %s", events, event.PollID.String())
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
+	_, err = mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
 
 	return err
 }
@@ -139,13 +219,15 @@ func (mgr Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error
 		return nil
 	}
 
-	txReceipt, err := mgr.GetTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	mgr.waitUntilResumed(event.Chain, event.PollID)
+
+	txReceipt, err := mgr.getStableTxReceipt(event.Chain, event.PollID, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger().Infof("broadcasting empty vote for poll %s", event.PollID.String())
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
+		_, err := mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
 
 		return err
 	}
@@ -187,7 +269,7 @@ func (mgr Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error
 	}
 
 	mgr.logger().Infof("broadcasting vote %v for poll %s", events, event.PollID.String())
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
+	_, err = mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
 
 	return err
 }
@@ -199,13 +281,15 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferSta
 		return nil
 	}
 
-	txReceipt, err := mgr.GetTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	mgr.waitUntilResumed(event.Chain, event.PollID)
+
+	txReceipt, err := mgr.getStableTxReceipt(event.Chain, event.PollID, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger().Infof("broadcasting empty vote for poll %s", event.PollID.String())
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
+		_, err := mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
 
 		return err
 	}
@@ -245,7 +329,7 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferSta
 	}
 
 	mgr.logger().Infof("broadcasting vote %v for poll %s", events, event.PollID.String())
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
+	_, err = mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
 
 	return err
 }
@@ -257,20 +341,22 @@ func (mgr Mgr) ProcessGatewayTxConfirmation(event *types.ConfirmGatewayTxStarted
 		return nil
 	}
 
-	txReceipt, err := mgr.GetTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	mgr.waitUntilResumed(event.Chain, event.PollID)
+
+	txReceipt, err := mgr.getStableTxReceipt(event.Chain, event.PollID, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger().Infof("broadcasting empty vote for poll %s", event.PollID.String())
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
+		_, err := mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain)))
 
 		return err
 	}
 
 	events := mgr.processGatewayTxLogs(event.Chain, event.GatewayAddress, txReceipt.Logs)
 	mgr.logger().Infof("broadcasting vote %v for poll %s", events, event.PollID.String())
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
+	_, err = mgr.broadcaster.Broadcast(audit.WithPoll(context.TODO(), event.PollID, event.Chain, event.TxID.Hex()), voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...)))
 
 	return err
 }
@@ -283,26 +369,56 @@ func (mgr Mgr) ProcessGatewayTxsConfirmation(event *types.ConfirmGatewayTxsStart
 		return nil
 	}
 
-	txIDs := slices.Map(event.PollMappings, func(poll types.PollMapping) common.Hash { return common.Hash(poll.TxID) })
+	mgr.waitUntilResumed(event.Chain, slices.Map(event.PollMappings, func(m types.PollMapping) vote.PollID { return m.PollID })...)
+
+	pending := event.PollMappings
+	for {
+		reorganized, err := mgr.voteOnGatewayTxs(event, pending)
+		if err != nil {
+			return err
+		}
+
+		pollIDs := mgr.reorgs.recheckable(slices.Map(reorganized, func(m types.PollMapping) vote.PollID { return m.PollID })...)
+		if len(pollIDs) == 0 {
+			return nil
+		}
+
+		pending = slices.Filter(reorganized, func(m types.PollMapping) bool {
+			return slices.Any(pollIDs, func(pollID vote.PollID) bool { return pollID == m.PollID })
+		})
+		mgr.logger("chain", event.Chain, "poll_ids", pollIDs).Info("checking the transactions again because their blocks were reorganized")
+	}
+}
+
+// voteOnGatewayTxs broadcasts the votes for the given polls and returns the polls whose transaction's block was reorganized,
+// so they can be checked again once the block is stable
+func (mgr Mgr) voteOnGatewayTxs(event *types.ConfirmGatewayTxsStarted, pollMappings []types.PollMapping) ([]types.PollMapping, error) {
+	txIDs := slices.Map(pollMappings, func(poll types.PollMapping) common.Hash { return common.Hash(poll.TxID) })
 	txReceipts, err := mgr.GetTxReceiptsIfFinalized(event.Chain, txIDs, event.ConfirmationHeight)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var votes []sdk.Msg
+	var reorganized []types.PollMapping
+	ctx := context.TODO()
 	for i, result := range txReceipts {
-		pollID := event.PollMappings[i].PollID
-		txID := event.PollMappings[i].TxID
+		pollID := pollMappings[i].PollID
+		txID := pollMappings[i].TxID
+		ctx = audit.WithPoll(ctx, pollID, event.Chain, txID.Hex())
 
 		logger := mgr.logger("chain", event.Chain, "poll_id", pollID.String(), "tx_id", txID.Hex())
 
 		// only broadcast empty votes if the tx is not found or not finalized
-		switch result.Err() {
-		case nil:
+		switch err := result.Err(); {
+		case goerrors.Is(err, ErrReorgDetected):
+			logger.Infof("not voting yet because the transaction's block was reorganized: %s", err.Error())
+			reorganized = append(reorganized, pollMappings[i])
+		case err == nil:
 			events := mgr.processGatewayTxLogs(event.Chain, event.GatewayAddress, result.Ok().Logs)
 			logger.Infof("broadcasting vote %v", events)
 			votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain, events...)))
-		case NotFinalized, ethereum.NotFound:
+		case err == NotFinalized, err == ethereum.NotFound:
 			logger.Infof("broadcasting empty vote due to error: %s", result.Err().Error())
 			votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain)))
 		default:
@@ -311,8 +427,12 @@ func (mgr Mgr) ProcessGatewayTxsConfirmation(event *types.ConfirmGatewayTxsStart
 
 	}
 
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), votes...)
-	return err
+	if len(votes) == 0 {
+		return reorganized, nil
+	}
+
+	_, err = mgr.broadcaster.Broadcast(ctx, votes...)
+	return reorganized, err
 }
 
 func DecodeEventTokenSent(log *geth.Log) (types.EventTokenSent, error) {
@@ -414,7 +534,8 @@ func DecodeEventContractCallWithToken(log *geth.Log) (types.EventContractCallWit
 }
 
 func (mgr Mgr) isTxReceiptFinalized(chain nexus.ChainName, txReceipt *geth.Receipt, confHeight uint64) (bool, error) {
-	client, ok := mgr.rpcs[strings.ToLower(chain.String())]
+	client, release, ok := mgr.rpcs.acquire(chain)
+	defer release()
 	if !ok {
 		return false, fmt.Errorf("rpc client not found for chain %s", chain.String())
 	}
@@ -437,8 +558,22 @@ func (mgr Mgr) isTxReceiptFinalized(chain nexus.ChainName, txReceipt *geth.Recei
 	return true, nil
 }
 
+// getStableTxReceipt returns the receipt of the given transaction if it is finalized, see GetTxReceiptIfFinalized.
+// While the transaction's block is found to be reorganized, the receipt is checked again until its block is stable or the poll closes.
+func (mgr Mgr) getStableTxReceipt(chain nexus.ChainName, pollID vote.PollID, txID common.Hash, confHeight uint64) (*geth.Receipt, error) {
+	for {
+		txReceipt, err := mgr.GetTxReceiptIfFinalized(chain, txID, confHeight)
+		if !goerrors.Is(err, ErrReorgDetected) || len(mgr.reorgs.recheckable(pollID)) == 0 {
+			return txReceipt, err
+		}
+
+		mgr.logger("chain", chain.String(), "poll_id", pollID.String(), "tx_id", txID.Hex()).Info("checking the transaction again because its block was reorganized")
+	}
+}
+
 func (mgr Mgr) GetTxReceiptIfFinalized(chain nexus.ChainName, txID common.Hash, confHeight uint64) (*geth.Receipt, error) {
-	client, ok := mgr.rpcs[strings.ToLower(chain.String())]
+	client, release, ok := mgr.rpcs.acquire(chain)
+	defer release()
 	if !ok {
 		return nil, fmt.Errorf("rpc client not found for chain %s", chain.String())
 	}
@@ -464,12 +599,17 @@ func (mgr Mgr) GetTxReceiptIfFinalized(chain nexus.ChainName, txID common.Hash,
 		return nil, nil
 	}
 
+	if err := mgr.verifyCanonical(client, newHeaderCache(client), chain, txReceipt); err != nil {
+		return nil, sdkerrors.Wrapf(err, "cannot verify that the transaction %s is in the canonical chain", txID.Hex())
+	}
+
 	return txReceipt, nil
 }
 
 // GetTxReceiptsIfFinalized retrieves receipts for provided transaction IDs, only if they're finalized.
 func (mgr Mgr) GetTxReceiptsIfFinalized(chain nexus.ChainName, txIDs []common.Hash, confHeight uint64) ([]rs.Result[*geth.Receipt], error) {
-	client, ok := mgr.rpcs[strings.ToLower(chain.String())]
+	client, release, ok := mgr.rpcs.acquire(chain)
+	defer release()
 	if !ok {
 		return nil, fmt.Errorf("rpc client not found for chain %s", chain.String())
 	}
@@ -480,6 +620,8 @@ func (mgr Mgr) GetTxReceiptsIfFinalized(chain nexus.ChainName, txIDs []common.Ha
 			"cannot get transaction receipts")
 	}
 
+	// receipts of the same batch are often in the same or nearby blocks, so their headers are only fetched once
+	headers := newHeaderCache(client)
 	isFinalized := func(receipt *geth.Receipt) rs.Result[*geth.Receipt] {
 		isFinalized, err := mgr.isTxReceiptFinalized(chain, receipt, confHeight)
 		if err != nil {
@@ -492,6 +634,10 @@ func (mgr Mgr) GetTxReceiptsIfFinalized(chain nexus.ChainName, txIDs []common.Ha
 			return rs.FromErr[*geth.Receipt](NotFinalized)
 		}
 
+		if err := mgr.verifyCanonical(client, headers, chain, receipt); err != nil {
+			return rs.FromErr[*geth.Receipt](sdkerrors.Wrapf(err, "cannot verify that the transaction %s is in the canonical chain", receipt.TxHash.Hex()))
+		}
+
 		return rs.FromOk(receipt)
 	}
 
@@ -510,6 +656,7 @@ func DecodeERC20TransferEvent(log *geth.Log) (types.EventTransfer, error) {
 		return types.EventTransfer{}, err
 	}
 
+	from := common.BytesToAddress(log.Topics[1][:])
 	to := common.BytesToAddress(log.Topics[2][:])
 
 	arguments := abi.Arguments{
@@ -524,6 +671,7 @@ func DecodeERC20TransferEvent(log *geth.Log) (types.EventTransfer, error) {
 	return types.EventTransfer{
 		To:     types.Address(to),
 		Amount: sdk.NewUintFromBigInt(params[0].(*big.Int)),
+		From:   types.Address(from),
 	}, nil
 }
 
@@ -167,6 +167,7 @@ func TestDecodeErc20TransferEvent_CorrectData(t *testing.T) {
 	transfer, err := evm.DecodeERC20TransferEvent(&l)
 
 	assert.NoError(t, err)
+	assert.Equal(t, types.Address(expectedFrom), transfer.From)
 	assert.Equal(t, types.Address(expectedTo), transfer.To)
 	assert.Equal(t, expectedAmount, transfer.Amount)
 }
@@ -868,6 +869,9 @@ func TestMgr_GetTxReceiptsIfFinalized(t *testing.T) {
 			LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) {
 				return big.NewInt(latestFinalizedBlockNumber), nil
 			},
+			HeaderByNumberFunc: func(_ context.Context, number *big.Int) (*evmRpc.Header, error) {
+				return &evmRpc.Header{Number: (*hexutil.Big)(number), Transactions: txHashes}, nil
+			},
 		}
 		cache = &evmmock.LatestFinalizedBlockCacheMock{
 			GetFunc: func(chain nexus.ChainName) *big.Int { return big.NewInt(0) },
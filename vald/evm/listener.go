@@ -0,0 +1,56 @@
+package evm
+
+import (
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	"github.com/axelarnetwork/axelar-core/vald/listener"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+var _ listener.ChainListener = Mgr{}
+
+// Subscriptions returns the EVM events vald processes
+func (mgr Mgr) Subscriptions() []listener.Subscription {
+	return []listener.Subscription{
+		listener.Handle("evm_new_chain", mgr.ProcessNewChain),
+		listener.OnChain(listener.HandleSessions("evm_deposit_confirmation", journal.Poll,
+			func(e *types.ConfirmDepositStarted) []string { return mgr.pollSessions(e.PollParticipants) },
+			mgr.ProcessDepositConfirmation),
+			func(e *types.ConfirmDepositStarted) nexus.ChainName { return e.Chain }),
+		listener.OnChain(listener.HandleSessions("evm_token_confirmation", journal.Poll,
+			func(e *types.ConfirmTokenStarted) []string { return mgr.pollSessions(e.PollParticipants) },
+			mgr.ProcessTokenConfirmation),
+			func(e *types.ConfirmTokenStarted) nexus.ChainName { return e.Chain }),
+		listener.OnChain(listener.HandleSessions("evm_key_transfer_confirmation", journal.Poll,
+			func(e *types.ConfirmKeyTransferStarted) []string { return mgr.pollSessions(e.PollParticipants) },
+			mgr.ProcessTransferKeyConfirmation),
+			func(e *types.ConfirmKeyTransferStarted) nexus.ChainName { return e.Chain }),
+		listener.OnChain(listener.HandleSessions("evm_gateway_tx_confirmation", journal.Poll,
+			func(e *types.ConfirmGatewayTxStarted) []string { return mgr.pollSessions(e.PollParticipants) },
+			mgr.ProcessGatewayTxConfirmation),
+			func(e *types.ConfirmGatewayTxStarted) nexus.ChainName { return e.Chain }),
+		listener.OnChain(listener.HandleSessions("evm_gateway_txs_confirmation", journal.Poll, mgr.gatewayTxsPollSessions,
+			mgr.ProcessGatewayTxsConfirmation),
+			func(e *types.ConfirmGatewayTxsStarted) nexus.ChainName { return e.Chain }),
+	}
+}
+
+// pollSessions returns the ID of the poll if the validator participates in it
+func (mgr Mgr) pollSessions(participants vote.PollParticipants) []string {
+	if !mgr.isParticipantOf(participants.Participants) {
+		return nil
+	}
+
+	return []string{participants.PollID.String()}
+}
+
+// gatewayTxsPollSessions returns the IDs of all polls of the event if the validator participates in them
+func (mgr Mgr) gatewayTxsPollSessions(event *types.ConfirmGatewayTxsStarted) []string {
+	if !mgr.isParticipantOf(event.Participants) {
+		return nil
+	}
+
+	return slices.Map(event.PollMappings, func(m types.PollMapping) string { return m.PollID.String() })
+}
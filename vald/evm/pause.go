@@ -0,0 +1,76 @@
+package evm
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// chainPauses keeps track of chains whose vote processing is paused
+type chainPauses struct {
+	lock   sync.Mutex
+	paused map[string]chan struct{}
+}
+
+func newChainPauses() *chainPauses {
+	return &chainPauses{paused: make(map[string]chan struct{})}
+}
+
+// pause pauses the given chain. Returns false if the chain is already paused.
+func (p *chainPauses) pause(chain nexus.ChainName) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	chainName := strings.ToLower(chain.String())
+	if _, ok := p.paused[chainName]; ok {
+		return false
+	}
+
+	p.paused[chainName] = make(chan struct{})
+	return true
+}
+
+// resume resumes the given chain. Returns false if the chain is not paused.
+func (p *chainPauses) resume(chain nexus.ChainName) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	chainName := strings.ToLower(chain.String())
+	resumed, ok := p.paused[chainName]
+	if !ok {
+		return false
+	}
+
+	close(resumed)
+	delete(p.paused, chainName)
+	return true
+}
+
+// resumed returns a channel that is closed once the given chain is not paused
+func (p *chainPauses) resumed(chain nexus.ChainName) <-chan struct{} {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if resumed, ok := p.paused[strings.ToLower(chain.String())]; ok {
+		return resumed
+	}
+
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+
+func (p *chainPauses) chains() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	chains := make([]string, 0, len(p.paused))
+	for chain := range p.paused {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	return chains
+}
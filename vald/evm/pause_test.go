@@ -0,0 +1,57 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestMgr_Pause(t *testing.T) {
+	valAddr := rand.ValAddr()
+	requested := make(chan struct{}, 1)
+	client := &mock.ClientMock{
+		TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
+			requested <- struct{}{}
+			return nil, errors.New("unavailable")
+		},
+	}
+	mgr := evm.NewMgr(map[string]rpc.Client{"ethereum": client}, nil, valAddr, rand.AccAddr(), evm.NewLatestFinalizedBlockCache())
+
+	assert.True(t, mgr.Pause("Ethereum"))
+	assert.False(t, mgr.Pause("ethereum"))
+	assert.Equal(t, []string{"ethereum"}, mgr.PausedChains())
+
+	processed := make(chan error, 1)
+	go func() {
+		processed <- mgr.ProcessGatewayTxConfirmation(&types.ConfirmGatewayTxStarted{
+			Chain:            "ethereum",
+			PollParticipants: vote.PollParticipants{PollID: vote.PollID(1), Participants: []sdk.ValAddress{valAddr}},
+		})
+	}()
+
+	select {
+	case <-requested:
+		assert.FailNow(t, "poll must be held back while the chain is paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.True(t, mgr.Resume("ethereum"))
+	assert.False(t, mgr.Resume("ethereum"))
+	assert.Empty(t, mgr.PausedChains())
+
+	<-requested
+	assert.Error(t, <-processed)
+}
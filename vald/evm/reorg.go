@@ -0,0 +1,228 @@
+package evm
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/axelarnetwork/axelar-core/utils/errors"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// ErrReorgDetected is returned when the block of a transaction receipt is no longer part of the canonical chain
+var ErrReorgDetected = goerrors.New("chain reorganization detected")
+
+// observedBlockHashesLimit is the maximum number of receipt block hashes that are remembered to detect reorgs
+const observedBlockHashesLimit = 10000
+
+var reorgsDetected = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "vald",
+	Subsystem: "evm",
+	Name:      "reorgs_detected_total",
+	Help:      "Number of transaction receipts whose block was found to be reorganized",
+}, []string{"chain"})
+
+// RegisterMetrics registers the EVM manager metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	return registerer.Register(reorgsDetected)
+}
+
+// reorgGuard remembers the block hashes of receipts and how deep the canonical chain is checked per chain
+type reorgGuard struct {
+	lock        sync.Mutex
+	depths      map[string]uint64
+	blockHashes map[common.Hash]common.Hash
+	// order of observed transactions, oldest first, so the oldest can be evicted
+	observed []common.Hash
+
+	recheckInterval time.Duration
+	// pollOpen returns true while votes for the given poll are still accepted. Reorganized receipts are not rechecked if nil
+	pollOpen func(vote.PollID) bool
+}
+
+func newReorgGuard() *reorgGuard {
+	return &reorgGuard{
+		depths:      make(map[string]uint64),
+		blockHashes: make(map[common.Hash]common.Hash),
+	}
+}
+
+func (g *reorgGuard) setDepth(chain nexus.ChainName, depth uint64) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.depths[strings.ToLower(chain.String())] = depth
+}
+
+func (g *reorgGuard) depth(chain nexus.ChainName) uint64 {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return g.depths[strings.ToLower(chain.String())]
+}
+
+// moved returns true if the transaction of the given receipt was previously observed in a different block
+func (g *reorgGuard) moved(receipt *geth.Receipt) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	blockHash, ok := g.blockHashes[receipt.TxHash]
+	return ok && blockHash != receipt.BlockHash
+}
+
+// observe records the block hash of the given receipt
+func (g *reorgGuard) observe(receipt *geth.Receipt) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if _, ok := g.blockHashes[receipt.TxHash]; ok {
+		g.blockHashes[receipt.TxHash] = receipt.BlockHash
+		return
+	}
+
+	g.blockHashes[receipt.TxHash] = receipt.BlockHash
+	g.observed = append(g.observed, receipt.TxHash)
+	if len(g.observed) > observedBlockHashesLimit {
+		delete(g.blockHashes, g.observed[0])
+		g.observed = g.observed[1:]
+	}
+}
+
+// recheckable waits for the recheck interval and returns the given polls that are still open afterwards,
+// so the receipts of their transactions can be checked again until their blocks are stable
+func (g *reorgGuard) recheckable(pollIDs ...vote.PollID) []vote.PollID {
+	g.lock.Lock()
+	interval, pollOpen := g.recheckInterval, g.pollOpen
+	g.lock.Unlock()
+
+	if pollOpen == nil || len(slices.Filter(pollIDs, pollOpen)) == 0 {
+		return nil
+	}
+
+	time.Sleep(interval)
+
+	return slices.Filter(pollIDs, pollOpen)
+}
+
+// SetReorgRecheck makes polls whose transaction's block was reorganized check the transaction again after the given interval,
+// until the block is stable to the configured depth or pollOpen returns false for the poll
+func (mgr Mgr) SetReorgRecheck(interval time.Duration, pollOpen func(vote.PollID) bool) {
+	mgr.reorgs.lock.Lock()
+	defer mgr.reorgs.lock.Unlock()
+
+	mgr.reorgs.recheckInterval = interval
+	mgr.reorgs.pollOpen = pollOpen
+}
+
+// SetReorgCheckDepth sets the number of descendant blocks that must link back to a receipt's block before vald votes on it
+func (mgr Mgr) SetReorgCheckDepth(chain nexus.ChainName, depth uint64) {
+	mgr.reorgs.setDepth(chain, depth)
+}
+
+// verifyCanonical checks that the block of the given finalized receipt is part of the canonical chain, see checkCanonical.
+// A receipt whose block turns out to be reorganized is evicted from the client's receipt cache, so it is fetched again next time.
+func (mgr Mgr) verifyCanonical(client rpc.Client, headers *headerCache, chain nexus.ChainName, receipt *geth.Receipt) error {
+	err := mgr.checkCanonical(headers, chain, receipt)
+	if evicter, ok := client.(rpc.ReceiptEvicter); ok && goerrors.Is(err, ErrReorgDetected) {
+		evicter.EvictReceipt(receipt.TxHash)
+	}
+
+	return err
+}
+
+// checkCanonical checks that the block of the given finalized receipt is part of the canonical chain.
+// The block hash must match the canonical header at the receipt's height, the header must contain the transaction,
+// and the following blocks up to the configured depth (bounded by the latest finalized block) must link back to it.
+// If the transaction was observed in a different block before, its current block must be confirmed by the full configured depth.
+func (mgr Mgr) checkCanonical(headers *headerCache, chain nexus.ChainName, receipt *geth.Receipt) error {
+	keyvals := []interface{}{"chain", chain.String(), "tx_id", receipt.TxHash.Hex(), "block_number", receipt.BlockNumber.String(), "block_hash", receipt.BlockHash.Hex()}
+
+	header, err := headers.byNumber(receipt.BlockNumber)
+	if err != nil {
+		return errors.With(err, keyvals...)
+	}
+
+	if header.Hash != receipt.BlockHash {
+		return mgr.reorgDetected(chain, fmt.Sprintf("canonical block hash is %s", header.Hash.Hex()), keyvals)
+	}
+
+	if !slices.Any(header.Transactions, func(txHash common.Hash) bool { return txHash == receipt.TxHash }) {
+		return mgr.reorgDetected(chain, "canonical block does not contain the transaction", keyvals)
+	}
+
+	last := new(big.Int).Add(receipt.BlockNumber, new(big.Int).SetUint64(mgr.reorgs.depth(chain)))
+	confirmedToDepth := true
+	if finalized := mgr.latestFinalizedBlockCache.Get(chain); finalized.Cmp(last) < 0 {
+		last = finalized
+		confirmedToDepth = false
+	}
+
+	parent := header
+	for number := new(big.Int).Add(receipt.BlockNumber, big.NewInt(1)); number.Cmp(last) <= 0; number = new(big.Int).Add(number, big.NewInt(1)) {
+		child, err := headers.byNumber(number)
+		if err != nil {
+			return errors.With(err, keyvals...)
+		}
+
+		if child.ParentHash != parent.Hash {
+			return mgr.reorgDetected(chain, fmt.Sprintf("block %s does not descend from the receipt's block", number.String()), keyvals)
+		}
+		parent = child
+	}
+
+	if mgr.reorgs.moved(receipt) {
+		// the recorded block is kept until the new block is confirmed, so the transaction cannot slip through on a later check
+		if !confirmedToDepth {
+			return mgr.reorgDetected(chain, "transaction was previously observed in a different block", keyvals)
+		}
+
+		reorgsDetected.WithLabelValues(strings.ToLower(chain.String())).Inc()
+		mgr.logger(keyvals...).Info("transaction moved to a different block that is confirmed to the configured depth")
+	}
+	mgr.reorgs.observe(receipt)
+
+	return nil
+}
+
+// headerCache memoizes the headers fetched to check the receipts of a single request.
+// Receipts of the same or nearby blocks share most of the descendants that are checked.
+type headerCache struct {
+	client  rpc.Client
+	headers map[string]*rpc.Header
+}
+
+func newHeaderCache(client rpc.Client) *headerCache {
+	return &headerCache{client: client, headers: make(map[string]*rpc.Header)}
+}
+
+func (c *headerCache) byNumber(number *big.Int) (*rpc.Header, error) {
+	if header, ok := c.headers[number.String()]; ok {
+		return header, nil
+	}
+
+	header, err := c.client.HeaderByNumber(context.Background(), number)
+	if err != nil {
+		return nil, err
+	}
+
+	c.headers[number.String()] = header
+	return header, nil
+}
+
+func (mgr Mgr) reorgDetected(chain nexus.ChainName, reason string, keyvals []interface{}) error {
+	reorgsDetected.WithLabelValues(strings.ToLower(chain.String())).Inc()
+	mgr.logger(keyvals...).Info(fmt.Sprintf("reorg detected: %s", reason))
+
+	return errors.With(fmt.Errorf("%w: %s", ErrReorgDetected, reason), keyvals...)
+}
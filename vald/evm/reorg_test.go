@@ -0,0 +1,311 @@
+package evm_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	broadcastmock "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmRpc "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestMgr_GetTxReceiptIfFinalized_Reorg(t *testing.T) {
+	chain := nexus.ChainName(strings.ToLower(rand.NormalizedStr(5)))
+	txHash := common.BytesToHash(rand.Bytes(common.HashLength))
+	blockNumber := big.NewInt(100)
+	latestFinalized := big.NewInt(200)
+	randHash := func() common.Hash { return common.BytesToHash(rand.Bytes(common.HashLength)) }
+
+	setup := func(receipt *geth.Receipt, headers map[int64]*evmRpc.Header, depth uint64) *evm.Mgr {
+		client := &mock.ClientMock{
+			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) { return receipt, nil },
+			LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) {
+				return latestFinalized, nil
+			},
+			HeaderByNumberFunc: func(_ context.Context, number *big.Int) (*evmRpc.Header, error) {
+				header, ok := headers[number.Int64()]
+				if !ok {
+					return nil, fmt.Errorf("unexpected block %s", number.String())
+				}
+
+				return header, nil
+			},
+		}
+
+		mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): client}, nil, rand.ValAddr(), rand.AccAddr(), evm.NewLatestFinalizedBlockCache())
+		mgr.SetReorgCheckDepth(chain, depth)
+
+		return mgr
+	}
+
+	chainOf := func(blockHash common.Hash, length int64) map[int64]*evmRpc.Header {
+		headers := map[int64]*evmRpc.Header{
+			blockNumber.Int64(): {Number: (*hexutil.Big)(blockNumber), Hash: blockHash, Transactions: []common.Hash{txHash}},
+		}
+		for i := int64(1); i <= length; i++ {
+			number := new(big.Int).Add(blockNumber, big.NewInt(i))
+			headers[number.Int64()] = &evmRpc.Header{Number: (*hexutil.Big)(number), Hash: randHash(), ParentHash: headers[number.Int64()-1].Hash}
+		}
+
+		return headers
+	}
+
+	t.Run("returns the receipt if its block is canonical", func(t *testing.T) {
+		blockHash := randHash()
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: blockHash, BlockNumber: blockNumber, Status: 1}
+		mgr := setup(receipt, chainOf(blockHash, 5), 5)
+
+		actual, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, actual)
+	})
+
+	t.Run("detects a different canonical block hash", func(t *testing.T) {
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: randHash(), BlockNumber: blockNumber, Status: 1}
+		mgr := setup(receipt, chainOf(randHash(), 0), 0)
+
+		actual, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.ErrorIs(t, err, evm.ErrReorgDetected)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("detects a canonical block without the transaction", func(t *testing.T) {
+		blockHash := randHash()
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: blockHash, BlockNumber: blockNumber, Status: 1}
+		headers := chainOf(blockHash, 0)
+		headers[blockNumber.Int64()].Transactions = []common.Hash{randHash()}
+		mgr := setup(receipt, headers, 0)
+
+		_, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.ErrorIs(t, err, evm.ErrReorgDetected)
+	})
+
+	t.Run("detects a descendant that does not link back to the block", func(t *testing.T) {
+		blockHash := randHash()
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: blockHash, BlockNumber: blockNumber, Status: 1}
+		headers := chainOf(blockHash, 5)
+		headers[blockNumber.Int64()+3].ParentHash = randHash()
+		mgr := setup(receipt, headers, 5)
+
+		_, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.ErrorIs(t, err, evm.ErrReorgDetected)
+	})
+
+	t.Run("does not check descendants beyond the latest finalized block", func(t *testing.T) {
+		blockHash := randHash()
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: blockHash, BlockNumber: blockNumber, Status: 1}
+		mgr := setup(receipt, chainOf(blockHash, latestFinalized.Int64()-blockNumber.Int64()), 1000)
+
+		_, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.NoError(t, err)
+	})
+
+	moveTx := func(receipt *geth.Receipt, headers map[int64]*evmRpc.Header) {
+		receipt.BlockHash = randHash()
+		for number, header := range chainOf(receipt.BlockHash, int64(len(headers)-1)) {
+			headers[number] = header
+		}
+	}
+
+	t.Run("accepts a transaction that moved to a block confirmed to the configured depth", func(t *testing.T) {
+		blockHash := randHash()
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: blockHash, BlockNumber: blockNumber, Status: 1}
+		headers := chainOf(blockHash, 5)
+		mgr := setup(receipt, headers, 5)
+
+		_, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.NoError(t, err)
+
+		moveTx(receipt, headers)
+
+		actual, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, actual)
+	})
+
+	t.Run("detects a transaction that moved to a block not yet confirmed to the configured depth", func(t *testing.T) {
+		blockHash := randHash()
+		receipt := &geth.Receipt{TxHash: txHash, BlockHash: blockHash, BlockNumber: blockNumber, Status: 1}
+		headers := chainOf(blockHash, latestFinalized.Int64()-blockNumber.Int64())
+		mgr := setup(receipt, headers, 1000)
+
+		_, err := mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+		assert.NoError(t, err)
+
+		moveTx(receipt, headers)
+
+		for i := 0; i < 2; i++ {
+			_, err = mgr.GetTxReceiptIfFinalized(chain, txHash, 1)
+			assert.ErrorIs(t, err, evm.ErrReorgDetected)
+		}
+	})
+}
+
+func TestMgr_RecheckReorganizedTx(t *testing.T) {
+	chain := nexus.ChainName(strings.ToLower(rand.NormalizedStr(5)))
+	latestFinalized := big.NewInt(200)
+	randHash := func() common.Hash { return common.BytesToHash(rand.Bytes(common.HashLength)) }
+	valAddr := rand.ValAddr()
+
+	var (
+		client      *mock.ClientMock
+		broadcaster *broadcastmock.BroadcasterMock
+		receipts    map[common.Hash]*geth.Receipt
+		// reorganized blocks return a different hash the given number of times before they are stable
+		reorganized map[int64]int
+	)
+
+	blockHash := func(number *big.Int) common.Hash {
+		for _, receipt := range receipts {
+			if receipt.BlockNumber.Cmp(number) == 0 {
+				return receipt.BlockHash
+			}
+		}
+
+		return common.BigToHash(number)
+	}
+
+	setup := func(pollOpen func(vote.PollID) bool) *evm.Mgr {
+		receipts = make(map[common.Hash]*geth.Receipt)
+		reorganized = make(map[int64]int)
+
+		client = &mock.ClientMock{
+			TransactionReceiptFunc: func(_ context.Context, txID common.Hash) (*geth.Receipt, error) { return receipts[txID], nil },
+			TransactionReceiptsFunc: func(_ context.Context, txIDs []common.Hash) ([]evmRpc.Result, error) {
+				return slices.Map(txIDs, func(txID common.Hash) evmRpc.Result { return evmRpc.Result(results.FromOk(receipts[txID])) }), nil
+			},
+			LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) { return latestFinalized, nil },
+			HeaderByNumberFunc: func(_ context.Context, number *big.Int) (*evmRpc.Header, error) {
+				header := &evmRpc.Header{Number: (*hexutil.Big)(number), Hash: blockHash(number), ParentHash: blockHash(new(big.Int).Sub(number, big.NewInt(1)))}
+				for _, receipt := range receipts {
+					if receipt.BlockNumber.Cmp(number) == 0 {
+						header.Transactions = append(header.Transactions, receipt.TxHash)
+					}
+				}
+
+				if reorganized[number.Int64()] > 0 {
+					reorganized[number.Int64()]--
+					header.Hash = randHash()
+				}
+
+				return header, nil
+			},
+		}
+		broadcaster = &broadcastmock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, nil },
+		}
+
+		mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): client}, broadcaster, valAddr, rand.AccAddr(), evm.NewLatestFinalizedBlockCache())
+		mgr.SetReorgRecheck(time.Millisecond, pollOpen)
+
+		return mgr
+	}
+
+	newTx := func(blockNumber int64) common.Hash {
+		receipt := &geth.Receipt{TxHash: randHash(), BlockHash: randHash(), BlockNumber: big.NewInt(blockNumber), Status: 1}
+		receipts[receipt.TxHash] = receipt
+
+		return receipt.TxHash
+	}
+
+	gatewayTxPoll := func(txID common.Hash) *types.ConfirmGatewayTxStarted {
+		return &types.ConfirmGatewayTxStarted{
+			TxID:               types.Hash(txID),
+			Chain:              chain,
+			ConfirmationHeight: 1,
+			PollParticipants:   vote.PollParticipants{PollID: vote.PollID(rand.PosI64()), Participants: []sdk.ValAddress{valAddr}},
+		}
+	}
+
+	t.Run("votes once the block of the transaction is stable", func(t *testing.T) {
+		mgr := setup(func(vote.PollID) bool { return true })
+		txID := newTx(100)
+		reorganized[100] = 2
+
+		assert.NoError(t, mgr.ProcessGatewayTxConfirmation(gatewayTxPoll(txID)))
+		assert.Len(t, client.HeaderByNumberCalls(), 3)
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+	})
+
+	t.Run("stops checking the transaction once the poll is closed", func(t *testing.T) {
+		closed := false
+		mgr := setup(func(vote.PollID) bool { return !closed })
+		txID := newTx(100)
+		reorganized[100] = 1000
+		client.TransactionReceiptFunc = func(_ context.Context, txID common.Hash) (*geth.Receipt, error) {
+			if len(client.TransactionReceiptCalls()) == 3 {
+				closed = true
+			}
+
+			return receipts[txID], nil
+		}
+
+		assert.ErrorIs(t, mgr.ProcessGatewayTxConfirmation(gatewayTxPoll(txID)), evm.ErrReorgDetected)
+		assert.Len(t, client.TransactionReceiptCalls(), 3)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	})
+
+	t.Run("does not check the transaction again without open polls", func(t *testing.T) {
+		mgr := setup(nil)
+		txID := newTx(100)
+		reorganized[100] = 1
+
+		assert.ErrorIs(t, mgr.ProcessGatewayTxConfirmation(gatewayTxPoll(txID)), evm.ErrReorgDetected)
+		assert.Len(t, client.TransactionReceiptCalls(), 1)
+	})
+
+	t.Run("votes on the other transactions of a batch right away and on reorganized ones once they are stable", func(t *testing.T) {
+		mgr := setup(func(vote.PollID) bool { return true })
+		stable := newTx(100)
+		moved := newTx(101)
+		reorganized[101] = 1
+
+		assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(&types.ConfirmGatewayTxsStarted{
+			PollMappings: []types.PollMapping{
+				{TxID: types.Hash(stable), PollID: vote.PollID(rand.PosI64())},
+				{TxID: types.Hash(moved), PollID: vote.PollID(rand.PosI64())},
+			},
+			Chain:              chain,
+			ConfirmationHeight: 1,
+			Participants:       []sdk.ValAddress{valAddr},
+		}))
+
+		assert.Len(t, broadcaster.BroadcastCalls(), 2)
+		assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, 1)
+		assert.Len(t, broadcaster.BroadcastCalls()[1].Msgs, 1)
+		assert.Equal(t, []common.Hash{moved}, client.TransactionReceiptsCalls()[1].TxHashes)
+	})
+
+	t.Run("fetches the headers of a batch only once", func(t *testing.T) {
+		mgr := setup(nil)
+		mgr.SetReorgCheckDepth(chain, 5)
+		txIDs := slices.Expand(func(int) common.Hash { return newTx(100) }, 10)
+		for _, txID := range txIDs {
+			receipts[txID].BlockHash = receipts[txIDs[0]].BlockHash
+		}
+
+		results, err := mgr.GetTxReceiptsIfFinalized(chain, txIDs, 1)
+		assert.NoError(t, err)
+		for _, result := range results {
+			assert.NoError(t, result.Err())
+		}
+		assert.Len(t, client.HeaderByNumberCalls(), 6)
+	})
+}
@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "vald",
+		Subsystem: "evm_rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of EVM JSON-RPC requests",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"chain", "method"})
+	requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "evm_rpc",
+		Name:      "errors_total",
+		Help:      "Number of failed EVM JSON-RPC requests",
+	}, []string{"chain", "method"})
+	rateLimitedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "evm_rpc",
+		Name:      "rate_limited_total",
+		Help:      "Number of EVM JSON-RPC requests that were throttled by the endpoint",
+	}, []string{"chain"})
+	receiptCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "evm_rpc",
+		Name:      "receipt_cache_hits_total",
+		Help:      "Number of transaction receipts served from the cache or from a concurrent request for the same receipt",
+	}, []string{"chain"})
+)
+
+// RegisterMetrics registers all EVM JSON-RPC metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{requestDuration, requestErrors, rateLimitedRequests, receiptCacheHits} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type metricsClient struct {
+	Client
+	chain string
+}
+
+// WithMetrics wraps the given client so that the latency and error rate of all requests is recorded for the given chain
+func WithMetrics(client Client, chain string) Client {
+	return metricsClient{Client: client, chain: chain}
+}
+
+func (c metricsClient) observe(method string, start time.Time, err error) {
+	requestDuration.WithLabelValues(c.chain, method).Observe(time.Since(start).Seconds())
+
+	// a missing receipt or block is an expected answer and not a failure of the endpoint
+	if err != nil && err != ethereum.NotFound {
+		requestErrors.WithLabelValues(c.chain, method).Inc()
+	}
+}
+
+// TransactionReceipt implements the Client interface
+func (c metricsClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	defer func(start time.Time) { c.observe("transaction_receipt", start, err) }(time.Now())
+
+	return c.Client.TransactionReceipt(ctx, txHash)
+}
+
+// TransactionReceipts implements the Client interface
+func (c metricsClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) (results []Result, err error) {
+	defer func(start time.Time) { c.observe("transaction_receipts", start, err) }(time.Now())
+
+	return c.Client.TransactionReceipts(ctx, txHashes)
+}
+
+// HeaderByNumber implements the Client interface
+func (c metricsClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *Header, err error) {
+	defer func(start time.Time) { c.observe("header_by_number", start, err) }(time.Now())
+
+	return c.Client.HeaderByNumber(ctx, number)
+}
+
+// LatestFinalizedBlockNumber implements the Client interface
+func (c metricsClient) LatestFinalizedBlockNumber(ctx context.Context, confirmations uint64) (blockNumber *big.Int, err error) {
+	defer func(start time.Time) { c.observe("latest_finalized_block_number", start, err) }(time.Now())
+
+	return c.Client.LatestFinalizedBlockNumber(ctx, confirmations)
+}
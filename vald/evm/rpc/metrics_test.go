@@ -0,0 +1,74 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+)
+
+func TestWithMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	assert.NoError(t, rpc.RegisterMetrics(registry))
+
+	labels := func(chain, method string) map[string]string {
+		return map[string]string{"chain": chain, "method": method}
+	}
+
+	t.Run("records the duration of every request", func(t *testing.T) {
+		chain := rand.Str(10)
+		client := rpc.WithMetrics(receiptClient(&types.Receipt{Status: 1}, nil), chain)
+
+		_, err := client.TransactionReceipt(context.Background(), randomTxHashes(1)[0])
+		assert.NoError(t, err)
+		_, err = client.TransactionReceipts(context.Background(), randomTxHashes(3))
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1.0, testutils.MetricValue(t, registry, "vald_evm_rpc_request_duration_seconds", labels(chain, "transaction_receipt")))
+		assert.Equal(t, 1.0, testutils.MetricValue(t, registry, "vald_evm_rpc_request_duration_seconds", labels(chain, "transaction_receipts")))
+		assert.Zero(t, testutils.MetricValue(t, registry, "vald_evm_rpc_errors_total", map[string]string{"chain": chain}))
+	})
+
+	t.Run("counts failed requests", func(t *testing.T) {
+		chain := rand.Str(10)
+		client := rpc.WithMetrics(receiptClient(nil, errors.New("connection refused")), chain)
+
+		_, err := client.TransactionReceipt(context.Background(), randomTxHashes(1)[0])
+		assert.Error(t, err)
+
+		assert.Equal(t, 1.0, testutils.MetricValue(t, registry, "vald_evm_rpc_errors_total", labels(chain, "transaction_receipt")))
+	})
+
+	t.Run("does not count missing receipts as failures", func(t *testing.T) {
+		chain := rand.Str(10)
+		client := rpc.WithMetrics(receiptClient(nil, ethereum.NotFound), chain)
+
+		_, err := client.TransactionReceipt(context.Background(), randomTxHashes(1)[0])
+		assert.ErrorIs(t, err, ethereum.NotFound)
+
+		assert.Equal(t, 1.0, testutils.MetricValue(t, registry, "vald_evm_rpc_request_duration_seconds", labels(chain, "transaction_receipt")))
+		assert.Zero(t, testutils.MetricValue(t, registry, "vald_evm_rpc_errors_total", labels(chain, "transaction_receipt")))
+	})
+
+	t.Run("counts throttled requests", func(t *testing.T) {
+		chain := rand.Str(10)
+		config := rpc.RateLimitConfig{BatchSize: 10, MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+		client := rpc.WithRateLimit(finalizedClient(10, gethRPC.HTTPError{StatusCode: http.StatusTooManyRequests}), chain, config)
+
+		_, err := client.LatestFinalizedBlockNumber(context.Background(), 1)
+		assert.Error(t, err)
+
+		assert.Equal(t, 2.0, testutils.MetricValue(t, registry, "vald_evm_rpc_rate_limited_total", map[string]string{"chain": chain}))
+	})
+}
@@ -0,0 +1,262 @@
+package rpc
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on the answer to a request
+var ErrNoQuorum = goerrors.New("RPC endpoints did not reach quorum")
+
+// MultiClient is a Client that distributes requests over multiple endpoints of the same chain.
+// Requests fail over to the healthiest endpoint available. If a quorum is set, transaction receipts
+// and finalized block numbers are only accepted once at least that many endpoints agree.
+type MultiClient struct {
+	lock      sync.Mutex
+	endpoints []*endpoint
+	quorum    int
+}
+
+type endpoint struct {
+	client Client
+	// penalty grows with every failed request and decays with every successful one, lower is healthier
+	penalty uint64
+}
+
+// NewMultiClient returns a new MultiClient for the given clients. A quorum below 2 disables quorum checks.
+func NewMultiClient(clients []Client, quorum int) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("at least one client required")
+	}
+
+	if quorum > len(clients) {
+		return nil, fmt.Errorf("quorum %d cannot be reached with %d endpoints", quorum, len(clients))
+	}
+
+	endpoints := make([]*endpoint, 0, len(clients))
+	for _, client := range clients {
+		endpoints = append(endpoints, &endpoint{client: client})
+	}
+
+	return &MultiClient{endpoints: endpoints, quorum: quorum}, nil
+}
+
+func (c *MultiClient) requiresQuorum() bool {
+	return c.quorum > 1
+}
+
+// byHealth returns the endpoints ordered from healthiest to least healthy
+func (c *MultiClient) byHealth() []*endpoint {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	sorted := append([]*endpoint{}, c.endpoints...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].penalty < sorted[j].penalty })
+
+	return sorted
+}
+
+func (c *MultiClient) report(e *endpoint, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	switch {
+	case err == nil, err == ethereum.NotFound:
+		e.penalty /= 2
+	default:
+		e.penalty++
+	}
+}
+
+// failover tries all endpoints in order of their health until one returns a usable answer
+func failover[T any](c *MultiClient, f func(client Client) (T, error)) (T, error) {
+	var (
+		result T
+		err    error
+	)
+
+	for _, e := range c.byHealth() {
+		result, err = f(e.client)
+		c.report(e, err)
+
+		if err == nil || err == ethereum.NotFound {
+			return result, err
+		}
+	}
+
+	return result, err
+}
+
+type answer[T any] struct {
+	result T
+	err    error
+}
+
+// queryAll sends the request to all endpoints concurrently and returns the answers in the order of the endpoints
+func queryAll[T any](c *MultiClient, f func(client Client) (T, error)) []answer[T] {
+	answers := make([]answer[T], len(c.endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+
+			result, err := f(e.client)
+			c.report(e, err)
+			answers[i] = answer[T]{result: result, err: err}
+		}(i, e)
+	}
+	wg.Wait()
+
+	return answers
+}
+
+// receiptKey identifies the content of a receipt, so answers from different endpoints can be compared
+func receiptKey(receipt *types.Receipt, err error) (common.Hash, bool) {
+	if err == ethereum.NotFound {
+		return common.Hash{}, true
+	}
+
+	if err != nil || receipt == nil {
+		return common.Hash{}, false
+	}
+
+	consensusFields, err := receipt.MarshalBinary()
+	if err != nil {
+		return common.Hash{}, false
+	}
+
+	var blockNumber []byte
+	if receipt.BlockNumber != nil {
+		blockNumber = receipt.BlockNumber.Bytes()
+	}
+
+	return crypto.Keccak256Hash(consensusFields, receipt.TxHash.Bytes(), receipt.BlockHash.Bytes(), blockNumber), true
+}
+
+// receiptQuorum returns the receipt that at least quorum endpoints agree on
+func receiptQuorum(answers []answer[*types.Receipt], quorum int) (*types.Receipt, error) {
+	votes := make(map[common.Hash]int)
+	for _, a := range answers {
+		key, ok := receiptKey(a.result, a.err)
+		if !ok {
+			continue
+		}
+
+		votes[key]++
+		if votes[key] >= quorum {
+			if a.err != nil {
+				return nil, a.err
+			}
+
+			return a.result, nil
+		}
+	}
+
+	return nil, ErrNoQuorum
+}
+
+// TransactionReceipt returns the transaction receipt for the given transaction hash
+func (c *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	query := func(client Client) (*types.Receipt, error) { return client.TransactionReceipt(ctx, txHash) }
+
+	if !c.requiresQuorum() {
+		return failover(c, query)
+	}
+
+	return receiptQuorum(queryAll(c, query), c.quorum)
+}
+
+// TransactionReceipts returns transaction receipts for the given transaction hashes
+func (c *MultiClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]Result, error) {
+	query := func(client Client) ([]Result, error) {
+		receipts, err := client.TransactionReceipts(ctx, txHashes)
+		if err == nil && len(receipts) != len(txHashes) {
+			return nil, fmt.Errorf("expected %d receipts, got %d", len(txHashes), len(receipts))
+		}
+
+		return receipts, err
+	}
+
+	if !c.requiresQuorum() {
+		return failover(c, query)
+	}
+
+	var successful [][]Result
+	for _, a := range queryAll(c, query) {
+		if a.err == nil {
+			successful = append(successful, a.result)
+		}
+	}
+
+	if len(successful) < c.quorum {
+		return nil, ErrNoQuorum
+	}
+
+	receipts := make([]Result, len(txHashes))
+	for i := range txHashes {
+		answers := make([]answer[*types.Receipt], 0, len(successful))
+		for _, result := range successful {
+			receipt := results.Result[*types.Receipt](result[i])
+			answers = append(answers, answer[*types.Receipt]{result: receipt.Ok(), err: receipt.Err()})
+		}
+
+		receipt, err := receiptQuorum(answers, c.quorum)
+		if err != nil {
+			receipts[i] = Result(results.FromErr[*types.Receipt](err))
+			continue
+		}
+
+		receipts[i] = Result(results.FromOk(receipt))
+	}
+
+	return receipts, nil
+}
+
+// HeaderByNumber returns the block header for the given block number
+func (c *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*Header, error) {
+	return failover(c, func(client Client) (*Header, error) { return client.HeaderByNumber(ctx, number) })
+}
+
+// LatestFinalizedBlockNumber returns the latest finalized block number.
+// With a quorum, it returns the highest block number that at least quorum endpoints consider finalized.
+func (c *MultiClient) LatestFinalizedBlockNumber(ctx context.Context, confirmations uint64) (*big.Int, error) {
+	query := func(client Client) (*big.Int, error) { return client.LatestFinalizedBlockNumber(ctx, confirmations) }
+
+	if !c.requiresQuorum() {
+		return failover(c, query)
+	}
+
+	var blockNumbers []*big.Int
+	for _, a := range queryAll(c, query) {
+		if a.err == nil && a.result != nil {
+			blockNumbers = append(blockNumbers, a.result)
+		}
+	}
+
+	if len(blockNumbers) < c.quorum {
+		return nil, ErrNoQuorum
+	}
+
+	sort.Slice(blockNumbers, func(i, j int) bool { return blockNumbers[i].Cmp(blockNumbers[j]) > 0 })
+	return blockNumbers[c.quorum-1], nil
+}
+
+// Close closes the connections of all endpoints
+func (c *MultiClient) Close() {
+	for _, e := range c.endpoints {
+		e.client.Close()
+	}
+}
@@ -0,0 +1,160 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+func receiptClient(receipt *types.Receipt, err error) *mock.ClientMock {
+	return &mock.ClientMock{
+		TransactionReceiptFunc: func(context.Context, common.Hash) (*types.Receipt, error) { return receipt, err },
+		TransactionReceiptsFunc: func(_ context.Context, txHashes []common.Hash) ([]rpc.Result, error) {
+			if err != nil && err != ethereum.NotFound {
+				return nil, err
+			}
+
+			receipts := make([]rpc.Result, len(txHashes))
+			for i := range receipts {
+				if err != nil {
+					receipts[i] = rpc.Result(results.FromErr[*types.Receipt](err))
+				} else {
+					receipts[i] = rpc.Result(results.FromOk(receipt))
+				}
+			}
+			return receipts, nil
+		},
+	}
+}
+
+func finalizedClient(blockNumber int64, err error) *mock.ClientMock {
+	return &mock.ClientMock{
+		LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) {
+			if err != nil {
+				return nil, err
+			}
+			return big.NewInt(blockNumber), nil
+		},
+	}
+}
+
+func TestNewMultiClient(t *testing.T) {
+	_, err := rpc.NewMultiClient(nil, 0)
+	assert.Error(t, err)
+
+	_, err = rpc.NewMultiClient([]rpc.Client{&mock.ClientMock{}}, 2)
+	assert.Error(t, err)
+
+	_, err = rpc.NewMultiClient([]rpc.Client{&mock.ClientMock{}, &mock.ClientMock{}}, 2)
+	assert.NoError(t, err)
+}
+
+func TestMultiClient_TransactionReceipt(t *testing.T) {
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: common.HexToHash("0x1"), BlockNumber: big.NewInt(10)}
+	forked := &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: common.HexToHash("0x2"), BlockNumber: big.NewInt(10)}
+	failure := errors.New("connection refused")
+
+	t.Run("fails over to the next endpoint without quorum", func(t *testing.T) {
+		broken := receiptClient(nil, failure)
+		client, _ := rpc.NewMultiClient([]rpc.Client{broken, receiptClient(receipt, nil)}, 0)
+
+		actual, err := client.TransactionReceipt(context.Background(), common.Hash{})
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, actual)
+
+		// the broken endpoint is no longer tried first
+		_, err = client.TransactionReceipt(context.Background(), common.Hash{})
+		assert.NoError(t, err)
+		assert.Len(t, broken.TransactionReceiptCalls(), 1)
+	})
+
+	t.Run("returns the error of the last endpoint if all fail", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(nil, failure), receiptClient(nil, failure)}, 0)
+
+		_, err := client.TransactionReceipt(context.Background(), common.Hash{})
+		assert.ErrorIs(t, err, failure)
+	})
+
+	t.Run("returns the receipt that reaches quorum", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(forked, nil), receiptClient(receipt, nil), receiptClient(receipt, nil)}, 2)
+
+		actual, err := client.TransactionReceipt(context.Background(), common.Hash{})
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, actual)
+	})
+
+	t.Run("returns not found if quorum agrees", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(nil, ethereum.NotFound), receiptClient(receipt, nil), receiptClient(nil, ethereum.NotFound)}, 2)
+
+		_, err := client.TransactionReceipt(context.Background(), common.Hash{})
+		assert.ErrorIs(t, err, ethereum.NotFound)
+	})
+
+	t.Run("fails if endpoints disagree", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(forked, nil), receiptClient(receipt, nil), receiptClient(nil, failure)}, 2)
+
+		_, err := client.TransactionReceipt(context.Background(), common.Hash{})
+		assert.ErrorIs(t, err, rpc.ErrNoQuorum)
+	})
+}
+
+func TestMultiClient_TransactionReceipts(t *testing.T) {
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: common.HexToHash("0x1"), BlockNumber: big.NewInt(10)}
+	forked := &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockHash: common.HexToHash("0x2"), BlockNumber: big.NewInt(10)}
+	txHashes := []common.Hash{common.HexToHash("0xa"), common.HexToHash("0xb")}
+
+	t.Run("returns receipts that reach quorum", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(receipt, nil), receiptClient(forked, nil), receiptClient(receipt, nil)}, 2)
+
+		actual, err := client.TransactionReceipts(context.Background(), txHashes)
+		assert.NoError(t, err)
+		assert.Len(t, actual, len(txHashes))
+		for _, result := range actual {
+			assert.Equal(t, receipt, results.Result[*types.Receipt](result).Ok())
+		}
+	})
+
+	t.Run("marks receipts without quorum as failed", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(receipt, nil), receiptClient(forked, nil)}, 2)
+
+		actual, err := client.TransactionReceipts(context.Background(), txHashes)
+		assert.NoError(t, err)
+		for _, result := range actual {
+			assert.ErrorIs(t, results.Result[*types.Receipt](result).Err(), rpc.ErrNoQuorum)
+		}
+	})
+
+	t.Run("fails if not enough endpoints respond", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{receiptClient(receipt, nil), receiptClient(nil, errors.New("timeout"))}, 2)
+
+		_, err := client.TransactionReceipts(context.Background(), txHashes)
+		assert.ErrorIs(t, err, rpc.ErrNoQuorum)
+	})
+}
+
+func TestMultiClient_LatestFinalizedBlockNumber(t *testing.T) {
+	t.Run("returns the highest block finalized by a quorum of endpoints", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{finalizedClient(100, nil), finalizedClient(90, nil), finalizedClient(95, nil)}, 2)
+
+		actual, err := client.LatestFinalizedBlockNumber(context.Background(), 0)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 95, actual.Int64())
+	})
+
+	t.Run("fails if not enough endpoints respond", func(t *testing.T) {
+		client, _ := rpc.NewMultiClient([]rpc.Client{finalizedClient(100, nil), finalizedClient(0, errors.New("timeout")), finalizedClient(0, errors.New("timeout"))}, 2)
+
+		_, err := client.LatestFinalizedBlockNumber(context.Background(), 0)
+		assert.ErrorIs(t, err, rpc.ErrNoQuorum)
+	})
+}
@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"context"
+	goerrors "errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+// JSON-RPC error codes that providers use to signal that a request was rejected because of rate limits
+const (
+	limitExceededErrorCode   = -32005
+	tooManyRequestsErrorCode = -32029
+)
+
+var rateLimitMessages = []string{"rate limit", "too many requests", "limit exceeded", "exceeded the quota", "request limit"}
+
+// IsRateLimited returns true if the given error signals that the endpoint throttled the request
+func IsRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if goerrors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if goerrors.As(err, &rpcErr) && (rpcErr.ErrorCode() == limitExceededErrorCode || rpcErr.ErrorCode() == tooManyRequestsErrorCode) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, rateLimitMsg := range rateLimitMessages {
+		if strings.Contains(msg, rateLimitMsg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RateLimitConfig defines how requests are split and retried when an endpoint throttles them
+type RateLimitConfig struct {
+	// BatchSize is the maximum number of receipts requested in a single batch
+	BatchSize int
+	// MaxRetries is the number of times a throttled request is retried before its error is returned
+	MaxRetries int
+	// MinBackoff is the initial delay after a throttled request, it doubles with every consecutive throttled request up to MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// backoff is the delay between requests to an endpoint, adapting to how often it throttles them
+type backoff struct {
+	lock    sync.Mutex
+	min     time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+// throttled increases the delay and returns the new delay
+func (b *backoff) throttled() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.current *= 2
+	if b.current < b.min {
+		b.current = b.min
+	}
+	if b.current > b.max {
+		b.current = b.max
+	}
+
+	return b.current
+}
+
+// succeeded decreases the delay until requests are no longer held back
+func (b *backoff) succeeded() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.current /= 2
+	if b.current < b.min {
+		b.current = 0
+	}
+}
+
+func (b *backoff) wait(ctx context.Context) error {
+	b.lock.Lock()
+	delay := b.current
+	b.lock.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type rateLimitedClient struct {
+	Client
+	chain   string
+	config  RateLimitConfig
+	backoff *backoff
+}
+
+// WithRateLimit wraps the given client so that receipt batches are split into chunks of the configured size,
+// and requests that are throttled by the endpoint are retried with an adaptive backoff
+func WithRateLimit(client Client, chain string, config RateLimitConfig) Client {
+	return rateLimitedClient{
+		Client:  client,
+		chain:   chain,
+		config:  config,
+		backoff: &backoff{min: config.MinBackoff, max: config.MaxBackoff},
+	}
+}
+
+// do sends the request, retrying it as long as it is throttled and retries are left
+func (c rateLimitedClient) do(ctx context.Context, request func() error) error {
+	for i := 0; ; i++ {
+		if err := c.backoff.wait(ctx); err != nil {
+			return err
+		}
+
+		err := request()
+		if !IsRateLimited(err) {
+			c.backoff.succeeded()
+			return err
+		}
+
+		rateLimitedRequests.WithLabelValues(c.chain).Inc()
+		delay := c.backoff.throttled()
+		if i >= c.config.MaxRetries {
+			return err
+		}
+
+		log.WithKeyVals("chain", c.chain).Debugf("request was rate limited, retrying in %s", delay)
+	}
+}
+
+// TransactionReceipt implements the Client interface
+func (c rateLimitedClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = c.do(ctx, func() error {
+		receipt, err = c.Client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+
+	return receipt, err
+}
+
+// TransactionReceipts implements the Client interface.
+// Receipts are requested in chunks, and only the throttled receipts of a chunk are requested again.
+func (c rateLimitedClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]Result, error) {
+	receipts := make([]Result, len(txHashes))
+
+	for start := 0; start < len(txHashes); start += c.batchSize() {
+		end := start + c.batchSize()
+		if end > len(txHashes) {
+			end = len(txHashes)
+		}
+
+		if err := c.fetchChunk(ctx, txHashes[start:end], receipts[start:end]); err != nil {
+			return nil, err
+		}
+	}
+
+	return receipts, nil
+}
+
+func (c rateLimitedClient) fetchChunk(ctx context.Context, txHashes []common.Hash, receipts []Result) error {
+	pending := make([]int, len(txHashes))
+	for i := range pending {
+		pending[i] = i
+	}
+
+	// once the chunk has been fetched, errors of single receipts are returned as their results instead of failing the whole batch
+	var fetchedOnce bool
+	err := c.do(ctx, func() error {
+		chunk := make([]common.Hash, len(pending))
+		for i, idx := range pending {
+			chunk[i] = txHashes[idx]
+		}
+
+		fetched, err := c.Client.TransactionReceipts(ctx, chunk)
+		if err != nil {
+			return err
+		}
+		fetchedOnce = true
+
+		var throttled []int
+		for i, idx := range pending {
+			receipts[idx] = fetched[i]
+			if IsRateLimited(results.Result[*types.Receipt](fetched[i]).Err()) {
+				throttled = append(throttled, idx)
+			}
+		}
+
+		if len(throttled) == 0 {
+			return nil
+		}
+
+		// the throttled receipts are kept as results in case no retries are left
+		pending = throttled
+		return results.Result[*types.Receipt](receipts[throttled[0]]).Err()
+	})
+
+	if !fetchedOnce {
+		return err
+	}
+
+	return nil
+}
+
+func (c rateLimitedClient) batchSize() int {
+	if c.config.BatchSize <= 0 {
+		return 1
+	}
+
+	return c.config.BatchSize
+}
+
+// HeaderByNumber implements the Client interface
+func (c rateLimitedClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *Header, err error) {
+	err = c.do(ctx, func() error {
+		header, err = c.Client.HeaderByNumber(ctx, number)
+		return err
+	})
+
+	return header, err
+}
+
+// LatestFinalizedBlockNumber implements the Client interface
+func (c rateLimitedClient) LatestFinalizedBlockNumber(ctx context.Context, confirmations uint64) (blockNumber *big.Int, err error) {
+	err = c.do(ctx, func() error {
+		blockNumber, err = c.Client.LatestFinalizedBlockNumber(ctx, confirmations)
+		return err
+	})
+
+	return blockNumber, err
+}
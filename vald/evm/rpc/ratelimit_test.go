@@ -0,0 +1,156 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+type rpcError struct {
+	code int
+	msg  string
+}
+
+func (e rpcError) Error() string  { return e.msg }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestIsRateLimited(t *testing.T) {
+	assert.False(t, rpc.IsRateLimited(nil))
+	assert.False(t, rpc.IsRateLimited(errors.New("execution reverted")))
+	assert.False(t, rpc.IsRateLimited(gethRPC.HTTPError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}))
+
+	assert.True(t, rpc.IsRateLimited(gethRPC.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}))
+	assert.True(t, rpc.IsRateLimited(rpcError{code: -32005, msg: "query returned more than 10000 results"}))
+	assert.True(t, rpc.IsRateLimited(rpcError{code: -32000, msg: "daily request limit reached"}))
+	assert.True(t, rpc.IsRateLimited(errors.New("project ID Rate Limit exceeded")))
+}
+
+func randomTxHashes(n int) []common.Hash {
+	return slices.Expand2(func() common.Hash { return common.BytesToHash(rand.Bytes(common.HashLength)) }, n)
+}
+
+func TestWithRateLimit_TransactionReceipts(t *testing.T) {
+	config := rpc.RateLimitConfig{BatchSize: 10, MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	throttled := rpcError{code: -32005, msg: "limit exceeded"}
+
+	t.Run("splits the batch into chunks", func(t *testing.T) {
+		inner := receiptClient(&types.Receipt{Status: 1}, nil)
+		client := rpc.WithRateLimit(inner, "ethereum", config)
+
+		receipts, err := client.TransactionReceipts(context.Background(), randomTxHashes(25))
+		assert.NoError(t, err)
+		assert.Len(t, receipts, 25)
+		assert.Equal(t, []int{10, 10, 5}, slices.Map(inner.TransactionReceiptsCalls(), func(call struct {
+			Ctx      context.Context
+			TxHashes []common.Hash
+		}) int {
+			return len(call.TxHashes)
+		}))
+	})
+
+	t.Run("retries throttled batches", func(t *testing.T) {
+		inner := receiptClient(&types.Receipt{Status: 1}, nil)
+		receiptsFunc := inner.TransactionReceiptsFunc
+		inner.TransactionReceiptsFunc = func(ctx context.Context, txHashes []common.Hash) ([]rpc.Result, error) {
+			if len(inner.TransactionReceiptsCalls()) == 1 {
+				return nil, gethRPC.HTTPError{StatusCode: http.StatusTooManyRequests}
+			}
+			return receiptsFunc(ctx, txHashes)
+		}
+		client := rpc.WithRateLimit(inner, "ethereum", config)
+
+		receipts, err := client.TransactionReceipts(context.Background(), randomTxHashes(5))
+		assert.NoError(t, err)
+		assert.True(t, slices.All(receipts, func(r rpc.Result) bool { return results.Result[*types.Receipt](r).Err() == nil }))
+		assert.Len(t, inner.TransactionReceiptsCalls(), 2)
+	})
+
+	t.Run("only requests throttled receipts again", func(t *testing.T) {
+		txHashes := randomTxHashes(5)
+		inner := &mock.ClientMock{
+			TransactionReceiptsFunc: func(_ context.Context, hashes []common.Hash) ([]rpc.Result, error) {
+				return slices.Map(hashes, func(hash common.Hash) rpc.Result {
+					if hash == txHashes[2] && len(hashes) > 1 {
+						return rpc.Result(results.FromErr[*types.Receipt](throttled))
+					}
+					return rpc.Result(results.FromOk(&types.Receipt{TxHash: hash}))
+				}), nil
+			},
+		}
+		client := rpc.WithRateLimit(inner, "ethereum", config)
+
+		receipts, err := client.TransactionReceipts(context.Background(), txHashes)
+		assert.NoError(t, err)
+		for i, receipt := range receipts {
+			assert.Equal(t, txHashes[i], results.Result[*types.Receipt](receipt).Ok().TxHash)
+		}
+		assert.Len(t, inner.TransactionReceiptsCalls(), 2)
+		assert.Equal(t, []common.Hash{txHashes[2]}, inner.TransactionReceiptsCalls()[1].TxHashes)
+	})
+
+	t.Run("returns throttled receipts as errors once retries are exhausted", func(t *testing.T) {
+		txHashes := randomTxHashes(3)
+		inner := &mock.ClientMock{
+			TransactionReceiptsFunc: func(_ context.Context, hashes []common.Hash) ([]rpc.Result, error) {
+				return slices.Map(hashes, func(hash common.Hash) rpc.Result {
+					if hash == txHashes[0] {
+						return rpc.Result(results.FromErr[*types.Receipt](throttled))
+					}
+					return rpc.Result(results.FromOk(&types.Receipt{TxHash: hash}))
+				}), nil
+			},
+		}
+		client := rpc.WithRateLimit(inner, "ethereum", config)
+
+		receipts, err := client.TransactionReceipts(context.Background(), txHashes)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, results.Result[*types.Receipt](receipts[0]).Err(), throttled)
+		assert.NoError(t, results.Result[*types.Receipt](receipts[1]).Err())
+		assert.Len(t, inner.TransactionReceiptsCalls(), config.MaxRetries+1)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		inner := receiptClient(nil, errors.New("connection refused"))
+		client := rpc.WithRateLimit(inner, "ethereum", config)
+
+		_, err := client.TransactionReceipts(context.Background(), randomTxHashes(5))
+		assert.Error(t, err)
+		assert.Len(t, inner.TransactionReceiptsCalls(), 1)
+	})
+}
+
+func TestWithRateLimit_BacksOff(t *testing.T) {
+	config := rpc.RateLimitConfig{BatchSize: 10, MaxRetries: 0, MinBackoff: 50 * time.Millisecond, MaxBackoff: time.Second}
+	inner := finalizedClient(10, gethRPC.HTTPError{StatusCode: http.StatusTooManyRequests})
+	client := rpc.WithRateLimit(inner, "ethereum", config)
+
+	_, err := client.LatestFinalizedBlockNumber(context.Background(), 0)
+	assert.True(t, rpc.IsRateLimited(err))
+
+	inner.LatestFinalizedBlockNumberFunc = func(context.Context, uint64) (*big.Int, error) { return big.NewInt(10), nil }
+
+	start := time.Now()
+	blockNumber, err := client.LatestFinalizedBlockNumber(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, blockNumber.Int64())
+	assert.GreaterOrEqual(t, time.Since(start), config.MinBackoff)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.LatestFinalizedBlockNumber(ctx, 0)
+	assert.NoError(t, err, "backoff must decrease after a successful request")
+}
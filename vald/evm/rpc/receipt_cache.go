@@ -0,0 +1,253 @@
+package rpc
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+type cachedReceipt struct {
+	txHash    common.Hash
+	receipt   *types.Receipt
+	expiresAt time.Time
+}
+
+// pendingReceipt is a receipt that is currently requested by a concurrent call
+type pendingReceipt struct {
+	done   chan struct{}
+	result Result
+}
+
+// receiptCache holds the finalized receipts that have been fetched recently, and the receipts that are currently being fetched
+type receiptCache struct {
+	lock    sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[common.Hash]*list.Element
+	// order of the cached receipts, oldest first, so the oldest can be evicted
+	order   *list.List
+	pending map[common.Hash]*pendingReceipt
+	// latest finalized block number seen, receipts of later blocks are not cached because they can still be reorganized
+	finalized *big.Int
+}
+
+func newReceiptCache(size int, ttl time.Duration) *receiptCache {
+	return &receiptCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[common.Hash]*list.Element),
+		order:   list.New(),
+		pending: make(map[common.Hash]*pendingReceipt),
+	}
+}
+
+// claim returns the cached or pending receipts for the given hashes, and registers the remaining ones as pending.
+// The caller must fetch the claimed receipts and release them.
+func (c *receiptCache) claim(txHashes []common.Hash) (cached map[common.Hash]*types.Receipt, pending map[common.Hash]*pendingReceipt, claimed []common.Hash) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cached = make(map[common.Hash]*types.Receipt)
+	pending = make(map[common.Hash]*pendingReceipt)
+	for _, txHash := range txHashes {
+		if _, ok := cached[txHash]; ok {
+			continue
+		}
+		if _, ok := pending[txHash]; ok {
+			continue
+		}
+
+		if receipt, ok := c.get(txHash); ok {
+			cached[txHash] = receipt
+			continue
+		}
+
+		if p, ok := c.pending[txHash]; ok {
+			pending[txHash] = p
+			continue
+		}
+
+		c.pending[txHash] = &pendingReceipt{done: make(chan struct{})}
+		claimed = append(claimed, txHash)
+	}
+
+	return cached, pending, claimed
+}
+
+// release caches the successfully fetched receipts and hands all results to the concurrent calls waiting for them
+func (c *receiptCache) release(txHashes []common.Hash, fetched []Result, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for i, txHash := range txHashes {
+		p := c.pending[txHash]
+		delete(c.pending, txHash)
+
+		if err != nil {
+			p.result = Result(results.FromErr[*types.Receipt](err))
+		} else {
+			p.result = fetched[i]
+		}
+		close(p.done)
+
+		if receipt := results.Result[*types.Receipt](p.result); receipt.Err() == nil && c.isFinalized(receipt.Ok()) {
+			c.set(txHash, receipt.Ok())
+		}
+	}
+}
+
+// setFinalized records the given block number as finalized if it is later than the one recorded before
+func (c *receiptCache) setFinalized(blockNumber *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.finalized == nil || blockNumber.Cmp(c.finalized) > 0 {
+		c.finalized = new(big.Int).Set(blockNumber)
+	}
+}
+
+func (c *receiptCache) isFinalized(receipt *types.Receipt) bool {
+	return receipt != nil && receipt.BlockNumber != nil && c.finalized != nil && receipt.BlockNumber.Cmp(c.finalized) <= 0
+}
+
+// evict removes the receipt of the given transaction from the cache
+func (c *receiptCache) evict(txHash common.Hash) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[txHash]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, txHash)
+	}
+}
+
+func (c *receiptCache) get(txHash common.Hash) (*types.Receipt, bool) {
+	elem, ok := c.entries[txHash]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cachedReceipt)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, txHash)
+		return nil, false
+	}
+
+	return entry.receipt, true
+}
+
+func (c *receiptCache) set(txHash common.Hash, receipt *types.Receipt) {
+	if elem, ok := c.entries[txHash]; ok {
+		c.order.Remove(elem)
+	}
+
+	c.entries[txHash] = c.order.PushBack(&cachedReceipt{txHash: txHash, receipt: receipt, expiresAt: time.Now().Add(c.ttl)})
+	for c.order.Len() > c.size {
+		oldest := c.order.Front()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedReceipt).txHash)
+	}
+}
+
+// ReceiptEvicter is implemented by clients that cache receipts, so receipts that turn out to be reorganized can be dropped
+type ReceiptEvicter interface {
+	EvictReceipt(txHash common.Hash)
+}
+
+type receiptCachingClient struct {
+	Client
+	chain string
+	cache *receiptCache
+}
+
+// WithReceiptCache wraps the given client so that receipts are cached for the given duration, up to the given number of receipts.
+// Concurrent requests for the same receipt are only sent to the endpoint once.
+// Only receipts at or below the latest finalized block number requested through the client are cached.
+// Receipts that are not found or fail to be fetched are not cached.
+func WithReceiptCache(client Client, chain string, size int, ttl time.Duration) Client {
+	return receiptCachingClient{Client: client, chain: chain, cache: newReceiptCache(size, ttl)}
+}
+
+// EvictReceipt implements the ReceiptEvicter interface
+func (c receiptCachingClient) EvictReceipt(txHash common.Hash) {
+	c.cache.evict(txHash)
+}
+
+// LatestFinalizedBlockNumber implements the Client interface
+func (c receiptCachingClient) LatestFinalizedBlockNumber(ctx context.Context, confirmations uint64) (*big.Int, error) {
+	blockNumber, err := c.Client.LatestFinalizedBlockNumber(ctx, confirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.setFinalized(blockNumber)
+	return blockNumber, nil
+}
+
+// TransactionReceipt implements the Client interface
+func (c receiptCachingClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipts, err := c.TransactionReceipts(ctx, []common.Hash{txHash})
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := results.Result[*types.Receipt](receipts[0])
+	return receipt.Ok(), receipt.Err()
+}
+
+// TransactionReceipts implements the Client interface
+func (c receiptCachingClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]Result, error) {
+	cached, pending, claimed := c.cache.claim(txHashes)
+
+	var fetched []Result
+	var err error
+	if len(claimed) > 0 {
+		fetched, err = c.Client.TransactionReceipts(ctx, claimed)
+		if err == nil && len(fetched) != len(claimed) {
+			err = fmt.Errorf("expected %d receipts, got %d", len(claimed), len(fetched))
+		}
+		c.cache.release(claimed, fetched, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	own := make(map[common.Hash]Result, len(claimed))
+	for i, txHash := range claimed {
+		own[txHash] = fetched[i]
+	}
+
+	receipts := make([]Result, len(txHashes))
+	for i, txHash := range txHashes {
+		if receipt, ok := cached[txHash]; ok {
+			receiptCacheHits.WithLabelValues(c.chain).Inc()
+			receipts[i] = Result(results.FromOk(receipt))
+			continue
+		}
+
+		if result, ok := own[txHash]; ok {
+			receipts[i] = result
+			continue
+		}
+
+		p := pending[txHash]
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-p.done:
+			receiptCacheHits.WithLabelValues(c.chain).Inc()
+			receipts[i] = p.result
+		}
+	}
+
+	return receipts, nil
+}
@@ -0,0 +1,179 @@
+package rpc_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestWithReceiptCache(t *testing.T) {
+	txHashes := randomTxHashes(4)
+	notFound := txHashes[3]
+
+	newInner := func() *mock.ClientMock {
+		return &mock.ClientMock{
+			TransactionReceiptsFunc: func(_ context.Context, hashes []common.Hash) ([]rpc.Result, error) {
+				return slices.Map(hashes, func(hash common.Hash) rpc.Result {
+					if hash == notFound {
+						return rpc.Result(results.FromErr[*types.Receipt](ethereum.NotFound))
+					}
+					return rpc.Result(results.FromOk(&types.Receipt{TxHash: hash, BlockNumber: big.NewInt(100)}))
+				}), nil
+			},
+			LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) { return big.NewInt(100), nil },
+		}
+	}
+
+	newClient := func(inner rpc.Client, size int, ttl time.Duration) rpc.Client {
+		client := rpc.WithReceiptCache(inner, "ethereum", size, ttl)
+		_, err := client.LatestFinalizedBlockNumber(context.Background(), 1)
+		assert.NoError(t, err)
+
+		return client
+	}
+
+	t.Run("serves fetched receipts from the cache", func(t *testing.T) {
+		inner := newInner()
+		client := newClient(inner, 10, time.Minute)
+
+		_, err := client.TransactionReceipts(context.Background(), txHashes[:2])
+		assert.NoError(t, err)
+
+		receipts, err := client.TransactionReceipts(context.Background(), txHashes)
+		assert.NoError(t, err)
+		for i, receipt := range receipts[:3] {
+			assert.Equal(t, txHashes[i], results.Result[*types.Receipt](receipt).Ok().TxHash)
+		}
+		assert.ErrorIs(t, results.Result[*types.Receipt](receipts[3]).Err(), ethereum.NotFound)
+
+		assert.Len(t, inner.TransactionReceiptsCalls(), 2)
+		assert.Equal(t, txHashes[2:], inner.TransactionReceiptsCalls()[1].TxHashes)
+
+		receipt, err := client.TransactionReceipt(context.Background(), txHashes[2])
+		assert.NoError(t, err)
+		assert.Equal(t, txHashes[2], receipt.TxHash)
+
+		// receipts that are not found are requested again
+		_, err = client.TransactionReceipt(context.Background(), notFound)
+		assert.ErrorIs(t, err, ethereum.NotFound)
+		assert.Len(t, inner.TransactionReceiptsCalls(), 3)
+	})
+
+	t.Run("evicts expired and oldest receipts", func(t *testing.T) {
+		inner := newInner()
+		client := newClient(inner, 2, time.Minute)
+
+		_, err := client.TransactionReceipts(context.Background(), txHashes[:3])
+		assert.NoError(t, err)
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:3])
+		assert.NoError(t, err)
+		assert.Equal(t, txHashes[:1], inner.TransactionReceiptsCalls()[1].TxHashes)
+
+		inner = newInner()
+		client = newClient(inner, 10, time.Nanosecond)
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:1])
+		assert.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:1])
+		assert.NoError(t, err)
+		assert.Len(t, inner.TransactionReceiptsCalls(), 2)
+	})
+
+	t.Run("fetches receipts requested concurrently only once", func(t *testing.T) {
+		inner := newInner()
+		fetching := make(chan struct{})
+		release := make(chan struct{})
+		receiptsFunc := inner.TransactionReceiptsFunc
+		inner.TransactionReceiptsFunc = func(ctx context.Context, hashes []common.Hash) ([]rpc.Result, error) {
+			close(fetching)
+			<-release
+			return receiptsFunc(ctx, hashes)
+		}
+		client := newClient(inner, 10, time.Minute)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.TransactionReceipts(context.Background(), txHashes[:2])
+			assert.NoError(t, err)
+		}()
+		<-fetching
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			receipts, err := client.TransactionReceipts(context.Background(), txHashes[:2])
+			assert.NoError(t, err)
+			assert.Equal(t, txHashes[1], results.Result[*types.Receipt](receipts[1]).Ok().TxHash)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Len(t, inner.TransactionReceiptsCalls(), 1)
+	})
+
+	t.Run("does not cache receipts above the latest finalized block", func(t *testing.T) {
+		inner := newInner()
+		client := rpc.WithReceiptCache(inner, "ethereum", 10, time.Minute)
+
+		_, err := client.TransactionReceipts(context.Background(), txHashes[:1])
+		assert.NoError(t, err)
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:1])
+		assert.NoError(t, err)
+		assert.Len(t, inner.TransactionReceiptsCalls(), 2)
+
+		inner.LatestFinalizedBlockNumberFunc = func(context.Context, uint64) (*big.Int, error) { return big.NewInt(99), nil }
+		_, err = client.LatestFinalizedBlockNumber(context.Background(), 1)
+		assert.NoError(t, err)
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:1])
+		assert.NoError(t, err)
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:1])
+		assert.NoError(t, err)
+		assert.Len(t, inner.TransactionReceiptsCalls(), 4)
+	})
+
+	t.Run("evicts receipts", func(t *testing.T) {
+		inner := newInner()
+		client := newClient(inner, 10, time.Minute)
+
+		_, err := client.TransactionReceipts(context.Background(), txHashes[:2])
+		assert.NoError(t, err)
+
+		client.(rpc.ReceiptEvicter).EvictReceipt(txHashes[0])
+
+		_, err = client.TransactionReceipts(context.Background(), txHashes[:2])
+		assert.NoError(t, err)
+		assert.Len(t, inner.TransactionReceiptsCalls(), 2)
+		assert.Equal(t, txHashes[:1], inner.TransactionReceiptsCalls()[1].TxHashes)
+	})
+
+	t.Run("returns an error if the endpoint returns fewer receipts than requested", func(t *testing.T) {
+		inner := newInner()
+		inner.TransactionReceiptsFunc = func(context.Context, []common.Hash) ([]rpc.Result, error) {
+			return []rpc.Result{rpc.Result(results.FromOk(&types.Receipt{TxHash: txHashes[0], BlockNumber: big.NewInt(100)}))}, nil
+		}
+		client := newClient(inner, 10, time.Minute)
+
+		_, err := client.TransactionReceipts(context.Background(), txHashes[:2])
+		assert.Error(t, err)
+
+		// the failed batch must not leave pending receipts behind
+		_, err = client.TransactionReceipts(context.Background(), txHashes[1:2])
+		assert.NoError(t, err)
+	})
+}
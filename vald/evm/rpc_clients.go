@@ -0,0 +1,117 @@
+package evm
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/log"
+)
+
+// rpcClients holds the RPC clients of all chains and allows them to be replaced at runtime.
+// A replaced client is only closed once all requests that are still using it have completed.
+type rpcClients struct {
+	lock     sync.RWMutex
+	clients  map[string]rpc.Client
+	inFlight map[string]*sync.WaitGroup
+}
+
+func newRPCClients(clients map[string]rpc.Client) *rpcClients {
+	return &rpcClients{
+		clients:  clients,
+		inFlight: make(map[string]*sync.WaitGroup),
+	}
+}
+
+// acquire returns the client for the given chain. The returned release function must be called once the client is no longer in use.
+func (r *rpcClients) acquire(chain nexus.ChainName) (rpc.Client, func(), bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	chainName := strings.ToLower(chain.String())
+	client, ok := r.clients[chainName]
+	if !ok {
+		return nil, func() {}, false
+	}
+
+	inFlight, ok := r.inFlight[chainName]
+	if !ok {
+		inFlight = &sync.WaitGroup{}
+		r.inFlight[chainName] = inFlight
+	}
+
+	inFlight.Add(1)
+	return client, inFlight.Done, true
+}
+
+// set registers the client for the given chain, replacing any existing one
+func (r *rpcClients) set(chain nexus.ChainName, client rpc.Client) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	chainName := strings.ToLower(chain.String())
+	r.retire(chainName)
+	r.clients[chainName] = client
+}
+
+// remove deregisters the client for the given chain
+func (r *rpcClients) remove(chain nexus.ChainName) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	chainName := strings.ToLower(chain.String())
+	if _, ok := r.clients[chainName]; !ok {
+		return false
+	}
+
+	r.retire(chainName)
+	delete(r.clients, chainName)
+
+	return true
+}
+
+// retire closes the current client of the given chain in the background as soon as it is idle. Must be called while holding the lock.
+func (r *rpcClients) retire(chainName string) {
+	old, ok := r.clients[chainName]
+	if !ok {
+		return
+	}
+
+	inFlight, ok := r.inFlight[chainName]
+	if !ok {
+		inFlight = &sync.WaitGroup{}
+	}
+	delete(r.inFlight, chainName)
+
+	go func() {
+		inFlight.Wait()
+		old.Close()
+		log.WithKeyVals("chain", chainName).Debug("closed retired RPC client")
+	}()
+}
+
+// chains returns the names of all chains with a registered client
+func (r *rpcClients) chains() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	chains := make([]string, 0, len(r.clients))
+	for chain := range r.clients {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	return chains
+}
+
+// close closes all registered clients
+func (r *rpcClients) close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, client := range r.clients {
+		client.Close()
+	}
+}
@@ -0,0 +1,111 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmmock "github.com/axelarnetwork/axelar-core/vald/evm/mock"
+	evmRpc "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	evmtestutils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMgr_SetRPCClient(t *testing.T) {
+	chain := nexus.ChainName(strings.ToLower(rand.NormalizedStr(5)))
+
+	var (
+		mgr       *evm.Mgr
+		oldClient *mock.ClientMock
+		newClient *mock.ClientMock
+		closed    chan struct{}
+		unblock   chan struct{}
+		requested chan struct{}
+	)
+
+	Given("an evm manager with an rpc client", func() {
+		closed = make(chan struct{})
+		unblock = make(chan struct{})
+		requested = make(chan struct{})
+
+		oldClient = &mock.ClientMock{
+			CloseFunc: func() { close(closed) },
+			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
+				close(requested)
+				<-unblock
+				return nil, ethereum.NotFound
+			},
+		}
+		newClient = &mock.ClientMock{
+			TransactionReceiptFunc: func(context.Context, common.Hash) (*geth.Receipt, error) {
+				return nil, ethereum.NotFound
+			},
+		}
+
+		cache := &evmmock.LatestFinalizedBlockCacheMock{
+			GetFunc: func(nexus.ChainName) *big.Int { return big.NewInt(0) },
+			SetFunc: func(nexus.ChainName, *big.Int) {},
+		}
+		mgr = evm.NewMgr(map[string]evmRpc.Client{chain.String(): oldClient}, nil, rand.ValAddr(), rand.AccAddr(), cache)
+	}).
+		When("a request is in flight", func() {
+			go func() {
+				_, _ = mgr.GetTxReceiptIfFinalized(chain, common.Hash(evmtestutils.RandomHash()), 1)
+			}()
+			<-requested
+		}).
+		Then("the old client is only closed after the in-flight request completes", func(t *testing.T) {
+			mgr.SetRPCClient(chain, newClient)
+
+			_, err := mgr.GetTxReceiptIfFinalized(chain, common.Hash(evmtestutils.RandomHash()), 1)
+			assert.NoError(t, err)
+			assert.Len(t, newClient.TransactionReceiptCalls(), 1)
+
+			select {
+			case <-closed:
+				assert.Fail(t, "client closed while a request was in flight")
+			case <-time.After(10 * time.Millisecond):
+			}
+
+			close(unblock)
+
+			select {
+			case <-closed:
+			case <-time.After(time.Second):
+				assert.Fail(t, "client was not closed")
+			}
+		}).
+		Run(t)
+}
+
+func TestMgr_RemoveRPCClient(t *testing.T) {
+	chain := nexus.ChainName(strings.ToLower(rand.NormalizedStr(5)))
+	closed := make(chan struct{})
+	client := &mock.ClientMock{CloseFunc: func() { close(closed) }}
+	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): client}, nil, rand.ValAddr(), rand.AccAddr(), evm.NewLatestFinalizedBlockCache())
+
+	assert.Equal(t, []string{chain.String()}, mgr.RPCChains())
+	assert.True(t, mgr.RemoveRPCClient(chain))
+	assert.False(t, mgr.RemoveRPCClient(chain))
+	assert.Empty(t, mgr.RPCChains())
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "client was not closed")
+	}
+
+	_, err := mgr.GetTxReceiptIfFinalized(chain, common.Hash(evmtestutils.RandomHash()), 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package vald
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const evmConfigKey = "axelar_bridge_evm"
+
+// evmConfigReloader keeps the RPC clients of the EVM manager in sync with the axelar_bridge_evm configuration
+type evmConfigReloader struct {
+	lock      sync.Mutex
+	mgr       *evm.Mgr
+	configs   map[string]evmTypes.EVMConfig
+	newClient func(config evmTypes.EVMConfig) (evmRPC.Client, error)
+}
+
+func newEVMConfigReloader(mgr *evm.Mgr, configs []evmTypes.EVMConfig, newClient func(config evmTypes.EVMConfig) (evmRPC.Client, error)) *evmConfigReloader {
+	applied := make(map[string]evmTypes.EVMConfig)
+	for _, config := range configs {
+		if config.WithBridge {
+			applied[strings.ToLower(config.Name)] = config
+		}
+	}
+
+	return &evmConfigReloader{
+		mgr:       mgr,
+		configs:   applied,
+		newClient: newClient,
+	}
+}
+
+// Reload connects to newly configured chains, re-points chains with a changed RPC configuration and disconnects removed chains.
+// Chains that cannot be connected keep their previous client.
+func (r *evmConfigReloader) Reload(configs []evmTypes.EVMConfig) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.reload(configs)
+}
+
+func (r *evmConfigReloader) reload(configs []evmTypes.EVMConfig) error {
+	desired := make(map[string]evmTypes.EVMConfig)
+	for _, config := range configs {
+		if !config.WithBridge {
+			continue
+		}
+
+		chainName := strings.ToLower(config.Name)
+		if _, ok := desired[chainName]; ok {
+			return fmt.Errorf("duplicate bridge configuration found for EVM chain %s", config.Name)
+		}
+		desired[chainName] = config
+	}
+
+	for chainName, config := range desired {
+		r.mgr.SetReorgCheckDepth(nexus.ChainName(chainName), config.ReorgCheckDepth)
+
+		if current, ok := r.configs[chainName]; ok && sameRPCConfig(current, config) {
+			continue
+		}
+
+		client, err := r.newClient(config)
+		if err != nil {
+			log.WithKeyVals("chain", config.Name, "url", config.RPCAddr).
+				Error(sdkerrors.Wrap(err, "failed to create an RPC connection for reloaded EVM chain config").Error())
+			continue
+		}
+
+		r.mgr.SetRPCClient(nexus.ChainName(chainName), client)
+		r.configs[chainName] = config
+		log.Infof("successfully connected to EVM bridge for chain %s after config change", chainName)
+	}
+
+	for chainName := range r.configs {
+		if _, ok := desired[chainName]; ok {
+			continue
+		}
+
+		r.mgr.RemoveRPCClient(nexus.ChainName(chainName))
+		delete(r.configs, chainName)
+		log.Infof("disconnected from EVM bridge for chain %s after config change", chainName)
+	}
+
+	return nil
+}
+
+// RPCEndpoints returns the configured RPC endpoints of the given chain, reduced to scheme and host so they carry no credentials
+func (r *evmConfigReloader) RPCEndpoints(chain nexus.ChainName) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	config, ok := r.configs[strings.ToLower(chain.String())]
+	if !ok {
+		return nil
+	}
+
+	endpoints := make([]string, 0, len(config.RPCAddrs()))
+	for _, addr := range config.RPCAddrs() {
+		endpoints = append(endpoints, redactEndpoint(addr))
+	}
+
+	return endpoints
+}
+
+// redactEndpoint strips everything but the scheme and host from the given URL, because API keys are commonly part of the path or query
+func redactEndpoint(addr string) string {
+	u, err := url.Parse(addr)
+	if err != nil || u.Host == "" {
+		return "<redacted>"
+	}
+
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+}
+
+func sameRPCConfig(a, b evmTypes.EVMConfig) bool {
+	return slices.Equal(a.RPCAddrs(), b.RPCAddrs()) &&
+		a.RPCQuorum == b.RPCQuorum &&
+		a.FinalityOverride == b.FinalityOverride
+}
+
+func (r *evmConfigReloader) reloadFromViper(v *viper.Viper) error {
+	var configs []evmTypes.EVMConfig
+	if err := v.UnmarshalKey(evmConfigKey, &configs, config.AddDecodeHooks); err != nil {
+		return sdkerrors.Wrap(err, "failed to parse EVM chain config")
+	}
+
+	return r.reload(configs)
+}
+
+// Watch reloads the EVM chain configuration whenever the config file changes
+func (r *evmConfigReloader) Watch(v *viper.Viper) {
+	if v.ConfigFileUsed() == "" {
+		log.Info("no config file in use, hot-reload of the EVM chain configuration is disabled")
+		return
+	}
+
+	v.OnConfigChange(func(e fsnotify.Event) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		log.Infof("config file %s changed, reloading EVM chain configuration", e.Name)
+		if err := r.reloadFromViper(v); err != nil {
+			log.Error(err.Error())
+		}
+	})
+	v.WatchConfig()
+}
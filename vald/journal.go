@@ -0,0 +1,258 @@
+package vald
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	errors2 "github.com/axelarnetwork/axelar-core/utils/errors"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// replayRange is the range of block heights [From, To) for which unhandled sessions are replayed on start
+type replayRange struct {
+	From int64
+	To   int64
+}
+
+// createJournaledJob works like createJobTyped, but additionally records the sessions started by each event and their outcome in the journal.
+// Sessions of this job that have not been handled before the last shutdown are replayed first.
+// Events of the catch-up channel are processed alongside the subscription, it may be nil.
+// If schedule is not nil, the processing of each event is run through it instead of being started immediately.
+func createJournaledJob[T proto.Message](
+	name string,
+	j *journal.Journal,
+	kind journal.Kind,
+	sessionIDs func(event T) []string,
+	replay replayRange,
+	sub <-chan tmEvents.ABCIEventWithHeight,
+	catchUp <-chan tmEvents.ABCIEventWithHeight,
+	processor func(event T) error,
+	schedule scheduler,
+	cancel context.CancelFunc,
+) jobs.Job {
+	jobStatuses.register(name)
+
+	return func(ctx context.Context) error {
+		processWithLog := func(e tmEvents.ABCIEventWithHeight) {
+			event := funcs.Must(sdk.ParseTypedEvent(e.Event)).(T)
+			ids := sessionIDs(event)
+			startSessions(j, name, kind, ids, e)
+
+			start := time.Now()
+			var err error
+			if schedule != nil {
+				err = schedule(e.Height, event, func() error { return processor(event) })
+			} else {
+				err = processor(event)
+			}
+			observeJob(name, start, err)
+
+			finishSessions(j, kind, ids, err)
+			if err != nil {
+				ctx = log.AppendKeyVals(ctx, errors2.KeyVals(err)...)
+				log.FromCtx(ctx).Error(err.Error())
+			}
+		}
+
+		replayed, err := replaySessions(j, name, replay)
+		if err != nil {
+			log.Error(err.Error())
+		}
+
+		consume := tmEvents.Consume(mergeEvents(ctx, replayed, sub, catchUp), processWithLog)
+		if err := consume(ctx); err != nil {
+			cancel()
+			return err
+		}
+
+		return nil
+	}
+}
+
+func startSessions(j *journal.Journal, job string, kind journal.Kind, ids []string, e tmEvents.ABCIEventWithHeight) {
+	if len(ids) == 0 {
+		return
+	}
+
+	bz, err := e.Event.Marshal()
+	if err != nil {
+		log.Errorf("failed to encode event for the journal: %s", err.Error())
+		return
+	}
+
+	for _, id := range ids {
+		if err := j.Start(journal.Session{Kind: kind, ID: id, Job: job, Height: e.Height, Event: bz}); err != nil {
+			log.WithKeyVals("kind", kind, "id", id).Errorf("failed to record session in journal: %s", err.Error())
+		}
+	}
+}
+
+func finishSessions(j *journal.Journal, kind journal.Kind, ids []string, processingErr error) {
+	for _, id := range ids {
+		if err := j.Finish(kind, id, processingErr); err != nil {
+			log.WithKeyVals("kind", kind, "id", id).Errorf("failed to record session outcome in journal: %s", err.Error())
+		}
+	}
+}
+
+// replaySessions returns the events of all replayable sessions of the given job.
+// Events that started multiple sessions are only returned once.
+func replaySessions(j *journal.Journal, job string, replay replayRange) ([]tmEvents.ABCIEventWithHeight, error) {
+	sessions, err := j.Replayable(job, replay.From, replay.To)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var events []tmEvents.ABCIEventWithHeight
+	for _, session := range sessions {
+		if seen[string(session.Event)] {
+			continue
+		}
+		seen[string(session.Event)] = true
+
+		var event abci.Event
+		if err := event.Unmarshal(session.Event); err != nil {
+			log.WithKeyVals("kind", session.Kind, "id", session.ID).Errorf("failed to decode journaled event: %s", err.Error())
+			continue
+		}
+
+		log.WithKeyVals("job", job, "kind", session.Kind, "id", session.ID, "height", session.Height).Info("replaying unhandled session from journal")
+		events = append(events, tmEvents.ABCIEventWithHeight{Height: session.Height, Event: event})
+	}
+
+	return events, nil
+}
+
+// mergeEvents returns a channel that emits the replayed events first and then forwards all events of the subscription.
+// Events of the catch-up channel are forwarded concurrently until it is closed.
+func mergeEvents(ctx context.Context, replayed []tmEvents.ABCIEventWithHeight, sub <-chan tmEvents.ABCIEventWithHeight, catchUp <-chan tmEvents.ABCIEventWithHeight) <-chan tmEvents.ABCIEventWithHeight {
+	if len(replayed) == 0 && catchUp == nil {
+		return sub
+	}
+
+	merged := make(chan tmEvents.ABCIEventWithHeight)
+	go func() {
+		defer close(merged)
+
+		for _, e := range replayed {
+			select {
+			case <-ctx.Done():
+				return
+			case merged <- e:
+			}
+		}
+
+		for {
+			var (
+				e  tmEvents.ABCIEventWithHeight
+				ok bool
+			)
+
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok = <-catchUp:
+				if !ok {
+					// a nil channel blocks forever, so only the subscription is left
+					catchUp = nil
+					continue
+				}
+			case e, ok = <-sub:
+				if !ok {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case merged <- e:
+			}
+		}
+	}()
+
+	return merged
+}
+
+// isSessionEnd matches all events that signal the end of a poll, keygen or signing session
+func isSessionEnd(e tmEvents.ABCIEventWithHeight) bool {
+	return slices.Any([]func(tmEvents.ABCIEventWithHeight) bool{
+		tmEvents.Filter[*evmTypes.PollCompleted](),
+		tmEvents.Filter[*evmTypes.PollExpired](),
+		tmEvents.Filter[*evmTypes.PollFailed](),
+		tmEvents.Filter[*multisigTypes.KeygenCompleted](),
+		tmEvents.Filter[*multisigTypes.KeygenExpired](),
+		tmEvents.Filter[*multisigTypes.SigningCompleted](),
+		tmEvents.Filter[*multisigTypes.SigningExpired](),
+	}, func(filter func(tmEvents.ABCIEventWithHeight) bool) bool { return filter(e) })
+}
+
+// closeSessions marks sessions as closed in the journal when they end on chain, so they are not replayed anymore
+func closeSessions(j *journal.Journal) func(event proto.Message) error {
+	return func(event proto.Message) error {
+		var (
+			kind journal.Kind
+			id   string
+		)
+
+		switch event := event.(type) {
+		case *evmTypes.PollCompleted:
+			kind, id = journal.Poll, event.PollID.String()
+		case *evmTypes.PollExpired:
+			kind, id = journal.Poll, event.PollID.String()
+		case *evmTypes.PollFailed:
+			kind, id = journal.Poll, event.PollID.String()
+		case *multisigTypes.KeygenCompleted:
+			kind, id = journal.Keygen, event.KeyID.String()
+		case *multisigTypes.KeygenExpired:
+			kind, id = journal.Keygen, event.KeyID.String()
+		case *multisigTypes.SigningCompleted:
+			kind, id = journal.Signing, strconv.FormatUint(event.SigID, 10)
+		case *multisigTypes.SigningExpired:
+			kind, id = journal.Signing, strconv.FormatUint(event.SigID, 10)
+		default:
+			return nil
+		}
+
+		return j.MarkClosed(kind, id)
+	}
+}
+
+// keygenSessions returns the key ID of the keygen if the validator participates in it
+func keygenSessions(valAddr sdk.ValAddress) func(event *multisigTypes.KeygenStarted) []string {
+	return func(event *multisigTypes.KeygenStarted) []string {
+		if !isParticipant(valAddr, event.Participants) {
+			return nil
+		}
+
+		return []string{event.KeyID.String()}
+	}
+}
+
+// signingSessions returns the signature ID of the signing if the validator participates in it
+func signingSessions(valAddr sdk.ValAddress) func(event *multisigTypes.SigningStarted) []string {
+	return func(event *multisigTypes.SigningStarted) []string {
+		if _, ok := event.PubKeys[valAddr.String()]; !ok {
+			return nil
+		}
+
+		return []string{strconv.FormatUint(event.SigID, 10)}
+	}
+}
+
+func isParticipant(valAddr sdk.ValAddress, participants []sdk.ValAddress) bool {
+	return slices.Any(participants, func(v sdk.ValAddress) bool { return v.Equals(valAddr) })
+}
@@ -0,0 +1,264 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Kind is the kind of session that is tracked by the journal
+type Kind string
+
+// Kinds of sessions
+const (
+	Poll    Kind = "poll"
+	Keygen  Kind = "keygen"
+	Signing Kind = "signing"
+)
+
+// Status is the outcome of vald's participation in a session
+type Status string
+
+// Session statuses
+const (
+	// Seen means the session has been received, but its processing has not finished
+	Seen Status = "seen"
+	// Voted means vald broadcast its vote for the poll
+	Voted Status = "voted"
+	// Signed means vald broadcast its signature for the signing session
+	Signed Status = "signed"
+	// Submitted means vald broadcast its public key for the keygen session
+	Submitted Status = "submitted"
+	// Failed means the processing of the session returned an error
+	Failed Status = "failed"
+)
+
+// Completed returns the status of a successfully handled session of the given kind
+func (k Kind) Completed() Status {
+	switch k {
+	case Poll:
+		return Voted
+	case Signing:
+		return Signed
+	case Keygen:
+		return Submitted
+	default:
+		panic(fmt.Sprintf("unknown session kind %s", k))
+	}
+}
+
+// Session is a journal entry for a single poll, keygen or signing session vald participates in
+type Session struct {
+	Kind   Kind   `json:"kind"`
+	ID     string `json:"id"`
+	Job    string `json:"job"`
+	Height int64  `json:"height"`
+	// Event is the encoded event that started the session, so it can be replayed
+	Event     []byte    `json:"event"`
+	Status    Status    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Closed    bool      `json:"closed"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Unhandled returns true if the session has not been successfully handled yet
+func (s Session) Unhandled() bool {
+	return s.Status == Seen || s.Status == Failed
+}
+
+// Journal persists the sessions vald has seen and their outcome
+type Journal struct {
+	lock sync.Mutex
+	db   dbm.DB
+}
+
+// New returns a new Journal backed by the given database
+func New(db dbm.DB) *Journal {
+	return &Journal{db: db}
+}
+
+// Open opens or creates the journal in the given directory
+func Open(dir string) (*Journal, error) {
+	db, err := dbm.NewGoLevelDB("journal", dir)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to open vald journal")
+	}
+
+	return New(db), nil
+}
+
+// Close closes the underlying database
+func (j *Journal) Close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	return j.db.Close()
+}
+
+func key(kind Kind, id string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", kind, id))
+}
+
+// Get returns the session of the given kind and ID
+func (j *Journal) Get(kind Kind, id string) (Session, bool, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	return j.get(kind, id)
+}
+
+func (j *Journal) get(kind Kind, id string) (Session, bool, error) {
+	bz, err := j.db.Get(key(kind, id))
+	if err != nil {
+		return Session{}, false, err
+	}
+
+	if bz == nil {
+		return Session{}, false, nil
+	}
+
+	var session Session
+	if err := json.Unmarshal(bz, &session); err != nil {
+		return Session{}, false, sdkerrors.Wrapf(err, "journal entry %s is in unexpected format", key(kind, id))
+	}
+
+	return session, true, nil
+}
+
+func (j *Journal) set(session Session) error {
+	session.UpdatedAt = time.Now().UTC()
+
+	bz, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	return j.db.SetSync(key(session.Kind, session.ID), bz)
+}
+
+// Start records that processing of the given session begins. Sessions that are already known keep their event and closed flag.
+func (j *Journal) Start(session Session) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	existing, ok, err := j.get(session.Kind, session.ID)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		existing.Status = Seen
+		existing.Error = ""
+		return j.set(existing)
+	}
+
+	session.Status = Seen
+	session.Error = ""
+	return j.set(session)
+}
+
+// Finish records the outcome of processing the given session
+func (j *Journal) Finish(kind Kind, id string, processingErr error) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	session, ok, err := j.get(kind, id)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("%s session %s not found in journal", kind, id)
+	}
+
+	session.Status = kind.Completed()
+	session.Error = ""
+	if processingErr != nil {
+		session.Status = Failed
+		session.Error = processingErr.Error()
+	}
+
+	return j.set(session)
+}
+
+// MarkClosed records that the given session has ended on chain, so it must not be replayed anymore.
+// Sessions that are unknown to the journal are ignored.
+func (j *Journal) MarkClosed(kind Kind, id string) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	session, ok, err := j.get(kind, id)
+	if err != nil || !ok {
+		return err
+	}
+
+	session.Closed = true
+	return j.set(session)
+}
+
+// Sessions returns all sessions in the journal that match the given filter
+func (j *Journal) Sessions(filter func(Session) bool) ([]Session, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	iter, err := j.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var sessions []Session
+	for ; iter.Valid(); iter.Next() {
+		var session Session
+		if err := json.Unmarshal(iter.Value(), &session); err != nil {
+			return nil, sdkerrors.Wrapf(err, "journal entry %s is in unexpected format", iter.Key())
+		}
+
+		if filter(session) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, iter.Error()
+}
+
+// Replayable returns the sessions of the given job that are still open, have not been handled successfully and were started in [from, to)
+func (j *Journal) Replayable(job string, from, to int64) ([]Session, error) {
+	return j.Sessions(func(session Session) bool {
+		return session.Job == job &&
+			!session.Closed &&
+			session.Unhandled() &&
+			session.Height >= from &&
+			session.Height < to
+	})
+}
+
+// Prune deletes all sessions that were started before the given height
+func (j *Journal) Prune(height int64) (int, error) {
+	old, err := j.Sessions(func(session Session) bool { return session.Height < height })
+	if err != nil {
+		return 0, err
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+
+	for _, session := range old {
+		if err := batch.Delete(key(session.Kind, session.ID)); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+
+	return len(old), nil
+}
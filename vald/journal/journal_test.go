@@ -0,0 +1,100 @@
+package journal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+)
+
+func TestJournal(t *testing.T) {
+	var j *journal.Journal
+
+	setup := func() {
+		j = journal.New(dbm.NewMemDB())
+	}
+
+	t.Run("records the outcome of sessions", func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, j.Start(journal.Session{Kind: journal.Poll, ID: "1", Job: "job", Height: 10, Event: []byte("event")}))
+		session, ok, err := j.Get(journal.Poll, "1")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, journal.Seen, session.Status)
+		assert.Equal(t, []byte("event"), session.Event)
+
+		assert.NoError(t, j.Finish(journal.Poll, "1", nil))
+		session, _, _ = j.Get(journal.Poll, "1")
+		assert.Equal(t, journal.Voted, session.Status)
+
+		assert.NoError(t, j.Start(journal.Session{Kind: journal.Signing, ID: "1", Job: "job", Height: 10}))
+		assert.NoError(t, j.Finish(journal.Signing, "1", errors.New("tofnd unavailable")))
+		session, _, _ = j.Get(journal.Signing, "1")
+		assert.Equal(t, journal.Failed, session.Status)
+		assert.Equal(t, "tofnd unavailable", session.Error)
+
+		assert.Error(t, j.Finish(journal.Keygen, "unknown", nil))
+	})
+
+	t.Run("returns only open unhandled sessions for replay", func(t *testing.T) {
+		setup()
+
+		for _, session := range []journal.Session{
+			{Kind: journal.Poll, ID: "1", Job: "job", Height: 10},
+			{Kind: journal.Poll, ID: "2", Job: "job", Height: 11},
+			{Kind: journal.Poll, ID: "3", Job: "job", Height: 12},
+			{Kind: journal.Poll, ID: "4", Job: "job", Height: 13},
+			{Kind: journal.Poll, ID: "5", Job: "other", Height: 13},
+			{Kind: journal.Poll, ID: "6", Job: "job", Height: 5},
+			{Kind: journal.Poll, ID: "7", Job: "job", Height: 20},
+		} {
+			assert.NoError(t, j.Start(session))
+		}
+
+		assert.NoError(t, j.Finish(journal.Poll, "2", nil))
+		assert.NoError(t, j.Finish(journal.Poll, "3", errors.New("failed")))
+		assert.NoError(t, j.MarkClosed(journal.Poll, "4"))
+		assert.NoError(t, j.MarkClosed(journal.Poll, "unknown"))
+
+		sessions, err := j.Replayable("job", 10, 20)
+		assert.NoError(t, err)
+
+		var ids []string
+		for _, session := range sessions {
+			ids = append(ids, session.ID)
+		}
+		assert.ElementsMatch(t, []string{"1", "3"}, ids)
+	})
+
+	t.Run("restarting a session keeps it closed", func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, j.Start(journal.Session{Kind: journal.Keygen, ID: "key", Job: "job", Height: 10}))
+		assert.NoError(t, j.MarkClosed(journal.Keygen, "key"))
+		assert.NoError(t, j.Start(journal.Session{Kind: journal.Keygen, ID: "key", Job: "job", Height: 10}))
+
+		session, _, _ := j.Get(journal.Keygen, "key")
+		assert.True(t, session.Closed)
+		assert.Equal(t, journal.Seen, session.Status)
+	})
+
+	t.Run("prunes old sessions", func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, j.Start(journal.Session{Kind: journal.Poll, ID: "1", Height: 10}))
+		assert.NoError(t, j.Start(journal.Session{Kind: journal.Poll, ID: "2", Height: 20}))
+
+		pruned, err := j.Prune(15)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, pruned)
+
+		_, ok, _ := j.Get(journal.Poll, "1")
+		assert.False(t, ok)
+		_, ok, _ = j.Get(journal.Poll, "2")
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,45 @@
+package vald
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func TestJournaledSessions(t *testing.T) {
+	valAddr := rand.ValAddr()
+	j := journal.New(dbm.NewMemDB())
+
+	event := &evmTypes.ConfirmGatewayTxsStarted{
+		PollMappings: []evmTypes.PollMapping{{PollID: vote.PollID(1)}, {PollID: vote.PollID(2)}},
+		Chain:        "ethereum",
+		Participants: []sdk.ValAddress{valAddr},
+	}
+	abciEvent := funcs.Must(sdk.TypedEventToEvent(event))
+	e := tmEvents.ABCIEventWithHeight{Height: 100, Event: abci.Event(abciEvent)}
+	ids := []string{"1", "2"}
+
+	startSessions(j, "evm_gateway_txs_confirmation", journal.Poll, ids, e)
+
+	replayed, err := replaySessions(j, "evm_gateway_txs_confirmation", replayRange{From: 50, To: 150})
+	assert.NoError(t, err)
+	assert.Len(t, replayed, 1, "events that started multiple sessions must only be replayed once")
+	assert.Equal(t, e, replayed[0])
+
+	assert.NoError(t, closeSessions(j)(&evmTypes.PollCompleted{PollID: vote.PollID(1)}))
+	finishSessions(j, journal.Poll, []string{"2"}, nil)
+
+	replayed, err = replaySessions(j, "evm_gateway_txs_confirmation", replayRange{From: 50, To: 150})
+	assert.NoError(t, err)
+	assert.Empty(t, replayed)
+}
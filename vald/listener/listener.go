@@ -0,0 +1,185 @@
+package listener
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/viper"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// ChainListener processes the events of a chain family that vald needs to act on, e.g. by voting on polls
+type ChainListener interface {
+	// Subscriptions returns the typed events the listener subscribes to and how they are processed
+	Subscriptions() []Subscription
+}
+
+// Subscription binds the events matching the filter to the job that processes them
+type Subscription struct {
+	// Job is the unique name of the job processing the events
+	Job     string
+	Filter  func(e tmEvents.ABCIEventWithHeight) bool
+	Process func(event proto.Message) error
+	// Kind and Sessions are optional. If set, the sessions started by each event are tracked in the journal
+	Kind     journal.Kind
+	Sessions func(event proto.Message) []string
+	// Chain is optional. If set, polls are processed by the worker pool of the chain they belong to
+	Chain func(event proto.Message) nexus.ChainName
+}
+
+// Journaled returns true if the sessions started by the subscribed events are tracked in the journal
+func (s Subscription) Journaled() bool {
+	return s.Sessions != nil
+}
+
+// Handle returns a subscription that processes all events of type T
+func Handle[T proto.Message](job string, process func(event T) error) Subscription {
+	return Subscription{
+		Job:     job,
+		Filter:  tmEvents.Filter[T](),
+		Process: func(event proto.Message) error { return process(event.(T)) },
+	}
+}
+
+// HandleSessions returns a subscription that processes all events of type T and tracks the sessions they start in the journal
+func HandleSessions[T proto.Message](job string, kind journal.Kind, sessions func(event T) []string, process func(event T) error) Subscription {
+	subscription := Handle(job, process)
+	subscription.Kind = kind
+	subscription.Sessions = func(event proto.Message) []string { return sessions(event.(T)) }
+
+	return subscription
+}
+
+// OnChain assigns the events of the subscription to the chain returned by the given function
+func OnChain[T proto.Message](subscription Subscription, chain func(event T) nexus.ChainName) Subscription {
+	subscription.Chain = func(event proto.Message) nexus.ChainName { return chain(event.(T)) }
+
+	return subscription
+}
+
+// VoteOn returns a subscription that votes on the polls started by events of type T.
+// The vote payload is produced by the given function and only requested if the validator participates in the poll.
+func VoteOn[T proto.Message](job string, voter Voter, poll func(event T) vote.PollParticipants, payload func(event T) (codec.ProtoMarshaler, error)) Subscription {
+	sessions := func(event T) []string {
+		participants := poll(event)
+		if !voter.IsParticipant(participants.Participants) {
+			return nil
+		}
+
+		return []string{participants.PollID.String()}
+	}
+
+	process := func(event T) error {
+		participants := poll(event)
+		if !voter.IsParticipant(participants.Participants) {
+			log.WithKeyVals("job", job, "poll", participants.PollID).Debug("ignoring poll: not a participant")
+			return nil
+		}
+
+		payloadMsg, err := payload(event)
+		if err != nil {
+			return err
+		}
+
+		return voter.Vote(context.Background(), participants.PollID, payloadMsg)
+	}
+
+	return HandleSessions(job, journal.Poll, sessions, process)
+}
+
+// Voter broadcasts votes on behalf of a validator
+type Voter struct {
+	broadcaster broadcast.Broadcaster
+	validator   sdk.ValAddress
+	proxy       sdk.AccAddress
+}
+
+// NewVoter returns a new Voter that broadcasts votes from the validator's proxy account
+func NewVoter(broadcaster broadcast.Broadcaster, validator sdk.ValAddress, proxy sdk.AccAddress) Voter {
+	return Voter{broadcaster: broadcaster, validator: validator, proxy: proxy}
+}
+
+// IsParticipant returns true if the validator is one of the given participants
+func (v Voter) IsParticipant(participants []sdk.ValAddress) bool {
+	return slices.Any(participants, func(p sdk.ValAddress) bool { return p.Equals(v.validator) })
+}
+
+// Vote broadcasts the vote for the given poll
+func (v Voter) Vote(ctx context.Context, pollID vote.PollID, payload codec.ProtoMarshaler) error {
+	log.FromCtx(ctx).Infof("broadcasting vote for poll %s", pollID.String())
+	_, err := v.broadcaster.Broadcast(ctx, voteTypes.NewVoteRequest(v.proxy, pollID, payload))
+
+	return err
+}
+
+// Context provides chain listeners with everything they need to process events
+type Context struct {
+	Voter       Voter
+	Broadcaster broadcast.Broadcaster
+	Validator   sdk.ValAddress
+	Proxy       sdk.AccAddress
+	// Config gives access to the full vald configuration, so listeners can read their own config section
+	Config *viper.Viper
+	// OnShutdown registers a function that is called when vald shuts down
+	OnShutdown func(func())
+}
+
+// Factory creates a chain listener
+type Factory func(ctx Context) (ChainListener, error)
+
+var (
+	registryLock sync.Mutex
+	registry     = make(map[string]Factory)
+)
+
+// Register makes a chain listener available to vald. It is meant to be called from the init function of the package implementing the listener.
+// Panics if a listener with the same name is already registered.
+func Register(name string, factory Factory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("chain listener %s is already registered", name))
+	}
+
+	registry[name] = factory
+}
+
+// CreateRegistered creates all registered chain listeners, ordered by name
+func CreateRegistered(ctx Context) ([]ChainListener, error) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	listeners := make([]ChainListener, 0, len(names))
+	for _, name := range names {
+		listener, err := registry[name](ctx)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to create chain listener %s", name)
+		}
+
+		log.Infof("created chain listener %s", name)
+		listeners = append(listeners, listener)
+	}
+
+	return listeners, nil
+}
@@ -0,0 +1,108 @@
+package listener_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	"github.com/axelarnetwork/axelar-core/vald/listener"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func TestHandle(t *testing.T) {
+	var processed *evmTypes.ChainAdded
+	sub := listener.Handle("new_chain", func(event *evmTypes.ChainAdded) error {
+		processed = event
+		return nil
+	})
+
+	event := &evmTypes.ChainAdded{Chain: "ethereum"}
+	abciEvent := abci.Event(funcs.Must(sdk.TypedEventToEvent(event)))
+
+	assert.True(t, sub.Filter(tmEvents.ABCIEventWithHeight{Height: 1, Event: abciEvent}))
+	assert.False(t, sub.Filter(tmEvents.ABCIEventWithHeight{Height: 1, Event: abci.Event(funcs.Must(sdk.TypedEventToEvent(&evmTypes.PollCompleted{})))}))
+	assert.False(t, sub.Journaled())
+
+	assert.NoError(t, sub.Process(event))
+	assert.Equal(t, event, processed)
+}
+
+func TestVoteOn(t *testing.T) {
+	valAddr := rand.ValAddr()
+	proxy := rand.AccAddr()
+	broadcaster := &mock.BroadcasterMock{
+		BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, nil },
+	}
+	voter := listener.NewVoter(broadcaster, valAddr, proxy)
+
+	payloadErr := errors.New("rpc unavailable")
+	sub := listener.VoteOn("deposit", voter,
+		func(event *evmTypes.ConfirmDepositStarted) vote.PollParticipants { return event.PollParticipants },
+		func(event *evmTypes.ConfirmDepositStarted) (codec.ProtoMarshaler, error) {
+			if event.Chain == "broken" {
+				return nil, payloadErr
+			}
+			return evmTypes.NewVoteEvents(event.Chain), nil
+		})
+
+	assert.True(t, sub.Journaled())
+	assert.Equal(t, journal.Poll, sub.Kind)
+
+	t.Run("ignores polls without the validator", func(t *testing.T) {
+		event := &evmTypes.ConfirmDepositStarted{Chain: "ethereum", PollParticipants: vote.PollParticipants{PollID: 1, Participants: []sdk.ValAddress{rand.ValAddr()}}}
+
+		assert.Empty(t, sub.Sessions(event))
+		assert.NoError(t, sub.Process(event))
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	})
+
+	t.Run("votes on polls with the validator", func(t *testing.T) {
+		event := &evmTypes.ConfirmDepositStarted{Chain: "ethereum", PollParticipants: vote.PollParticipants{PollID: 2, Participants: []sdk.ValAddress{valAddr}}}
+
+		assert.Equal(t, []string{"2"}, sub.Sessions(event))
+		assert.NoError(t, sub.Process(event))
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+
+		msg := broadcaster.BroadcastCalls()[0].Msgs[0].(*voteTypes.VoteRequest)
+		assert.Equal(t, vote.PollID(2), msg.PollID)
+		assert.Equal(t, proxy, msg.Sender)
+	})
+
+	t.Run("returns payload errors", func(t *testing.T) {
+		event := &evmTypes.ConfirmDepositStarted{Chain: "broken", PollParticipants: vote.PollParticipants{PollID: 3, Participants: []sdk.ValAddress{valAddr}}}
+
+		assert.ErrorIs(t, sub.Process(event), payloadErr)
+	})
+}
+
+type testListener struct {
+	subs []listener.Subscription
+}
+
+func (l testListener) Subscriptions() []listener.Subscription { return l.subs }
+
+func TestRegister(t *testing.T) {
+	listener.Register("test", func(ctx listener.Context) (listener.ChainListener, error) {
+		return testListener{subs: []listener.Subscription{listener.Handle("test_new_chain", func(*evmTypes.ChainAdded) error { return nil })}}, nil
+	})
+	assert.Panics(t, func() {
+		listener.Register("test", func(listener.Context) (listener.ChainListener, error) { return testListener{}, nil })
+	})
+
+	listeners, err := listener.CreateRegistered(listener.Context{})
+	assert.NoError(t, err)
+	assert.Len(t, listeners, 1)
+	assert.Equal(t, "test_new_chain", listeners[0].Subscriptions()[0].Job)
+}
@@ -0,0 +1,75 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/viper"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
+	"github.com/axelarnetwork/axelar-core/vald/listener"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/jobs"
+)
+
+// listenerSubscription is a chain listener subscription together with the channel of its subscribed events
+type listenerSubscription struct {
+	listener.Subscription
+	events <-chan tmEvents.ABCIEventWithHeight
+}
+
+// createChainListeners returns the given built-in listeners followed by all listeners registered with the listener package
+func createChainListeners(b broadcast.Broadcaster, valAddr sdk.ValAddress, proxy sdk.AccAddress, v *viper.Viper, builtIn ...listener.ChainListener) []listener.ChainListener {
+	registered, err := listener.CreateRegistered(listener.Context{
+		Voter:       listener.NewVoter(b, valAddr, proxy),
+		Broadcaster: b,
+		Validator:   valAddr,
+		Proxy:       proxy,
+		Config:      v,
+		OnShutdown:  func(f func()) { cleanupCommands = append(cleanupCommands, f) },
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return append(builtIn, registered...)
+}
+
+// subscribeListeners subscribes to the events of all chain listeners. Panics if two subscriptions share the same job name.
+func subscribeListeners(eventBus *tmEvents.Bus, listeners []listener.ChainListener) []listenerSubscription {
+	jobNames := make(map[string]bool)
+
+	var subs []listenerSubscription
+	for _, l := range listeners {
+		for _, sub := range l.Subscriptions() {
+			if jobNames[sub.Job] {
+				panic(fmt.Sprintf("duplicate chain listener job %s", sub.Job))
+			}
+			jobNames[sub.Job] = true
+
+			subs = append(subs, listenerSubscription{Subscription: sub, events: eventBus.Subscribe(sub.Filter)})
+		}
+	}
+
+	return subs
+}
+
+// createListenerJob returns the job that processes the events of the given subscription.
+// Polls that belong to a chain are processed in the worker pool of that chain.
+// Journaled subscriptions also process the still open sessions that started in skipped blocks.
+func createListenerJob(ctx context.Context, sub listenerSubscription, j *journal.Journal, replay replayRange, sessionCatchUp *catchUp, polls *pollScheduler, cancel context.CancelFunc) jobs.Job {
+	if sub.Journaled() {
+		var schedule scheduler
+		if sub.Chain != nil && sub.Kind == journal.Poll {
+			schedule = polls.Schedule(sub.Chain, sub.Sessions)
+		}
+
+		catchUp := catchUpEvents[proto.Message](ctx, sessionCatchUp, sub.Kind, sub.Filter, sub.Sessions)
+		return createJournaledJob[proto.Message](sub.Job, j, sub.Kind, sub.Sessions, replay, sub.events, catchUp, sub.Process, schedule, cancel)
+	}
+
+	return createJobTyped[proto.Message](sub.Job, sub.events, sub.Process, cancel)
+}
@@ -0,0 +1,152 @@
+package vald
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/policy"
+	grpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	"github.com/axelarnetwork/axelar-core/vald/workers"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+var (
+	jobEventsProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "job",
+		Name:      "events_processed_total",
+		Help:      "Number of events processed by each job",
+	}, []string{"job"})
+	jobErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "job",
+		Name:      "errors_total",
+		Help:      "Number of events for which processing returned an error",
+	}, []string{"job"})
+	jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "vald",
+		Subsystem: "job",
+		Name:      "processing_duration_seconds",
+		Help:      "Time it takes each job to process an event",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"job"})
+	latestProcessedBlock = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Name:      "latest_processed_block_height",
+		Help:      "Latest block height that has been persisted to the state store",
+	})
+)
+
+func observeJob(job string, start time.Time, err error) {
+	jobStatuses.observe(job, err)
+
+	jobEventsProcessed.WithLabelValues(job).Inc()
+	jobDuration.WithLabelValues(job).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		jobErrors.WithLabelValues(job).Inc()
+	}
+}
+
+func newMetricsRegistry() (*prometheus.Registry, error) {
+	registry := prometheus.NewRegistry()
+
+	for _, collector := range []prometheus.Collector{jobEventsProcessed, jobErrors, jobDuration, latestProcessedBlock} {
+		if err := registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := broadcast.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+
+	if err := evmRPC.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+
+	if err := policy.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+
+	if err := grpc.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+
+	if err := workers.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+
+	if err := evm.RegisterMetrics(registry); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// metricsJobs returns the job serving prometheus metrics if metrics are enabled, and no jobs otherwise
+func metricsJobs(cfg config.MetricsConfig) []jobs.Job {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return []jobs.Job{createMetricsServer(cfg)}
+}
+
+// createMetricsServer returns a job that serves prometheus metrics until the context is canceled.
+// Metrics are optional, so if the server fails the error is logged and vald keeps running without them
+func createMetricsServer(cfg config.MetricsConfig) jobs.Job {
+	return func(ctx context.Context) error {
+		if err := serveMetrics(ctx, cfg); err != nil {
+			log.Errorf("metrics server on %s stopped, vald continues without metrics: %s", cfg.ListenAddr, err.Error())
+		}
+
+		return nil
+	}
+}
+
+func serveMetrics(ctx context.Context, cfg config.MetricsConfig) error {
+	registry, err := newMetricsRegistry()
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", cfg.ListenAddr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("failed to shut down metrics server: %s", err.Error())
+		}
+	}()
+
+	log.Infof("serving metrics on %s", cfg.ListenAddr)
+	if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
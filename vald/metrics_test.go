@@ -0,0 +1,84 @@
+package vald
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func TestMetricsJobs(t *testing.T) {
+	t.Run("does not serve metrics when disabled", func(t *testing.T) {
+		assert.Empty(t, metricsJobs(config.DefaultMetricsConfig()))
+	})
+
+	t.Run("serves job metrics when enabled", func(t *testing.T) {
+		listener := funcs.Must(net.Listen("tcp", "127.0.0.1:0"))
+		addr := listener.Addr().String()
+		assert.NoError(t, listener.Close())
+
+		cfg := config.DefaultMetricsConfig()
+		cfg.Enabled = true
+		cfg.ListenAddr = addr
+
+		js := metricsJobs(cfg)
+		assert.Len(t, js, 1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- js[0](ctx) }()
+
+		job := rand.StrBetween(5, 10)
+		observeJob(job, time.Now(), errors.New("some error"))
+
+		assert.Eventually(t, func() bool {
+			resp, err := http.Get("http://" + addr + "/metrics")
+			if err != nil {
+				return false
+			}
+			defer resp.Body.Close()
+
+			body := funcs.Must(io.ReadAll(resp.Body))
+			return strings.Contains(string(body), `vald_job_events_processed_total{job="`+job+`"} 1`) &&
+				strings.Contains(string(body), `vald_job_errors_total{job="`+job+`"} 1`)
+		}, 5*time.Second, 50*time.Millisecond)
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("keeps vald running when the metrics server fails to listen", func(t *testing.T) {
+		listener := funcs.Must(net.Listen("tcp", "127.0.0.1:0"))
+		defer listener.Close()
+
+		cfg := config.DefaultMetricsConfig()
+		cfg.Enabled = true
+		cfg.ListenAddr = listener.Addr().String()
+
+		assert.NoError(t, metricsJobs(cfg)[0](context.Background()))
+	})
+}
+
+func TestObserveJob(t *testing.T) {
+	registry := funcs.Must(newMetricsRegistry())
+	job := rand.StrBetween(5, 10)
+
+	observeJob(job, time.Now(), nil)
+	observeJob(job, time.Now(), errors.New("some error"))
+
+	labels := map[string]string{"job": job}
+	assert.Equal(t, 2.0, testutils.MetricValue(t, registry, "vald_job_events_processed_total", labels))
+	assert.Equal(t, 1.0, testutils.MetricValue(t, registry, "vald_job_errors_total", labels))
+	assert.Equal(t, 2.0, testutils.MetricValue(t, registry, "vald_job_processing_duration_seconds", labels))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -18,16 +19,19 @@ func (mgr *Mgr) ProcessKeygenStarted(event *types.KeygenStarted) error {
 		return nil
 	}
 
+	// the session expires after roughly this long, so tofnd requests are not retried past it
+	deadline := time.Now().Add(mgr.retry.KeygenWindow)
+
 	keyUID := fmt.Sprintf("%s_%d", event.GetKeyID().String(), 0)
 	partyUID := mgr.participant.String()
 
-	pubKey, err := mgr.generateKey(keyUID)
+	pubKey, err := mgr.generateKey(keyUID, deadline)
 	if err != nil {
 		return err
 	}
 
 	payloadHash := sha256.Sum256(mgr.ctx.FromAddress)
-	sig, err := mgr.sign(keyUID, payloadHash[:], pubKey)
+	sig, err := mgr.sign(keyUID, payloadHash[:], pubKey, deadline)
 	if err != nil {
 		return err
 	}
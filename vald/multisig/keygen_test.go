@@ -20,6 +20,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils"
 	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
@@ -46,6 +47,8 @@ func TestMgr_ProcessKeygenStarted(t *testing.T) {
 			participant,
 			broadcaster,
 			time.Second,
+			multisig.RetryPolicy{KeygenWindow: time.Second, SigningWindow: time.Second, BackOff: utils.LinearBackOff(time.Millisecond)},
+			nil,
 		)
 	})
 
@@ -10,11 +10,33 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	tofndgrpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils"
+	"github.com/axelarnetwork/utils/log"
 )
 
+//go:generate moq -pkg mock -out ./mock/policy.go . SigningPolicy
+
+// SigningPolicy decides whether vald may sign the payload of a signing session
+type SigningPolicy interface {
+	Check(event *types.SigningStarted) error
+	// CheckPayload decides whether vald may sign the given command batch before its signing session starts
+	CheckPayload(chain nexus.ChainName, data []byte) error
+}
+
+// RetryPolicy defines how long keygen and sign requests to tofnd are retried when tofnd is temporarily unavailable.
+// Requests are only retried while the session they belong to has not expired. Keygen and sign are idempotent in tofnd, so retries are safe.
+type RetryPolicy struct {
+	// KeygenWindow and SigningWindow are the expected durations of keygen and signing sessions. No retries if zero
+	KeygenWindow  time.Duration
+	SigningWindow time.Duration
+	BackOff       utils.BackOff
+}
+
 // Mgr represents an object that manages all communication with the multisig process
 type Mgr struct {
 	client      Client
@@ -22,16 +44,22 @@ type Mgr struct {
 	participant sdk.ValAddress
 	broadcaster broadcast.Broadcaster
 	timeout     time.Duration
+	retry       RetryPolicy
+	policy      SigningPolicy
+	presigned   *presignCache
 }
 
-// NewMgr is the constructor of mgr
-func NewMgr(client Client, ctx sdkclient.Context, participant sdk.ValAddress, broadcaster broadcast.Broadcaster, timeout time.Duration) *Mgr {
+// NewMgr is the constructor of mgr. If the signing policy is nil, all payloads are signed.
+func NewMgr(client Client, ctx sdkclient.Context, participant sdk.ValAddress, broadcaster broadcast.Broadcaster, timeout time.Duration, retry RetryPolicy, policy SigningPolicy) *Mgr {
 	return &Mgr{
 		client:      client,
 		ctx:         ctx,
 		participant: participant,
 		broadcaster: broadcaster,
 		timeout:     timeout,
+		retry:       retry,
+		policy:      policy,
+		presigned:   newPresignCache(),
 	}
 }
 
@@ -39,13 +67,12 @@ func (mgr Mgr) isParticipant(p sdk.ValAddress) bool {
 	return mgr.participant.Equals(p)
 }
 
-func (mgr Mgr) generateKey(keyUID string) (exported.PublicKey, error) {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
-	defer cancel()
-
-	res, err := mgr.client.Keygen(grpcCtx, &tofnd.KeygenRequest{
-		KeyUid:   keyUID,
-		PartyUid: mgr.participant.String(),
+func (mgr Mgr) generateKey(keyUID string, deadline time.Time) (exported.PublicKey, error) {
+	res, err := withRetry(mgr, deadline, func(ctx context.Context) (*tofnd.KeygenResponse, error) {
+		return mgr.client.Keygen(ctx, &tofnd.KeygenRequest{
+			KeyUid:   keyUID,
+			PartyUid: mgr.participant.String(),
+		})
 	})
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "failed generating key")
@@ -61,15 +88,14 @@ func (mgr Mgr) generateKey(keyUID string) (exported.PublicKey, error) {
 	}
 }
 
-func (mgr Mgr) sign(keyUID string, payloadHash exported.Hash, pubKey []byte) (types.Signature, error) {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
-	defer cancel()
-
-	res, err := mgr.client.Sign(grpcCtx, &tofnd.SignRequest{
-		KeyUid:    keyUID,
-		MsgToSign: payloadHash,
-		PartyUid:  mgr.participant.String(),
-		PubKey:    pubKey,
+func (mgr Mgr) sign(keyUID string, payloadHash exported.Hash, pubKey []byte, deadline time.Time) (types.Signature, error) {
+	res, err := withRetry(mgr, deadline, func(ctx context.Context) (*tofnd.SignResponse, error) {
+		return mgr.client.Sign(ctx, &tofnd.SignRequest{
+			KeyUid:    keyUID,
+			MsgToSign: payloadHash,
+			PartyUid:  mgr.participant.String(),
+			PubKey:    pubKey,
+		})
 	})
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "failed signing")
@@ -84,3 +110,25 @@ func (mgr Mgr) sign(keyUID string, payloadHash exported.Hash, pubKey []byte) (ty
 		panic(fmt.Errorf("unknown multisig sign response %T", res.GetSignResponse()))
 	}
 }
+
+// withRetry sends the request to tofnd and retries it while tofnd is unavailable, until the given deadline.
+// Each attempt times out after the manager's timeout.
+func withRetry[T any](mgr Mgr, deadline time.Time, request func(ctx context.Context) (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
+		res, err := request(grpcCtx)
+		cancel()
+
+		if err == nil || !tofndgrpc.IsTransient(err) || mgr.retry.BackOff == nil {
+			return res, err
+		}
+
+		wait := mgr.retry.BackOff(attempt)
+		if time.Now().Add(wait).After(deadline) {
+			return res, sdkerrors.Wrapf(err, "tofnd unavailable until session expiry after %d attempts", attempt)
+		}
+
+		log.Infof("tofnd unavailable, retrying in %s: %s", wait, err.Error())
+		time.Sleep(wait)
+	}
+}
@@ -0,0 +1,147 @@
+package multisig
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+//go:generate moq -pkg mock -out ./mock/presign.go . BatchPredictor
+
+// PendingBatch is a command batch that the chain is expected to request a signature for next
+type PendingBatch struct {
+	Chain       nexus.ChainName
+	KeyID       exported.KeyID
+	Data        []byte
+	PayloadHash exported.Hash
+	// PubKey is the participant's public key of the signing key
+	PubKey exported.PublicKey
+}
+
+// BatchPredictor predicts the command batches that will be signed next
+type BatchPredictor interface {
+	PendingBatches(ctx context.Context) ([]PendingBatch, error)
+}
+
+type presignedSig struct {
+	sig       types.Signature
+	expiresAt time.Time
+}
+
+// presignCache holds signatures for payloads that have been signed before their signing session started
+type presignCache struct {
+	lock sync.Mutex
+	sigs map[string]presignedSig
+}
+
+func newPresignCache() *presignCache {
+	return &presignCache{sigs: make(map[string]presignedSig)}
+}
+
+func presignKey(keyID exported.KeyID, payloadHash []byte) string {
+	return fmt.Sprintf("%s_%s", keyID, hex.EncodeToString(payloadHash))
+}
+
+func (c *presignCache) has(keyID exported.KeyID, payloadHash []byte) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	sig, ok := c.sigs[presignKey(keyID, payloadHash)]
+	return ok && time.Now().Before(sig.expiresAt)
+}
+
+func (c *presignCache) set(keyID exported.KeyID, payloadHash []byte, sig types.Signature, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sigs[presignKey(keyID, payloadHash)] = presignedSig{sig: sig, expiresAt: time.Now().Add(ttl)}
+}
+
+// take returns the signature for the given payload and removes it from the cache
+func (c *presignCache) take(keyID exported.KeyID, payloadHash []byte) (types.Signature, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := presignKey(keyID, payloadHash)
+	sig, ok := c.sigs[key]
+	if !ok {
+		return nil, false
+	}
+
+	delete(c.sigs, key)
+	return sig.sig, time.Now().Before(sig.expiresAt)
+}
+
+func (c *presignCache) prune() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	for key, sig := range c.sigs {
+		if !now.Before(sig.expiresAt) {
+			delete(c.sigs, key)
+		}
+	}
+}
+
+// Presign returns a job that signs the predicted command batches in the given interval, before their signing sessions start.
+// The signatures are kept for the given duration and only submitted once the chain starts a signing session for the same payload,
+// so they can be broadcast within the first block of the session.
+func (mgr *Mgr) Presign(predictor BatchPredictor, interval time.Duration, ttl time.Duration) jobs.Job {
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				mgr.presign(ctx, predictor, ttl)
+			}
+		}
+	}
+}
+
+func (mgr *Mgr) presign(ctx context.Context, predictor BatchPredictor, ttl time.Duration) {
+	mgr.presigned.prune()
+
+	queryCtx, cancel := context.WithTimeout(ctx, mgr.timeout)
+	batches, err := predictor.PendingBatches(queryCtx)
+	cancel()
+	if err != nil {
+		log.Debugf("failed to predict pending command batches: %s", err.Error())
+		return
+	}
+
+	for _, batch := range batches {
+		if mgr.presigned.has(batch.KeyID, batch.PayloadHash) {
+			continue
+		}
+
+		if mgr.policy != nil {
+			if err := mgr.policy.CheckPayload(batch.Chain, batch.Data); err != nil {
+				continue
+			}
+		}
+
+		// a single attempt suffices, the batch is signed again once its session starts if this fails
+		keyUID := fmt.Sprintf("%s_%d", batch.KeyID.String(), 0)
+		sig, err := mgr.sign(keyUID, batch.PayloadHash, batch.PubKey, time.Now())
+		if err != nil {
+			log.Debugf("failed to pre-sign command batch for chain %s: %s", batch.Chain, err.Error())
+			continue
+		}
+
+		mgr.presigned.set(batch.KeyID, batch.PayloadHash, sig, ttl)
+		log.Debugf("pre-signed command batch %s for chain %s", hex.EncodeToString(batch.PayloadHash), batch.Chain)
+	}
+}
@@ -0,0 +1,139 @@
+package multisig_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	broadcastmock "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/vald/multisig/mock"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	typestestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMgr_Presign(t *testing.T) {
+	var (
+		mgr         *multisig.Mgr
+		participant sdk.ValAddress
+		client      *mock.ClientMock
+		policy      *mock.SigningPolicyMock
+		predictor   *mock.BatchPredictorMock
+		broadcaster *broadcastmock.BroadcasterMock
+		privateKey  *btcec.PrivateKey
+		key         types.Key
+		batch       multisig.PendingBatch
+	)
+
+	presign := func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = mgr.Presign(predictor, time.Millisecond, time.Minute)(ctx)
+		}()
+
+		assert.Eventually(t, func() bool { return len(predictor.PendingBatchesCalls()) > 1 }, time.Second, time.Millisecond)
+		cancel()
+		<-done
+	}
+
+	signingStarted := func(payloadHash exported.Hash) *types.SigningStarted {
+		return types.NewSigningStarted(uint64(rand.PosI64()), key, payloadHash, rand.NormalizedStr(3), nil)
+	}
+
+	givenPendingBatch := Given("the multisig manager", func() {
+		client = &mock.ClientMock{
+			SignFunc: func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: ec.Sign(privateKey, in.MsgToSign).Serialize()}}, nil
+			},
+		}
+		policy = &mock.SigningPolicyMock{
+			CheckFunc:        func(*types.SigningStarted) error { return nil },
+			CheckPayloadFunc: func(nexus.ChainName, []byte) error { return nil },
+		}
+		broadcaster = &broadcastmock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		participant = rand.ValAddr()
+
+		mgr = multisig.NewMgr(
+			client,
+			sdkclient.Context{FromAddress: rand.AccAddr()},
+			participant,
+			broadcaster,
+			time.Second,
+			multisig.RetryPolicy{KeygenWindow: time.Second, SigningWindow: time.Second, BackOff: utils.LinearBackOff(time.Millisecond)},
+			policy,
+		)
+	}).
+		Given("a pending command batch", func() {
+			key = typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey())
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			batch = multisig.PendingBatch{
+				Chain:       nexus.ChainName(rand.Str(5)),
+				KeyID:       key.ID,
+				Data:        rand.Bytes(100),
+				PayloadHash: rand.Bytes(exported.HashLength),
+				PubKey:      key.PubKeys[participant.String()],
+			}
+			predictor = &mock.BatchPredictorMock{
+				PendingBatchesFunc: func(context.Context) ([]multisig.PendingBatch, error) { return []multisig.PendingBatch{batch}, nil },
+			}
+		})
+
+	givenPendingBatch.
+		When("the batch is pre-signed", presign).
+		Then("should sign it only once", func(t *testing.T) {
+			assert.Len(t, client.SignCalls(), 1)
+			assert.Equal(t, batch.PayloadHash, exported.Hash(client.SignCalls()[0].In.MsgToSign))
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}).
+		Then("should submit the pre-signed signature once the session starts", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessSigningStarted(signingStarted(batch.PayloadHash)))
+
+			assert.Len(t, client.SignCalls(), 1)
+			assert.Len(t, broadcaster.BroadcastCalls(), 1)
+			msg := broadcaster.BroadcastCalls()[0].Msgs[0].(*types.SubmitSignatureRequest)
+			assert.NoError(t, msg.ValidateBasic())
+		}).
+		Run(t)
+
+	givenPendingBatch.
+		When("the batch is pre-signed", presign).
+		When("a session for another payload starts", func() {}).
+		Then("should sign the payload of the session", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessSigningStarted(signingStarted(rand.Bytes(exported.HashLength))))
+
+			assert.Len(t, client.SignCalls(), 2)
+			assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		}).
+		Run(t)
+
+	givenPendingBatch.
+		When("the batch violates the signing policy", func() {
+			policy.CheckPayloadFunc = func(nexus.ChainName, []byte) error { return fmt.Errorf("chain not allowed") }
+		}).
+		When("the batch is pre-signed", presign).
+		Then("should not sign it", func(t *testing.T) {
+			assert.Len(t, client.SignCalls(), 0)
+		}).
+		Run(t)
+}
@@ -3,9 +3,11 @@ package multisig
 import (
 	"context"
 	"fmt"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/axelarnetwork/axelar-core/vald/audit"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/utils/log"
 )
@@ -17,18 +19,31 @@ func (mgr *Mgr) ProcessSigningStarted(event *types.SigningStarted) error {
 		return nil
 	}
 
+	if mgr.policy != nil {
+		if err := mgr.policy.Check(event); err != nil {
+			return sdkerrors.Wrapf(err, "refusing to sign for signing %d", event.GetSigID())
+		}
+	}
+
+	// the session expires after roughly this long, so tofnd requests are not retried past it
+	deadline := time.Now().Add(mgr.retry.SigningWindow)
+
 	keyUID := fmt.Sprintf("%s_%d", event.GetKeyID().String(), 0)
 	partyUID := mgr.participant.String()
 
-	sig, err := mgr.sign(keyUID, event.GetPayloadHash(), pubKey)
-	if err != nil {
-		return err
+	sig, ok := mgr.presigned.take(event.GetKeyID(), event.GetPayloadHash())
+	if !ok {
+		var err error
+		if sig, err = mgr.sign(keyUID, event.GetPayloadHash(), pubKey, deadline); err != nil {
+			return err
+		}
 	}
 
 	log.Infof("operator %s sending signature for signing %d", partyUID, event.GetSigID())
 
 	msg := types.NewSubmitSignatureRequest(mgr.ctx.FromAddress, event.GetSigID(), sig)
-	if _, err := mgr.broadcaster.Broadcast(context.Background(), msg); err != nil {
+	ctx := audit.WithSigning(context.Background(), event.GetSigID(), event.GetKeyID().String(), event.GetPayloadHash())
+	if _, err := mgr.broadcaster.Broadcast(ctx, msg); err != nil {
 		return sdkerrors.Wrap(err, "handler goroutine: failure to broadcast outgoing submit signature message")
 	}
 
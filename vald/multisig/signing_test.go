@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	broadcastmock "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
@@ -21,6 +23,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 	typestestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils"
 	"github.com/axelarnetwork/utils/funcs"
 	. "github.com/axelarnetwork/utils/test"
 )
@@ -30,6 +33,7 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 		mgr         *multisig.Mgr
 		participant sdk.ValAddress
 		client      *mock.ClientMock
+		policy      *mock.SigningPolicyMock
 		broadcaster *broadcastmock.BroadcasterMock
 		privateKey  *btcec.PrivateKey
 
@@ -38,6 +42,7 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 
 	givenMgr := Given("the multisig manager", func() {
 		client = &mock.ClientMock{}
+		policy = &mock.SigningPolicyMock{CheckFunc: func(*types.SigningStarted) error { return nil }}
 		broadcaster = &broadcastmock.BroadcasterMock{}
 		participant = rand.ValAddr()
 
@@ -47,13 +52,15 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 			participant,
 			broadcaster,
 			time.Second,
+			multisig.RetryPolicy{KeygenWindow: time.Second, SigningWindow: time.Second, BackOff: utils.LinearBackOff(time.Millisecond)},
+			policy,
 		)
 	})
 
 	givenMgr.
 		When("is not part of the listed participants", func() {
 			key := typestestutils.Key()
-			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3))
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), nil)
 		}).
 		Then("should ignore", func(t *testing.T) {
 			err := mgr.ProcessSigningStarted(event)
@@ -68,7 +75,7 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 			privateKey = funcs.Must(btcec.NewPrivateKey())
 			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
 
-			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3))
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), nil)
 		}).
 		Then("should handle", func(t *testing.T) {
 			client.SignFunc = func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
@@ -95,4 +102,96 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 			assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, 1)
 		}).
 		Run(t)
+
+	givenMgr.
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey())
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), nil)
+		}).
+		When("tofnd is briefly unavailable", func() {
+			client.SignFunc = func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				if len(client.SignCalls()) < 3 {
+					return nil, status.Error(codes.Unavailable, "connection refused")
+				}
+
+				return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: ec.Sign(privateKey, in.MsgToSign).Serialize()}}, nil
+			}
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		}).
+		Then("should retry signing", func(t *testing.T) {
+			err := mgr.ProcessSigningStarted(event)
+			assert.NoError(t, err)
+
+			assert.Len(t, client.SignCalls(), 3)
+			assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		}).
+		Run(t)
+
+	givenMgr.
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey())
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), nil)
+		}).
+		When("tofnd is unavailable until the session expires", func() {
+			client.SignFunc = func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return nil, status.Error(codes.Unavailable, "connection refused")
+			}
+		}).
+		Then("should give up", func(t *testing.T) {
+			start := time.Now()
+			err := mgr.ProcessSigningStarted(event)
+
+			assert.Error(t, err)
+			assert.Less(t, time.Since(start), 2*time.Second)
+			assert.Greater(t, len(client.SignCalls()), 1)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}).
+		Run(t)
+
+	givenMgr.
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey())
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), nil)
+		}).
+		When("tofnd rejects the request", func() {
+			client.SignFunc = func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return nil, status.Error(codes.InvalidArgument, "invalid key")
+			}
+		}).
+		Then("should not retry", func(t *testing.T) {
+			err := mgr.ProcessSigningStarted(event)
+
+			assert.Error(t, err)
+			assert.Len(t, client.SignCalls(), 1)
+		}).
+		Run(t)
+
+	givenMgr.
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey())
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), nil)
+		}).
+		When("the payload violates the signing policy", func() {
+			policy.CheckFunc = func(*types.SigningStarted) error { return fmt.Errorf("chain not allowed") }
+		}).
+		Then("should refuse to sign", func(t *testing.T) {
+			err := mgr.ProcessSigningStarted(event)
+
+			assert.ErrorContains(t, err, "chain not allowed")
+			assert.Len(t, client.SignCalls(), 0)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}).
+		Run(t)
 }
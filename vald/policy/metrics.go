@@ -0,0 +1,28 @@
+package policy
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var violations = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "vald",
+	Subsystem: "signing_policy",
+	Name:      "refused_total",
+	Help:      "Number of signing sessions vald refused to sign, either because they violate the policy or cannot be verified",
+}, []string{"reason"})
+
+var unverified = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "vald",
+	Subsystem: "signing_policy",
+	Name:      "unverified_total",
+	Help:      "Number of signing sessions vald signed without verifying them, because the policy falls back to signing them",
+}, []string{"reason"})
+
+// RegisterMetrics registers all signing policy metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{violations, unverified} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	goerrors "errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/log"
+)
+
+//go:generate moq -pkg mock -out ./mock/policy.go . CommandBatchSource
+
+var (
+	// ErrViolation is returned for signing sessions whose payload violates the policy
+	ErrViolation = goerrors.New("signing policy violation")
+	// ErrUnverifiable is returned for signing sessions whose payload cannot be reconstructed
+	ErrUnverifiable = goerrors.New("payload cannot be verified")
+)
+
+// CommandBatchSource provides the command batches of EVM chains
+type CommandBatchSource interface {
+	BatchedCommands(ctx context.Context, in *evmtypes.BatchedCommandsRequest, opts ...grpc.CallOption) (*evmtypes.BatchedCommandsResponse, error)
+}
+
+// ChainRules restricts what vald signs for a single chain
+type ChainRules struct {
+	// Contracts are the destination contracts that contract calls may be approved for. Any contract if empty
+	Contracts []common.Address
+}
+
+// MintCap limits the amount of an asset a single command batch may mint
+type MintCap struct {
+	// Chain the cap applies to. All chains if empty
+	Chain     nexus.ChainName
+	Symbol    string
+	MaxAmount *big.Int
+}
+
+// Rules is the local operator policy signing sessions are checked against
+type Rules struct {
+	// Chains vald signs command batches for. All chains if empty
+	Chains   map[nexus.ChainName]ChainRules
+	MintCaps []MintCap
+	// SignWithoutMetadata signs sessions without module metadata with a warning instead of refusing them.
+	// Such sessions are started by chains that predate the SigningStarted event carrying the module metadata
+	SignWithoutMetadata bool
+	// SignOtherModules signs sessions requested by modules other than evm with a warning instead of refusing them
+	SignOtherModules bool
+}
+
+func (r Rules) chain(chain nexus.ChainName) (ChainRules, bool) {
+	if len(r.Chains) == 0 {
+		return ChainRules{}, true
+	}
+
+	for name, rules := range r.Chains {
+		if name.Equals(chain) {
+			return rules, true
+		}
+	}
+
+	return ChainRules{}, false
+}
+
+// verifiedLimit is the number of verified payloads the engine remembers
+const verifiedLimit = 1000
+
+// Engine reconstructs the payload of signing sessions from the chain state and checks it against the operator's rules.
+// It guards against signing payloads a compromised chain state would have vald sign.
+type Engine struct {
+	batches CommandBatchSource
+	rules   Rules
+	timeout time.Duration
+
+	lock     sync.Mutex
+	verified map[string]bool
+	order    []string
+}
+
+// NewEngine returns a new policy engine. Queries for the signed payloads time out after the given duration.
+func NewEngine(batches CommandBatchSource, rules Rules, timeout time.Duration) *Engine {
+	return &Engine{
+		batches:  batches,
+		rules:    rules,
+		timeout:  timeout,
+		verified: make(map[string]bool),
+	}
+}
+
+// Check returns an error if vald must not sign the payload of the given signing session
+func (e *Engine) Check(event *multisigtypes.SigningStarted) error {
+	err := e.check(event)
+	if err != nil {
+		violations.WithLabelValues(reason(err)).Inc()
+		logger(event).Errorf("refusing to sign: %s", err.Error())
+	}
+
+	return err
+}
+
+// CheckPayload returns an error if vald must not sign the given command batch data for the given chain.
+// Payloads that pass are remembered, so signing sessions for them are approved without querying the batch again.
+func (e *Engine) CheckPayload(chain nexus.ChainName, data []byte) error {
+	if e.isVerified(chain, evmtypes.GetSignHash(data).Bytes()) {
+		return nil
+	}
+
+	err := e.evaluate(chain, data)
+	if err != nil {
+		violations.WithLabelValues(reason(err)).Inc()
+		log.WithKeyVals("chain", chain, "payload_hash", evmtypes.GetSignHash(data).Hex()).
+			Errorf("pending command batch violates the signing policy: %s", err.Error())
+	}
+
+	return err
+}
+
+func (e *Engine) check(event *multisigtypes.SigningStarted) error {
+	switch {
+	case event.RequestingModule != evmtypes.ModuleName && e.rules.SignOtherModules:
+		unverified.WithLabelValues("other_module").Inc()
+		logger(event).Errorf("signing payload requested by module %s without verifying it", event.RequestingModule)
+		return nil
+	case event.RequestingModule != evmtypes.ModuleName:
+		return sdkerrors.Wrapf(ErrUnverifiable, "signing requested by unknown module %s", event.RequestingModule)
+	case event.ModuleMetadata == nil && e.rules.SignWithoutMetadata:
+		unverified.WithLabelValues("no_metadata").Inc()
+		logger(event).Errorf("signing payload without module metadata without verifying it")
+		return nil
+	}
+
+	metadata, err := sigMetadata(event)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Type != evmtypes.SigCommand {
+		return sdkerrors.Wrapf(ErrUnverifiable, "unexpected signature type %s", metadata.Type)
+	}
+
+	if _, ok := e.rules.chain(metadata.Chain); !ok {
+		return sdkerrors.Wrapf(ErrViolation, "chain %s is not allowed", metadata.Chain)
+	}
+
+	// the payload hash commits to the batch data, so a payload that has been verified before does not need to be queried again
+	if e.isVerified(metadata.Chain, event.GetPayloadHash()) {
+		return nil
+	}
+
+	data, err := e.batchData(metadata, event.GetPayloadHash())
+	if err != nil {
+		return err
+	}
+
+	return e.evaluate(metadata.Chain, data)
+}
+
+// evaluate checks the commands of the given batch data against the rules of the given chain
+func (e *Engine) evaluate(chain nexus.ChainName, data []byte) error {
+	chainRules, ok := e.rules.chain(chain)
+	if !ok {
+		return sdkerrors.Wrapf(ErrViolation, "chain %s is not allowed", chain)
+	}
+
+	_, commands, err := evmtypes.UnpackCommandBatchData(data)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrUnverifiable, "failed to unpack command batch: %s", err.Error())
+	}
+
+	minted := make(map[string]*big.Int)
+	for _, command := range commands {
+		params, err := decodeParams(command)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrUnverifiable, "failed to decode command %s: %s", command.ID.Hex(), err.Error())
+		}
+
+		switch command.Type {
+		case evmtypes.COMMAND_TYPE_APPROVE_CONTRACT_CALL, evmtypes.COMMAND_TYPE_APPROVE_CONTRACT_CALL_WITH_MINT:
+			if !contractAllowed(chainRules, common.HexToAddress(params["contractAddress"])) {
+				return sdkerrors.Wrapf(ErrViolation, "contract %s on chain %s is not allowed", params["contractAddress"], chain)
+			}
+		}
+
+		switch command.Type {
+		case evmtypes.COMMAND_TYPE_MINT_TOKEN, evmtypes.COMMAND_TYPE_APPROVE_CONTRACT_CALL_WITH_MINT:
+			amount, ok := new(big.Int).SetString(params["amount"], 10)
+			if !ok {
+				return sdkerrors.Wrapf(ErrUnverifiable, "invalid amount %s of command %s", params["amount"], command.ID.Hex())
+			}
+
+			symbol := params["symbol"]
+			if _, ok := minted[symbol]; !ok {
+				minted[symbol] = big.NewInt(0)
+			}
+			minted[symbol].Add(minted[symbol], amount)
+		}
+	}
+
+	for _, mintCap := range e.rules.MintCaps {
+		if mintCap.Chain != "" && !mintCap.Chain.Equals(chain) {
+			continue
+		}
+
+		if amount, ok := minted[mintCap.Symbol]; ok && amount.Cmp(mintCap.MaxAmount) > 0 {
+			return sdkerrors.Wrapf(ErrViolation, "batch mints %s %s on chain %s, exceeding the cap of %s", amount, mintCap.Symbol, chain, mintCap.MaxAmount)
+		}
+	}
+
+	e.setVerified(chain, evmtypes.GetSignHash(data).Bytes())
+
+	return nil
+}
+
+// batchData returns the data of the batch the signature is requested for.
+// The batch is only trusted if its data hashes to the payload hash that is signed.
+func (e *Engine) batchData(metadata evmtypes.SigMetadata, payloadHash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	res, err := e.batches.BatchedCommands(ctx, &evmtypes.BatchedCommandsRequest{
+		Chain: metadata.Chain.String(),
+		Id:    hex.EncodeToString(metadata.CommandBatchID),
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrUnverifiable, "failed to query command batch %x: %s", metadata.CommandBatchID, err.Error())
+	}
+
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrUnverifiable, "invalid data of command batch %x", metadata.CommandBatchID)
+	}
+
+	if signHash := evmtypes.GetSignHash(data); !bytes.Equal(signHash.Bytes(), payloadHash) {
+		return nil, sdkerrors.Wrapf(ErrViolation, "payload hash does not match command batch %x", metadata.CommandBatchID)
+	}
+
+	return data, nil
+}
+
+func verifiedKey(chain nexus.ChainName, payloadHash []byte) string {
+	return fmt.Sprintf("%s_%x", strings.ToLower(chain.String()), payloadHash)
+}
+
+func (e *Engine) isVerified(chain nexus.ChainName, payloadHash []byte) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return e.verified[verifiedKey(chain, payloadHash)]
+}
+
+func (e *Engine) setVerified(chain nexus.ChainName, payloadHash []byte) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	key := verifiedKey(chain, payloadHash)
+	if e.verified[key] {
+		return
+	}
+
+	e.verified[key] = true
+	e.order = append(e.order, key)
+	if len(e.order) > verifiedLimit {
+		delete(e.verified, e.order[0])
+		e.order = e.order[1:]
+	}
+}
+
+func logger(event *multisigtypes.SigningStarted) log.Logger {
+	return log.WithKeyVals("sig_id", event.GetSigID(), "key_id", event.GetKeyID(), "requesting_module", event.GetRequestingModule())
+}
+
+func sigMetadata(event *multisigtypes.SigningStarted) (evmtypes.SigMetadata, error) {
+	var metadata evmtypes.SigMetadata
+
+	if event.ModuleMetadata == nil {
+		return metadata, sdkerrors.Wrap(ErrUnverifiable, "signing session has no module metadata")
+	}
+
+	if typeURL := "/" + proto.MessageName(&metadata); event.ModuleMetadata.TypeUrl != typeURL {
+		return metadata, sdkerrors.Wrapf(ErrUnverifiable, "unexpected module metadata %s", event.ModuleMetadata.TypeUrl)
+	}
+
+	if err := proto.Unmarshal(event.ModuleMetadata.Value, &metadata); err != nil {
+		return metadata, sdkerrors.Wrapf(ErrUnverifiable, "invalid module metadata: %s", err.Error())
+	}
+
+	return metadata, nil
+}
+
+// decodeParams decodes the command params, which panics on malformed params
+func decodeParams(command evmtypes.Command) (params map[string]string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return command.DecodeParams()
+}
+
+func contractAllowed(rules ChainRules, contract common.Address) bool {
+	if len(rules.Contracts) == 0 {
+		return true
+	}
+
+	for _, allowed := range rules.Contracts {
+		if allowed == contract {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reason(err error) string {
+	if goerrors.Is(err, ErrViolation) {
+		return "violation"
+	}
+
+	return "unverifiable"
+}
+
+// ParseAmount parses a mint cap amount given as an integer string
+func ParseAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(strings.TrimSpace(amount), 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %s", amount)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,244 @@
+package policy_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/policy"
+	"github.com/axelarnetwork/axelar-core/vald/policy/mock"
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigtestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	typestestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestEngine_Check(t *testing.T) {
+	var (
+		chain    nexus.ChainName
+		contract common.Address
+		batches  *mock.CommandBatchSourceMock
+		rules    policy.Rules
+		commands []evmtypes.Command
+		event    *multisigtypes.SigningStarted
+	)
+
+	mint := func(symbol string, amount int64) evmtypes.Command {
+		return evmtypes.NewMintTokenCommand(multisigtestutils.KeyID(), nexus.TransferID(rand.PosI64()), symbol, common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(amount))
+	}
+
+	approve := func(contract common.Address) evmtypes.Command {
+		return evmtypes.NewApproveContractCallCommand(sdk.NewInt(1), multisigtestutils.KeyID(), nexus.ChainName(rand.Str(5)), evmtypes.Hash(common.BytesToHash(rand.Bytes(common.HashLength))), uint64(rand.PosI64()), evmtypes.EventContractCall{
+			Sender:           evmtypes.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+			ContractAddress:  contract.Hex(),
+			PayloadHash:      evmtypes.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			DestinationChain: chain,
+		})
+	}
+
+	signingStarted := func(metadata *evmtypes.SigMetadata, payloadHash []byte) *multisigtypes.SigningStarted {
+		var moduleMetadata *codectypes.Any
+		if metadata != nil {
+			moduleMetadata = funcs.Must(codectypes.NewAnyWithValue(metadata))
+		}
+
+		return multisigtypes.NewSigningStarted(uint64(rand.PosI64()), typestestutils.Key(), payloadHash, evmtypes.ModuleName, moduleMetadata)
+	}
+
+	givenBatch := Given("a command batch for a chain", func() {
+		chain = nexus.ChainName(rand.Str(5))
+		contract = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		commands = []evmtypes.Command{mint("USDC", 100), mint("USDC", 50), approve(contract)}
+		rules = policy.Rules{}
+	}).
+		When("signing the batch is requested", func() {
+			batch := funcs.Must(evmtypes.NewCommandBatchMetadata(rand.PosI64(), sdk.NewInt(1), multisigtestutils.KeyID(), commands))
+
+			batches = &mock.CommandBatchSourceMock{
+				BatchedCommandsFunc: func(_ context.Context, req *evmtypes.BatchedCommandsRequest, _ ...grpc.CallOption) (*evmtypes.BatchedCommandsResponse, error) {
+					assert.Equal(t, chain.String(), req.Chain)
+					assert.Equal(t, hex.EncodeToString(batch.ID), req.Id)
+
+					return &evmtypes.BatchedCommandsResponse{Data: hex.EncodeToString(batch.Data)}, nil
+				},
+			}
+
+			event = signingStarted(&evmtypes.SigMetadata{Type: evmtypes.SigCommand, Chain: chain, CommandBatchID: batch.ID}, batch.SigHash.Bytes())
+		})
+
+	check := func() error {
+		return policy.NewEngine(batches, rules, time.Second).Check(event)
+	}
+
+	givenBatch.
+		When("the policy allows everything", func() {}).
+		Then("should allow signing", func(t *testing.T) {
+			assert.NoError(t, check())
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the batch complies with the policy", func() {
+			rules = policy.Rules{
+				Chains:   map[nexus.ChainName]policy.ChainRules{chain: {Contracts: []common.Address{contract}}},
+				MintCaps: []policy.MintCap{{Symbol: "USDC", MaxAmount: big.NewInt(150)}},
+			}
+		}).
+		Then("should allow signing", func(t *testing.T) {
+			assert.NoError(t, check())
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the chain is not allowed", func() {
+			rules = policy.Rules{Chains: map[nexus.ChainName]policy.ChainRules{nexus.ChainName(rand.Str(6)): {}}}
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, check(), policy.ErrViolation)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the destination contract is not allowed", func() {
+			rules = policy.Rules{Chains: map[nexus.ChainName]policy.ChainRules{chain: {Contracts: []common.Address{common.BytesToAddress(rand.Bytes(common.AddressLength))}}}}
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, check(), policy.ErrViolation)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the batch mints more than the cap in total", func() {
+			rules = policy.Rules{MintCaps: []policy.MintCap{{Chain: chain, Symbol: "USDC", MaxAmount: big.NewInt(149)}}}
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, check(), policy.ErrViolation)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the mint cap applies to another chain", func() {
+			rules = policy.Rules{MintCaps: []policy.MintCap{{Chain: nexus.ChainName(rand.Str(6)), Symbol: "USDC", MaxAmount: big.NewInt(1)}}}
+		}).
+		Then("should allow signing", func(t *testing.T) {
+			assert.NoError(t, check())
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the payload hash does not match the batch", func() {
+			event.PayloadHash = rand.Bytes(common.HashLength)
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, check(), policy.ErrViolation)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the signing session has no module metadata", func() {
+			event.ModuleMetadata = nil
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, check(), policy.ErrUnverifiable)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("signing is requested by another module", func() {
+			event.RequestingModule = rand.Str(5)
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, check(), policy.ErrUnverifiable)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the signing session has no module metadata", func() {
+			event.ModuleMetadata = nil
+		}).
+		When("the policy signs sessions without metadata", func() {
+			rules.SignWithoutMetadata = true
+		}).
+		Then("should sign", func(t *testing.T) {
+			assert.NoError(t, check())
+			assert.Len(t, batches.BatchedCommandsCalls(), 0)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("signing is requested by another module", func() {
+			event.RequestingModule = rand.Str(5)
+		}).
+		When("the policy signs sessions of other modules", func() {
+			rules.SignOtherModules = true
+		}).
+		Then("should sign", func(t *testing.T) {
+			assert.NoError(t, check())
+			assert.Len(t, batches.BatchedCommandsCalls(), 0)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the signing session has metadata that does not match the batch", func() {
+			event.PayloadHash = rand.Bytes(common.HashLength)
+		}).
+		When("the policy signs sessions without metadata", func() {
+			rules.SignWithoutMetadata = true
+		}).
+		Then("should still refuse", func(t *testing.T) {
+			assert.Error(t, check())
+		}).
+		Run(t)
+}
+
+func TestEngine_CheckPayload(t *testing.T) {
+	var (
+		chain   nexus.ChainName
+		batches *mock.CommandBatchSourceMock
+		engine  *policy.Engine
+		batch   evmtypes.CommandBatchMetadata
+	)
+
+	givenBatch := Given("a pending command batch", func() {
+		chain = nexus.ChainName(rand.Str(5))
+		command := evmtypes.NewMintTokenCommand(multisigtestutils.KeyID(), nexus.TransferID(rand.PosI64()), "USDC", common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(100))
+		batch = funcs.Must(evmtypes.NewCommandBatchMetadata(rand.PosI64(), sdk.NewInt(1), multisigtestutils.KeyID(), []evmtypes.Command{command}))
+		batches = &mock.CommandBatchSourceMock{}
+	})
+
+	givenBatch.
+		When("the batch exceeds the mint cap", func() {
+			engine = policy.NewEngine(batches, policy.Rules{MintCaps: []policy.MintCap{{Symbol: "USDC", MaxAmount: big.NewInt(99)}}}, time.Second)
+		}).
+		Then("should refuse", func(t *testing.T) {
+			assert.ErrorIs(t, engine.CheckPayload(chain, batch.Data), policy.ErrViolation)
+		}).
+		Run(t)
+
+	givenBatch.
+		When("the batch complies with the policy", func() {
+			engine = policy.NewEngine(batches, policy.Rules{MintCaps: []policy.MintCap{{Symbol: "USDC", MaxAmount: big.NewInt(100)}}}, time.Second)
+		}).
+		Then("should allow signing the session for it without querying the batch", func(t *testing.T) {
+			assert.NoError(t, engine.CheckPayload(chain, batch.Data))
+
+			metadata := &evmtypes.SigMetadata{Type: evmtypes.SigCommand, Chain: chain, CommandBatchID: batch.ID}
+			event := multisigtypes.NewSigningStarted(uint64(rand.PosI64()), typestestutils.Key(), batch.SigHash.Bytes(), evmtypes.ModuleName, funcs.Must(codectypes.NewAnyWithValue(metadata)))
+
+			assert.NoError(t, engine.Check(event))
+			assert.Len(t, batches.BatchedCommandsCalls(), 0)
+		}).
+		Run(t)
+}
@@ -0,0 +1,137 @@
+package vald
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/workers"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/utils/log"
+)
+
+// revoteLockingPeriodTTL is how long the revote locking period of a chain is cached before it is queried again
+const revoteLockingPeriodTTL = 10 * time.Minute
+
+// scheduler runs the processing of an event that was emitted at the given height
+type scheduler func(height int64, event proto.Message, process func() error) error
+
+// pollScheduler processes polls in a worker pool per chain. Polls closest to their expiry are processed first,
+// and polls are abandoned once they expire because a vote would be rejected anyway.
+type pollScheduler struct {
+	pool          *workers.Pool
+	lockingPeriod func(chain nexus.ChainName) (int64, error)
+
+	lock      sync.Mutex
+	periods   map[string]cachedPeriod
+	deadlines map[string]int64
+}
+
+type cachedPeriod struct {
+	period    int64
+	fetchedAt time.Time
+}
+
+func newPollScheduler(cfg config.PollWorkersConfig, lockingPeriod func(chain nexus.ChainName) (int64, error)) *pollScheduler {
+	return &pollScheduler{
+		pool:          workers.NewPool(cfg.ConcurrencyOf),
+		lockingPeriod: lockingPeriod,
+		periods:       make(map[string]cachedPeriod),
+		deadlines:     make(map[string]int64),
+	}
+}
+
+// revoteLockingPeriod queries the revote locking period of the given chain, i.e. the number of blocks a poll is open for
+func revoteLockingPeriod(clientCtx sdkClient.Context) func(chain nexus.ChainName) (int64, error) {
+	return func(chain nexus.ChainName) (int64, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		res, err := evmTypes.NewQueryServiceClient(clientCtx).Params(ctx, &evmTypes.ParamsRequest{Chain: chain.String()})
+		if err != nil {
+			return 0, err
+		}
+
+		return res.Params.RevoteLockingPeriod, nil
+	}
+}
+
+// SetHeight abandons all polls that expire at or before the given height
+func (s *pollScheduler) SetHeight(height int64) {
+	s.pool.SetHeight(height)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for poll, expiresAt := range s.deadlines {
+		if expiresAt <= height {
+			delete(s.deadlines, poll)
+		}
+	}
+}
+
+// Schedule returns a scheduler that processes events in the worker pool of the chain they belong to.
+// The expiry of the polls started by each event is recorded so votes can be broadcast with a deadline.
+func (s *pollScheduler) Schedule(chainOf func(event proto.Message) nexus.ChainName, sessions func(event proto.Message) []string) scheduler {
+	return func(height int64, event proto.Message, process func() error) error {
+		chain := chainOf(event)
+		expiresAt := s.expiry(chain, height)
+		if expiresAt > 0 && sessions != nil {
+			s.lock.Lock()
+			for _, poll := range sessions(event) {
+				s.deadlines[poll] = expiresAt
+			}
+			s.lock.Unlock()
+		}
+
+		return s.pool.Do(strings.ToLower(chain.String()), expiresAt, process)
+	}
+}
+
+// Deadline returns the height at which the given poll expires, if it is known
+func (s *pollScheduler) Deadline(poll vote.PollID) (int64, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	expiresAt, ok := s.deadlines[poll.String()]
+	return expiresAt, ok
+}
+
+// expiry returns the height at which a poll started at the given height expires, or 0 if it is unknown
+func (s *pollScheduler) expiry(chain nexus.ChainName, height int64) int64 {
+	key := strings.ToLower(chain.String())
+
+	s.lock.Lock()
+	cached, ok := s.periods[key]
+	s.lock.Unlock()
+
+	if !ok || time.Since(cached.fetchedAt) > revoteLockingPeriodTTL {
+		period, err := s.lockingPeriod(chain)
+		if err != nil {
+			log.WithKeyVals("chain", chain).Errorf("failed to query revote locking period: %s", err.Error())
+
+			if !ok {
+				return 0
+			}
+		} else {
+			cached = cachedPeriod{period: period, fetchedAt: time.Now()}
+
+			s.lock.Lock()
+			s.periods[key] = cached
+			s.lock.Unlock()
+		}
+	}
+
+	if cached.period <= 0 {
+		return 0
+	}
+
+	return height + cached.period
+}
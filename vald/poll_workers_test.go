@@ -0,0 +1,62 @@
+package vald
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/workers"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+func TestPollScheduler(t *testing.T) {
+	chainOf := func(event proto.Message) nexus.ChainName { return event.(*evmTypes.ConfirmDepositStarted).Chain }
+	event := &evmTypes.ConfirmDepositStarted{Chain: "Ethereum"}
+
+	t.Run("abandons polls after their expiry", func(t *testing.T) {
+		queried := 0
+		polls := newPollScheduler(config.DefaultPollWorkersConfig(), func(chain nexus.ChainName) (int64, error) {
+			queried++
+			return 10, nil
+		})
+		schedule := polls.Schedule(chainOf, nil)
+
+		assert.NoError(t, schedule(100, event, func() error { return nil }))
+
+		polls.SetHeight(110)
+		err := schedule(100, event, func() error { panic("must not run") })
+		assert.ErrorIs(t, err, workers.ErrExpired)
+
+		assert.NoError(t, schedule(105, event, func() error { return nil }))
+		assert.Equal(t, 1, queried)
+	})
+
+	t.Run("records the deadline of polls until they expire", func(t *testing.T) {
+		polls := newPollScheduler(config.DefaultPollWorkersConfig(), func(chain nexus.ChainName) (int64, error) { return 10, nil })
+		sessions := func(proto.Message) []string { return []string{"7"} }
+
+		assert.NoError(t, polls.Schedule(chainOf, sessions)(100, event, func() error { return nil }))
+		deadline, ok := polls.Deadline(7)
+		assert.True(t, ok)
+		assert.EqualValues(t, 110, deadline)
+
+		polls.SetHeight(110)
+		_, ok = polls.Deadline(7)
+		assert.False(t, ok)
+	})
+
+	t.Run("processes polls without deadline if the expiry is unknown", func(t *testing.T) {
+		polls := newPollScheduler(config.DefaultPollWorkersConfig(), func(chain nexus.ChainName) (int64, error) {
+			return 0, errors.New("node unavailable")
+		})
+		polls.SetHeight(1000)
+
+		processed := false
+		assert.NoError(t, polls.Schedule(chainOf, nil)(100, event, func() error { processed = true; return nil }))
+		assert.True(t, processed)
+	})
+}
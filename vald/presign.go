@@ -0,0 +1,106 @@
+package vald
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigExported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/utils/log"
+)
+
+// batchPredictor predicts the next command batch of each activated EVM chain from the pending commands on chain
+type batchPredictor struct {
+	evm         evmTypes.QueryServiceClient
+	multisig    multisigTypes.QueryServiceClient
+	participant sdk.ValAddress
+	// pubKeys caches the participant's public keys by key ID. Keys the participant does not hold map to nil
+	pubKeys map[multisigExported.KeyID]multisigExported.PublicKey
+}
+
+func newBatchPredictor(evm evmTypes.QueryServiceClient, multisig multisigTypes.QueryServiceClient, participant sdk.ValAddress) *batchPredictor {
+	return &batchPredictor{
+		evm:         evm,
+		multisig:    multisig,
+		participant: participant,
+		pubKeys:     make(map[multisigExported.KeyID]multisigExported.PublicKey),
+	}
+}
+
+// PendingBatches returns the command batches the participant is expected to sign next
+func (p *batchPredictor) PendingBatches(ctx context.Context) ([]multisig.PendingBatch, error) {
+	chains, err := p.evm.Chains(ctx, &evmTypes.ChainsRequest{Status: evmTypes.Activated})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to query activated chains")
+	}
+
+	var batches []multisig.PendingBatch
+	for _, chain := range chains.Chains {
+		res, err := p.evm.PendingCommands(ctx, &evmTypes.PendingCommandsRequest{Chain: chain.String()})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to query pending commands of chain %s", chain)
+		}
+
+		next := res.NextBatch
+		if next == nil {
+			continue
+		}
+
+		// the sig hash is recomputed so a signature is only ever produced for the predicted data
+		payloadHash := evmTypes.GetSignHash(next.Data).Bytes()
+		if !bytes.Equal(payloadHash, next.SigHash) {
+			log.Errorf("predicted command batch of chain %s does not match its sig hash", chain)
+			continue
+		}
+
+		pubKey, err := p.pubKey(ctx, next.KeyID)
+		if err != nil {
+			return nil, err
+		}
+
+		if pubKey == nil {
+			continue
+		}
+
+		batches = append(batches, multisig.PendingBatch{
+			Chain:       chain,
+			KeyID:       next.KeyID,
+			Data:        next.Data,
+			PayloadHash: payloadHash,
+			PubKey:      pubKey,
+		})
+	}
+
+	return batches, nil
+}
+
+func (p *batchPredictor) pubKey(ctx context.Context, keyID multisigExported.KeyID) (multisigExported.PublicKey, error) {
+	if pubKey, ok := p.pubKeys[keyID]; ok {
+		return pubKey, nil
+	}
+
+	res, err := p.multisig.Key(ctx, &multisigTypes.KeyRequest{KeyID: keyID})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to query key %s", keyID)
+	}
+
+	var pubKey multisigExported.PublicKey
+	for _, participant := range res.Participants {
+		if participant.Address != p.participant.String() {
+			continue
+		}
+
+		if pubKey, err = hex.DecodeString(participant.PubKey); err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid public key of key %s", keyID)
+		}
+	}
+
+	p.pubKeys[keyID] = pubKey
+	return pubKey, nil
+}
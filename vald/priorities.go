@@ -0,0 +1,89 @@
+package vald
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+// pollDeadlines returns the height at which a poll expires, if it is known
+type pollDeadlines interface {
+	Deadline(poll vote.PollID) (int64, bool)
+}
+
+type prioritizedBroadcaster struct {
+	broadcaster broadcast.Broadcaster
+	polls       pollDeadlines
+}
+
+// withPriorities returns a broadcaster that assigns a priority and deadline to msgs whose context does not define them already.
+// Signatures for signing sessions go out first and heartbeats last. Votes are dropped once their poll expires.
+func withPriorities(broadcaster broadcast.Broadcaster, polls pollDeadlines) broadcast.Broadcaster {
+	return prioritizedBroadcaster{broadcaster: broadcaster, polls: polls}
+}
+
+// Broadcast implements the broadcast.Broadcaster interface
+func (b prioritizedBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if _, ok := broadcast.PriorityOf(ctx); !ok {
+		ctx = broadcast.WithPriority(ctx, priorityOf(msgs))
+	}
+
+	if _, ok := broadcast.DeadlineOf(ctx); !ok {
+		if deadline, ok := b.deadlineOf(msgs); ok {
+			ctx = broadcast.WithDeadline(ctx, deadline)
+		}
+	}
+
+	return b.broadcaster.Broadcast(ctx, msgs...)
+}
+
+// priorityOf returns the highest priority of the given msgs
+func priorityOf(msgs []sdk.Msg) broadcast.Priority {
+	priority := broadcast.PriorityLow
+	for _, msg := range msgs {
+		var p broadcast.Priority
+		switch msg.(type) {
+		case *multisigTypes.SubmitSignatureRequest:
+			p = broadcast.PriorityHigh
+		case *tssTypes.HeartBeatRequest:
+			p = broadcast.PriorityLow
+		default:
+			p = broadcast.PriorityNormal
+		}
+
+		if p > priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// deadlineOf returns the latest expiry of the polls the given msgs vote on.
+// There is no deadline unless all msgs are votes on polls with known expiry.
+func (b prioritizedBroadcaster) deadlineOf(msgs []sdk.Msg) (int64, bool) {
+	var deadline int64
+	for _, msg := range msgs {
+		voteReq, ok := msg.(*voteTypes.VoteRequest)
+		if !ok {
+			return 0, false
+		}
+
+		expiresAt, ok := b.polls.Deadline(voteReq.PollID)
+		if !ok {
+			return 0, false
+		}
+
+		if expiresAt > deadline {
+			deadline = expiresAt
+		}
+	}
+
+	return deadline, len(msgs) > 0
+}
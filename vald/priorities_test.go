@@ -0,0 +1,73 @@
+package vald
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+type fixedDeadlines map[vote.PollID]int64
+
+func (d fixedDeadlines) Deadline(poll vote.PollID) (int64, bool) {
+	expiresAt, ok := d[poll]
+	return expiresAt, ok
+}
+
+func TestWithPriorities(t *testing.T) {
+	var ctx context.Context
+	inner := &mock.BroadcasterMock{
+		BroadcastFunc: func(c context.Context, _ ...sdk.Msg) (*sdk.TxResponse, error) {
+			ctx = c
+			return &sdk.TxResponse{}, nil
+		},
+	}
+	bc := withPriorities(inner, fixedDeadlines{1: 100, 2: 150})
+	sender := rand.AccAddr()
+
+	broadcastWith := func(c context.Context, msgs ...sdk.Msg) (broadcast.Priority, int64, bool) {
+		_, err := bc.Broadcast(c, msgs...)
+		assert.NoError(t, err)
+
+		priority, _ := broadcast.PriorityOf(ctx)
+		deadline, ok := broadcast.DeadlineOf(ctx)
+		return priority, deadline, ok
+	}
+
+	t.Run("signatures have high priority", func(t *testing.T) {
+		priority, _, hasDeadline := broadcastWith(context.Background(), &tssTypes.HeartBeatRequest{Sender: sender}, &multisigTypes.SubmitSignatureRequest{Sender: sender})
+		assert.Equal(t, broadcast.PriorityHigh, priority)
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("heartbeats have low priority", func(t *testing.T) {
+		priority, _, _ := broadcastWith(context.Background(), &tssTypes.HeartBeatRequest{Sender: sender})
+		assert.Equal(t, broadcast.PriorityLow, priority)
+	})
+
+	t.Run("votes expire with their poll", func(t *testing.T) {
+		priority, deadline, hasDeadline := broadcastWith(context.Background(), &voteTypes.VoteRequest{Sender: sender, PollID: 1}, &voteTypes.VoteRequest{Sender: sender, PollID: 2})
+		assert.Equal(t, broadcast.PriorityNormal, priority)
+		assert.True(t, hasDeadline)
+		assert.EqualValues(t, 150, deadline)
+
+		_, _, hasDeadline = broadcastWith(context.Background(), &voteTypes.VoteRequest{Sender: sender, PollID: 1}, &voteTypes.VoteRequest{Sender: sender, PollID: 3})
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("keeps explicit priority and deadline", func(t *testing.T) {
+		c := broadcast.WithDeadline(broadcast.WithPriority(context.Background(), broadcast.PriorityLow), 42)
+		priority, deadline, _ := broadcastWith(c, &multisigTypes.SubmitSignatureRequest{Sender: sender})
+		assert.Equal(t, broadcast.PriorityLow, priority)
+		assert.EqualValues(t, 42, deadline)
+	})
+}
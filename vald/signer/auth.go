@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const authorizationHeader = "authorization"
+
+// IsUnixSocket returns true if the given address of the remote signer is a unix socket, e.g. unix:///path/to/signer.sock
+func IsUnixSocket(address string) bool {
+	return strings.HasPrefix(address, "unix://")
+}
+
+// IsLoopback returns true if the given address of the remote signer can only be reached from the local host,
+// i.e. it is a unix socket or a TCP address on a loopback interface
+func IsLoopback(address string) bool {
+	if IsUnixSocket(address) {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// RequireToken returns a server interceptor that rejects all requests which do not carry the given shared token
+func RequireToken(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(authorizationHeader)
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid token")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// tokenCredentials attaches the shared token to every request to the remote signer
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+var _ credentials.PerRPCCredentials = tokenCredentials{}
+
+// GetRequestMetadata implements the credentials.PerRPCCredentials interface
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity implements the credentials.PerRPCCredentials interface.
+// The token is only sent over plain connections to loopback addresses, so it never leaves the host in cleartext.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
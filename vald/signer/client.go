@@ -0,0 +1,105 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/axelarnetwork/utils/log"
+)
+
+// Client signs txs with a remote signer
+type Client struct {
+	client   SignerClient
+	registry codectypes.InterfaceRegistry
+	timeout  time.Duration
+}
+
+// Connect connects to the remote signer at the given address, e.g. unix:///path/to/signer.sock or localhost:9898.
+// The shared token is sent with every request. It is required unless the remote signer listens on a unix socket.
+// If a CA file is given, the connection is secured with TLS, which is required unless the remote signer listens on a loopback address.
+func Connect(address string, token string, caFile string, timeout time.Duration) (*grpc.ClientConn, error) {
+	if !IsUnixSocket(address) && token == "" {
+		return nil, fmt.Errorf("a token is required to connect to a remote signer that is not on a unix socket")
+	}
+
+	opts := []grpc.DialOption{grpc.WithBlock()}
+	switch {
+	case caFile != "":
+		creds, err := credentials.NewClientTLSFromFile(caFile, "")
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to load the CA certificate of the remote signer")
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	case IsLoopback(address):
+		opts = append(opts, grpc.WithInsecure())
+	default:
+		return nil, fmt.Errorf("TLS is required to connect to a remote signer that is not on a unix socket or loopback address")
+	}
+
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: token, requireTLS: caFile != ""}))
+	}
+
+	log.Infof("initiate connection to remote signer: %s", address)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return grpc.DialContext(ctx, address, opts...)
+}
+
+// NewClient returns a new remote signer client. Public keys are unpacked with the given registry, and each request times out after the given duration.
+func NewClient(conn *grpc.ClientConn, registry codectypes.InterfaceRegistry, timeout time.Duration) *Client {
+	return &Client{
+		client:   NewSignerClient(conn),
+		registry: registry,
+		timeout:  timeout,
+	}
+}
+
+// PubKey returns the public key of the remote key with the given name
+func (c *Client) PubKey(ctx context.Context, keyName string) (cryptotypes.PubKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.client.PubKey(ctx, &PubKeyRequest{KeyName: keyName})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "remote signer failed to return the public key")
+	}
+
+	if res.PubKey == nil {
+		return nil, fmt.Errorf("remote signer returned no public key for %s", keyName)
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := c.registry.UnpackAny(res.PubKey, &pubKey); err != nil {
+		return nil, sdkerrors.Wrap(err, "remote signer returned an invalid public key")
+	}
+
+	return pubKey, nil
+}
+
+// Sign signs the given sign bytes with the remote key with the given name
+func (c *Client) Sign(ctx context.Context, keyName string, signMode signing.SignMode, signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.client.Sign(ctx, &SignRequest{KeyName: keyName, SignMode: signMode, SignBytes: signBytes})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "remote signer failed to sign")
+	}
+
+	if len(res.Signature) == 0 {
+		return nil, fmt.Errorf("remote signer returned an empty signature")
+	}
+
+	return res.Signature, nil
+}
@@ -0,0 +1,183 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/types"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+var _ SignerServer = &Server{}
+
+// valdMsgs are the msgs that vald broadcasts, either directly or wrapped in a refund request
+var valdMsgs = map[string]bool{
+	sdk.MsgTypeURL(&vote.VoteRequest{}):                true,
+	sdk.MsgTypeURL(&tss.HeartBeatRequest{}):            true,
+	sdk.MsgTypeURL(&multisig.SubmitSignatureRequest{}): true,
+	sdk.MsgTypeURL(&multisig.SubmitPubKeyRequest{}):    true,
+}
+
+// Server is the reference implementation of a remote signer. It signs txs with the keys of a local keyring.
+// Only txs in SIGN_MODE_DIRECT for the configured chain that consist of msgs vald broadcasts and stay within the gas and fee limits are signed,
+// so the keys cannot be used to sign arbitrary data or txs, or to drain the accounts through fees.
+type Server struct {
+	keyring keyring.Keyring
+	chainID string
+	maxGas  uint64
+	maxFee  sdk.Coins
+	keys    map[string]bool
+}
+
+// NewServer returns a new remote signer for the given chain that refuses to sign txs with a gas limit above maxGas or fees above maxFee.
+// If key names are given, only those keys can be used to sign.
+func NewServer(kr keyring.Keyring, chainID string, maxGas uint64, maxFee sdk.Coins, keyNames ...string) *Server {
+	keys := make(map[string]bool)
+	for _, name := range keyNames {
+		keys[name] = true
+	}
+
+	return &Server{
+		keyring: kr,
+		chainID: chainID,
+		maxGas:  maxGas,
+		maxFee:  maxFee,
+		keys:    keys,
+	}
+}
+
+// PubKey implements the SignerServer interface
+func (s *Server) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	info, err := s.key(req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := codectypes.NewAnyWithValue(info.GetPubKey())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &PubKeyResponse{PubKey: pubKey}, nil
+}
+
+// Sign implements the SignerServer interface
+func (s *Server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	info, err := s.key(req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SignMode != signing.SignMode_SIGN_MODE_DIRECT {
+		return nil, status.Errorf(codes.InvalidArgument, "sign mode %s is not supported", req.SignMode)
+	}
+
+	var signDoc tx.SignDoc
+	if err := signDoc.Unmarshal(req.SignBytes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sign bytes are not a valid sign doc: %s", err)
+	}
+
+	if signDoc.ChainId != s.chainID {
+		return nil, status.Errorf(codes.PermissionDenied, "refusing to sign tx for chain %s", signDoc.ChainId)
+	}
+
+	var body tx.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx body: %s", err)
+	}
+
+	if err := checkMsgs(body.Messages); err != nil {
+		return nil, err
+	}
+
+	var authInfo tx.AuthInfo
+	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid auth info: %s", err)
+	}
+
+	if err := s.checkFee(authInfo.Fee); err != nil {
+		return nil, err
+	}
+
+	sig, _, err := s.keyring.Sign(info.GetName(), req.SignBytes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	msgTypes := slices.Map(body.Messages, func(msg *codectypes.Any) string { return msg.TypeUrl })
+	log.WithKeyVals("key", info.GetName(), "account_number", signDoc.AccountNumber, "msgs", strings.Join(msgTypes, ",")).
+		Debug("signed tx")
+
+	return &SignResponse{Signature: sig}, nil
+}
+
+// checkMsgs returns an error unless the tx has msgs and all of them are msgs vald broadcasts
+func checkMsgs(msgs []*codectypes.Any) error {
+	if len(msgs) == 0 {
+		return status.Error(codes.PermissionDenied, "refusing to sign tx without msgs")
+	}
+
+	for _, msg := range msgs {
+		typeURL := msg.TypeUrl
+		if typeURL == sdk.MsgTypeURL(&reward.RefundMsgRequest{}) {
+			var refund reward.RefundMsgRequest
+			if err := refund.Unmarshal(msg.Value); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid refund request: %s", err)
+			}
+
+			if refund.InnerMessage == nil {
+				return status.Error(codes.PermissionDenied, "refusing to sign refund request without inner msg")
+			}
+			typeURL = refund.InnerMessage.TypeUrl
+		}
+
+		if !valdMsgs[typeURL] {
+			return status.Errorf(codes.PermissionDenied, "refusing to sign tx with msg %s", msg.TypeUrl)
+		}
+	}
+
+	return nil
+}
+
+// checkFee returns an error if the gas limit or the fees of the tx exceed the limits of the signer
+func (s *Server) checkFee(fee *tx.Fee) error {
+	if fee == nil {
+		return nil
+	}
+
+	if fee.GasLimit > s.maxGas {
+		return status.Errorf(codes.PermissionDenied, "refusing to sign tx with gas limit %d above the max of %d", fee.GasLimit, s.maxGas)
+	}
+
+	if !fee.Amount.IsAllLTE(s.maxFee) {
+		return status.Errorf(codes.PermissionDenied, "refusing to sign tx with fees %s above the max of %s", fee.Amount, s.maxFee)
+	}
+
+	return nil
+}
+
+func (s *Server) key(name string) (keyring.Info, error) {
+	if len(s.keys) > 0 && !s.keys[name] {
+		return nil, status.Errorf(codes.PermissionDenied, "key %s is not allowed to sign", name)
+	}
+
+	info, err := s.keyring.Key(name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("key %s not found", name))
+	}
+
+	return info, nil
+}
@@ -0,0 +1,194 @@
+package signer_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/signer"
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+	tsstypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	votetypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	kr := keyring.NewInMemory()
+	broadcaster, _, err := kr.NewMnemonic("broadcaster", keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	assert.NoError(t, err)
+	_, _, err = kr.NewMnemonic("other", keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	assert.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	signer.RegisterSignerServer(server, signer.NewServer(kr, "axelar", 1000000, sdk.NewCoins(sdk.NewInt64Coin("uaxl", 10000)), "broadcaster"))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn := funcs.Must(grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() })))
+	defer conn.Close()
+
+	client := signer.NewClient(conn, encCfg.InterfaceRegistry, time.Second)
+	sender := rand.AccAddr()
+	signMsgsWithFee := func(fee tx.Fee, chainID string, msgs ...sdk.Msg) []byte {
+		anys := slices.Map(msgs, func(msg sdk.Msg) *codectypes.Any { return funcs.Must(codectypes.NewAnyWithValue(msg)) })
+		body := funcs.Must((&tx.TxBody{Messages: anys, Memo: "memo"}).Marshal())
+		authInfo := funcs.Must((&tx.AuthInfo{Fee: &fee}).Marshal())
+		return funcs.Must((&tx.SignDoc{BodyBytes: body, AuthInfoBytes: authInfo, ChainId: chainID, AccountNumber: 1}).Marshal())
+	}
+	signMsgs := func(chainID string, msgs ...sdk.Msg) []byte {
+		return signMsgsWithFee(tx.Fee{GasLimit: 200000, Amount: sdk.NewCoins(sdk.NewInt64Coin("uaxl", 1400))}, chainID, msgs...)
+	}
+	signBytes := func(chainID string) []byte {
+		return signMsgs(chainID, votetypes.NewVoteRequest(sender, vote.PollID(1), evmtypes.NewVoteEvents("ethereum")), tsstypes.NewHeartBeatRequest(sender, nil))
+	}
+
+	t.Run("returns the public key", func(t *testing.T) {
+		pubKey, err := client.PubKey(context.Background(), "broadcaster")
+		assert.NoError(t, err)
+		assert.Equal(t, broadcaster.GetPubKey(), pubKey)
+	})
+
+	t.Run("signs txs of the configured chain", func(t *testing.T) {
+		bz := signBytes("axelar")
+		sig, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, bz)
+		assert.NoError(t, err)
+		assert.True(t, broadcaster.GetPubKey().VerifySignature(bz, sig))
+	})
+
+	t.Run("signs refund requests of vald msgs", func(t *testing.T) {
+		bz := signMsgs("axelar", rewardtypes.NewRefundMsgRequest(sender, multisigtypes.NewSubmitSignatureRequest(sender, 1, rand.Bytes(64))))
+		sig, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, bz)
+		assert.NoError(t, err)
+		assert.True(t, broadcaster.GetPubKey().VerifySignature(bz, sig))
+	})
+
+	t.Run("refuses to sign txs with msgs vald does not broadcast", func(t *testing.T) {
+		send := banktypes.NewMsgSend(sender, rand.AccAddr(), sdk.NewCoins(sdk.NewInt64Coin("uaxl", 1)))
+
+		for _, bz := range [][]byte{
+			signMsgs("axelar"),
+			signMsgs("axelar", send),
+			signMsgs("axelar", tsstypes.NewHeartBeatRequest(sender, nil), send),
+			signMsgs("axelar", rewardtypes.NewRefundMsgRequest(sender, send)),
+			signMsgs("axelar", rewardtypes.NewRefundMsgRequest(sender, rewardtypes.NewRefundMsgRequest(sender, send))),
+		} {
+			_, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, bz)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("refuses to sign txs above the max gas or fees", func(t *testing.T) {
+		heartBeat := tsstypes.NewHeartBeatRequest(sender, nil)
+
+		bz := signMsgsWithFee(tx.Fee{GasLimit: 1000000, Amount: sdk.NewCoins(sdk.NewInt64Coin("uaxl", 10000))}, "axelar", heartBeat)
+		_, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, bz)
+		assert.NoError(t, err)
+
+		for _, fee := range []tx.Fee{
+			{GasLimit: 1000001, Amount: sdk.NewCoins(sdk.NewInt64Coin("uaxl", 1))},
+			{GasLimit: 200000, Amount: sdk.NewCoins(sdk.NewInt64Coin("uaxl", 10001))},
+			{GasLimit: 200000, Amount: sdk.NewCoins(sdk.NewInt64Coin("uaxl", 1), sdk.NewInt64Coin("uother", 1))},
+		} {
+			_, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, signMsgsWithFee(fee, "axelar", heartBeat))
+			assert.ErrorContains(t, err, "above the max")
+		}
+	})
+
+	t.Run("refuses to sign txs of other chains", func(t *testing.T) {
+		_, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, signBytes("other-chain"))
+		assert.Error(t, err)
+	})
+
+	t.Run("refuses to sign arbitrary data", func(t *testing.T) {
+		_, err := client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_DIRECT, []byte("not a tx"))
+		assert.Error(t, err)
+
+		_, err = client.Sign(context.Background(), "broadcaster", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signBytes("axelar"))
+		assert.Error(t, err)
+	})
+
+	t.Run("refuses to use keys that are not allowed", func(t *testing.T) {
+		_, err := client.PubKey(context.Background(), "other")
+		assert.Error(t, err)
+
+		_, err = client.Sign(context.Background(), "other", signing.SignMode_SIGN_MODE_DIRECT, signBytes("axelar"))
+		assert.Error(t, err)
+	})
+}
+
+func TestRequireToken(t *testing.T) {
+	kr := keyring.NewInMemory()
+	_, _, err := kr.NewMnemonic("broadcaster", keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	assert.NoError(t, err)
+
+	token := rand.Str(20)
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(signer.RequireToken(token)))
+	signer.RegisterSignerServer(server, signer.NewServer(kr, "axelar", 1000000, sdk.NewCoins(sdk.NewInt64Coin("uaxl", 10000))))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	dial := func(opts ...grpc.DialOption) *signer.Client {
+		opts = append(opts, grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }))
+		conn := funcs.Must(grpc.Dial("bufnet", opts...))
+		t.Cleanup(func() { _ = conn.Close() })
+
+		return signer.NewClient(conn, app.MakeEncodingConfig().InterfaceRegistry, time.Second)
+	}
+
+	_, err = dial().PubKey(context.Background(), "broadcaster")
+	assert.Error(t, err)
+
+	_, err = dial(grpc.WithPerRPCCredentials(bearer(rand.Str(20)))).PubKey(context.Background(), "broadcaster")
+	assert.Error(t, err)
+
+	_, err = dial(grpc.WithPerRPCCredentials(bearer(token))).PubKey(context.Background(), "broadcaster")
+	assert.NoError(t, err)
+}
+
+func TestConnect(t *testing.T) {
+	_, err := signer.Connect("localhost:9898", "", "", time.Millisecond)
+	assert.ErrorContains(t, err, "token is required")
+
+	_, err = signer.Connect("10.0.0.1:9898", rand.Str(20), "", time.Millisecond)
+	assert.ErrorContains(t, err, "TLS is required")
+}
+
+func TestIsLoopback(t *testing.T) {
+	for _, address := range []string{"unix:///var/run/vald-signer.sock", "localhost:9898", "127.0.0.1:9898", "[::1]:9898"} {
+		assert.True(t, signer.IsLoopback(address), address)
+	}
+
+	for _, address := range []string{"0.0.0.0:9898", "10.0.0.1:9898", "signer.example.com:9898", ":9898", "localhost"} {
+		assert.False(t, signer.IsLoopback(address), address)
+	}
+}
+
+type bearer string
+
+func (b bearer) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(b)}, nil
+}
+
+func (b bearer) RequireTransportSecurity() bool { return false }
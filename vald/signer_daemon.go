@@ -0,0 +1,193 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/vald/signer"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const (
+	flagListen      = "listen"
+	flagKeys        = "keys"
+	flagTokenFile   = "token-file"
+	flagTLSCertFile = "tls-cert-file"
+	flagTLSKeyFile  = "tls-key-file"
+	flagMaxGas      = "max-gas"
+	flagMaxFee      = "max-fee"
+)
+
+// GetSignerCommand returns the command to start a remote signer that signs the txs of vald with the keys of a local keyring
+func GetSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-signer",
+		Short: "Start a remote signer that signs the transactions vald broadcasts, so the broadcaster keys can be kept out of vald",
+		Long: "Start a remote signer that signs the transactions vald broadcasts, so the broadcaster keys can be kept out of vald. " +
+			"Point vald to it by setting broadcast.remote_signer.address in its configuration. " +
+			"Only transactions for the given chain ID that consist of messages vald broadcasts and stay within the max gas and fees are signed. " +
+			"By default, the signer listens on a unix socket in the home directory. " +
+			"Listening on TCP requires a shared token, which vald must send by setting broadcast.remote_signer.token. " +
+			"Listening on a TCP address other than a loopback address also requires TLS, so vald must trust the certificate by setting broadcast.remote_signer.tls_ca_file.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			log.Setup(serverCtx.Logger.With("module", "vald-signer"))
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Keyring == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no keyring configured")
+			}
+
+			address, err := cmd.Flags().GetString(flagListen)
+			if err != nil {
+				return err
+			}
+			if address == "" {
+				address = "unix://" + filepath.Join(serverCtx.Config.RootDir, "vald-signer.sock")
+			}
+
+			tokenFile, err := cmd.Flags().GetString(flagTokenFile)
+			if err != nil {
+				return err
+			}
+
+			opts, err := transportSecurity(cmd, address)
+			if err != nil {
+				return err
+			}
+
+			if tokenFile != "" {
+				token, err := readToken(tokenFile)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, grpc.UnaryInterceptor(signer.RequireToken(token)))
+			} else if !signer.IsUnixSocket(address) {
+				return fmt.Errorf("listening on %s requires a token, use --%s or listen on a unix socket", address, flagTokenFile)
+			}
+
+			keys, err := cmd.Flags().GetStringSlice(flagKeys)
+			if err != nil {
+				return err
+			}
+
+			maxGas, err := cmd.Flags().GetUint64(flagMaxGas)
+			if err != nil {
+				return err
+			}
+
+			maxFee, err := sdk.ParseCoinsNormalized(cmd.Flag(flagMaxFee).Value.String())
+			if err != nil {
+				return sdkerrors.Wrapf(err, "invalid --%s", flagMaxFee)
+			}
+
+			listener, err := listenOn(address)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to listen on %s", address)
+			}
+
+			grpcServer := grpc.NewServer(opts...)
+			signer.RegisterSignerServer(grpcServer, signer.NewServer(clientCtx.Keyring, clientCtx.ChainID, maxGas, maxFee, keys...))
+
+			ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+
+			go func() {
+				<-ctx.Done()
+				log.Info("stopping remote signer")
+				grpcServer.GracefulStop()
+			}()
+
+			log.Infof("remote signer for chain %s listening on %s", clientCtx.ChainID, address)
+			return grpcServer.Serve(listener)
+		},
+	}
+
+	cmd.Flags().String(flagListen, "", "address to listen on, either unix:///path/to/socket or host:port (default unix://<home>/vald-signer.sock)")
+	cmd.Flags().String(flagTokenFile, "", "file containing the token that vald must send with each request, required when listening on TCP")
+	cmd.Flags().String(flagTLSCertFile, "", "file containing the TLS certificate of the signer, required when listening on a TCP address other than a loopback address")
+	cmd.Flags().String(flagTLSKeyFile, "", "file containing the TLS private key of the signer")
+	cmd.Flags().StringSlice(flagKeys, nil, "names of the keys that may be used to sign, all keys of the keyring if empty")
+	cmd.Flags().Uint64(flagMaxGas, 20000000, "the max gas limit of the transactions to sign")
+	cmd.Flags().String(flagMaxFee, "2000000"+axelarnet.NativeAsset, "the max fees of the transactions to sign")
+	cmd.Flags().String(flags.FlagChainID, app.Name, "the chain ID of the transactions to sign")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "the client keyring directory; if omitted, the default 'home' directory will be used")
+	cmd.Flags().String(flags.FlagKeyringBackend, "file", "select keyring's backend (os|file|test)")
+
+	return cmd
+}
+
+// transportSecurity returns the server options to secure connections with TLS if a certificate is given.
+// TLS is required unless the signer listens on a unix socket or loopback address, so the token never leaves the host in cleartext.
+func transportSecurity(cmd *cobra.Command, address string) ([]grpc.ServerOption, error) {
+	certFile, err := cmd.Flags().GetString(flagTLSCertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile, err := cmd.Flags().GetString(flagTLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case certFile != "" && keyFile != "":
+		creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to load the TLS certificate")
+		}
+
+		return []grpc.ServerOption{grpc.Creds(creds)}, nil
+	case certFile != "" || keyFile != "":
+		return nil, fmt.Errorf("both --%s and --%s are required to enable TLS", flagTLSCertFile, flagTLSKeyFile)
+	case !signer.IsLoopback(address):
+		return nil, fmt.Errorf("listening on %s requires TLS, use --%s and --%s or listen on a unix socket or loopback address", address, flagTLSCertFile, flagTLSKeyFile)
+	default:
+		return nil, nil
+	}
+}
+
+// readToken reads the shared token of the remote signer from the given file
+func readToken(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, "failed to read token file %s", path)
+	}
+
+	token := strings.TrimSpace(string(bz))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+func listenOn(address string) (net.Listener, error) {
+	if path := strings.TrimPrefix(address, "unix://"); path != address {
+		return net.Listen("unix", path)
+	}
+
+	return net.Listen("tcp", address)
+}
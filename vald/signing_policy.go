@@ -0,0 +1,55 @@
+package vald
+
+import (
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/vald/policy"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/log"
+)
+
+// createSigningPolicy returns the policy that signing sessions are checked against, or nil if every payload may be signed
+func createSigningPolicy(clientCtx sdkClient.Context, cfg config.SigningPolicyConfig) (multisig.SigningPolicy, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	rules := policy.Rules{
+		Chains:              make(map[nexus.ChainName]policy.ChainRules),
+		SignWithoutMetadata: cfg.SignWithoutMetadata,
+		SignOtherModules:    cfg.SignOtherModules,
+	}
+	for _, chain := range cfg.Chains {
+		chainRules := policy.ChainRules{}
+		for _, contract := range chain.Contracts {
+			if !common.IsHexAddress(contract) {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract %s for chain %s in signing policy", contract, chain.Name)
+			}
+
+			chainRules.Contracts = append(chainRules.Contracts, common.HexToAddress(contract))
+		}
+
+		rules.Chains[nexus.ChainName(chain.Name)] = chainRules
+	}
+
+	for _, mintCap := range cfg.MintCaps {
+		amount, err := policy.ParseAmount(mintCap.MaxAmount)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid mint cap for %s in signing policy", mintCap.Symbol)
+		}
+
+		rules.MintCaps = append(rules.MintCaps, policy.MintCap{Chain: nexus.ChainName(mintCap.Chain), Symbol: mintCap.Symbol, MaxAmount: amount})
+	}
+
+	log.Infof("signing policy enabled for %d chains with %d mint caps", len(rules.Chains), len(rules.MintCaps))
+	if rules.SignWithoutMetadata || rules.SignOtherModules {
+		log.Infof("signing policy signs unverifiable sessions (without metadata: %t, other modules: %t)", rules.SignWithoutMetadata, rules.SignOtherModules)
+	}
+
+	return policy.NewEngine(evmTypes.NewQueryServiceClient(clientCtx), rules, cfg.Timeout), nil
+}
@@ -6,8 +6,10 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -30,17 +33,24 @@ import (
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/utils"
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
 	errors2 "github.com/axelarnetwork/axelar-core/utils/errors"
+	"github.com/axelarnetwork/axelar-core/vald/alert"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
 	"github.com/axelarnetwork/axelar-core/vald/config"
 	"github.com/axelarnetwork/axelar-core/vald/evm"
 	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/journal"
 	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/vald/signer"
 	grpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
 	"github.com/axelarnetwork/axelar-core/vald/tss"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
-	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	snapshotTypes "github.com/axelarnetwork/axelar-core/x/snapshot/types"
 	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
 	tmEvents "github.com/axelarnetwork/tm-events/events"
 	"github.com/axelarnetwork/tm-events/pubsub"
 	"github.com/axelarnetwork/tm-events/tendermint"
@@ -65,6 +75,11 @@ func GetValdCommand() *cobra.Command {
 		Use: "vald-start",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
+			// the keys of a remote signer are not in the local keyring, so the broadcaster account is resolved once vald starts
+			if usesRemoteSigner(serverCtx.Viper) {
+				return nil
+			}
+
 			if !cmd.Flags().Changed(flags.FlagFrom) {
 				if err := cmd.Flags().Set(flags.FlagFrom, serverCtx.Viper.GetString("broadcast.broadcaster-account")); err != nil {
 					return err
@@ -87,12 +102,22 @@ func GetValdCommand() *cobra.Command {
 			if err := v.BindPFlag("tss.tofnd-dial-timeout", cmd.PersistentFlags().Lookup("tofnd-dial-timeout")); err != nil {
 				return err
 			}
+			if err := v.BindPFlag("tss.tofnd-standby-host", cmd.PersistentFlags().Lookup("tofnd-standby-host")); err != nil {
+				return err
+			}
+			if err := v.BindPFlag("tss.tofnd-standby-port", cmd.PersistentFlags().Lookup("tofnd-standby-port")); err != nil {
+				return err
+			}
 
 			cliCtx, err := sdkClient.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			if usesRemoteSigner(v) && cliCtx.From == "" {
+				cliCtx = cliCtx.WithFrom(v.GetString("broadcast.broadcaster-account"))
+			}
+
 			// dynamically adjust gas limit by simulating the tx first
 			txf := tx.NewFactoryCLI(cliCtx, cmd.Flags()).WithSimulateAndExecute(true)
 
@@ -154,8 +179,36 @@ func runVald(cliCtx sdkClient.Context, txf tx.Factory, viper *viper.Viper) error
 	fPath := filepath.Join(valdHome, "state.json")
 	stateSource := NewRWFile(fPath)
 
+	sessionJournal, err := journal.Open(valdHome)
+	if err != nil {
+		return err
+	}
+	// the journal must only be closed once all jobs have stopped
+	defer func() {
+		if err := sessionJournal.Close(); err != nil {
+			log.Error(err.Error())
+		}
+	}()
+
+	auditLog, err := openAuditLog(valdHome, valdConf.Audit)
+	if err != nil {
+		return err
+	}
+	if auditLog != nil {
+		defer func() {
+			if err := auditLog.Close(); err != nil {
+				log.Error(err.Error())
+			}
+		}()
+	}
+
+	alerts, err := createAlertManager(valdHome, valAddr, valdConf.Alerting)
+	if err != nil {
+		return err
+	}
+
 	log.Info("start listening to events")
-	listen(cliCtx, txf, valdConf, valAddr, stateSource)
+	listen(cliCtx, txf, valdConf, valAddr, stateSource, sessionJournal, auditLog, alerts, viper)
 	log.Info("shutting down")
 	return nil
 }
@@ -171,22 +224,40 @@ func setPersistentFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String("tofnd-host", defaultConf.Host, "host name for tss daemon")
 	cmd.PersistentFlags().String("tofnd-port", defaultConf.Port, "port for tss daemon")
 	cmd.PersistentFlags().String("tofnd-dial-timeout", defaultConf.DialTimeout.String(), "dialup timeout to the tss daemon")
+	cmd.PersistentFlags().String("tofnd-standby-host", defaultConf.StandbyHost, "host name for a standby tss daemon that takes over while the primary is unavailable")
+	cmd.PersistentFlags().String("tofnd-standby-port", defaultConf.StandbyPort, "port for the standby tss daemon")
 	cmd.PersistentFlags().String("validator-addr", "", "the address of the validator operator, i.e axelarvaloper1..")
 	cmd.PersistentFlags().String(flags.FlagChainID, app.Name, "The network chain ID")
 }
 
-func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, stateSource ReadWriter) {
+func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, stateSource ReadWriter, sessionJournal *journal.Journal, auditLog *audit.Log, alerts *alert.Manager, v *viper.Viper) {
 	encCfg := app.MakeEncodingConfig()
 	cdc := encCfg.Amino
-	sender, err := clientCtx.Keyring.Key(clientCtx.From)
+	txSigner := createTxSigner(clientCtx, axelarCfg.RemoteSigner)
+	sender, err := accountOf(txSigner, clientCtx.From)
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "failed to read broadcaster account info from keyring"))
+		panic(sdkerrors.Wrap(err, "failed to read broadcaster account info"))
 	}
 	clientCtx = clientCtx.
-		WithFromAddress(sender.GetAddress()).
-		WithFromName(sender.GetName())
+		WithFromAddress(sender).
+		WithFromName(clientCtx.From)
+
+	// latest block height seen by vald, used to drop broadcasts that missed their deadline
+	var latestHeight atomic.Int64
+	polls := newPollScheduler(axelarCfg.PollWorkers, revoteLockingPeriod(clientCtx))
+	// evmConfigs is set up once the EVM manager exists, which needs the broadcaster first
+	var evmConfigs *evmConfigReloader
+	refundableBroadcaster := createRefundableBroadcaster(txf, clientCtx, axelarCfg, valAddr, txSigner, latestHeight.Load)
+	if auditLog != nil {
+		refundableBroadcaster = audit.WithAudit(refundableBroadcaster, auditLog, func(chain nexus.ChainName) []string {
+			if evmConfigs == nil {
+				return nil
+			}
 
-	bc := createRefundableBroadcaster(txf, clientCtx, axelarCfg)
+			return evmConfigs.RPCEndpoints(chain)
+		})
+	}
+	bc := broadcast.WithTracking(withPriorities(refundableBroadcaster, polls))
 
 	robustClient := tendermint.NewRobustClient(func() (rpcclient.Client, error) {
 		cl, err := sdkClient.NewClientFromNode(clientCtx.NodeURI)
@@ -200,22 +271,52 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 		}
 		return cl, nil
 	})
-	tssMgr := createTSSMgr(bc, clientCtx, axelarCfg, valAddr.String(), cdc)
-
-	evmMgr := createEVMMgr(axelarCfg, clientCtx, bc, valAddr)
-	multisigMgr := createMultisigMgr(bc, clientCtx, axelarCfg, valAddr)
+	tofndClient, err := createTofndClient(axelarCfg.TssConfig)
+	if err != nil {
+		panic(err)
+	}
+	tssMgr := createTSSMgr(bc, clientCtx, tofndClient, valAddr.String(), cdc)
+
+	connectEVMChain := evmChainConnector(axelarCfg.EVMRPC)
+	evmMgr := createEVMMgr(axelarCfg, clientCtx, bc, valAddr, connectEVMChain)
+	// polls are only tracked until they expire, so reorganized transactions are rechecked for as long as a vote is accepted
+	evmMgr.SetReorgRecheck(axelarCfg.EVMRPC.ReorgRecheckInterval, func(poll vote.PollID) bool {
+		_, ok := polls.Deadline(poll)
+		return ok
+	})
+	evmConfigs = newEVMConfigReloader(evmMgr, axelarCfg.EVMConfig, connectEVMChain)
+	evmConfigs.Watch(v)
 
 	nodeHeight, err := waitUntilNetworkSync(axelarCfg, robustClient)
 	if err != nil {
 		panic(err)
 	}
 
+	signingPolicy, err := createSigningPolicy(clientCtx, axelarCfg.SigningPolicy)
+	if err != nil {
+		panic(err)
+	}
+	multisigMgr := multisig.NewMgr(tofndClient, clientCtx, valAddr, bc, timeout, multisigRetryPolicy(clientCtx, axelarCfg.TssConfig), signingPolicy)
+
 	stateStore := NewStateStore(stateSource)
 	startBlock, err := getStartBlock(axelarCfg, stateStore, nodeHeight)
 	if err != nil {
 		panic(err)
 	}
 
+	catchUpBlocks := getCatchUpRange(axelarCfg, stateStore, startBlock, nodeHeight)
+	if !catchUpBlocks.Empty() {
+		// live events are processed right after the skipped blocks, so no block falls in between
+		startBlock = catchUpBlocks.To
+	}
+
+	replay := getReplayRange(axelarCfg, startBlock, nodeHeight)
+	if pruned, err := sessionJournal.Prune(nodeHeight - axelarCfg.Journal.Retention); err != nil {
+		log.Errorf("failed to prune the journal: %s", err.Error())
+	} else if pruned > 0 {
+		log.Infof("pruned %d old sessions from the journal", pruned)
+	}
+
 	eventBus := createEventBus(robustClient, startBlock, axelarCfg.EventNotificationsMaxRetries, axelarCfg.EventNotificationsBackOff)
 	var blockHeight int64
 	blockHeaderSub := eventBus.Subscribe(func(event tmEvents.ABCIEventWithHeight) bool {
@@ -233,16 +334,19 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 			event.Attributes[sdk.AttributeKeyAction] == tssTypes.AttributeValueSend
 	})
 
-	evmNewChain := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ChainAdded]())
-	evmDepConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmDepositStarted]())
-	evmTokConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmTokenStarted]())
-	evmTraConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmKeyTransferStarted]())
-	evmGatewayTxConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmGatewayTxStarted]())
-	evmGatewayTxsConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmGatewayTxsStarted]())
+	chainListeners := createChainListeners(bc, valAddr, clientCtx.FromAddress, v, evmMgr)
+	listenerSubs := subscribeListeners(eventBus, chainListeners)
 
 	multisigKeygen := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.KeygenStarted]())
 	multisigSigning := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.SigningStarted]())
 
+	sessionEnds := eventBus.Subscribe(isSessionEnd)
+
+	var keygenParticipation <-chan tmEvents.ABCIEventWithHeight
+	if alerts != nil {
+		keygenParticipation = eventBus.Subscribe(isKeygenOptOutOrIn)
+	}
+
 	eventCtx, cancelEventCtx := context.WithCancel(context.Background())
 	eGroup, eventCtx := errgroup.WithContext(eventCtx)
 
@@ -269,14 +373,32 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 		}
 	}
 
+	var sessionCatchUp *catchUp
+	if !catchUpBlocks.Empty() {
+		sessionCatchUp = &catchUp{
+			scanner:  newCatchUpScanner(robustClient, catchUpBlocks, axelarCfg.EventNotificationsMaxRetries, axelarCfg.EventNotificationsBackOff),
+			journal:  sessionJournal,
+			sessions: chainSessions{vote: voteTypes.NewQueryServiceClient(clientCtx), multisig: multisigTypes.NewQueryServiceClient(clientCtx)},
+		}
+	}
+
 	timer := time.AfterFunc(0, func() {})
 	defer timer.Stop()
 	blockTimeout, timeoutCancel := context.WithCancel(context.Background())
+	var lastBlockTime atomic.Int64
 	processBlockHeader := func(event tmEvents.Event) error {
 		timer.Stop()
 		timer = time.AfterFunc(axelarCfg.NoNewBlockPanicTimeout, timeoutCancel)
+		lastBlockTime.Store(time.Now().UnixNano())
 
-		return stateStore.SetState(event.Height)
+		if err := stateStore.SetState(event.Height); err != nil {
+			return err
+		}
+
+		latestHeight.Store(event.Height)
+		polls.SetHeight(event.Height)
+		latestProcessedBlock.Set(float64(event.Height))
+		return nil
 	}
 
 	failOnTimeout := func(ctx context.Context) error {
@@ -289,18 +411,53 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 	}
 
 	js := []jobs.Job{
-		createJob(blockHeaderSub, processBlockHeader, cancelEventCtx),
+		createJob("block_header", blockHeaderSub, processBlockHeader, cancelEventCtx),
 		fetchEvents,
 		failOnTimeout,
-		createJob(heartbeat, tssMgr.ProcessHeartBeatEvent, cancelEventCtx),
-		createJobTyped(evmNewChain, evmMgr.ProcessNewChain, cancelEventCtx),
-		createJobTyped(evmDepConf, evmMgr.ProcessDepositConfirmation, cancelEventCtx),
-		createJobTyped(evmTokConf, evmMgr.ProcessTokenConfirmation, cancelEventCtx),
-		createJobTyped(evmTraConf, evmMgr.ProcessTransferKeyConfirmation, cancelEventCtx),
-		createJobTyped(evmGatewayTxConf, evmMgr.ProcessGatewayTxConfirmation, cancelEventCtx),
-		createJobTyped(evmGatewayTxsConf, evmMgr.ProcessGatewayTxsConfirmation, cancelEventCtx),
-		createJobTyped(multisigKeygen, multisigMgr.ProcessKeygenStarted, cancelEventCtx),
-		createJobTyped(multisigSigning, multisigMgr.ProcessSigningStarted, cancelEventCtx),
+		createJob("heartbeat", heartbeat, tssMgr.ProcessHeartBeatEvent, cancelEventCtx),
+		createJournaledJob("multisig_keygen", sessionJournal, journal.Keygen, keygenSessions(valAddr), replay, multisigKeygen,
+			catchUpEvents(eventCtx, sessionCatchUp, journal.Keygen, tmEvents.Filter[*multisigTypes.KeygenStarted](), keygenSessions(valAddr)),
+			multisigMgr.ProcessKeygenStarted, nil, cancelEventCtx),
+		createJournaledJob("multisig_signing", sessionJournal, journal.Signing, signingSessions(valAddr), replay, multisigSigning,
+			catchUpEvents(eventCtx, sessionCatchUp, journal.Signing, tmEvents.Filter[*multisigTypes.SigningStarted](), signingSessions(valAddr)),
+			multisigMgr.ProcessSigningStarted, nil, cancelEventCtx),
+		createJobTyped("journal_session_end", sessionEnds, closeSessions(sessionJournal), cancelEventCtx),
+		tofndClient.MonitorHealth(axelarCfg.TssConfig.HealthCheckInterval, axelarCfg.TssConfig.DialTimeout),
+	}
+
+	if axelarCfg.Presign.Enabled {
+		predictor := newBatchPredictor(evmTypes.NewQueryServiceClient(clientCtx), multisigTypes.NewQueryServiceClient(clientCtx), valAddr)
+		js = append(js, multisigMgr.Presign(predictor, axelarCfg.Presign.Interval, axelarCfg.Presign.TTL))
+	}
+
+	if alerts != nil {
+		js = append(js,
+			alerts.Run,
+			watchBlocks(alerts, func() time.Time {
+				if nanos := lastBlockTime.Load(); nanos != 0 {
+					return time.Unix(0, nanos)
+				}
+				return time.Time{}
+			}, axelarCfg.Alerting.NoNewBlocksAfter),
+			watchBalance(alerts, bankTypes.NewQueryClient(clientCtx), clientCtx.FromAddress, axelarCfg.Alerting.MinBalance, axelarCfg.Alerting.BalanceCheckInterval),
+			watchTofnd(alerts, tofndClient.Healthy, axelarCfg.TssConfig.HealthCheckInterval),
+			createJobTyped("keygen_participation", keygenParticipation, observeKeygenOptOut(alerts, clientCtx.FromAddress), cancelEventCtx),
+		)
+	}
+
+	for _, sub := range listenerSubs {
+		js = append(js, createListenerJob(eventCtx, sub, sessionJournal, replay, sessionCatchUp, polls, cancelEventCtx))
+	}
+
+	// all catch-up subscriptions are made by now, so the scan can start
+	if sessionCatchUp != nil {
+		js = append(js, sessionCatchUp.scanner.Scan)
+	}
+
+	js = append(js, metricsJobs(axelarCfg.Metrics)...)
+
+	if axelarCfg.Admin.Enabled {
+		js = append(js, createAdminServer(axelarCfg.Admin, adminServer{evmMgr: evmMgr, evmConfigs: evmConfigs, broadcaster: bc}))
 	}
 
 	slices.ForEach(js, func(job jobs.Job) {
@@ -312,10 +469,14 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 	}
 }
 
-func createJob(sub <-chan tmEvents.ABCIEventWithHeight, processor func(event tmEvents.Event) error, cancel context.CancelFunc) jobs.Job {
+func createJob(name string, sub <-chan tmEvents.ABCIEventWithHeight, processor func(event tmEvents.Event) error, cancel context.CancelFunc) jobs.Job {
+	jobStatuses.register(name)
+
 	return func(ctx context.Context) error {
 		processWithLog := func(e tmEvents.ABCIEventWithHeight) {
+			start := time.Now()
 			err := processor(tmEvents.Map(e))
+			observeJob(name, start, err)
 			if err != nil {
 				ctx = log.AppendKeyVals(ctx, errors2.KeyVals(err)...)
 				log.FromCtx(ctx).Error(err.Error())
@@ -331,11 +492,15 @@ func createJob(sub <-chan tmEvents.ABCIEventWithHeight, processor func(event tmE
 	}
 }
 
-func createJobTyped[T proto.Message](sub <-chan tmEvents.ABCIEventWithHeight, processor func(event T) error, cancel context.CancelFunc) jobs.Job {
+func createJobTyped[T proto.Message](name string, sub <-chan tmEvents.ABCIEventWithHeight, processor func(event T) error, cancel context.CancelFunc) jobs.Job {
+	jobStatuses.register(name)
+
 	return func(ctx context.Context) error {
 		processWithLog := func(e tmEvents.ABCIEventWithHeight) {
 			event := funcs.Must(sdk.ParseTypedEvent(e.Event)).(T)
+			start := time.Now()
 			err := processor(event)
+			observeJob(name, start, err)
 			if err != nil {
 				ctx = log.AppendKeyVals(ctx, errors2.KeyVals(err)...)
 				log.FromCtx(ctx).Error(err.Error())
@@ -404,60 +569,228 @@ func getStartBlock(cfg config.ValdConfig, stateStore StateStore, nodeHeight int6
 	return startBlock, nil
 }
 
+// Return the range of block heights for which unhandled sessions from the journal are replayed.
+// Blocks from the start block onwards are processed again anyway, so they are excluded.
+func getReplayRange(cfg config.ValdConfig, startBlock int64, nodeHeight int64) replayRange {
+	to := startBlock
+	if to == 0 {
+		to = nodeHeight
+	}
+
+	return replayRange{From: to - cfg.Journal.ReplayWindow, To: to}
+}
+
 func createEventBus(client *tendermint.RobustClient, startBlock int64, retries int, backOff time.Duration) *tmEvents.Bus {
 	notifier := tmEvents.NewBlockNotifier(client, tmEvents.Retries(retries), tmEvents.BackOff(backOff)).StartingAt(startBlock)
 	return tmEvents.NewEventBus(tmEvents.NewBlockSource(client, notifier, tmEvents.Retries(retries), tmEvents.BackOff(backOff)), pubsub.NewBus[tmEvents.ABCIEventWithHeight]())
 }
 
-func createRefundableBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig) broadcast.Broadcaster {
-	broadcaster := broadcast.WithStateManager(ctx, txf, broadcast.WithResponseTimeout(axelarCfg.BroadcastConfig.MaxTimeout))
-	broadcaster = broadcast.WithRetry(broadcaster, axelarCfg.MaxRetries, axelarCfg.MinSleepBeforeRetry)
-	broadcaster = broadcast.Batched(broadcaster, axelarCfg.BatchThreshold, axelarCfg.BatchSizeLimit)
-	broadcaster = broadcast.WithRefund(broadcaster)
+func createRefundableBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, txSigner broadcast.TxSigner, latestHeight func() int64) broadcast.Broadcaster {
+	options := []broadcast.BroadcasterOption{
+		broadcast.WithResponseTimeout(axelarCfg.BroadcastConfig.MaxTimeout),
+		broadcast.WithSigner(txSigner),
+	}
+	// all lanes share the fee oracle, so they spend from the same budget
+	if oracle := createFeeOracle(txf, ctx, axelarCfg.Fees); oracle != nil {
+		options = append(options, broadcast.WithFeeOracle(oracle))
+	}
+
+	var broadcaster broadcast.Broadcaster
+	if lanes := createBroadcastLanes(txf, ctx, axelarCfg, valAddr, txSigner, latestHeight, options); len(lanes) > 1 {
+		broadcaster = broadcast.WithLanes(lanes...)
+	} else {
+		broadcaster = lanes[0].Broadcaster
+	}
 	broadcaster = broadcast.SuppressExecutionErrs(broadcaster)
 
 	return broadcaster
 }
 
-func createMultisigMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, valAddr sdk.ValAddress) *multisig.Mgr {
-	conn, err := grpc.Connect(axelarCfg.TssConfig.Host, axelarCfg.TssConfig.Port, axelarCfg.TssConfig.DialTimeout)
+// createBroadcastLanes returns a broadcast lane for the proxy account and one for each configured lane proxy that is registered for the validator
+func createBroadcastLanes(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, txSigner broadcast.TxSigner, latestHeight func() int64, options []broadcast.BroadcasterOption) []broadcast.Lane {
+	lanes := []broadcast.Lane{{Sender: ctx.GetFromAddress(), Broadcaster: createLaneBroadcaster(txf, ctx, axelarCfg, latestHeight, options)}}
+	if len(axelarCfg.BroadcastConfig.Lanes) == 0 {
+		return lanes
+	}
+
+	registered, err := registeredLaneProxies(ctx, valAddr)
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "failed to create multisig manager"))
+		log.Errorf("failed to query the registered lane proxies, using all configured lanes: %s", err.Error())
 	}
-	log.Debug("successful connection to tofnd gRPC server")
 
-	return multisig.NewMgr(tofnd.NewMultisigClient(conn), cliCtx, valAddr, broadcaster, timeout)
+	for _, name := range axelarCfg.BroadcastConfig.Lanes {
+		addr, err := accountOf(txSigner, name)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "failed to read lane account %s", name))
+		}
+
+		if registered != nil && !registered[addr.String()] {
+			log.Errorf("account %s (%s) is not registered as lane proxy of validator %s, not using it to broadcast", name, addr.String(), valAddr.String())
+			continue
+		}
+
+		laneCtx := ctx.WithFromAddress(addr).WithFromName(name)
+		laneTxf := txf.WithAccountNumber(0).WithSequence(0)
+		lanes = append(lanes, broadcast.Lane{Sender: addr, Broadcaster: createLaneBroadcaster(laneTxf, laneCtx, axelarCfg, latestHeight, options)})
+	}
+
+	log.Infof("broadcasting from %d accounts", len(lanes))
+	return lanes
+}
+
+func usesRemoteSigner(v *viper.Viper) bool {
+	return v.GetString("broadcast.remote_signer.address") != ""
+}
+
+// createTxSigner returns a signer backed by the remote signer if one is configured, and by the local keyring otherwise
+func createTxSigner(ctx sdkClient.Context, cfg config.RemoteSignerConfig) broadcast.TxSigner {
+	if cfg.Address == "" {
+		return broadcast.KeyringSigner(ctx.Keyring)
+	}
+
+	conn, err := signer.Connect(cfg.Address, cfg.Token, cfg.TLSCAFile, cfg.DialTimeout)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "failed to reach remote signer at %s", cfg.Address))
+	}
+
+	return signer.NewClient(conn, ctx.InterfaceRegistry, cfg.Timeout)
+}
+
+// accountOf returns the address of the account of the given key
+func accountOf(txSigner broadcast.TxSigner, keyName string) (sdk.AccAddress, error) {
+	pubKey, err := txSigner.PubKey(context.Background(), keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddress(pubKey.Address()), nil
+}
+
+func registeredLaneProxies(ctx sdkClient.Context, valAddr sdk.ValAddress) (map[string]bool, error) {
+	res, err := snapshotTypes.NewQueryServiceClient(ctx).LaneProxies(context.Background(), &snapshotTypes.LaneProxiesRequest{Validator: valAddr.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	registered := make(map[string]bool)
+	for _, proxy := range res.LaneProxies {
+		registered[proxy] = true
+	}
+
+	return registered, nil
 }
 
-func createTSSMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, valAddr string, cdc *codec.LegacyAmino) *tss.Mgr {
-	create := func() (*tss.Mgr, error) {
-		conn, err := tss.Connect(axelarCfg.TssConfig.Host, axelarCfg.TssConfig.Port, axelarCfg.TssConfig.DialTimeout)
+// createLaneBroadcaster returns a broadcaster that keeps track of the sequence number of the account defined by ctx.GetFromAddress().
+// Msgs are dropped once latestHeight reaches their deadline.
+// Refunds are requested per batch, because a tx that mixes refundable and other msgs is rejected.
+func createLaneBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig, latestHeight func() int64, options []broadcast.BroadcasterOption) broadcast.Broadcaster {
+	broadcaster := broadcast.WithStateManager(ctx, txf, options...)
+	broadcaster = broadcast.WithRetry(broadcaster, axelarCfg.MaxRetries, axelarCfg.MinSleepBeforeRetry, latestHeight)
+	broadcaster = broadcast.WithRefund(broadcaster, ctx.InterfaceRegistry)
+	broadcaster = broadcast.Batched(broadcaster, axelarCfg.BatchThreshold, axelarCfg.BatchSizeLimit, latestHeight)
+
+	return broadcaster
+}
+
+// createFeeOracle returns an oracle that prices txs based on the state of the chain, or nil if it is disabled.
+// The configured gas price is the lowest price the oracle chooses.
+func createFeeOracle(txf tx.Factory, ctx sdkClient.Context, cfg config.FeeConfig) *broadcast.FeeOracle {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if len(txf.GasPrices()) == 0 {
+		log.Info("no gas prices configured, disabling the fee oracle")
+		return nil
+	}
+
+	return broadcast.NewFeeOracle(broadcast.NodeFeeSource(ctx), ctx.InterfaceRegistry, txf.GasPrices()[0], broadcast.FeeParams{
+		MaxGasPrice:       floatToDec(cfg.MaxGasPrice),
+		CongestionPremium: floatToDec(cfg.CongestionPremium),
+		MinGasAdjustment:  cfg.MinGasAdjustment,
+		MaxGasAdjustment:  cfg.MaxGasAdjustment,
+		BlockWindow:       cfg.BlockWindow,
+		RefreshInterval:   cfg.RefreshInterval,
+		Budget:            sdk.NewInt(cfg.Budget),
+		BudgetPeriod:      cfg.BudgetPeriod,
+	})
+}
+
+func floatToDec(f float64) sdk.Dec {
+	return sdk.MustNewDecFromStr(strconv.FormatFloat(f, 'f', sdk.Precision, 64))
+}
+
+func createTSSMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, tofndClient *grpc.Client, valAddr string, cdc *codec.LegacyAmino) *tss.Mgr {
+	return tss.NewMgr(tofndClient, cliCtx, 2*time.Hour, valAddr, broadcaster, cdc)
+}
+
+func createEVMClient(config evmTypes.EVMConfig, rpcCfg config.EVMRPCConfig) (evmRPC.Client, error) {
+	// rate limits are enforced by each provider, so every endpoint backs off independently
+	newClient := func(url string) (evmRPC.Client, error) {
+		client, err := evmRPC.NewClient(url, config.FinalityOverride)
 		if err != nil {
 			return nil, err
 		}
-		log.Debug("successful connection to tofnd gRPC server")
 
-		// creates client to communicate with the external tofnd process service
-		multiSigClient := tofnd.NewMultisigClient(conn)
+		return evmRPC.WithRateLimit(client, strings.ToLower(config.Name), evmRPC.RateLimitConfig{
+			BatchSize:  rpcCfg.BatchSize,
+			MaxRetries: rpcCfg.MaxRetries,
+			MinBackoff: rpcCfg.MinBackoff,
+			MaxBackoff: rpcCfg.MaxBackoff,
+		}), nil
+	}
 
-		tssMgr := tss.NewMgr(multiSigClient, cliCtx, 2*time.Hour, valAddr, broadcaster, cdc)
+	if len(config.FallbackRPCAddrs) == 0 {
+		return newClient(config.RPCAddr)
+	}
 
-		return tssMgr, nil
+	required := config.RPCQuorum
+	if required < 1 {
+		required = 1
 	}
 
-	mgr, err := create()
-	if err != nil {
-		panic(sdkerrors.Wrap(err, "failed to create tss manager"))
+	var clients []evmRPC.Client
+	for _, url := range config.RPCAddrs() {
+		client, err := newClient(url)
+		if err != nil {
+			log.WithKeyVals("chain", config.Name, "url", url).
+				Error(sdkerrors.Wrap(err, "failed to connect to RPC endpoint").Error())
+			continue
+		}
+
+		clients = append(clients, client)
 	}
 
-	return mgr
+	if len(clients) < required {
+		slices.ForEach(clients, func(client evmRPC.Client) { client.Close() })
+		return nil, fmt.Errorf("connected to %d RPC endpoints, but at least %d are required", len(clients), required)
+	}
+
+	return evmRPC.NewMultiClient(clients, config.RPCQuorum)
 }
 
-func createEVMClient(config evmTypes.EVMConfig) (evmRPC.Client, error) {
-	return evmRPC.NewClient(config.RPCAddr, config.FinalityOverride)
+// evmChainConnector returns a function that connects to the RPC endpoints of an EVM chain.
+// All polls of the chain share the receipt cache of the returned client.
+func evmChainConnector(rpcCfg config.EVMRPCConfig) func(config evmTypes.EVMConfig) (evmRPC.Client, error) {
+	return func(config evmTypes.EVMConfig) (evmRPC.Client, error) {
+		if config.L1ChainName != nil {
+			log.Infof("`l1_chain_name` config is deprecated for EVM chain '%s'. Please remove it from your RPC config", config.Name)
+		}
+
+		client, err := createEVMClient(config, rpcCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		log.WithKeyVals("chain", config.Name, "url", config.RPCAddr).
+			Debugf("created JSON-RPC client of type %T", client)
+
+		chain := strings.ToLower(config.Name)
+		return evmRPC.WithReceiptCache(evmRPC.WithMetrics(client, chain), chain, rpcCfg.ReceiptCacheSize, rpcCfg.ReceiptCacheTTL), nil
+	}
 }
 
-func createEVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broadcast.Broadcaster, valAddr sdk.ValAddress) *evm.Mgr {
+func createEVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broadcast.Broadcaster, valAddr sdk.ValAddress, connectEVMChain func(config evmTypes.EVMConfig) (evmRPC.Client, error)) *evm.Mgr {
 	rpcs := make(map[string]evmRPC.Client)
 
 	chainConfigs := slices.Filter(axelarCfg.EVMConfig, func(config evmTypes.EVMConfig) bool {
@@ -472,28 +805,26 @@ func createEVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broad
 			panic(err)
 		}
 
-		if config.L1ChainName != nil {
-			log.Infof("`l1_chain_name` config is deprecated for EVM chain '%s'. Please remove it from your RPC config", config.Name)
-		}
-
-		client, err := createEVMClient(config)
+		client, err := connectEVMChain(config)
 		if err != nil {
 			err = sdkerrors.Wrap(err, fmt.Sprintf("failed to create an RPC connection for EVM chain %s. Verify your RPC config.", config.Name))
 			log.Error(err.Error())
 			panic(err)
 		}
 
-		log.WithKeyVals("chain", config.Name, "url", config.RPCAddr).
-			Debugf("created JSON-RPC client of type %T", client)
-
-		// clean up evmRPC connection on process shutdown
-		cleanupCommands = append(cleanupCommands, client.Close)
-
 		rpcs[chainName] = client
 		log.Infof("successfully connected to EVM bridge for chain %s", chainName)
 	})
 
-	return evm.NewMgr(rpcs, b, valAddr, cliCtx.FromAddress, evm.NewLatestFinalizedBlockCache())
+	mgr := evm.NewMgr(rpcs, b, valAddr, cliCtx.FromAddress, evm.NewLatestFinalizedBlockCache())
+	slices.ForEach(chainConfigs, func(config evmTypes.EVMConfig) {
+		mgr.SetReorgCheckDepth(nexus.ChainName(config.Name), config.ReorgCheckDepth)
+	})
+
+	// clean up evmRPC connections on process shutdown
+	cleanupCommands = append(cleanupCommands, mgr.Close)
+
+	return mgr
 }
 
 // RWFile implements the ReadWriter interface for an underlying file
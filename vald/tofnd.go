@@ -0,0 +1,114 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	grpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	"github.com/axelarnetwork/utils"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const (
+	// blockTimeSampleSize is the number of blocks the block time is averaged over
+	blockTimeSampleSize = 100
+	// defaultBlockTime is assumed if the block time cannot be determined from the chain
+	defaultBlockTime = 5 * time.Second
+)
+
+// createTofndClient connects to the primary tofnd and the standby, if one is configured.
+// Connections are re-established in the background, so vald keeps running while tofnd restarts.
+func createTofndClient(cfg tssTypes.TssConfig) (*grpc.Client, error) {
+	connect := func(host, port string) (grpc.Endpoint, error) {
+		conn, err := grpc.Dial(host, port, cfg.DialTimeout, cfg.MaxBackoff)
+		if err != nil {
+			return grpc.Endpoint{}, sdkerrors.Wrapf(err, "failed to create connection to tofnd at %s:%s", host, port)
+		}
+
+		return grpc.Endpoint{Name: fmt.Sprintf("%s:%s", host, port), Client: tofnd.NewMultisigClient(conn)}, nil
+	}
+
+	primary, err := connect(cfg.Host, cfg.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	var standbys []grpc.Endpoint
+	if cfg.StandbyHost != "" {
+		standby, err := connect(cfg.StandbyHost, cfg.StandbyPort)
+		if err != nil {
+			return nil, err
+		}
+
+		standbys = append(standbys, standby)
+	}
+
+	client := grpc.NewClient(primary, standbys...)
+
+	client.Probe(context.Background(), cfg.DialTimeout)
+	if !client.Healthy() {
+		log.Error("no tofnd endpoint is reachable, continuing to reconnect in the background")
+	} else {
+		log.Debug("successful connection to tofnd gRPC server")
+	}
+
+	return client, nil
+}
+
+// multisigRetryPolicy returns a policy that retries tofnd requests until the keygen or signing session expires.
+// Session timeouts are defined in blocks, so their duration is estimated from the recent block time.
+func multisigRetryPolicy(clientCtx sdkClient.Context, cfg tssTypes.TssConfig) multisig.RetryPolicy {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	params := multisigTypes.DefaultParams()
+	if res, err := multisigTypes.NewQueryServiceClient(clientCtx).Params(ctx, &multisigTypes.ParamsRequest{}); err != nil {
+		log.Infof("failed to query multisig params, assuming default session timeouts: %s", err.Error())
+	} else {
+		params = res.Params
+	}
+
+	blockTime, err := estimateBlockTime(ctx, clientCtx)
+	if err != nil {
+		log.Infof("failed to estimate the block time, assuming %s: %s", defaultBlockTime, err.Error())
+		blockTime = defaultBlockTime
+	}
+
+	policy := multisig.RetryPolicy{
+		KeygenWindow:  time.Duration(params.KeygenTimeout) * blockTime,
+		SigningWindow: time.Duration(params.SigningTimeout) * blockTime,
+		BackOff:       utils.LinearBackOff(cfg.RetryBackoff),
+	}
+	log.Debugf("retrying tofnd keygen requests for up to %s and sign requests for up to %s", policy.KeygenWindow, policy.SigningWindow)
+
+	return policy
+}
+
+// estimateBlockTime returns the average time between the latest blocks
+func estimateBlockTime(ctx context.Context, clientCtx sdkClient.Context) (time.Duration, error) {
+	status, err := clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	earlier := latest - blockTimeSampleSize
+	if earlier < 1 {
+		return 0, fmt.Errorf("chain is too short to estimate the block time")
+	}
+
+	block, err := clientCtx.Client.Block(ctx, &earlier)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockTime.Sub(block.Block.Time) / blockTimeSampleSize, nil
+}
@@ -0,0 +1,221 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+var _ tofnd.MultisigClient = &Client{}
+
+// healthCheckKeyUID is the key ID of the key presence request used to probe tofnd. tofnd answers ABSENT if it works properly.
+const healthCheckKeyUID = "dummyID"
+
+// Dial creates a connection to the tofnd gRPC server at the given address. Unlike Connect, it does not wait for the server to be reachable.
+// The connection is re-established in the background with exponential backoff up to maxBackoff whenever it drops.
+func Dial(host string, port string, dialTimeout time.Duration, maxBackoff time.Duration) (*grpc.ClientConn, error) {
+	serverAddr := fmt.Sprintf("%s:%s", host, port)
+	log.Infof("initiate connection to tofnd gRPC server: %s", serverAddr)
+
+	backoffCfg := backoff.DefaultConfig
+	backoffCfg.MaxDelay = maxBackoff
+
+	return grpc.Dial(serverAddr, grpc.WithInsecure(), grpc.WithConnectParams(grpc.ConnectParams{
+		Backoff:           backoffCfg,
+		MinConnectTimeout: dialTimeout,
+	}))
+}
+
+// Endpoint is a tofnd server that requests can be sent to
+type Endpoint struct {
+	Name   string
+	Client tofnd.MultisigClient
+}
+
+type endpoint struct {
+	Endpoint
+	healthy atomic.Bool
+}
+
+// Client sends requests to the primary tofnd endpoint and fails over to the standby endpoints while the primary is unavailable.
+// All endpoints must be set up with the same mnemonic, so they generate the same keys and signatures.
+type Client struct {
+	endpoints []*endpoint
+}
+
+// NewClient returns a new tofnd client. Endpoints are preferred in the given order, all of them are assumed healthy until proven otherwise.
+func NewClient(primary Endpoint, standbys ...Endpoint) *Client {
+	var endpoints []*endpoint
+	for _, e := range append([]Endpoint{primary}, standbys...) {
+		ep := &endpoint{Endpoint: e}
+		ep.healthy.Store(true)
+		endpointUp.WithLabelValues(e.Name).Set(1)
+
+		endpoints = append(endpoints, ep)
+	}
+
+	return &Client{endpoints: endpoints}
+}
+
+// KeyPresence implements the tofnd.MultisigClient interface
+func (c *Client) KeyPresence(ctx context.Context, in *tofnd.KeyPresenceRequest, opts ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+	return call(ctx, c, func(client tofnd.MultisigClient) (*tofnd.KeyPresenceResponse, error) {
+		return client.KeyPresence(ctx, in, opts...)
+	})
+}
+
+// Keygen implements the tofnd.MultisigClient interface
+func (c *Client) Keygen(ctx context.Context, in *tofnd.KeygenRequest, opts ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+	return call(ctx, c, func(client tofnd.MultisigClient) (*tofnd.KeygenResponse, error) {
+		return client.Keygen(ctx, in, opts...)
+	})
+}
+
+// Sign implements the tofnd.MultisigClient interface
+func (c *Client) Sign(ctx context.Context, in *tofnd.SignRequest, opts ...grpc.CallOption) (*tofnd.SignResponse, error) {
+	return call(ctx, c, func(client tofnd.MultisigClient) (*tofnd.SignResponse, error) {
+		return client.Sign(ctx, in, opts...)
+	})
+}
+
+// Healthy returns true if at least one endpoint is healthy
+func (c *Client) Healthy() bool {
+	for _, e := range c.endpoints {
+		if e.healthy.Load() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Probe checks the health of all endpoints. Each check times out after the given duration.
+func (c *Client) Probe(ctx context.Context, timeout time.Duration) {
+	for _, e := range c.endpoints {
+		probeCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := probe(probeCtx, e.Client)
+		cancel()
+
+		c.setHealth(e, err)
+	}
+}
+
+// MonitorHealth returns a job that probes all endpoints in the given interval until the context is canceled
+func (c *Client) MonitorHealth(interval time.Duration, timeout time.Duration) jobs.Job {
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				c.Probe(ctx, timeout)
+			}
+		}
+	}
+}
+
+// call sends the request to healthy endpoints first, in order of preference. Unhealthy endpoints are only tried as a last resort,
+// because they might have recovered since they were last probed.
+func call[T any](ctx context.Context, c *Client, request func(client tofnd.MultisigClient) (T, error)) (T, error) {
+	var (
+		res T
+		err error
+	)
+
+	for _, e := range c.ordered() {
+		res, err = request(e.Client)
+		if err == nil {
+			c.setHealth(e, nil)
+		}
+
+		if !isUnavailable(err) || ctx.Err() != nil {
+			return res, err
+		}
+
+		c.setHealth(e, err)
+	}
+
+	return res, err
+}
+
+func (c *Client) ordered() []*endpoint {
+	var healthy, unhealthy []*endpoint
+	for _, e := range c.endpoints {
+		if e.healthy.Load() {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (c *Client) setHealth(e *endpoint, err error) {
+	healthy := err == nil
+	if e.healthy.Swap(healthy) == healthy {
+		return
+	}
+
+	if healthy {
+		log.Infof("tofnd endpoint %s is healthy again", e.Name)
+		endpointUp.WithLabelValues(e.Name).Set(1)
+	} else {
+		log.Errorf("tofnd endpoint %s is unhealthy: %s", e.Name, err.Error())
+		endpointUp.WithLabelValues(e.Name).Set(0)
+	}
+}
+
+func probe(ctx context.Context, client tofnd.MultisigClient) error {
+	res, err := client.KeyPresence(ctx, &tofnd.KeyPresenceRequest{KeyUid: healthCheckKeyUID, PubKey: []byte{}})
+	if err != nil {
+		return err
+	}
+
+	switch res.Response {
+	case tofnd.RESPONSE_PRESENT, tofnd.RESPONSE_ABSENT:
+		return nil
+	default:
+		return fmt.Errorf("tofnd not set up correctly, responded with %s", res.Response)
+	}
+}
+
+// IsTransient returns true if the error is caused by tofnd being temporarily unreachable, so the request can be retried
+func IsTransient(err error) bool {
+	if isUnavailable(err) {
+		return true
+	}
+
+	s, ok := status.FromError(unwrap(err))
+	return ok && (s.Code() == codes.DeadlineExceeded || s.Code() == codes.ResourceExhausted || s.Code() == codes.Aborted)
+}
+
+func isUnavailable(err error) bool {
+	s, ok := status.FromError(unwrap(err))
+	return ok && s.Code() == codes.Unavailable
+}
+
+// unwrap returns the innermost error, so gRPC status errors can be recognized after they have been wrapped
+func unwrap(err error) error {
+	for {
+		cause, ok := err.(interface{ Cause() error })
+		if !ok || cause.Cause() == nil || cause.Cause() == err {
+			return err
+		}
+
+		err = cause.Cause()
+	}
+}
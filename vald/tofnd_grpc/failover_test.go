@@ -0,0 +1,135 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/multisig/mock"
+	tofndgrpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestClient(t *testing.T) {
+	var (
+		primary, standby *mock.ClientMock
+		client           *tofndgrpc.Client
+		signature        []byte
+	)
+
+	sign := func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+		return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: signature}}, nil
+	}
+	unavailable := func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	}
+	presence := func(response tofnd.KeyPresenceResponse_Response, err error) func(context.Context, *tofnd.KeyPresenceRequest, ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+		return func(context.Context, *tofnd.KeyPresenceRequest, ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+			if err != nil {
+				return nil, err
+			}
+
+			return &tofnd.KeyPresenceResponse{Response: response}, nil
+		}
+	}
+
+	givenClient := Given("a client with a primary and a standby endpoint", func() {
+		primary = &mock.ClientMock{}
+		standby = &mock.ClientMock{}
+		signature = rand.Bytes(64)
+
+		client = tofndgrpc.NewClient(tofndgrpc.Endpoint{Name: "primary", Client: primary}, tofndgrpc.Endpoint{Name: "standby", Client: standby})
+	})
+
+	givenClient.
+		When("the primary is available", func() {
+			primary.SignFunc = sign
+		}).
+		Then("should send requests to the primary", func(t *testing.T) {
+			res, err := client.Sign(context.Background(), &tofnd.SignRequest{})
+
+			assert.NoError(t, err)
+			assert.Equal(t, signature, res.GetSignature())
+			assert.Len(t, standby.SignCalls(), 0)
+		}).
+		Run(t)
+
+	givenClient.
+		When("the primary is unavailable", func() {
+			primary.SignFunc = unavailable
+			standby.SignFunc = sign
+		}).
+		Then("should fail over to the standby", func(t *testing.T) {
+			for i := 0; i < 3; i++ {
+				res, err := client.Sign(context.Background(), &tofnd.SignRequest{})
+
+				assert.NoError(t, err)
+				assert.Equal(t, signature, res.GetSignature())
+			}
+
+			assert.Len(t, primary.SignCalls(), 1)
+			assert.Len(t, standby.SignCalls(), 3)
+			assert.True(t, client.Healthy())
+		}).
+		Run(t)
+
+	givenClient.
+		When("the primary rejects the request", func() {
+			primary.SignFunc = func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return nil, status.Error(codes.InvalidArgument, "invalid key")
+			}
+		}).
+		Then("should not fail over", func(t *testing.T) {
+			_, err := client.Sign(context.Background(), &tofnd.SignRequest{})
+
+			assert.Error(t, err)
+			assert.False(t, tofndgrpc.IsTransient(err))
+			assert.Len(t, standby.SignCalls(), 0)
+		}).
+		Run(t)
+
+	givenClient.
+		When("all endpoints are unavailable", func() {
+			primary.SignFunc = unavailable
+			standby.SignFunc = unavailable
+		}).
+		Then("should return a transient error", func(t *testing.T) {
+			_, err := client.Sign(context.Background(), &tofnd.SignRequest{})
+
+			assert.True(t, tofndgrpc.IsTransient(err))
+			assert.False(t, client.Healthy())
+		}).
+		Run(t)
+
+	givenClient.
+		When("the primary fails its health check", func() {
+			primary.KeyPresenceFunc = presence(tofnd.RESPONSE_FAIL, nil)
+			standby.KeyPresenceFunc = presence(tofnd.RESPONSE_ABSENT, nil)
+			client.Probe(context.Background(), time.Second)
+
+			primary.SignFunc = sign
+			standby.SignFunc = sign
+		}).
+		Then("should prefer the standby until the primary recovers", func(t *testing.T) {
+			_, err := client.Sign(context.Background(), &tofnd.SignRequest{})
+			assert.NoError(t, err)
+			assert.Len(t, primary.SignCalls(), 0)
+			assert.Len(t, standby.SignCalls(), 1)
+
+			primary.KeyPresenceFunc = presence(tofnd.RESPONSE_ABSENT, nil)
+			client.Probe(context.Background(), time.Second)
+
+			_, err = client.Sign(context.Background(), &tofnd.SignRequest{})
+			assert.NoError(t, err)
+			assert.Len(t, primary.SignCalls(), 1)
+			assert.Len(t, standby.SignCalls(), 1)
+		}).
+		Run(t)
+}
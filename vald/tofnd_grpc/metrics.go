@@ -0,0 +1,15 @@
+package grpc
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var endpointUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "vald",
+	Subsystem: "tofnd",
+	Name:      "endpoint_up",
+	Help:      "Whether a tofnd endpoint passed its latest health check",
+}, []string{"endpoint"})
+
+// RegisterMetrics registers all tofnd client metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	return registerer.Register(endpointUp)
+}
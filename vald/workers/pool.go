@@ -0,0 +1,286 @@
+package workers
+
+import (
+	"container/heap"
+	goerrors "errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ErrExpired is returned for tasks that reach their expiry height before they complete
+var ErrExpired = goerrors.New("task expired")
+
+var (
+	queuedTasks = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "workers",
+		Name:      "queued_tasks",
+		Help:      "Number of tasks waiting for a free worker",
+	}, []string{"pool"})
+	runningTasks = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "workers",
+		Name:      "running_tasks",
+		Help:      "Number of tasks currently processed",
+	}, []string{"pool"})
+	expiredTasks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "workers",
+		Name:      "expired_tasks_total",
+		Help:      "Number of tasks that expired before they were completed",
+	}, []string{"pool"})
+)
+
+// RegisterMetrics registers the worker pool metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{queuedTasks, runningTasks, expiredTasks} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Pool limits how many tasks run concurrently in each of its named pools, e.g. one pool per chain.
+// Waiting tasks are started in order of their expiry height, earliest first.
+// Tasks are abandoned once the block height reaches their expiry, so they no longer hold up other tasks.
+type Pool struct {
+	lock        sync.Mutex
+	concurrency func(pool string) int
+	height      int64
+	limiters    map[string]*limiter
+	// expiries are closed once the block height reaches the expiry height they are mapped to
+	expiries map[int64]chan struct{}
+	seq      uint64
+}
+
+// NewPool returns a new pool that runs at most concurrency(pool) tasks at the same time in each named pool
+func NewPool(concurrency func(pool string) int) *Pool {
+	return &Pool{
+		concurrency: concurrency,
+		limiters:    make(map[string]*limiter),
+		expiries:    make(map[int64]chan struct{}),
+	}
+}
+
+// SetHeight updates the current block height. Tasks that expire at or below the height are abandoned.
+func (p *Pool) SetHeight(height int64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if height <= p.height {
+		return
+	}
+	p.height = height
+
+	for expiresAt, expired := range p.expiries {
+		if expiresAt <= height {
+			close(expired)
+			delete(p.expiries, expiresAt)
+		}
+	}
+}
+
+// Do runs the task in the named pool as soon as a worker is free and returns its result.
+// Returns ErrExpired if the block height reaches expiresAt before the task completes. An expiry height of 0 means the task never expires.
+func (p *Pool) Do(pool string, expiresAt int64, task func() error) error {
+	expired, err := p.acquire(pool, expiresAt)
+	if err != nil {
+		return err
+	}
+	defer p.release(pool)
+
+	type outcome struct {
+		err      error
+		panicked interface{}
+	}
+
+	// buffered, so an abandoned task does not leak its goroutine once it completes
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			// re-panic in the caller, so panics are handled the same way as without the pool
+			if r := recover(); r != nil {
+				done <- outcome{panicked: r}
+			}
+		}()
+
+		done <- outcome{err: task()}
+	}()
+
+	select {
+	case result := <-done:
+		if result.panicked != nil {
+			panic(result.panicked)
+		}
+
+		return result.err
+	case <-expired:
+		expiredTasks.WithLabelValues(pool).Inc()
+		return fmt.Errorf("%w: abandoned at expiry height %d", ErrExpired, expiresAt)
+	}
+}
+
+func (p *Pool) acquire(pool string, expiresAt int64) (<-chan struct{}, error) {
+	p.lock.Lock()
+
+	if expiresAt > 0 && p.height >= expiresAt {
+		p.lock.Unlock()
+
+		expiredTasks.WithLabelValues(pool).Inc()
+		return nil, fmt.Errorf("%w: expired at height %d before it was started", ErrExpired, expiresAt)
+	}
+
+	expired := p.expiry(expiresAt)
+	l := p.limiter(pool)
+	if l.running < p.limit(pool) && l.waiting.Len() == 0 {
+		l.running++
+		runningTasks.WithLabelValues(pool).Inc()
+		p.lock.Unlock()
+
+		return expired, nil
+	}
+
+	p.seq++
+	w := &waiter{expiresAt: expiresAt, seq: p.seq, granted: make(chan struct{})}
+	heap.Push(&l.waiting, w)
+	queuedTasks.WithLabelValues(pool).Inc()
+	p.lock.Unlock()
+
+	select {
+	case <-w.granted:
+		return expired, nil
+	case <-expired:
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		select {
+		case <-w.granted:
+			// the task was started at the same time it expired, so the worker must be freed again
+			p.releaseLocked(pool)
+		default:
+			heap.Remove(&l.waiting, w.index)
+			queuedTasks.WithLabelValues(pool).Dec()
+		}
+
+		expiredTasks.WithLabelValues(pool).Inc()
+		return nil, fmt.Errorf("%w: expired at height %d before it was started", ErrExpired, expiresAt)
+	}
+}
+
+func (p *Pool) release(pool string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.releaseLocked(pool)
+}
+
+func (p *Pool) releaseLocked(pool string) {
+	l := p.limiter(pool)
+	l.running--
+	runningTasks.WithLabelValues(pool).Dec()
+
+	for l.running < p.limit(pool) && l.waiting.Len() > 0 {
+		w := heap.Pop(&l.waiting).(*waiter)
+		queuedTasks.WithLabelValues(pool).Dec()
+
+		l.running++
+		runningTasks.WithLabelValues(pool).Inc()
+		close(w.granted)
+	}
+}
+
+// expiry returns a channel that is closed once the block height reaches the given expiry height.
+// Returns a nil channel, which is never ready, for tasks that never expire.
+func (p *Pool) expiry(expiresAt int64) <-chan struct{} {
+	if expiresAt <= 0 {
+		return nil
+	}
+
+	expired, ok := p.expiries[expiresAt]
+	if !ok {
+		expired = make(chan struct{})
+		p.expiries[expiresAt] = expired
+	}
+
+	return expired
+}
+
+// limit returns the concurrency of the named pool, at least one task must be able to run
+func (p *Pool) limit(pool string) int {
+	if concurrency := p.concurrency(pool); concurrency > 0 {
+		return concurrency
+	}
+
+	return 1
+}
+
+func (p *Pool) limiter(pool string) *limiter {
+	l, ok := p.limiters[pool]
+	if !ok {
+		l = &limiter{}
+		p.limiters[pool] = l
+	}
+
+	return l
+}
+
+type limiter struct {
+	running int
+	waiting waitQueue
+}
+
+type waiter struct {
+	expiresAt int64
+	// seq keeps tasks with the same expiry in submission order
+	seq     uint64
+	index   int
+	granted chan struct{}
+}
+
+func (w *waiter) priority() int64 {
+	if w.expiresAt <= 0 {
+		return math.MaxInt64
+	}
+
+	return w.expiresAt
+}
+
+// waitQueue implements heap.Interface, ordered by expiry height
+type waitQueue []*waiter
+
+func (q waitQueue) Len() int { return len(q) }
+
+func (q waitQueue) Less(i, j int) bool {
+	if q[i].priority() != q[j].priority() {
+		return q[i].priority() < q[j].priority()
+	}
+
+	return q[i].seq < q[j].seq
+}
+
+func (q waitQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waitQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waitQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+
+	return w
+}
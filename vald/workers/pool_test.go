@@ -0,0 +1,141 @@
+package workers_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/workers"
+)
+
+// blockingTask returns a task that signals when it starts and blocks until released
+func blockingTask(started chan<- string, name string, release <-chan struct{}) func() error {
+	return func() error {
+		started <- name
+		<-release
+		return nil
+	}
+}
+
+func TestPool_LimitsConcurrencyPerPool(t *testing.T) {
+	pool := workers.NewPool(func(string) int { return 1 })
+
+	started := make(chan string, 3)
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"ethereum", "avalanche"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			assert.NoError(t, pool.Do(name, 0, blockingTask(started, name, release)))
+		}(name)
+	}
+
+	// a slow chain does not block other chains
+	assert.ElementsMatch(t, []string{"ethereum", "avalanche"}, []string{<-started, <-started})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, pool.Do("ethereum", 0, blockingTask(started, "ethereum 2", release)))
+	}()
+
+	select {
+	case name := <-started:
+		assert.FailNow(t, "task must wait for a free worker", name)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	assert.Equal(t, "ethereum 2", <-started)
+	wg.Wait()
+}
+
+func TestPool_PrioritizesByExpiry(t *testing.T) {
+	pool := workers.NewPool(func(string) int { return 1 })
+
+	started := make(chan string, 4)
+	release := make(chan struct{})
+	first := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, pool.Do("ethereum", 100, blockingTask(started, "running", first)))
+	}()
+	assert.Equal(t, "running", <-started)
+
+	for _, task := range []struct {
+		name      string
+		expiresAt int64
+	}{{"late", 300}, {"never", 0}, {"early", 200}} {
+		wg.Add(1)
+		go func(name string, expiresAt int64) {
+			defer wg.Done()
+			assert.NoError(t, pool.Do("ethereum", expiresAt, blockingTask(started, name, release)))
+		}(task.name, task.expiresAt)
+
+		// make sure the tasks are queued in the given order
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(release)
+	close(first)
+	assert.Equal(t, []string{"early", "late", "never"}, []string{<-started, <-started, <-started})
+	wg.Wait()
+}
+
+func TestPool_Expiry(t *testing.T) {
+	t.Run("rejects expired tasks", func(t *testing.T) {
+		pool := workers.NewPool(func(string) int { return 1 })
+		pool.SetHeight(100)
+
+		err := pool.Do("ethereum", 100, func() error { panic("must not run") })
+		assert.ErrorIs(t, err, workers.ErrExpired)
+	})
+
+	t.Run("abandons running and waiting tasks once they expire", func(t *testing.T) {
+		pool := workers.NewPool(func(string) int { return 1 })
+		pool.SetHeight(10)
+
+		started := make(chan string, 2)
+		release := make(chan struct{})
+		defer close(release)
+
+		running := make(chan error, 1)
+		go func() { running <- pool.Do("ethereum", 20, blockingTask(started, "running", release)) }()
+		assert.Equal(t, "running", <-started)
+
+		waiting := make(chan error, 1)
+		go func() { waiting <- pool.Do("ethereum", 15, blockingTask(started, "waiting", release)) }()
+		time.Sleep(10 * time.Millisecond)
+
+		pool.SetHeight(15)
+		assert.ErrorIs(t, <-waiting, workers.ErrExpired)
+
+		pool.SetHeight(20)
+		assert.ErrorIs(t, <-running, workers.ErrExpired)
+
+		// the abandoned task no longer holds the worker
+		assert.NoError(t, pool.Do("ethereum", 30, func() error { return nil }))
+	})
+
+	t.Run("returns task errors", func(t *testing.T) {
+		pool := workers.NewPool(func(string) int { return 1 })
+		taskErr := errors.New("rpc unavailable")
+
+		assert.ErrorIs(t, pool.Do("ethereum", 0, func() error { return taskErr }), taskErr)
+	})
+
+	t.Run("propagates panics", func(t *testing.T) {
+		pool := workers.NewPool(func(string) int { return 1 })
+
+		assert.Panics(t, func() { _ = pool.Do("ethereum", 0, func() error { panic("boom") }) })
+		assert.NoError(t, pool.Do("ethereum", 0, func() error { return nil }))
+	})
+}
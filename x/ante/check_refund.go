@@ -91,7 +91,7 @@ func (d CheckRefundFeeDecorator) validateRefundQualification(ctx sdk.Context, ms
 			}
 
 			sender := msg.GetSigners()[0]
-			operatorAddr := d.snapshotter.GetOperator(ctx, sender)
+			operatorAddr := d.snapshotter.GetOperatorForLane(ctx, sender)
 			if operatorAddr == nil {
 				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "signer is not a registered proxy")
 			}
@@ -27,7 +27,7 @@ type Nexus interface {
 
 // Snapshotter provides access to the snapshot functionality
 type Snapshotter interface {
-	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 	GetProxy(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool)
 }
 
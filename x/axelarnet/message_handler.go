@@ -315,7 +315,7 @@ func handleTokenSent(ctx sdk.Context, n types.Nexus, b types.BankKeeper, sourceA
 		return err
 	}
 
-	transferID, err := n.EnqueueTransfer(ctx, sourceAddress.Chain, crossChainAddr, token.Coin)
+	transferID, err := n.EnqueueTransfer(ctx, sourceAddress, crossChainAddr, token.Coin)
 	if err != nil {
 		return err
 	}
@@ -103,6 +103,9 @@ func TestHandleMessage(t *testing.T) {
 			RateLimitTransferFunc: func(ctx sdk.Context, chain nexus.ChainName, asset sdk.Coin, direction nexus.TransferDirection) error {
 				return nil
 			},
+			RateLimitAddressTransferFunc: func(ctx sdk.Context, address nexus.CrossChainAddress, asset sdk.Coin, direction nexus.TransferDirection) error {
+				return nil
+			},
 			GetChainByNativeAssetFunc: func(ctx sdk.Context, asset string) (nexus.Chain, bool) {
 				return srcChain, true
 			},
@@ -509,6 +512,9 @@ func TestHandleMessageWithToken(t *testing.T) {
 			RateLimitTransferFunc: func(ctx sdk.Context, chain nexus.ChainName, asset sdk.Coin, direction nexus.TransferDirection) error {
 				return nil
 			},
+			RateLimitAddressTransferFunc: func(ctx sdk.Context, address nexus.CrossChainAddress, asset sdk.Coin, direction nexus.TransferDirection) error {
+				return nil
+			},
 		}
 		ibcK = keeper.NewIBCKeeper(k, &mock.IBCTransferKeeperMock{
 			GetDenomTraceFunc: func(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
@@ -713,7 +719,7 @@ func TestHandleSendToken(t *testing.T) {
 			GetChainByNativeAssetFunc: func(ctx sdk.Context, asset string) (nexus.Chain, bool) {
 				return srcChain, true
 			},
-			EnqueueTransferFunc: func(ctx sdk.Context, senderChain nexus.Chain, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
+			EnqueueTransferFunc: func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
 				return nexustestutils.RandomTransferID(), nil
 			},
 			GenerateMessageIDFunc: func(ctx sdk.Context) (string, []byte, uint64) {
@@ -723,6 +729,9 @@ func TestHandleSendToken(t *testing.T) {
 			RateLimitTransferFunc: func(ctx sdk.Context, chain nexus.ChainName, asset sdk.Coin, direction nexus.TransferDirection) error {
 				return nil
 			},
+			RateLimitAddressTransferFunc: func(ctx sdk.Context, address nexus.CrossChainAddress, asset sdk.Coin, direction nexus.TransferDirection) error {
+				return nil
+			},
 		}
 		ibcK = keeper.NewIBCKeeper(k, &mock.IBCTransferKeeperMock{
 			GetDenomTraceFunc: func(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
@@ -769,7 +778,7 @@ func TestHandleSendToken(t *testing.T) {
 	}
 
 	whenEnqueueTransferFailed := func() {
-		n.EnqueueTransferFunc = func(ctx sdk.Context, senderChain nexus.Chain, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
+		n.EnqueueTransferFunc = func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
 			return 0, fmt.Errorf("enqueue transfer failed")
 		}
 	}
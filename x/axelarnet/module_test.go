@@ -156,6 +156,9 @@ func TestIBCModule(t *testing.T) {
 		n.RateLimitTransferFunc = func(ctx sdk.Context, chain exported.ChainName, asset sdk.Coin, direction exported.TransferDirection) error {
 			return nil
 		}
+		n.RateLimitAddressTransferFunc = func(ctx sdk.Context, address exported.CrossChainAddress, asset sdk.Coin, direction exported.TransferDirection) error {
+			return nil
+		}
 	})
 
 	givenAnIBCModule.
@@ -33,6 +33,7 @@ func NewRateLimiter(keeper *keeper.Keeper, nexus types.Nexus) RateLimiter {
 // RateLimitPacket implements rate limiting of IBC packets
 // - If the IBC channel that the packet is sent on is a registered chain, check the activation status.
 // - If the packet is an ICS-20 coin transfer, apply rate limiting on (chain, base denom) pair.
+// - Additionally, apply the rate limit of the packet's sender (incoming) or receiver (outgoing) on the counterparty chain.
 // - If the rate limit is exceeded, an error is returned.
 // Incoming direction is used for tokens incoming to Axelar (unlocked from IBC escrow/minted as an IBC denom).
 // Outgoing direction is used for tokens going out from Axelar (locked in the IBC escrow/burned as an IBC denom).
@@ -57,6 +58,15 @@ func (r RateLimiter) RateLimitPacket(ctx sdk.Context, packet ibcexported.PacketI
 		return err
 	}
 
+	address, err := parseCounterpartyAddressFromPacket(packet, direction)
+	if err != nil {
+		return err
+	}
+
+	if err := r.nexus.RateLimitAddressTransfer(ctx, nexus.CrossChainAddress{Chain: chain, Address: address}, token, direction); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -129,3 +139,18 @@ func parseTokenFromPacket(packet ibcexported.PacketI) (sdk.Coin, error) {
 
 	return token, nil
 }
+
+// parseCounterpartyAddressFromPacket returns the address on the counterparty chain,
+// i.e. the sender of incoming packets and the receiver of outgoing packets
+func parseCounterpartyAddressFromPacket(packet ibcexported.PacketI, direction nexus.TransferDirection) (string, error) {
+	data, err := types.ToICS20Packet(packet)
+	if err != nil {
+		return "", err
+	}
+
+	if direction == nexus.Incoming {
+		return data.Sender, nil
+	}
+
+	return data.Receiver, nil
+}
@@ -101,6 +101,9 @@ func TestRateLimitPacket(t *testing.T) {
 		n.IsChainActivatedFunc = func(ctx sdk.Context, chain nexus.Chain) bool {
 			return true
 		}
+		n.RateLimitAddressTransferFunc = func(ctx sdk.Context, address nexus.CrossChainAddress, asset sdk.Coin, direction nexus.TransferDirection) error {
+			return nil
+		}
 	})
 
 	givenKeeper.
@@ -189,6 +192,33 @@ func TestRateLimitPacket(t *testing.T) {
 		}).
 		Run(t, repeats)
 
+	givenKeeper.
+		Given2(givenPacket).
+		When2(whenIBCPathIsRegistered).
+		When2(whenChainIsRegistered).
+		When("address rate limit exceeded", func() {
+			n.RateLimitTransferFunc = func(ctx sdk.Context, chain nexus.ChainName, asset sdk.Coin, direction nexus.TransferDirection) error {
+				return nil
+			}
+			n.RateLimitAddressTransferFunc = func(ctx sdk.Context, address nexus.CrossChainAddress, asset sdk.Coin, direction nexus.TransferDirection) error {
+				return fmt.Errorf("address rate limit exceeded")
+			}
+		}).
+		Then("rate limit packet fails for the counterparty address", func(t *testing.T) {
+			err = rateLimiter.RateLimitPacket(ctx, packet, direction, ibcPath)
+			assert.ErrorContains(t, err, "address rate limit exceeded")
+
+			assert.Len(t, n.RateLimitAddressTransferCalls(), 1)
+			address := n.RateLimitAddressTransferCalls()[0].Address
+			assert.Equal(t, chain, address.Chain.Name)
+			if direction == nexus.Incoming {
+				assert.Equal(t, transfer.Sender, address.Address)
+			} else {
+				assert.Equal(t, transfer.Receiver, address.Address)
+			}
+		}).
+		Run(t, repeats)
+
 	givenKeeper.
 		Given("a packet with opposite channel id", func() {
 			port = rand.StrBetween(10, 20)
@@ -572,7 +572,7 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 //			EnqueueForTransferFunc: func(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, amount cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
 //				panic("mock out the EnqueueForTransfer method")
 //			},
-//			EnqueueTransferFunc: func(ctx cosmossdktypes.Context, senderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
+//			EnqueueTransferFunc: func(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
 //				panic("mock out the EnqueueTransfer method")
 //			},
 //			GenerateMessageIDFunc: func(ctx cosmossdktypes.Context) (string, []byte, uint64) {
@@ -605,6 +605,9 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 //			LinkAddressesFunc: func(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress) error {
 //				panic("mock out the LinkAddresses method")
 //			},
+//			RateLimitAddressTransferFunc: func(ctx cosmossdktypes.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
+//				panic("mock out the RateLimitAddressTransfer method")
+//			},
 //			RateLimitTransferFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
 //				panic("mock out the RateLimitTransfer method")
 //			},
@@ -649,7 +652,7 @@ type NexusMock struct {
 	EnqueueForTransferFunc func(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, amount cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error)
 
 	// EnqueueTransferFunc mocks the EnqueueTransfer method.
-	EnqueueTransferFunc func(ctx cosmossdktypes.Context, senderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error)
+	EnqueueTransferFunc func(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error)
 
 	// GenerateMessageIDFunc mocks the GenerateMessageID method.
 	GenerateMessageIDFunc func(ctx cosmossdktypes.Context) (string, []byte, uint64)
@@ -681,6 +684,9 @@ type NexusMock struct {
 	// LinkAddressesFunc mocks the LinkAddresses method.
 	LinkAddressesFunc func(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress) error
 
+	// RateLimitAddressTransferFunc mocks the RateLimitAddressTransfer method.
+	RateLimitAddressTransferFunc func(ctx cosmossdktypes.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error
+
 	// RateLimitTransferFunc mocks the RateLimitTransfer method.
 	RateLimitTransferFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error
 
@@ -737,8 +743,8 @@ type NexusMock struct {
 		EnqueueTransfer []struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
-			// SenderChain is the senderChain argument value.
-			SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
+			// Sender is the sender argument value.
+			Sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
 			// Recipient is the recipient argument value.
 			Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
 			// Asset is the asset argument value.
@@ -818,6 +824,17 @@ type NexusMock struct {
 			// Recipient is the recipient argument value.
 			Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
 		}
+		// RateLimitAddressTransfer holds details about calls to the RateLimitAddressTransfer method.
+		RateLimitAddressTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Address is the address argument value.
+			Address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+			// Asset is the asset argument value.
+			Asset cosmossdktypes.Coin
+			// Direction is the direction argument value.
+			Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+		}
 		// RateLimitTransfer holds details about calls to the RateLimitTransfer method.
 		RateLimitTransfer []struct {
 			// Ctx is the ctx argument value.
@@ -908,6 +925,7 @@ type NexusMock struct {
 	lockIsAssetRegistered             sync.RWMutex
 	lockIsChainActivated              sync.RWMutex
 	lockLinkAddresses                 sync.RWMutex
+	lockRateLimitAddressTransfer      sync.RWMutex
 	lockRateLimitTransfer             sync.RWMutex
 	lockRegisterAsset                 sync.RWMutex
 	lockRouteMessage                  sync.RWMutex
@@ -1032,25 +1050,25 @@ func (mock *NexusMock) EnqueueForTransferCalls() []struct {
 }
 
 // EnqueueTransfer calls EnqueueTransferFunc.
-func (mock *NexusMock) EnqueueTransfer(ctx cosmossdktypes.Context, senderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
+func (mock *NexusMock) EnqueueTransfer(ctx cosmossdktypes.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
 	if mock.EnqueueTransferFunc == nil {
 		panic("NexusMock.EnqueueTransferFunc: method is nil but Nexus.EnqueueTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx         cosmossdktypes.Context
-		SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
-		Recipient   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
-		Asset       cosmossdktypes.Coin
+		Ctx       cosmossdktypes.Context
+		Sender    github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     cosmossdktypes.Coin
 	}{
-		Ctx:         ctx,
-		SenderChain: senderChain,
-		Recipient:   recipient,
-		Asset:       asset,
+		Ctx:       ctx,
+		Sender:    sender,
+		Recipient: recipient,
+		Asset:     asset,
 	}
 	mock.lockEnqueueTransfer.Lock()
 	mock.calls.EnqueueTransfer = append(mock.calls.EnqueueTransfer, callInfo)
 	mock.lockEnqueueTransfer.Unlock()
-	return mock.EnqueueTransferFunc(ctx, senderChain, recipient, asset)
+	return mock.EnqueueTransferFunc(ctx, sender, recipient, asset)
 }
 
 // EnqueueTransferCalls gets all the calls that were made to EnqueueTransfer.
@@ -1058,16 +1076,16 @@ func (mock *NexusMock) EnqueueTransfer(ctx cosmossdktypes.Context, senderChain g
 //
 //	len(mockedNexus.EnqueueTransferCalls())
 func (mock *NexusMock) EnqueueTransferCalls() []struct {
-	Ctx         cosmossdktypes.Context
-	SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
-	Recipient   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
-	Asset       cosmossdktypes.Coin
+	Ctx       cosmossdktypes.Context
+	Sender    github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Asset     cosmossdktypes.Coin
 } {
 	var calls []struct {
-		Ctx         cosmossdktypes.Context
-		SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
-		Recipient   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
-		Asset       cosmossdktypes.Coin
+		Ctx       cosmossdktypes.Context
+		Sender    github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     cosmossdktypes.Coin
 	}
 	mock.lockEnqueueTransfer.RLock()
 	calls = mock.calls.EnqueueTransfer
@@ -1443,6 +1461,50 @@ func (mock *NexusMock) LinkAddressesCalls() []struct {
 	return calls
 }
 
+// RateLimitAddressTransfer calls RateLimitAddressTransferFunc.
+func (mock *NexusMock) RateLimitAddressTransfer(ctx cosmossdktypes.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
+	if mock.RateLimitAddressTransferFunc == nil {
+		panic("NexusMock.RateLimitAddressTransferFunc: method is nil but Nexus.RateLimitAddressTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		Address   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     cosmossdktypes.Coin
+		Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+	}{
+		Ctx:       ctx,
+		Address:   address,
+		Asset:     asset,
+		Direction: direction,
+	}
+	mock.lockRateLimitAddressTransfer.Lock()
+	mock.calls.RateLimitAddressTransfer = append(mock.calls.RateLimitAddressTransfer, callInfo)
+	mock.lockRateLimitAddressTransfer.Unlock()
+	return mock.RateLimitAddressTransferFunc(ctx, address, asset, direction)
+}
+
+// RateLimitAddressTransferCalls gets all the calls that were made to RateLimitAddressTransfer.
+// Check the length with:
+//
+//	len(mockedNexus.RateLimitAddressTransferCalls())
+func (mock *NexusMock) RateLimitAddressTransferCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	Address   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Asset     cosmossdktypes.Coin
+	Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		Address   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     cosmossdktypes.Coin
+		Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+	}
+	mock.lockRateLimitAddressTransfer.RLock()
+	calls = mock.calls.RateLimitAddressTransfer
+	mock.lockRateLimitAddressTransfer.RUnlock()
+	return calls
+}
+
 // RateLimitTransfer calls RateLimitTransferFunc.
 func (mock *NexusMock) RateLimitTransfer(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
 	if mock.RateLimitTransferFunc == nil {
@@ -41,9 +41,10 @@ func handleTokenSent(ctx sdk.Context, event types.Event, bk types.BaseKeeper, n
 		}
 	}
 
+	sender := nexus.CrossChainAddress{Chain: sourceChain, Address: e.Sender.Hex()}
 	recipient := nexus.CrossChainAddress{Chain: destinationChain, Address: e.DestinationAddress}
 	amount := sdk.NewCoin(asset, sdk.Int(e.Amount))
-	transferID, err := n.EnqueueTransfer(ctx, sourceChain, recipient, amount)
+	transferID, err := n.EnqueueTransfer(ctx, sender, recipient, amount)
 	if err != nil {
 		return sdkerrors.Wrap(err, "failed enqueuing transfer for event")
 	}
@@ -286,6 +287,15 @@ func handleConfirmDeposit(ctx sdk.Context, event types.Event, bk types.BaseKeepe
 	}
 
 	amount := sdk.NewCoin(burnerInfo.Asset, sdk.NewIntFromBigInt(e.Amount.BigInt()))
+	// anyone can link new deposit addresses, so the rate limit of the deposit address alone does not bound the transfers of a sender.
+	// Transfers confirmed before the sender was added to the event have no sender
+	if !e.From.IsZeroAddress() {
+		sender := nexus.CrossChainAddress{Chain: chain, Address: e.From.Hex()}
+		if err := n.RateLimitAddressTransfer(ctx, sender, amount, nexus.Incoming); err != nil {
+			return err
+		}
+	}
+
 	transferID, err := n.EnqueueForTransfer(ctx, depositAddr, amount)
 	if err != nil {
 		return err
@@ -776,7 +776,7 @@ func TestHandleTokenSent(t *testing.T) {
 
 	whenTokensAreConfirmed.
 		When("failed to enqueue the transfer", func() {
-			n.EnqueueTransferFunc = func(ctx sdk.Context, senderChain nexus.Chain, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
+			n.EnqueueTransferFunc = func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
 				return 0, fmt.Errorf("err")
 			}
 		}).
@@ -785,7 +785,7 @@ func TestHandleTokenSent(t *testing.T) {
 
 	whenTokensAreConfirmed.
 		When("enqueue the transfer", func() {
-			n.EnqueueTransferFunc = func(ctx sdk.Context, senderChain nexus.Chain, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
+			n.EnqueueTransferFunc = func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
 				return 0, nil
 			}
 		}).
@@ -800,7 +800,7 @@ func TestHandleTokenSent(t *testing.T) {
 		When("destination chain is not an evm chain", isDestinationChainEvm(false)).
 		When("token is confirmed on the source chain", tokenRegisteredOnChain(&sourceCk, true)).
 		When("enqueue the transfer", func() {
-			n.EnqueueTransferFunc = func(ctx sdk.Context, senderChain nexus.Chain, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
+			n.EnqueueTransferFunc = func(ctx sdk.Context, sender nexus.CrossChainAddress, recipient nexus.CrossChainAddress, asset sdk.Coin) (nexus.TransferID, error) {
 				return 0, nil
 			}
 		}).
@@ -1207,12 +1207,14 @@ func TestHandleConfirmDeposit(t *testing.T) {
 			Index: uint64(rand.PosI64()),
 			Event: &types.Event_Transfer{
 				Transfer: &types.EventTransfer{
+					From:   evmTestUtils.RandomAddress(),
 					To:     evmTestUtils.RandomAddress(),
 					Amount: sdk.NewUint(uint64(rand.I64Between(1, 10000))),
 				},
 			},
 		}
 		ctx, bk, n, _, sourceCk, _ = setup()
+		n.RateLimitAddressTransferFunc = func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, nexus.TransferDirection) error { return nil }
 
 		bk.ForChainFunc = func(_ sdk.Context, chain nexus.ChainName) (types.ChainKeeper, error) {
 			return sourceCk, nil
@@ -1317,6 +1319,23 @@ func TestHandleConfirmDeposit(t *testing.T) {
 		}).
 		Run(t)
 
+	givenTransferEvent.
+		When("burner info found", burnerInfoFound(true)).
+		When("recipient found", recipientFound(true)).
+		When("deposit does not exist", depositFound(false)).
+		When("the sender of the transfer exceeds its rate limit", func() {
+			n.RateLimitAddressTransferFunc = func(sdk.Context, nexus.CrossChainAddress, sdk.Coin, nexus.TransferDirection) error {
+				return fmt.Errorf("rate limit exceeded")
+			}
+		}).
+		Then("should fail", func(t *testing.T) {
+			err := handleConfirmDeposit(ctx, event, bk, n)
+			assert.ErrorContains(t, err, "rate limit exceeded")
+			assert.Len(t, n.EnqueueForTransferCalls(), 0)
+			assert.Len(t, sourceCk.SetDepositCalls(), 0)
+		}).
+		Run(t)
+
 	givenTransferEvent.
 		When("burner info found", burnerInfoFound(true)).
 		When("recipient found", recipientFound(true)).
@@ -1327,6 +1346,11 @@ func TestHandleConfirmDeposit(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Len(t, n.EnqueueForTransferCalls(), 1)
 			assert.Len(t, sourceCk.SetDepositCalls(), 1)
+
+			assert.Len(t, n.RateLimitAddressTransferCalls(), 1)
+			assert.Equal(t, sourceChainName, n.RateLimitAddressTransferCalls()[0].Address.Chain.Name)
+			assert.Equal(t, event.GetTransfer().From.Hex(), n.RateLimitAddressTransferCalls()[0].Address.Address)
+			assert.Equal(t, nexus.Incoming, n.RateLimitAddressTransferCalls()[0].Direction)
 		}).
 		Run(t)
 }
@@ -376,7 +376,27 @@ func (q Querier) PendingCommands(c context.Context, req *types.PendingCommandsRe
 		commands = append(commands, cmdResp)
 	}
 
-	return &types.PendingCommandsResponse{Commands: commands}, nil
+	return &types.PendingCommandsResponse{Commands: commands, NextBatch: predictBatchToSign(ctx, ck)}, nil
+}
+
+// predictBatchToSign returns the command batch the next sign commands request would sign, or nil if there is none.
+// The batch is created in a cached context that is discarded, so the state is not changed.
+func predictBatchToSign(ctx sdk.Context, ck types.ChainKeeper) *types.PendingCommandBatch {
+	if _, ok := ck.GetChainID(ctx); !ok {
+		return nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	batch, err := getCommandBatchToSign(cacheCtx, ck)
+	if err != nil || len(batch.GetCommandIDs()) == 0 {
+		return nil
+	}
+
+	return &types.PendingCommandBatch{
+		KeyID:   batch.GetKeyID(),
+		Data:    batch.GetData(),
+		SigHash: batch.GetSigHash().Bytes(),
+	}
 }
 
 func queryAddressByKeyID(ctx sdk.Context, multisig types.MultisigKeeper, chain nexustypes.Chain, keyID multisig.KeyID) (types.KeyAddressResponse, error) {
@@ -15,6 +15,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	"github.com/axelarnetwork/axelar-core/x/evm/exported"
@@ -45,7 +46,7 @@ func TestQueryPendingCommands(t *testing.T) {
 	)
 
 	setup := func() {
-		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
 		evmChain = nexus.ChainName(rand.StrBetween(5, 10))
 		asset = rand.Str(5)
 		symbol = rand.Str(5)
@@ -67,6 +68,12 @@ func TestQueryPendingCommands(t *testing.T) {
 			GetPendingCommandsFunc: func(sdk.Context) []types.Command {
 				return cmds
 			},
+			GetChainIDFunc:            func(sdk.Context) (sdk.Int, bool) { return chainID, true },
+			GetLatestCommandBatchFunc: func(sdk.Context) types.CommandBatch { return types.NonExistentCommand },
+			CreateNewBatchToSignFunc: func(sdk.Context) (types.CommandBatch, error) {
+				batch, err := types.NewCommandBatchMetadata(ctx.BlockHeight(), chainID, keyID, cmds)
+				return types.NewCommandBatch(batch, func(types.CommandBatchMetadata) {}), err
+			},
 		}
 
 		nexusKeeper = &mock.NexusMock{
@@ -108,6 +115,25 @@ func TestQueryPendingCommands(t *testing.T) {
 
 		assert.ElementsMatch(t, cmdResp, res.Commands)
 
+		expectedBatch, err := types.NewCommandBatchMetadata(ctx.BlockHeight(), chainID, keyID, cmds)
+		assert.NoError(t, err)
+		assert.Equal(t, keyID, res.NextBatch.KeyID)
+		assert.Equal(t, expectedBatch.Data, res.NextBatch.Data)
+		assert.Equal(t, expectedBatch.SigHash.Bytes(), res.NextBatch.SigHash)
+	}).Repeat(repeatCount))
+
+	t.Run("batch is being signed", testutils.Func(func(t *testing.T) {
+		setup()
+		chainKeeper.GetLatestCommandBatchFunc = func(sdk.Context) types.CommandBatch {
+			return types.NewCommandBatch(types.CommandBatchMetadata{ID: rand.Bytes(32), Status: types.BatchSigning}, func(types.CommandBatchMetadata) {})
+		}
+
+		q := evmKeeper.NewGRPCQuerier(baseKeeper, nexusKeeper, multisigKeeper)
+
+		res, err := q.PendingCommands(sdk.WrapSDKContext(ctx), &types.PendingCommandsRequest{Chain: evmChain.String()})
+		assert.NoError(t, err)
+		assert.Len(t, res.Commands, len(cmds))
+		assert.Nil(t, res.NextBatch)
 	}).Repeat(repeatCount))
 }
 
@@ -235,10 +235,26 @@ func (v voteHandler) handleContractCall(ctx sdk.Context, event types.Event) erro
 	}
 
 	// try routing the message
+	var err error
 	_ = utils.RunCached(ctx, v.keeper, func(ctx sdk.Context) (struct{}, error) {
-		return struct{}{}, v.nexus.RouteMessage(ctx, msg.ID)
+		err = v.nexus.RouteMessage(ctx, msg.ID)
+		return struct{}{}, err
 	})
 
+	// the message stays approved, so relayers need to know to route it again
+	if err != nil {
+		v.keeper.Logger(ctx).Info(fmt.Sprintf("failed to route general message %s: %s", msg.ID, err.Error()),
+			types.AttributeKeyChain, event.Chain.String(),
+			types.AttributeKeyMessageID, msg.ID,
+		)
+
+		events.Emit(ctx, &types.ContractCallRoutingFailed{
+			Chain:     event.Chain,
+			MessageID: msg.ID,
+			Reason:    err.Error(),
+		})
+	}
+
 	return nil
 }
 
@@ -10,11 +10,14 @@ import (
 	sdkstore "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils"
 	fakemock "github.com/axelarnetwork/axelar-core/testutils/fake/interfaces/mock"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
@@ -28,6 +31,7 @@ import (
 	rewardmock "github.com/axelarnetwork/axelar-core/x/reward/exported/mock"
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	votemock "github.com/axelarnetwork/axelar-core/x/vote/exported/mock"
+	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
@@ -360,6 +364,9 @@ func TestHandleResult(t *testing.T) {
 					assert.NoError(t, handler.HandleResult(ctx, result))
 					assert.Len(t, nexusK.SetNewMessageCalls(), 5)
 					assert.Len(t, nexusK.RouteMessageCalls(), 5)
+					assert.Empty(t, testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
+						return event.Type == proto.MessageName(&types.ContractCallRoutingFailed{})
+					}))
 				}),
 
 			When("event is contract call and is sent to an evm chain", func() {
@@ -369,15 +376,27 @@ func TestHandleResult(t *testing.T) {
 					chaink.SetConfirmedEventFunc = func(_ sdk.Context, _ types.Event) error { return nil }
 				}).
 				When("failed to route the general messages", func() {
-					nexusK.RouteMessageFunc = func(_ sdk.Context, _ string, _ ...nexus.RoutingContext) error { return fmt.Errorf("failed") }
+					nexusK.RouteMessageFunc = func(_ sdk.Context, _ string, _ ...nexus.RoutingContext) error {
+						return fmt.Errorf("message rate limit exceeded")
+					}
 				}).
-				Then("should set as approved general messages", func(t *testing.T) {
+				Then("should set as approved general messages and emit the reason routing failed", func(t *testing.T) {
 					nexusK.SetNewMessageFunc = func(_ sdk.Context, _ nexus.GeneralMessage) error { return nil }
 
 					assert.NoError(t, handler.HandleResult(ctx, result))
 					assert.Len(t, nexusK.SetNewMessageCalls(), 5)
 					assert.Len(t, nexusK.RouteMessageCalls(), 5)
 
+					routingFailed := testutils.Events(ctx.EventManager().ABCIEvents()).Filter(func(event abci.Event) bool {
+						return event.Type == proto.MessageName(&types.ContractCallRoutingFailed{})
+					})
+					assert.Len(t, routingFailed, 5)
+					for i, event := range routingFailed {
+						e := funcs.Must(sdk.ParseTypedEvent(event)).(*types.ContractCallRoutingFailed)
+						assert.Equal(t, exported.Ethereum.Name, e.Chain)
+						assert.Equal(t, nexusK.RouteMessageCalls()[i].ID, e.MessageID)
+						assert.Equal(t, "message rate limit exceeded", e.Reason)
+					}
 				}),
 
 			When("event is contract call and is sent to an non-evm chain", func() {
@@ -49,6 +49,9 @@ var (
 	uint8Type        = funcs.Must(abi.NewType("uint8", "uint8", nil))
 	uint256Type      = funcs.Must(abi.NewType("uint256", "uint256", nil))
 	uint256ArrayType = funcs.Must(abi.NewType("uint256[]", "uint256[]", nil))
+	bytes32ArrayType = funcs.Must(abi.NewType("bytes32[]", "bytes32[]", nil))
+	stringArrayType  = funcs.Must(abi.NewType("string[]", "string[]", nil))
+	bytesArrayType   = funcs.Must(abi.NewType("bytes[]", "bytes[]", nil))
 
 	deployTokenArguments                 = abi.Arguments{{Type: stringType}, {Type: stringType}, {Type: uint8Type}, {Type: uint256Type}, {Type: addressType}, {Type: uint256Type}}
 	mintTokenArguments                   = abi.Arguments{{Type: stringType}, {Type: addressType}, {Type: uint256Type}}
@@ -9,6 +9,14 @@ type EVMConfig struct {
 	WithBridge       bool                 `mapstructure:"start-with-bridge"`
 	L1ChainName      *string              `mapstructure:"l1_chain_name"` // Deprecated: Do not use.
 	FinalityOverride rpc.FinalityOverride `mapstructure:"finality_override"`
+	FallbackRPCAddrs []string             `mapstructure:"fallback_rpc_addrs"` // Additional endpoints of the same chain that are used for failover and quorum checks
+	RPCQuorum        int                  `mapstructure:"rpc_quorum"`         // Number of endpoints that must agree on receipts and finalized blocks. Values below 2 disable quorum checks
+	ReorgCheckDepth  uint64               `mapstructure:"reorg_check_depth"`  // Number of descendant blocks that must link back to a receipt's block before voting on it
+}
+
+// RPCAddrs returns all configured RPC endpoints, starting with the primary one
+func (c EVMConfig) RPCAddrs() []string {
+	return append([]string{c.RPCAddr}, c.FallbackRPCAddrs...)
 }
 
 // DefaultConfig returns a configuration populated with default values
@@ -1152,6 +1152,73 @@ func (*ContractCallFailed) XXX_MessageName() string {
 	return "axelar.evm.v1beta1.ContractCallFailed"
 }
 
+// ContractCallRoutingFailed is emitted when a confirmed contract call cannot be
+// routed right away, e.g. because a rate limit is exceeded. The message stays
+// approved and can be routed again with axelarnet's RouteMessage
+type ContractCallRoutingFailed struct {
+	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	MessageID string                                                          `protobuf:"bytes,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Reason    string                                                          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ContractCallRoutingFailed) Reset()         { *m = ContractCallRoutingFailed{} }
+func (m *ContractCallRoutingFailed) String() string { return proto.CompactTextString(m) }
+func (*ContractCallRoutingFailed) ProtoMessage()    {}
+func (*ContractCallRoutingFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_091c22a44ca6f0af, []int{19}
+}
+func (m *ContractCallRoutingFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallRoutingFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallRoutingFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCallRoutingFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallRoutingFailed.Merge(m, src)
+}
+func (m *ContractCallRoutingFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallRoutingFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallRoutingFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallRoutingFailed proto.InternalMessageInfo
+
+func (m *ContractCallRoutingFailed) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ContractCallRoutingFailed) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+func (m *ContractCallRoutingFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (*ContractCallRoutingFailed) XXX_MessageName() string {
+	return "axelar.evm.v1beta1.ContractCallRoutingFailed"
+}
+
 type ContractCallWithMintApproved struct {
 	Chain            github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	EventID          EventID                                                         `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,casttype=EventID" json:"event_id,omitempty"`
@@ -1167,7 +1234,7 @@ func (m *ContractCallWithMintApproved) Reset()         { *m = ContractCallWithMi
 func (m *ContractCallWithMintApproved) String() string { return proto.CompactTextString(m) }
 func (*ContractCallWithMintApproved) ProtoMessage()    {}
 func (*ContractCallWithMintApproved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{19}
+	return fileDescriptor_091c22a44ca6f0af, []int{20}
 }
 func (m *ContractCallWithMintApproved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1323,7 @@ func (m *TokenSent) Reset()         { *m = TokenSent{} }
 func (m *TokenSent) String() string { return proto.CompactTextString(m) }
 func (*TokenSent) ProtoMessage()    {}
 func (*TokenSent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{20}
+	return fileDescriptor_091c22a44ca6f0af, []int{21}
 }
 func (m *TokenSent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1351,7 +1418,7 @@ func (m *MintCommand) Reset()         { *m = MintCommand{} }
 func (m *MintCommand) String() string { return proto.CompactTextString(m) }
 func (*MintCommand) ProtoMessage()    {}
 func (*MintCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{21}
+	return fileDescriptor_091c22a44ca6f0af, []int{22}
 }
 func (m *MintCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,7 +1498,7 @@ func (m *BurnCommand) Reset()         { *m = BurnCommand{} }
 func (m *BurnCommand) String() string { return proto.CompactTextString(m) }
 func (*BurnCommand) ProtoMessage()    {}
 func (*BurnCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{22}
+	return fileDescriptor_091c22a44ca6f0af, []int{23}
 }
 func (m *BurnCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1511,6 +1578,7 @@ func init() {
 	proto.RegisterType((*EVMEventRetryFailed)(nil), "axelar.evm.v1beta1.EVMEventRetryFailed")
 	proto.RegisterType((*ContractCallApproved)(nil), "axelar.evm.v1beta1.ContractCallApproved")
 	proto.RegisterType((*ContractCallFailed)(nil), "axelar.evm.v1beta1.ContractCallFailed")
+	proto.RegisterType((*ContractCallRoutingFailed)(nil), "axelar.evm.v1beta1.ContractCallRoutingFailed")
 	proto.RegisterType((*ContractCallWithMintApproved)(nil), "axelar.evm.v1beta1.ContractCallWithMintApproved")
 	proto.RegisterType((*TokenSent)(nil), "axelar.evm.v1beta1.TokenSent")
 	proto.RegisterType((*MintCommand)(nil), "axelar.evm.v1beta1.MintCommand")
@@ -1520,87 +1588,88 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/events.proto", fileDescriptor_091c22a44ca6f0af) }
 
 var fileDescriptor_091c22a44ca6f0af = []byte{
-	// 1275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xf3, 0xd9, 0x4c, 0xd2, 0x2f, 0xb7, 0xec, 0x66, 0x2b, 0x14, 0x47, 0x11, 0x12, 0x41,
-	0xa2, 0x0e, 0x5d, 0x58, 0x09, 0xf1, 0x21, 0xa8, 0x93, 0xc2, 0x46, 0xab, 0x56, 0x2b, 0x6f, 0x59,
-	0x04, 0x42, 0xaa, 0x26, 0xf6, 0x34, 0xb1, 0x6a, 0x7b, 0x2c, 0xcf, 0x34, 0x9b, 0xdc, 0xe0, 0xc6,
-	0x91, 0x0b, 0x57, 0xc4, 0x3f, 0x00, 0x87, 0x95, 0x10, 0xff, 0x42, 0xb9, 0xf5, 0xb8, 0xe2, 0x60,
-	0xa1, 0xf4, 0x80, 0xb4, 0xe2, 0xcc, 0xa1, 0x08, 0x09, 0x79, 0x3c, 0x8e, 0x9d, 0xb6, 0xa8, 0xdd,
-	0xa5, 0x59, 0xb2, 0xed, 0x9e, 0xe2, 0x99, 0x79, 0xf3, 0xe6, 0xf7, 0xde, 0xef, 0xbd, 0x99, 0x37,
-	0x13, 0x20, 0xc1, 0x1e, 0x32, 0xa1, 0x5b, 0x43, 0x5d, 0xab, 0xd6, 0x5d, 0x6d, 0x21, 0x0a, 0x57,
-	0x6b, 0xa8, 0x8b, 0x6c, 0x4a, 0x64, 0xc7, 0xc5, 0x14, 0x8b, 0x62, 0x20, 0x20, 0xa3, 0xae, 0x25,
-	0x73, 0x81, 0xe5, 0xa5, 0x36, 0x6e, 0x63, 0x36, 0x5c, 0xf3, 0xbf, 0x02, 0xc9, 0xe5, 0x2a, 0x57,
-	0xd5, 0xc5, 0x14, 0xd5, 0x50, 0xcf, 0xc1, 0x2e, 0x45, 0xfa, 0x50, 0x29, 0xed, 0x3b, 0x88, 0xeb,
-	0x5c, 0x2e, 0x9d, 0xb2, 0xe8, 0xc8, 0xb8, 0x86, 0x89, 0x85, 0x49, 0xad, 0x05, 0x09, 0x1a, 0x0a,
-	0x68, 0xd8, 0xb0, 0x83, 0xf1, 0xca, 0x91, 0x00, 0xc0, 0x5d, 0x6c, 0x9a, 0x1f, 0x41, 0xc3, 0x44,
-	0xba, 0xf8, 0x1a, 0x48, 0xd3, 0xde, 0xb6, 0xa1, 0x17, 0x85, 0xb2, 0x50, 0x2d, 0x28, 0x4b, 0xfb,
-	0x9e, 0x34, 0xf5, 0xab, 0x27, 0xa5, 0x6e, 0x43, 0xd2, 0x19, 0x78, 0x52, 0x6a, 0xab, 0xd7, 0x6c,
-	0xa8, 0x29, 0xda, 0x6b, 0xea, 0xe2, 0x67, 0x20, 0xad, 0x75, 0xa0, 0x61, 0x17, 0x13, 0x65, 0xa1,
-	0x9a, 0x53, 0xea, 0x47, 0x9e, 0xf4, 0x41, 0xdb, 0xa0, 0x9d, 0xbd, 0x96, 0xac, 0x61, 0xab, 0x16,
-	0xe0, 0xb2, 0x11, 0x7d, 0x80, 0xdd, 0x5d, 0xde, 0x5a, 0xd1, 0xb0, 0x8b, 0x6a, 0xbd, 0x9a, 0x8d,
-	0x7a, 0x7b, 0x64, 0x68, 0x97, 0x5c, 0xf7, 0xd5, 0x6c, 0x42, 0x0b, 0xa9, 0x81, 0x46, 0x71, 0x07,
-	0x64, 0x1d, 0x6c, 0x9a, 0x3e, 0x8e, 0x64, 0x59, 0xa8, 0xa6, 0x94, 0x0d, 0x8e, 0xe3, 0xdd, 0x73,
-	0x2e, 0x30, 0xe2, 0x37, 0xd9, 0xb7, 0xaf, 0xd9, 0x18, 0x78, 0x52, 0x26, 0xf8, 0x52, 0x33, 0xbe,
-	0xf6, 0xa6, 0x5e, 0xf9, 0x4b, 0x00, 0x79, 0xbf, 0x6b, 0xbd, 0xe7, 0x18, 0xee, 0x95, 0xb3, 0xfe,
-	0x6f, 0x01, 0xcc, 0xf8, 0x5d, 0x75, 0x6c, 0x39, 0x26, 0xa2, 0x57, 0xce, 0xfe, 0xaf, 0x12, 0x60,
-	0x61, 0x13, 0xaf, 0xb3, 0x0c, 0xad, 0x63, 0x7b, 0xc7, 0x70, 0xad, 0x2b, 0xe7, 0x83, 0xc7, 0x09,
-	0x70, 0x83, 0xdb, 0x7e, 0x07, 0xf5, 0xb7, 0x5c, 0x68, 0x93, 0x1d, 0xe4, 0xde, 0xa3, 0xd0, 0x9f,
-	0x16, 0x19, 0x28, 0x5c, 0xb8, 0x81, 0x43, 0x37, 0x27, 0xce, 0x74, 0xf3, 0xdb, 0x60, 0xae, 0x0d,
-	0x29, 0x7a, 0x00, 0xfb, 0xdb, 0x50, 0xd7, 0x5d, 0x44, 0x08, 0xf3, 0x49, 0x41, 0x99, 0xe3, 0x93,
-	0xb2, 0x6b, 0x41, 0xb7, 0x3a, 0xcb, 0xe5, 0x78, 0x5b, 0xac, 0x81, 0x45, 0x2d, 0x30, 0x0e, 0x52,
-	0x03, 0xdb, 0xdb, 0x1d, 0x64, 0xb4, 0x3b, 0xb4, 0x98, 0xf2, 0x3d, 0xaa, 0x8a, 0xf1, 0xa1, 0xdb,
-	0x6c, 0x44, 0xfc, 0x02, 0x14, 0x1c, 0xe8, 0x52, 0x43, 0x33, 0x1c, 0x68, 0x53, 0x52, 0x4c, 0x97,
-	0x85, 0x6a, 0xfe, 0xa6, 0x2c, 0xf3, 0x8d, 0xdb, 0x77, 0xaa, 0x3c, 0xb4, 0x89, 0xef, 0xa6, 0xcc,
-	0xb9, 0x77, 0x63, 0xb3, 0x94, 0x69, 0x1f, 0xd7, 0x81, 0x27, 0x09, 0xea, 0x88, 0xb6, 0xca, 0x1f,
-	0x09, 0x70, 0x9d, 0x3b, 0xfb, 0xe3, 0x00, 0xe8, 0x56, 0x2f, 0x74, 0xf5, 0x64, 0x84, 0xdd, 0x65,
-	0x71, 0xf5, 0x3b, 0x89, 0xa2, 0x50, 0xf9, 0x9e, 0xef, 0xee, 0x1b, 0xd0, 0x71, 0x0c, 0xbb, 0xfd,
-	0x24, 0x2e, 0x8e, 0xa5, 0x5f, 0x62, 0x9c, 0xe9, 0xf7, 0x5d, 0x12, 0x14, 0x8f, 0x47, 0x04, 0x09,
-	0x43, 0x02, 0x81, 0x19, 0x06, 0xc2, 0x0a, 0xf0, 0x93, 0xa2, 0x50, 0x4e, 0x56, 0xf3, 0x37, 0x25,
-	0xf9, 0x64, 0x19, 0x21, 0xc7, 0xec, 0x54, 0x24, 0x1f, 0xeb, 0x63, 0x4f, 0xba, 0x3e, 0x32, 0xfb,
-	0x75, 0x6c, 0x19, 0x14, 0x59, 0x0e, 0xed, 0xab, 0x05, 0x27, 0x92, 0x26, 0x97, 0x24, 0x9c, 0x3e,
-	0x39, 0x11, 0x4e, 0xc9, 0x6a, 0x41, 0x59, 0x3d, 0xf2, 0xa4, 0x95, 0x98, 0x31, 0xbc, 0x18, 0x0a,
-	0x7e, 0x56, 0x88, 0xbe, 0xcb, 0x6b, 0xa5, 0xfb, 0xd0, 0x0c, 0x91, 0x8c, 0xa6, 0xec, 0xc3, 0x24,
-	0x78, 0x89, 0x13, 0xd4, 0x40, 0x0e, 0x26, 0x06, 0x9d, 0xb8, 0x84, 0xd5, 0x03, 0x5c, 0x67, 0x7a,
-	0x98, 0xcb, 0x85, 0x1e, 0x7e, 0x0b, 0xcc, 0x50, 0xbc, 0x8b, 0xec, 0xe1, 0xbc, 0xd4, 0xe9, 0xf3,
-	0x0a, 0x4c, 0xea, 0x0c, 0x5e, 0xd2, 0xe7, 0x4e, 0xf3, 0xcc, 0x45, 0xa6, 0xb9, 0xb8, 0x04, 0xd2,
-	0x90, 0x10, 0x44, 0x8b, 0x59, 0xdf, 0xb3, 0x6a, 0xd0, 0xa8, 0xfc, 0x9e, 0x04, 0x8b, 0x9c, 0xb4,
-	0x2d, 0x1f, 0xfc, 0x65, 0xd9, 0x63, 0x9f, 0x8e, 0xb2, 0x3b, 0xe1, 0x2c, 0x1d, 0x51, 0x68, 0x98,
-	0xe1, 0x4e, 0x5b, 0x3e, 0x6d, 0x1b, 0x61, 0xee, 0x6a, 0x04, 0x72, 0x4a, 0xca, 0xd7, 0xcb, 0x95,
-	0xf1, 0xbe, 0x7f, 0xe3, 0x3f, 0x73, 0x6e, 0xfe, 0xb3, 0x17, 0x7a, 0xa2, 0xb6, 0x01, 0x60, 0xfe,
-	0x5d, 0xd3, 0xf5, 0xb1, 0x96, 0x2b, 0x95, 0x1f, 0x04, 0x20, 0xd6, 0xb1, 0x65, 0x41, 0x5b, 0x57,
-	0x20, 0xd5, 0x3a, 0xf7, 0x8c, 0xb6, 0x8d, 0xc6, 0x1a, 0x26, 0xef, 0x81, 0x79, 0x2d, 0x58, 0x70,
-	0xbb, 0xe5, 0xaf, 0x18, 0x96, 0x82, 0x05, 0x45, 0x1c, 0x78, 0xd2, 0x6c, 0x1c, 0x4c, 0xb3, 0xa1,
-	0xce, 0x6a, 0xf1, 0xb6, 0x5e, 0xf9, 0x51, 0xf0, 0x53, 0x20, 0xea, 0x5a, 0x6b, 0xe1, 0xd1, 0x8a,
-	0x6e, 0xd2, 0x00, 0xff, 0x24, 0x80, 0x85, 0xf5, 0xfb, 0x1b, 0xac, 0x1a, 0x8f, 0x8a, 0xf1, 0x31,
-	0x16, 0xa0, 0xab, 0x60, 0x9a, 0x5d, 0xce, 0xc3, 0x33, 0x3e, 0xa7, 0x5c, 0x1b, 0x78, 0x52, 0x96,
-	0x01, 0x68, 0x36, 0x8e, 0xa2, 0x4f, 0x35, 0xcb, 0xe4, 0x9a, 0xba, 0x28, 0x82, 0x94, 0x7f, 0x5c,
-	0x30, 0xab, 0x72, 0x2a, 0xfb, 0x3e, 0x86, 0x3b, 0xbc, 0x48, 0x4d, 0x3e, 0xee, 0x87, 0x02, 0x98,
-	0x0d, 0x71, 0xf3, 0xbb, 0xff, 0xe4, 0x83, 0xfe, 0x59, 0x00, 0x8b, 0x21, 0x68, 0x15, 0x51, 0xb7,
-	0xff, 0xdc, 0x20, 0xff, 0x25, 0x09, 0x96, 0xea, 0xd8, 0xa6, 0x2e, 0xd4, 0x68, 0x1d, 0x9a, 0xe6,
-	0x9a, 0xe3, 0xb8, 0xb8, 0x3b, 0x71, 0xd0, 0xdf, 0x07, 0x20, 0xcc, 0xe1, 0x61, 0xf6, 0x96, 0xf8,
-	0xf1, 0x92, 0xe3, 0x19, 0xcc, 0x0a, 0xd9, 0xa8, 0xa1, 0xe6, 0xf8, 0x8c, 0xa6, 0x2e, 0x5e, 0x03,
-	0x19, 0x82, 0x6c, 0x1d, 0xb9, 0xec, 0x64, 0xca, 0xa9, 0xbc, 0x25, 0x3a, 0x60, 0x41, 0x47, 0x84,
-	0x1a, 0x76, 0x70, 0x68, 0x04, 0x06, 0xa7, 0x2f, 0xce, 0xe0, 0xf9, 0x98, 0xf6, 0x3a, 0xbf, 0x5e,
-	0xce, 0x6b, 0xdc, 0xdd, 0xc3, 0xd3, 0x32, 0xc3, 0x30, 0xcd, 0x85, 0xfd, 0x51, 0x49, 0x53, 0x70,
-	0x60, 0xdf, 0xc4, 0x50, 0xdf, 0xee, 0x40, 0xd2, 0x61, 0x27, 0x54, 0x41, 0x29, 0xc4, 0x8b, 0x03,
-	0x35, 0xcf, 0x25, 0xfc, 0x46, 0xe5, 0x5b, 0x76, 0x16, 0x44, 0x5c, 0x8e, 0x3f, 0x08, 0x5f, 0x01,
-	0x19, 0x8b, 0xb4, 0x23, 0x1e, 0x67, 0x7c, 0x06, 0x36, 0x10, 0x21, 0xb0, 0x8d, 0x9a, 0x0d, 0x35,
-	0x6d, 0x91, 0x76, 0x53, 0xaf, 0x7c, 0x9d, 0x02, 0x2f, 0xc7, 0x71, 0x7d, 0x6a, 0xd0, 0xce, 0x86,
-	0x61, 0xd3, 0x17, 0xb1, 0xf6, 0xdc, 0xc6, 0x9a, 0x78, 0x2b, 0x2c, 0x70, 0xa7, 0x59, 0xdd, 0x74,
-	0x43, 0x0e, 0xae, 0x2e, 0x72, 0x0b, 0x12, 0x34, 0x2c, 0x97, 0xea, 0xd8, 0xb0, 0x79, 0xb5, 0xc6,
-	0x2b, 0xe0, 0x2f, 0x53, 0x20, 0x17, 0x94, 0xbe, 0xc8, 0xa6, 0x13, 0xc6, 0x3b, 0x01, 0x79, 0xca,
-	0xdf, 0x99, 0xa2, 0xe7, 0x2d, 0x75, 0xe0, 0x49, 0x20, 0x7c, 0x7e, 0x62, 0x13, 0x3f, 0x7c, 0x3a,
-	0x84, 0x91, 0x0e, 0x15, 0x84, 0xcb, 0x4c, 0x54, 0xb4, 0xd4, 0xc0, 0x62, 0x7c, 0xc5, 0xd1, 0x80,
-	0x11, 0x63, 0x43, 0x61, 0xcc, 0xdc, 0x8a, 0xdf, 0x71, 0xce, 0x1f, 0x02, 0x7f, 0x26, 0x41, 0xde,
-	0xcf, 0x7e, 0x9e, 0x3c, 0xe3, 0x0c, 0x82, 0x63, 0x8c, 0x26, 0x9e, 0x09, 0xa3, 0xff, 0x71, 0xfb,
-	0x38, 0x95, 0xf8, 0xd4, 0xff, 0x40, 0x7c, 0xfa, 0x6c, 0xe2, 0x33, 0x4f, 0x44, 0xfc, 0xa3, 0x04,
-	0xc8, 0x2b, 0x7b, 0xae, 0xfd, 0x0c, 0x88, 0x1f, 0xe5, 0x20, 0x71, 0x21, 0x1c, 0x24, 0xc7, 0xc9,
-	0xc1, 0xab, 0x27, 0x9f, 0x4b, 0x82, 0xfd, 0xe0, 0xf8, 0xeb, 0xc8, 0xf0, 0x61, 0x21, 0x1d, 0x7b,
-	0x58, 0x50, 0x36, 0xf7, 0x07, 0x25, 0xe1, 0x60, 0x50, 0x12, 0x7e, 0x1b, 0x94, 0x84, 0x6f, 0x0e,
-	0x4b, 0x53, 0xfb, 0x87, 0x25, 0xe1, 0xe0, 0xb0, 0x34, 0xf5, 0xe8, 0xb0, 0x34, 0xf5, 0xf9, 0x1b,
-	0xe7, 0xc4, 0x8b, 0xba, 0x56, 0xf0, 0xec, 0xd4, 0xca, 0xb0, 0xff, 0xe0, 0xde, 0xfc, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0xdc, 0xa9, 0x5f, 0x3a, 0x1c, 0x00, 0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xfa, 0x6f, 0x3d, 0x76, 0xd2, 0x76, 0x13, 0x5a, 0x37, 0x42, 0x5e, 0xcb, 0x42, 0xc2,
+	0x48, 0x64, 0x4d, 0x0a, 0x95, 0x10, 0x7f, 0x04, 0x59, 0x3b, 0x50, 0xab, 0x4a, 0x54, 0x6d, 0x43,
+	0x11, 0x08, 0x29, 0x1a, 0xef, 0x4e, 0xd6, 0xab, 0xec, 0xee, 0xac, 0x76, 0x26, 0xae, 0x7d, 0x83,
+	0x1b, 0x47, 0x2e, 0x5c, 0x11, 0x5f, 0x00, 0x0e, 0x15, 0x88, 0xaf, 0x10, 0x6e, 0x39, 0x56, 0x1c,
+	0x2c, 0xe4, 0x1c, 0x90, 0x2a, 0xce, 0x1c, 0x82, 0x90, 0xd0, 0xcc, 0xce, 0xda, 0xeb, 0x24, 0x28,
+	0x69, 0x89, 0x8b, 0x9b, 0x70, 0xf2, 0xce, 0xcc, 0x9b, 0x37, 0xbf, 0xf7, 0x7e, 0xef, 0xcd, 0xbc,
+	0x19, 0x03, 0x05, 0x76, 0x91, 0x03, 0x83, 0x1a, 0xea, 0xb8, 0xb5, 0xce, 0x72, 0x0b, 0x51, 0xb8,
+	0x5c, 0x43, 0x1d, 0xe4, 0x51, 0xa2, 0xfa, 0x01, 0xa6, 0x58, 0x96, 0x43, 0x01, 0x15, 0x75, 0x5c,
+	0x55, 0x08, 0x2c, 0x2e, 0x58, 0xd8, 0xc2, 0x7c, 0xb8, 0xc6, 0xbe, 0x42, 0xc9, 0xc5, 0xaa, 0x50,
+	0xd5, 0xc1, 0x14, 0xd5, 0x50, 0xd7, 0xc7, 0x01, 0x45, 0xe6, 0x50, 0x29, 0xed, 0xf9, 0x48, 0xe8,
+	0x5c, 0x2c, 0x1d, 0xb3, 0xe8, 0xd8, 0xb8, 0x81, 0x89, 0x8b, 0x49, 0xad, 0x05, 0x09, 0x1a, 0x0a,
+	0x18, 0xd8, 0xf6, 0xc2, 0xf1, 0xca, 0x81, 0x04, 0xc0, 0x5d, 0xec, 0x38, 0x1f, 0x40, 0xdb, 0x41,
+	0xa6, 0xfc, 0x0a, 0x48, 0xd3, 0xee, 0xa6, 0x6d, 0x16, 0xa5, 0xb2, 0x54, 0x2d, 0x68, 0x0b, 0xbb,
+	0x7d, 0x65, 0xe6, 0x97, 0xbe, 0x92, 0xba, 0x0d, 0x49, 0x7b, 0xd0, 0x57, 0x52, 0x1b, 0xdd, 0x66,
+	0x43, 0x4f, 0xd1, 0x6e, 0xd3, 0x94, 0x3f, 0x01, 0x69, 0xa3, 0x0d, 0x6d, 0xaf, 0x98, 0x28, 0x4b,
+	0xd5, 0x9c, 0x56, 0x3f, 0xe8, 0x2b, 0xef, 0x59, 0x36, 0x6d, 0xef, 0xb4, 0x54, 0x03, 0xbb, 0xb5,
+	0x10, 0x97, 0x87, 0xe8, 0x03, 0x1c, 0x6c, 0x8b, 0xd6, 0x92, 0x81, 0x03, 0x54, 0xeb, 0xd6, 0x3c,
+	0xd4, 0xdd, 0x21, 0x43, 0xbb, 0xd4, 0x3a, 0x53, 0xb3, 0x0e, 0x5d, 0xa4, 0x87, 0x1a, 0xe5, 0x2d,
+	0x90, 0xf5, 0xb1, 0xe3, 0x30, 0x1c, 0xc9, 0xb2, 0x54, 0x4d, 0x69, 0x6b, 0x02, 0xc7, 0xdb, 0xa7,
+	0x5c, 0x60, 0xcc, 0x6f, 0x2a, 0xb3, 0xaf, 0xd9, 0x18, 0xf4, 0x95, 0x4c, 0xf8, 0xa5, 0x67, 0x98,
+	0xf6, 0xa6, 0x59, 0xf9, 0x53, 0x02, 0x79, 0xd6, 0xb5, 0xda, 0xf5, 0xed, 0xe0, 0xc2, 0x59, 0xff,
+	0x97, 0x04, 0x66, 0x59, 0x57, 0x1d, 0xbb, 0xbe, 0x83, 0xe8, 0x85, 0xb3, 0xff, 0x8b, 0x04, 0xb8,
+	0xba, 0x8e, 0x57, 0x79, 0x86, 0xd6, 0xb1, 0xb7, 0x65, 0x07, 0xee, 0x85, 0xf3, 0xc1, 0xe3, 0x04,
+	0xb8, 0x21, 0x6c, 0xbf, 0x83, 0x7a, 0x1b, 0x01, 0xf4, 0xc8, 0x16, 0x0a, 0xee, 0x51, 0xc8, 0xa6,
+	0x8d, 0x0c, 0x94, 0xce, 0xdc, 0xc0, 0xa1, 0x9b, 0x13, 0x27, 0xba, 0xf9, 0x4d, 0x70, 0xd9, 0x82,
+	0x14, 0x3d, 0x80, 0xbd, 0x4d, 0x68, 0x9a, 0x01, 0x22, 0x84, 0xfb, 0xa4, 0xa0, 0x5d, 0x16, 0x93,
+	0xb2, 0x2b, 0x61, 0xb7, 0x3e, 0x27, 0xe4, 0x44, 0x5b, 0xae, 0x81, 0x79, 0x23, 0x34, 0x0e, 0x52,
+	0x1b, 0x7b, 0x9b, 0x6d, 0x64, 0x5b, 0x6d, 0x5a, 0x4c, 0x31, 0x8f, 0xea, 0x72, 0x7c, 0xe8, 0x36,
+	0x1f, 0x91, 0x3f, 0x03, 0x05, 0x1f, 0x06, 0xd4, 0x36, 0x6c, 0x1f, 0x7a, 0x94, 0x14, 0xd3, 0x65,
+	0xa9, 0x9a, 0xbf, 0xa9, 0xaa, 0x62, 0xe3, 0x66, 0x4e, 0x55, 0x87, 0x36, 0x89, 0xdd, 0x94, 0x3b,
+	0xf7, 0x6e, 0x6c, 0x96, 0x76, 0x89, 0xe1, 0xda, 0xeb, 0x2b, 0x92, 0x3e, 0xa6, 0xad, 0xf2, 0x7b,
+	0x02, 0x5c, 0x17, 0xce, 0xfe, 0x30, 0x04, 0xba, 0xd1, 0x8d, 0x5c, 0x3d, 0x1d, 0x61, 0x77, 0x5e,
+	0x5c, 0xfd, 0x56, 0xa2, 0x28, 0x55, 0xbe, 0x15, 0xbb, 0xfb, 0x1a, 0xf4, 0x7d, 0xdb, 0xb3, 0x9e,
+	0xc4, 0xc5, 0xb1, 0xf4, 0x4b, 0x4c, 0x32, 0xfd, 0xbe, 0x49, 0x82, 0xe2, 0xe1, 0x88, 0x20, 0x51,
+	0x48, 0x20, 0x30, 0xcb, 0x41, 0xb8, 0x21, 0x7e, 0x52, 0x94, 0xca, 0xc9, 0x6a, 0xfe, 0xa6, 0xa2,
+	0x1e, 0x2d, 0x23, 0xd4, 0x98, 0x9d, 0x9a, 0xc2, 0xb0, 0x3e, 0xee, 0x2b, 0xd7, 0xc7, 0x66, 0xbf,
+	0x8a, 0x5d, 0x9b, 0x22, 0xd7, 0xa7, 0x3d, 0xbd, 0xe0, 0x8f, 0xa4, 0xc9, 0x39, 0x09, 0xa7, 0x8f,
+	0x8e, 0x84, 0x53, 0xb2, 0x5a, 0xd0, 0x96, 0x0f, 0xfa, 0xca, 0x52, 0xcc, 0x18, 0x51, 0x0c, 0x85,
+	0x3f, 0x4b, 0xc4, 0xdc, 0x16, 0xb5, 0xd2, 0x7d, 0xe8, 0x44, 0x48, 0xc6, 0x53, 0xf6, 0x61, 0x12,
+	0xbc, 0x20, 0x08, 0x6a, 0x20, 0x1f, 0x13, 0x9b, 0x4e, 0x5d, 0xc2, 0x9a, 0x21, 0xae, 0x13, 0x3d,
+	0x2c, 0xe4, 0x22, 0x0f, 0xbf, 0x01, 0x66, 0x29, 0xde, 0x46, 0xde, 0x70, 0x5e, 0xea, 0xf8, 0x79,
+	0x05, 0x2e, 0x75, 0x02, 0x2f, 0xe9, 0x53, 0xa7, 0x79, 0xe6, 0x2c, 0xd3, 0x5c, 0x5e, 0x00, 0x69,
+	0x48, 0x08, 0xa2, 0xc5, 0x2c, 0xf3, 0xac, 0x1e, 0x36, 0x2a, 0xbf, 0x25, 0xc1, 0xbc, 0x20, 0x6d,
+	0x83, 0x81, 0x3f, 0x2f, 0x7b, 0xec, 0xd3, 0x51, 0x76, 0x27, 0x9a, 0x65, 0x22, 0x0a, 0x6d, 0x27,
+	0xda, 0x69, 0xcb, 0xc7, 0x6d, 0x23, 0xdc, 0x5d, 0x8d, 0x50, 0x4e, 0x4b, 0x31, 0xbd, 0x42, 0x99,
+	0xe8, 0xfb, 0x27, 0xfe, 0x33, 0xa7, 0xe6, 0x3f, 0x7b, 0xa6, 0x27, 0xaa, 0x05, 0x00, 0xf7, 0xef,
+	0x8a, 0x69, 0x4e, 0xb4, 0x5c, 0xa9, 0x7c, 0x27, 0x01, 0xb9, 0x8e, 0x5d, 0x17, 0x7a, 0xa6, 0x06,
+	0xa9, 0xd1, 0xbe, 0x67, 0x5b, 0x1e, 0x9a, 0x68, 0x98, 0xbc, 0x03, 0xae, 0x18, 0xe1, 0x82, 0x9b,
+	0x2d, 0xb6, 0x62, 0x54, 0x0a, 0x16, 0x34, 0x79, 0xd0, 0x57, 0xe6, 0xe2, 0x60, 0x9a, 0x0d, 0x7d,
+	0xce, 0x88, 0xb7, 0xcd, 0xca, 0xf7, 0x12, 0x4b, 0x81, 0x51, 0xd7, 0x4a, 0x0b, 0x07, 0x74, 0x9a,
+	0x01, 0xff, 0x28, 0x81, 0xab, 0xab, 0xf7, 0xd7, 0x78, 0x35, 0x3e, 0x2a, 0xc6, 0x27, 0x58, 0x80,
+	0x2e, 0x83, 0x4b, 0xfc, 0x72, 0x1e, 0x9d, 0xf1, 0x39, 0xed, 0xda, 0xa0, 0xaf, 0x64, 0x39, 0x80,
+	0x66, 0xe3, 0x60, 0xf4, 0xa9, 0x67, 0xb9, 0x5c, 0xd3, 0x94, 0x65, 0x90, 0x62, 0xc7, 0x05, 0xb7,
+	0x2a, 0xa7, 0xf3, 0xef, 0x43, 0xb8, 0xa3, 0x8b, 0xd4, 0xf4, 0xe3, 0x7e, 0x28, 0x81, 0xb9, 0x08,
+	0xb7, 0xb8, 0xfb, 0x4f, 0x3f, 0xe8, 0x9f, 0x24, 0x30, 0x1f, 0x81, 0xd6, 0x11, 0x0d, 0x7a, 0xcf,
+	0x0d, 0xf2, 0x9f, 0x93, 0x60, 0xa1, 0x8e, 0x3d, 0x1a, 0x40, 0x83, 0xd6, 0xa1, 0xe3, 0xac, 0xf8,
+	0x7e, 0x80, 0x3b, 0x53, 0x07, 0xfd, 0x5d, 0x00, 0xa2, 0x1c, 0x1e, 0x66, 0x6f, 0x49, 0x1c, 0x2f,
+	0x39, 0x91, 0xc1, 0xbc, 0x90, 0x1d, 0x35, 0xf4, 0x9c, 0x98, 0xd1, 0x34, 0xe5, 0x6b, 0x20, 0x43,
+	0x90, 0x67, 0xa2, 0x80, 0x9f, 0x4c, 0x39, 0x5d, 0xb4, 0x64, 0x1f, 0x5c, 0x35, 0x11, 0xa1, 0xb6,
+	0x17, 0x1e, 0x1a, 0xa1, 0xc1, 0xe9, 0xb3, 0x33, 0xf8, 0x4a, 0x4c, 0x7b, 0x5d, 0x5c, 0x2f, 0xaf,
+	0x18, 0xc2, 0xdd, 0xc3, 0xd3, 0x32, 0xc3, 0x31, 0x5d, 0x8e, 0xfa, 0x47, 0x25, 0x4d, 0xc1, 0x87,
+	0x3d, 0x07, 0x43, 0x73, 0xb3, 0x0d, 0x49, 0x9b, 0x9f, 0x50, 0x05, 0xad, 0x10, 0x2f, 0x0e, 0xf4,
+	0xbc, 0x90, 0x60, 0x8d, 0xca, 0xd7, 0xfc, 0x2c, 0x18, 0x71, 0x39, 0xf9, 0x20, 0x7c, 0x09, 0x64,
+	0x5c, 0x62, 0x8d, 0x78, 0x9c, 0x65, 0x0c, 0xac, 0x21, 0x42, 0xa0, 0x85, 0x9a, 0x0d, 0x3d, 0xed,
+	0x12, 0xab, 0x69, 0x56, 0x7e, 0x90, 0xc0, 0x8d, 0x38, 0x2e, 0x1d, 0xef, 0x50, 0xdb, 0xb3, 0xa6,
+	0x04, 0x1e, 0x0b, 0x8e, 0x00, 0x41, 0x82, 0x3d, 0x91, 0x18, 0xa2, 0x55, 0xf9, 0x32, 0x05, 0x5e,
+	0x8c, 0xc3, 0xfe, 0xd8, 0xa6, 0xed, 0x35, 0xdb, 0xa3, 0xff, 0xa7, 0xc8, 0x73, 0x9b, 0x22, 0xf2,
+	0xad, 0xa8, 0x2e, 0xbf, 0xc4, 0xcb, 0xbd, 0x1b, 0x6a, 0x78, 0xe3, 0x52, 0xd9, 0x2b, 0xf4, 0xb0,
+	0xca, 0xab, 0x63, 0xdb, 0x13, 0x45, 0xa6, 0x28, 0xdc, 0x3f, 0x4f, 0x81, 0x5c, 0x58, 0xb1, 0x23,
+	0x8f, 0x4e, 0x19, 0xef, 0x04, 0xe4, 0xa9, 0x78, 0x1e, 0x1b, 0xbd, 0xca, 0xe9, 0x83, 0xbe, 0x02,
+	0xa2, 0x57, 0x33, 0x3e, 0xf1, 0xfd, 0xa7, 0x43, 0x38, 0xd2, 0xa1, 0x83, 0x68, 0x99, 0xa9, 0x8a,
+	0x96, 0x1a, 0x98, 0x8f, 0xaf, 0x38, 0x1e, 0x30, 0x72, 0x6c, 0x28, 0x8a, 0x99, 0x5b, 0xf1, 0xab,
+	0xd9, 0xe9, 0x43, 0xe0, 0x8f, 0x24, 0xc8, 0xb3, 0xec, 0x17, 0xc9, 0x33, 0xc9, 0x20, 0x38, 0xc4,
+	0x68, 0xe2, 0x99, 0x30, 0xfa, 0x2f, 0xb7, 0x8f, 0x63, 0x89, 0x4f, 0xfd, 0x07, 0xc4, 0xa7, 0x4f,
+	0x26, 0x3e, 0xf3, 0x44, 0xc4, 0x3f, 0x4a, 0x80, 0xbc, 0xb6, 0x13, 0x78, 0xcf, 0x80, 0xf8, 0x71,
+	0x0e, 0x12, 0x67, 0xc2, 0x41, 0x72, 0x92, 0x1c, 0xbc, 0x7c, 0xf4, 0x95, 0x27, 0xdc, 0x0f, 0x0e,
+	0x3f, 0xea, 0x0c, 0xdf, 0x43, 0xd2, 0xb1, 0xf7, 0x10, 0x6d, 0x7d, 0x77, 0x50, 0x92, 0xf6, 0x06,
+	0x25, 0xe9, 0xd7, 0x41, 0x49, 0xfa, 0x6a, 0xbf, 0x34, 0xb3, 0xbb, 0x5f, 0x92, 0xf6, 0xf6, 0x4b,
+	0x33, 0x8f, 0xf6, 0x4b, 0x33, 0x9f, 0xbe, 0x76, 0x4a, 0xbc, 0xec, 0x1f, 0x46, 0xfe, 0x5a, 0xd6,
+	0xca, 0xf0, 0xbf, 0x0e, 0x5f, 0xff, 0x7b, 0x00, 0x1d, 0xea, 0xc6, 0xd0, 0xf1, 0x1c, 0x00, 0x00,
 }
 
 func (m *PollFailed) Marshal() (dAtA []byte, err error) {
@@ -2581,6 +2650,50 @@ func (m *ContractCallFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractCallRoutingFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallRoutingFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallRoutingFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MessageID) > 0 {
+		i -= len(m.MessageID)
+		copy(dAtA[i:], m.MessageID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractCallWithMintApproved) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3278,6 +3391,27 @@ func (m *ContractCallFailed) Size() (n int) {
 	return n
 }
 
+func (m *ContractCallRoutingFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MessageID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *ContractCallWithMintApproved) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6465,6 +6599,152 @@ func (m *ContractCallFailed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractCallRoutingFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallRoutingFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallRoutingFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ContractCallWithMintApproved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -113,7 +113,7 @@ var _ types.Nexus = &NexusMock{}
 //			EnqueueForTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
 //				panic("mock out the EnqueueForTransfer method")
 //			},
-//			EnqueueTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, senderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
+//			EnqueueTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
 //				panic("mock out the EnqueueTransfer method")
 //			},
 //			GetChainFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, bool) {
@@ -149,6 +149,9 @@ var _ types.Nexus = &NexusMock{}
 //			LinkAddressesFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress) error {
 //				panic("mock out the LinkAddresses method")
 //			},
+//			RateLimitAddressTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
+//				panic("mock out the RateLimitAddressTransfer method")
+//			},
 //			RateLimitTransferFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset github_com_cosmos_cosmos_sdk_types.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
 //				panic("mock out the RateLimitTransfer method")
 //			},
@@ -193,7 +196,7 @@ type NexusMock struct {
 	EnqueueForTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, amount github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error)
 
 	// EnqueueTransferFunc mocks the EnqueueTransfer method.
-	EnqueueTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, senderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error)
+	EnqueueTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error)
 
 	// GetChainFunc mocks the GetChain method.
 	GetChainFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, bool)
@@ -228,6 +231,9 @@ type NexusMock struct {
 	// LinkAddressesFunc mocks the LinkAddresses method.
 	LinkAddressesFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress) error
 
+	// RateLimitAddressTransferFunc mocks the RateLimitAddressTransfer method.
+	RateLimitAddressTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error
+
 	// RateLimitTransferFunc mocks the RateLimitTransfer method.
 	RateLimitTransferFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset github_com_cosmos_cosmos_sdk_types.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error
 
@@ -292,8 +298,8 @@ type NexusMock struct {
 		EnqueueTransfer []struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
-			// SenderChain is the senderChain argument value.
-			SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
+			// Sender is the sender argument value.
+			Sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
 			// Recipient is the recipient argument value.
 			Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
 			// Asset is the asset argument value.
@@ -386,6 +392,17 @@ type NexusMock struct {
 			// Recipient is the recipient argument value.
 			Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
 		}
+		// RateLimitAddressTransfer holds details about calls to the RateLimitAddressTransfer method.
+		RateLimitAddressTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Address is the address argument value.
+			Address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+			// Asset is the asset argument value.
+			Asset github_com_cosmos_cosmos_sdk_types.Coin
+			// Direction is the direction argument value.
+			Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+		}
 		// RateLimitTransfer holds details about calls to the RateLimitTransfer method.
 		RateLimitTransfer []struct {
 			// Ctx is the ctx argument value.
@@ -471,6 +488,7 @@ type NexusMock struct {
 	lockIsAssetRegistered             sync.RWMutex
 	lockIsChainActivated              sync.RWMutex
 	lockLinkAddresses                 sync.RWMutex
+	lockRateLimitAddressTransfer      sync.RWMutex
 	lockRateLimitTransfer             sync.RWMutex
 	lockRegisterAsset                 sync.RWMutex
 	lockRouteMessage                  sync.RWMutex
@@ -638,25 +656,25 @@ func (mock *NexusMock) EnqueueForTransferCalls() []struct {
 }
 
 // EnqueueTransfer calls EnqueueTransferFunc.
-func (mock *NexusMock) EnqueueTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, senderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
+func (mock *NexusMock) EnqueueTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, sender github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, error) {
 	if mock.EnqueueTransferFunc == nil {
 		panic("NexusMock.EnqueueTransferFunc: method is nil but Nexus.EnqueueTransfer was just called")
 	}
 	callInfo := struct {
-		Ctx         github_com_cosmos_cosmos_sdk_types.Context
-		SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
-		Recipient   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
-		Asset       github_com_cosmos_cosmos_sdk_types.Coin
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Sender    github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     github_com_cosmos_cosmos_sdk_types.Coin
 	}{
-		Ctx:         ctx,
-		SenderChain: senderChain,
-		Recipient:   recipient,
-		Asset:       asset,
+		Ctx:       ctx,
+		Sender:    sender,
+		Recipient: recipient,
+		Asset:     asset,
 	}
 	mock.lockEnqueueTransfer.Lock()
 	mock.calls.EnqueueTransfer = append(mock.calls.EnqueueTransfer, callInfo)
 	mock.lockEnqueueTransfer.Unlock()
-	return mock.EnqueueTransferFunc(ctx, senderChain, recipient, asset)
+	return mock.EnqueueTransferFunc(ctx, sender, recipient, asset)
 }
 
 // EnqueueTransferCalls gets all the calls that were made to EnqueueTransfer.
@@ -664,16 +682,16 @@ func (mock *NexusMock) EnqueueTransfer(ctx github_com_cosmos_cosmos_sdk_types.Co
 //
 //	len(mockedNexus.EnqueueTransferCalls())
 func (mock *NexusMock) EnqueueTransferCalls() []struct {
-	Ctx         github_com_cosmos_cosmos_sdk_types.Context
-	SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
-	Recipient   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
-	Asset       github_com_cosmos_cosmos_sdk_types.Coin
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Sender    github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Asset     github_com_cosmos_cosmos_sdk_types.Coin
 } {
 	var calls []struct {
-		Ctx         github_com_cosmos_cosmos_sdk_types.Context
-		SenderChain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
-		Recipient   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
-		Asset       github_com_cosmos_cosmos_sdk_types.Coin
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Sender    github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Recipient github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     github_com_cosmos_cosmos_sdk_types.Coin
 	}
 	mock.lockEnqueueTransfer.RLock()
 	calls = mock.calls.EnqueueTransfer
@@ -1097,6 +1115,50 @@ func (mock *NexusMock) LinkAddressesCalls() []struct {
 	return calls
 }
 
+// RateLimitAddressTransfer calls RateLimitAddressTransferFunc.
+func (mock *NexusMock) RateLimitAddressTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, asset github_com_cosmos_cosmos_sdk_types.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
+	if mock.RateLimitAddressTransferFunc == nil {
+		panic("NexusMock.RateLimitAddressTransferFunc: method is nil but Nexus.RateLimitAddressTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Address   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     github_com_cosmos_cosmos_sdk_types.Coin
+		Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+	}{
+		Ctx:       ctx,
+		Address:   address,
+		Asset:     asset,
+		Direction: direction,
+	}
+	mock.lockRateLimitAddressTransfer.Lock()
+	mock.calls.RateLimitAddressTransfer = append(mock.calls.RateLimitAddressTransfer, callInfo)
+	mock.lockRateLimitAddressTransfer.Unlock()
+	return mock.RateLimitAddressTransferFunc(ctx, address, asset, direction)
+}
+
+// RateLimitAddressTransferCalls gets all the calls that were made to RateLimitAddressTransfer.
+// Check the length with:
+//
+//	len(mockedNexus.RateLimitAddressTransferCalls())
+func (mock *NexusMock) RateLimitAddressTransferCalls() []struct {
+	Ctx       github_com_cosmos_cosmos_sdk_types.Context
+	Address   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Asset     github_com_cosmos_cosmos_sdk_types.Coin
+	Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+} {
+	var calls []struct {
+		Ctx       github_com_cosmos_cosmos_sdk_types.Context
+		Address   github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Asset     github_com_cosmos_cosmos_sdk_types.Coin
+		Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+	}
+	mock.lockRateLimitAddressTransfer.RLock()
+	calls = mock.calls.RateLimitAddressTransfer
+	mock.lockRateLimitAddressTransfer.RUnlock()
+	return calls
+}
+
 // RateLimitTransfer calls RateLimitTransferFunc.
 func (mock *NexusMock) RateLimitTransfer(ctx github_com_cosmos_cosmos_sdk_types.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset github_com_cosmos_cosmos_sdk_types.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
 	if mock.RateLimitTransferFunc == nil {
@@ -780,6 +780,9 @@ var xxx_messageInfo_PendingCommandsRequest proto.InternalMessageInfo
 
 type PendingCommandsResponse struct {
 	Commands []QueryCommandResponse `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands"`
+	// next_batch is the command batch the next sign commands request would
+	// sign. It is empty if no batch can be signed right now
+	NextBatch *PendingCommandBatch `protobuf:"bytes,2,opt,name=next_batch,json=nextBatch,proto3" json:"next_batch,omitempty"`
 }
 
 func (m *PendingCommandsResponse) Reset()         { *m = PendingCommandsResponse{} }
@@ -815,6 +818,45 @@ func (m *PendingCommandsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingCommandsResponse proto.InternalMessageInfo
 
+type PendingCommandBatch struct {
+	KeyID   github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
+	Data    []byte                                                         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	SigHash []byte                                                         `protobuf:"bytes,3,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+}
+
+func (m *PendingCommandBatch) Reset()         { *m = PendingCommandBatch{} }
+func (m *PendingCommandBatch) String() string { return proto.CompactTextString(m) }
+func (*PendingCommandBatch) ProtoMessage()    {}
+func (*PendingCommandBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{18}
+}
+func (m *PendingCommandBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommandBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommandBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCommandBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommandBatch.Merge(m, src)
+}
+func (m *PendingCommandBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommandBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommandBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommandBatch proto.InternalMessageInfo
+
 type QueryCommandResponse struct {
 	ID         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type       string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -827,7 +869,7 @@ func (m *QueryCommandResponse) Reset()         { *m = QueryCommandResponse{} }
 func (m *QueryCommandResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommandResponse) ProtoMessage()    {}
 func (*QueryCommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{18}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{19}
 }
 func (m *QueryCommandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +906,7 @@ func (m *BurnerInfoRequest) Reset()         { *m = BurnerInfoRequest{} }
 func (m *BurnerInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfoRequest) ProtoMessage()    {}
 func (*BurnerInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{19}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{20}
 }
 func (m *BurnerInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +944,7 @@ func (m *BurnerInfoResponse) Reset()         { *m = BurnerInfoResponse{} }
 func (m *BurnerInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfoResponse) ProtoMessage()    {}
 func (*BurnerInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{20}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{21}
 }
 func (m *BurnerInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,7 +981,7 @@ func (m *ConfirmationHeightRequest) Reset()         { *m = ConfirmationHeightReq
 func (m *ConfirmationHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationHeightRequest) ProtoMessage()    {}
 func (*ConfirmationHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{21}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{22}
 }
 func (m *ConfirmationHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -976,7 +1018,7 @@ func (m *ConfirmationHeightResponse) Reset()         { *m = ConfirmationHeightRe
 func (m *ConfirmationHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationHeightResponse) ProtoMessage()    {}
 func (*ConfirmationHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{22}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{23}
 }
 func (m *ConfirmationHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1055,7 @@ func (m *GatewayAddressRequest) Reset()         { *m = GatewayAddressRequest{} }
 func (m *GatewayAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayAddressRequest) ProtoMessage()    {}
 func (*GatewayAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{23}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{24}
 }
 func (m *GatewayAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1092,7 @@ func (m *GatewayAddressResponse) Reset()         { *m = GatewayAddressResponse{}
 func (m *GatewayAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*GatewayAddressResponse) ProtoMessage()    {}
 func (*GatewayAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{24}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{25}
 }
 func (m *GatewayAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,7 +1130,7 @@ func (m *BytecodeRequest) Reset()         { *m = BytecodeRequest{} }
 func (m *BytecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*BytecodeRequest) ProtoMessage()    {}
 func (*BytecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{25}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{26}
 }
 func (m *BytecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1167,7 @@ func (m *BytecodeResponse) Reset()         { *m = BytecodeResponse{} }
 func (m *BytecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*BytecodeResponse) ProtoMessage()    {}
 func (*BytecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{26}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{27}
 }
 func (m *BytecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1165,7 +1207,7 @@ func (m *ERC20TokensRequest) Reset()         { *m = ERC20TokensRequest{} }
 func (m *ERC20TokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensRequest) ProtoMessage()    {}
 func (*ERC20TokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{27}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{28}
 }
 func (m *ERC20TokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1246,7 @@ func (m *ERC20TokensResponse) Reset()         { *m = ERC20TokensResponse{} }
 func (m *ERC20TokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensResponse) ProtoMessage()    {}
 func (*ERC20TokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{28}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{29}
 }
 func (m *ERC20TokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,7 +1284,7 @@ func (m *ERC20TokensResponse_Token) Reset()         { *m = ERC20TokensResponse_T
 func (m *ERC20TokensResponse_Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensResponse_Token) ProtoMessage()    {}
 func (*ERC20TokensResponse_Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{28, 0}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{29, 0}
 }
 func (m *ERC20TokensResponse_Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1284,7 +1326,7 @@ func (m *TokenInfoRequest) Reset()         { *m = TokenInfoRequest{} }
 func (m *TokenInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*TokenInfoRequest) ProtoMessage()    {}
 func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{29}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{30}
 }
 func (m *TokenInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1425,7 @@ func (m *TokenInfoResponse) Reset()         { *m = TokenInfoResponse{} }
 func (m *TokenInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TokenInfoResponse) ProtoMessage()    {}
 func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{30}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{31}
 }
 func (m *TokenInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1465,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{31}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{32}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1503,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{32}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{33}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1498,7 +1540,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{33}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{34}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1550,6 +1592,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "axelar.evm.v1beta1.CommandResponse.ParamsEntry")
 	proto.RegisterType((*PendingCommandsRequest)(nil), "axelar.evm.v1beta1.PendingCommandsRequest")
 	proto.RegisterType((*PendingCommandsResponse)(nil), "axelar.evm.v1beta1.PendingCommandsResponse")
+	proto.RegisterType((*PendingCommandBatch)(nil), "axelar.evm.v1beta1.PendingCommandBatch")
 	proto.RegisterType((*QueryCommandResponse)(nil), "axelar.evm.v1beta1.QueryCommandResponse")
 	proto.RegisterMapType((map[string]string)(nil), "axelar.evm.v1beta1.QueryCommandResponse.ParamsEntry")
 	proto.RegisterType((*BurnerInfoRequest)(nil), "axelar.evm.v1beta1.BurnerInfoRequest")
@@ -1573,113 +1616,117 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/query.proto", fileDescriptor_8fa0caa3a44d5acb) }
 
 var fileDescriptor_8fa0caa3a44d5acb = []byte{
-	// 1695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
-	0x15, 0x17, 0xf5, 0x65, 0xe9, 0xc9, 0x96, 0xb5, 0xb3, 0x5e, 0xad, 0x4c, 0xa4, 0x92, 0x42, 0x20,
-	0x80, 0x37, 0xc1, 0x4a, 0x59, 0x25, 0xdd, 0x26, 0x39, 0xec, 0x46, 0x5f, 0x8d, 0x69, 0xb7, 0xae,
-	0x4b, 0xcb, 0x4d, 0x37, 0x45, 0x21, 0x50, 0xe2, 0x58, 0x22, 0x6c, 0x91, 0x0a, 0x39, 0xd2, 0x4a,
-	0x87, 0x02, 0xed, 0xad, 0x70, 0x2f, 0xb9, 0xf4, 0xd0, 0x83, 0x51, 0xa0, 0xed, 0xa1, 0xc7, 0xde,
-	0x8a, 0xfe, 0x07, 0x0b, 0xf4, 0x92, 0x63, 0xd1, 0x83, 0xd0, 0x6a, 0xef, 0xfd, 0x03, 0x72, 0x2a,
-	0x38, 0x33, 0xa4, 0x28, 0x99, 0x96, 0x5d, 0x20, 0x29, 0x72, 0xd3, 0x7b, 0xf3, 0xde, 0x6f, 0xde,
-	0xbc, 0x6f, 0x0a, 0xf2, 0xea, 0x04, 0x5f, 0xa8, 0x56, 0x19, 0x8f, 0x07, 0xe5, 0xf1, 0x93, 0x0e,
-	0x26, 0xea, 0x93, 0xf2, 0xe7, 0x23, 0x6c, 0x4d, 0x4b, 0x43, 0xcb, 0x24, 0x26, 0x42, 0xec, 0xbc,
-	0x84, 0xc7, 0x83, 0x12, 0x3f, 0x17, 0x77, 0x7a, 0x66, 0xcf, 0xa4, 0xc7, 0x65, 0xe7, 0x17, 0x93,
-	0x14, 0x83, 0x90, 0xc8, 0x74, 0x88, 0x6d, 0x7e, 0x5e, 0x08, 0x38, 0x1f, 0xaa, 0x96, 0x3a, 0xe0,
-	0x02, 0xd2, 0xef, 0x05, 0x40, 0x0d, 0x3c, 0x34, 0x6d, 0x9d, 0xfc, 0xd8, 0xb1, 0xe0, 0x98, 0x1e,
-	0xa2, 0x1c, 0x6c, 0xa8, 0x9a, 0x66, 0x61, 0xdb, 0xce, 0x09, 0x45, 0x61, 0x2f, 0xa9, 0xb8, 0x24,
-	0xda, 0x81, 0x98, 0x6a, 0xdb, 0x98, 0xe4, 0xc2, 0x94, 0xcf, 0x08, 0xf4, 0x02, 0x62, 0xdd, 0xbe,
-	0xaa, 0x1b, 0xb9, 0x88, 0xc3, 0xad, 0xd5, 0xbf, 0x9a, 0x15, 0x9e, 0xf7, 0x74, 0xd2, 0x1f, 0x75,
-	0x4a, 0x5d, 0x73, 0x50, 0x66, 0x56, 0x18, 0x98, 0xbc, 0x34, 0xad, 0x73, 0x4e, 0x3d, 0xee, 0x9a,
-	0x16, 0x2e, 0x4f, 0xca, 0x06, 0x9e, 0x8c, 0xec, 0x32, 0x9e, 0x0c, 0x4d, 0x8b, 0x60, 0xad, 0x54,
-	0x77, 0x60, 0x8e, 0xd4, 0x01, 0x56, 0x18, 0xa2, 0xf4, 0x0c, 0xb2, 0x35, 0x95, 0x74, 0xfb, 0x58,
-	0xab, 0x9b, 0x83, 0x81, 0x6a, 0x68, 0xb6, 0x82, 0x3f, 0x1f, 0x61, 0x9b, 0x38, 0xa6, 0xb0, 0x4b,
-	0x99, 0x89, 0x8c, 0x40, 0x69, 0x08, 0xeb, 0x1a, 0xb7, 0x2e, 0xac, 0x6b, 0xd2, 0xdf, 0x23, 0xf0,
-	0xf0, 0x1a, 0x80, 0x3d, 0x34, 0x0d, 0x1b, 0xa3, 0x2c, 0x95, 0xa5, 0xea, 0xb5, 0xf8, 0x7c, 0x56,
-	0x08, 0xcb, 0x0d, 0x47, 0x07, 0x21, 0x88, 0x6a, 0x2a, 0x51, 0x39, 0x0a, 0xfd, 0x8d, 0xaa, 0x10,
-	0xb7, 0x89, 0x4a, 0x46, 0x36, 0x7d, 0x63, 0xba, 0xf2, 0xa8, 0x74, 0x3d, 0x4a, 0xa5, 0x95, 0x8b,
-	0x4e, 0xa8, 0x82, 0xc2, 0x15, 0x51, 0x07, 0xe2, 0xe7, 0x78, 0xda, 0xd6, 0xb5, 0x5c, 0x94, 0x5e,
-	0x79, 0x38, 0x9f, 0x15, 0x62, 0x87, 0x78, 0x2a, 0x37, 0xbe, 0x9a, 0x15, 0x9e, 0xdd, 0xd1, 0x5f,
-	0x83, 0xd1, 0x05, 0xd1, 0x6d, 0xbd, 0xb7, 0x70, 0x19, 0x45, 0x50, 0x62, 0xe7, 0x78, 0x2a, 0x6b,
-	0xe8, 0x4d, 0xd8, 0xc4, 0x13, 0xdc, 0x1d, 0x11, 0xdc, 0xa6, 0x4f, 0x88, 0xd3, 0x27, 0xa4, 0x38,
-	0xaf, 0xe1, 0xbc, 0x44, 0x81, 0xdc, 0xd0, 0xc2, 0xe3, 0x76, 0x87, 0x19, 0xdb, 0xee, 0x72, 0x6b,
-	0x1d, 0xc3, 0x36, 0xa8, 0x61, 0xbb, 0xf3, 0x59, 0xe1, 0xc1, 0xb1, 0x85, 0xc7, 0x2b, 0xef, 0x91,
-	0x1b, 0xca, 0x83, 0x61, 0x00, 0x5b, 0x43, 0x65, 0x48, 0x71, 0x98, 0xb6, 0xae, 0xd9, 0xb9, 0x44,
-	0x31, 0xb2, 0x97, 0xac, 0xa5, 0xe7, 0xb3, 0x02, 0x70, 0x21, 0xb9, 0x61, 0x2b, 0xc0, 0x45, 0x64,
-	0xcd, 0x46, 0x65, 0x88, 0x0d, 0x2d, 0xd3, 0x3c, 0xcb, 0x25, 0x8b, 0xc2, 0x5e, 0xaa, 0xb2, 0x1b,
-	0xe4, 0xcd, 0x63, 0x47, 0x40, 0x61, 0x72, 0x07, 0xd1, 0x44, 0x2c, 0x13, 0x97, 0x7e, 0x27, 0xc0,
-	0xbd, 0x43, 0x3c, 0xad, 0xb2, 0x6c, 0x5c, 0x9f, 0x09, 0xff, 0x07, 0x77, 0x1f, 0x44, 0x13, 0xe1,
-	0x4c, 0xe4, 0x20, 0x9a, 0x88, 0x64, 0xa2, 0xd2, 0x5f, 0xc3, 0x80, 0xfc, 0xb6, 0xf1, 0x24, 0x5b,
-	0x98, 0x21, 0x7c, 0x63, 0x51, 0xff, 0x0c, 0x92, 0xbc, 0x40, 0xb1, 0x9d, 0x0b, 0x17, 0x23, 0x7b,
-	0xa9, 0xca, 0xd3, 0x20, 0x8f, 0x5e, 0x37, 0xaf, 0xf4, 0x29, 0xd6, 0x7b, 0x7d, 0x82, 0x35, 0xce,
-	0xaf, 0x45, 0x5f, 0xcd, 0x0a, 0x21, 0x65, 0x01, 0x87, 0xde, 0x80, 0x24, 0xe9, 0x5b, 0xd8, 0xee,
-	0x9b, 0x17, 0x1a, 0xab, 0x6f, 0x65, 0xc1, 0x10, 0xeb, 0xb0, 0xbd, 0x82, 0xb0, 0xa6, 0x79, 0x64,
-	0x21, 0xfe, 0x92, 0x0a, 0xf3, 0xca, 0xe2, 0x94, 0xf4, 0x29, 0xec, 0xd2, 0xee, 0xd3, 0x32, 0xcf,
-	0xb1, 0xb1, 0xea, 0xbf, 0x9b, 0xe1, 0xde, 0x80, 0x64, 0xd7, 0x34, 0xce, 0x74, 0x6b, 0x80, 0x59,
-	0xc5, 0x27, 0x94, 0x05, 0xe3, 0xa3, 0x70, 0x4e, 0x90, 0x7e, 0x29, 0xc0, 0x43, 0x8a, 0xcc, 0x7b,
-	0x9c, 0x53, 0x90, 0x98, 0xf7, 0xb8, 0x47, 0x10, 0x23, 0x13, 0x37, 0x2c, 0x9b, 0xb5, 0x1d, 0xe7,
-	0xdd, 0xff, 0x9c, 0x15, 0xa2, 0xfb, 0xaa, 0xdd, 0x9f, 0xcf, 0x0a, 0xd1, 0xd6, 0x44, 0x6e, 0x28,
-	0x51, 0x32, 0x91, 0x35, 0xf4, 0x14, 0xd2, 0x9d, 0x91, 0x65, 0x60, 0xab, 0xed, 0x5a, 0x12, 0xa6,
-	0x3a, 0xdb, 0x5c, 0x67, 0xc3, 0xb5, 0x79, 0x8b, 0x89, 0x71, 0x92, 0x9a, 0xf0, 0x37, 0x01, 0xee,
-	0xfb, 0x6f, 0x77, 0x73, 0xf6, 0xc5, 0x52, 0xce, 0x7e, 0x9d, 0x2d, 0x13, 0xd5, 0x21, 0xce, 0x9a,
-	0x3c, 0x35, 0x33, 0x55, 0x79, 0x27, 0x28, 0x15, 0x6e, 0x70, 0x8b, 0xc2, 0x55, 0xa9, 0xed, 0xa7,
-	0xb0, 0xb3, 0x6c, 0x3a, 0x0f, 0xc9, 0x87, 0x5e, 0x2f, 0x0c, 0xd3, 0x5e, 0xf8, 0x66, 0xd0, 0x05,
-	0x3e, 0xcd, 0x45, 0x0f, 0xa4, 0xb0, 0xcf, 0x61, 0xb3, 0x39, 0xc6, 0x06, 0x59, 0x5f, 0xbe, 0xbb,
-	0x90, 0xc0, 0x8e, 0x54, 0xdb, 0x6b, 0xe7, 0x1b, 0x94, 0x96, 0x35, 0xe9, 0x63, 0xd8, 0xe2, 0x00,
-	0xdc, 0xa0, 0x32, 0xc4, 0xe8, 0x19, 0x45, 0xb8, 0xa1, 0x9b, 0x30, 0x0d, 0x26, 0x27, 0x3d, 0x05,
-	0x91, 0x3a, 0xa0, 0xe6, 0x8f, 0xd7, 0xed, 0x29, 0x27, 0xed, 0xc3, 0x16, 0x75, 0xb7, 0xd7, 0x7a,
-	0xbe, 0xe7, 0xb9, 0x42, 0xa0, 0xae, 0x28, 0x04, 0x5d, 0x4d, 0x55, 0x96, 0x1d, 0x21, 0x0d, 0x20,
-	0xed, 0x22, 0xf1, 0x5b, 0x7f, 0x06, 0x71, 0xfa, 0x72, 0x07, 0x2a, 0xf2, 0x75, 0xa5, 0x04, 0x87,
-	0x94, 0x9e, 0x41, 0x9a, 0x77, 0xe2, 0xf5, 0x5e, 0xcf, 0x2e, 0xc6, 0xa7, 0x7f, 0x24, 0x4a, 0xbf,
-	0x09, 0xc3, 0xb6, 0x07, 0x70, 0xfb, 0xf8, 0x74, 0x96, 0x10, 0x77, 0x7c, 0x3a, 0xbf, 0xd1, 0x0f,
-	0xbd, 0x9c, 0x8c, 0xd0, 0xf6, 0x54, 0x0e, 0xf4, 0xd3, 0xf2, 0x05, 0x25, 0x96, 0x92, 0x4d, 0x83,
-	0x58, 0x53, 0xde, 0x97, 0x38, 0x08, 0x2a, 0xae, 0xf4, 0xf6, 0xa4, 0xd7, 0x54, 0xdd, 0x96, 0x58,
-	0x84, 0xcd, 0x81, 0x3a, 0x69, 0xf7, 0x54, 0xbb, 0xdd, 0x35, 0x6d, 0x92, 0x8b, 0x15, 0x85, 0xbd,
-	0x2d, 0x05, 0x06, 0xea, 0xe4, 0x13, 0xd5, 0xae, 0x9b, 0x36, 0x11, 0x3f, 0x84, 0x94, 0xef, 0x02,
-	0x94, 0x81, 0xc8, 0x39, 0x9e, 0x72, 0x6f, 0x38, 0x3f, 0x1d, 0x0f, 0x8d, 0xd5, 0x8b, 0x91, 0xfb,
-	0x10, 0x46, 0x7c, 0x14, 0xfe, 0x40, 0x90, 0x4a, 0x90, 0x3d, 0xc6, 0x86, 0xa6, 0x1b, 0xbd, 0x3b,
-	0x2d, 0x25, 0x12, 0x86, 0x87, 0xd7, 0xe4, 0xb9, 0x13, 0x0f, 0x20, 0xe1, 0x0e, 0x60, 0x1a, 0xf7,
-	0x54, 0x65, 0xef, 0xc6, 0x72, 0x5d, 0xf1, 0x0f, 0xf7, 0x89, 0xa7, 0x2f, 0xfd, 0x36, 0x0c, 0x3b,
-	0x41, 0x82, 0xff, 0x53, 0xa4, 0x94, 0x95, 0x48, 0xbd, 0x7f, 0x57, 0x73, 0xbe, 0xbd, 0xe1, 0x7a,
-	0x06, 0xf7, 0x58, 0xa1, 0xcb, 0xc6, 0x99, 0xe9, 0x46, 0xea, 0xd1, 0x72, 0x91, 0x07, 0x74, 0x73,
-	0xaf, 0xea, 0xff, 0x22, 0x00, 0xf2, 0x03, 0x70, 0xaf, 0x7e, 0x83, 0x2d, 0xfc, 0x39, 0xa4, 0xf8,
-	0xc4, 0xd1, 0x8d, 0x33, 0x93, 0xf7, 0xf1, 0x7c, 0xe0, 0xca, 0xb9, 0xb0, 0x0b, 0x3a, 0xde, 0x6f,
-	0xe9, 0x09, 0xec, 0xd6, 0xd9, 0x28, 0x54, 0x89, 0x6e, 0x1a, 0xfb, 0x74, 0xd0, 0xae, 0x4f, 0xd2,
-	0xf7, 0x41, 0x0c, 0x52, 0xf1, 0x52, 0x28, 0xde, 0x67, 0xb3, 0xdb, 0x51, 0x8a, 0x2a, 0x9c, 0x92,
-	0x1e, 0xc3, 0x83, 0x4f, 0x54, 0x82, 0x5f, 0xaa, 0x77, 0x5a, 0xca, 0xa4, 0x0a, 0x64, 0x57, 0xc5,
-	0x6f, 0x6d, 0xba, 0x75, 0xd8, 0xae, 0x4d, 0x09, 0xee, 0x9a, 0x1a, 0x5e, 0xdf, 0xbc, 0x44, 0xa7,
-	0x96, 0x0c, 0x62, 0xa9, 0x5d, 0x77, 0xc3, 0xf0, 0x68, 0xa9, 0x04, 0x99, 0x05, 0x08, 0xbf, 0x52,
-	0x84, 0x44, 0x87, 0xf3, 0x38, 0x90, 0x47, 0x4b, 0x3f, 0x07, 0xd4, 0x54, 0xea, 0x95, 0x77, 0xe9,
-	0x4e, 0x72, 0xcb, 0xa6, 0xf9, 0xc4, 0x57, 0x46, 0xe9, 0xca, 0x77, 0x82, 0xc2, 0x44, 0x61, 0x5a,
-	0xd3, 0x21, 0x66, 0x55, 0xe6, 0x2c, 0xb2, 0xf7, 0x97, 0xf0, 0xb9, 0x49, 0x87, 0x10, 0x27, 0x94,
-	0xc3, 0x9b, 0xc1, 0xe3, 0xc0, 0x51, 0x76, 0x5d, 0x91, 0x5d, 0xe0, 0x96, 0x1d, 0x83, 0x10, 0xbf,
-	0x0b, 0x31, 0xca, 0x5e, 0x7c, 0xb5, 0x09, 0xfe, 0xaf, 0xb6, 0x2c, 0xc4, 0xed, 0xe9, 0xa0, 0x63,
-	0x5e, 0xb8, 0xeb, 0x18, 0xa3, 0xa4, 0x5f, 0x09, 0x90, 0xa1, 0x7a, 0xfe, 0x72, 0xb9, 0x69, 0x5c,
-	0xf8, 0x3f, 0x07, 0xf7, 0x43, 0x2e, 0x74, 0xce, 0x83, 0x8e, 0xf0, 0x03, 0x4e, 0x23, 0x71, 0x11,
-	0xe6, 0x28, 0x3f, 0x72, 0x19, 0xb5, 0x24, 0x6c, 0x9c, 0xe9, 0x86, 0xd6, 0xee, 0x4c, 0xa5, 0xff,
-	0x08, 0x70, 0xcf, 0x67, 0x03, 0xf7, 0x4e, 0xf0, 0x3b, 0x3e, 0x86, 0x0d, 0x0d, 0x13, 0x55, 0xbf,
-	0x70, 0x17, 0x9e, 0xe2, 0x8d, 0x11, 0x68, 0x30, 0x39, 0xee, 0x27, 0x57, 0xcd, 0x9f, 0x7b, 0x91,
-	0x35, 0x3b, 0x66, 0x74, 0x65, 0xc7, 0x44, 0x05, 0x48, 0xe9, 0x76, 0x1b, 0x4f, 0x08, 0xb6, 0x0c,
-	0xf5, 0x82, 0x36, 0xad, 0x84, 0x02, 0xba, 0xdd, 0xe4, 0x1c, 0xb4, 0x07, 0x19, 0x5e, 0xc7, 0x4e,
-	0x52, 0xb5, 0xfb, 0xaa, 0xdd, 0xe7, 0x9f, 0x64, 0x7c, 0xa3, 0xac, 0x9b, 0x1a, 0x76, 0x36, 0x4e,
-	0xe9, 0x17, 0x10, 0xa3, 0xdf, 0x3b, 0xce, 0x8d, 0x8b, 0x5d, 0x9e, 0x6e, 0x02, 0xfe, 0x6d, 0x3c,
-	0x07, 0x1b, 0x6c, 0x69, 0x66, 0x7b, 0x7e, 0x52, 0x71, 0xc9, 0xf5, 0x7b, 0x3a, 0xca, 0x03, 0xd8,
-	0x7a, 0xcf, 0x50, 0xc9, 0xc8, 0xc2, 0x8e, 0xe7, 0x1d, 0x55, 0x1f, 0x47, 0x7a, 0x0b, 0xb6, 0xf8,
-	0x02, 0xb8, 0xb6, 0x7c, 0x0f, 0x20, 0xed, 0x8a, 0xf1, 0x90, 0x7c, 0xe0, 0x8d, 0x0b, 0xb6, 0x7b,
-	0x89, 0x81, 0x5f, 0x72, 0x54, 0x62, 0x79, 0x28, 0xbc, 0xfd, 0x07, 0x01, 0x52, 0xbe, 0xcd, 0x08,
-	0xbd, 0x07, 0xb9, 0xfa, 0x7e, 0x55, 0x3e, 0x6a, 0x9f, 0xb4, 0xaa, 0xad, 0xd3, 0x93, 0xf6, 0xe9,
-	0xd1, 0xc9, 0x71, 0xb3, 0x2e, 0x7f, 0x5f, 0x6e, 0x36, 0x32, 0x21, 0xf1, 0xc1, 0xe5, 0x55, 0xf1,
-	0x1e, 0x93, 0x3c, 0x35, 0xec, 0x21, 0xee, 0xea, 0x67, 0x3a, 0xd6, 0xd0, 0x23, 0xc8, 0x2e, 0x29,
-	0x55, 0xeb, 0x2d, 0xf9, 0x27, 0xd5, 0x56, 0xb3, 0x91, 0x11, 0xc4, 0xad, 0xcb, 0xab, 0x62, 0xb2,
-	0xda, 0x25, 0xfa, 0x58, 0x25, 0x58, 0x43, 0x8f, 0x57, 0xf0, 0x1b, 0xcd, 0x85, 0x70, 0x58, 0xdc,
-	0xbe, 0xbc, 0x2a, 0xa6, 0x1a, 0x58, 0x75, 0xc5, 0xc5, 0xe8, 0xaf, 0xff, 0x98, 0x0f, 0xbd, 0xfd,
-	0x85, 0x00, 0x49, 0xaf, 0x76, 0xd1, 0x3b, 0x90, 0x6d, 0xfd, 0xe8, 0xb0, 0x79, 0xd4, 0x6e, 0xbd,
-	0x38, 0x6e, 0xae, 0x18, 0x48, 0x01, 0xfc, 0xa6, 0xbd, 0x05, 0xf7, 0x7d, 0xc2, 0xf2, 0x51, 0xab,
-	0xa9, 0x1c, 0x55, 0x7f, 0x90, 0x11, 0xc4, 0xcd, 0xcb, 0xab, 0x62, 0x42, 0x36, 0x78, 0x8a, 0x2c,
-	0x8b, 0x35, 0x7f, 0xca, 0xc5, 0xc2, 0x4c, 0xcc, 0xcd, 0x24, 0x31, 0xe1, 0x98, 0xf3, 0xe7, 0x3f,
-	0xe5, 0x85, 0xda, 0xd1, 0xab, 0x7f, 0xe7, 0x43, 0xaf, 0xe6, 0x79, 0xe1, 0xcb, 0x79, 0x5e, 0xf8,
-	0xd7, 0x3c, 0x2f, 0x7c, 0xf1, 0x3a, 0x1f, 0xfa, 0xf2, 0x75, 0x3e, 0xf4, 0x8f, 0xd7, 0xf9, 0xd0,
-	0x67, 0xef, 0xde, 0x71, 0x02, 0xe1, 0xf1, 0x80, 0xfd, 0x55, 0xd4, 0x89, 0xd3, 0xbf, 0x82, 0xde,
-	0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x20, 0x57, 0xf6, 0x97, 0x12, 0x00, 0x00,
+	// 1754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0xdb, 0xc6,
+	0x15, 0x5f, 0xea, 0x6b, 0xa5, 0xa7, 0xfd, 0x90, 0xc7, 0x6b, 0x59, 0x4b, 0xa4, 0x92, 0x42, 0x20,
+	0xe8, 0x3a, 0x81, 0xa5, 0x58, 0x49, 0xdd, 0x24, 0x07, 0x3b, 0xfa, 0x4a, 0x96, 0xbb, 0xed, 0x76,
+	0x4b, 0xcb, 0x4d, 0x9d, 0xa2, 0x10, 0x28, 0x71, 0x56, 0x22, 0x76, 0x45, 0x2a, 0x9c, 0x91, 0x2c,
+	0x1d, 0x0a, 0xb4, 0xb7, 0xc2, 0xbd, 0xe4, 0xd2, 0x43, 0x0f, 0x46, 0x81, 0xb6, 0x87, 0x5c, 0x0a,
+	0xf4, 0x56, 0xf4, 0x3f, 0x30, 0xd0, 0x4b, 0x8e, 0x45, 0x0f, 0x42, 0x2b, 0xdf, 0xfb, 0x07, 0xe4,
+	0x54, 0x70, 0x66, 0x48, 0x51, 0x5a, 0xae, 0xbc, 0x05, 0x92, 0xa0, 0x37, 0xce, 0xcc, 0x7b, 0xbf,
+	0x79, 0xf3, 0xe6, 0xbd, 0xdf, 0x7b, 0x43, 0xc8, 0xeb, 0x13, 0x7c, 0xa1, 0x3b, 0x65, 0x3c, 0x1e,
+	0x94, 0xc7, 0xf7, 0x3a, 0x98, 0xea, 0xf7, 0xca, 0x9f, 0x8d, 0xb0, 0x33, 0x2d, 0x0d, 0x1d, 0x9b,
+	0xda, 0x08, 0xf1, 0xf5, 0x12, 0x1e, 0x0f, 0x4a, 0x62, 0x5d, 0xde, 0xeb, 0xd9, 0x3d, 0x9b, 0x2d,
+	0x97, 0xdd, 0x2f, 0x2e, 0x29, 0x87, 0x21, 0xd1, 0xe9, 0x10, 0x13, 0xb1, 0x5e, 0x08, 0x59, 0x1f,
+	0xea, 0x8e, 0x3e, 0x10, 0x02, 0xca, 0xef, 0x25, 0x40, 0x0d, 0x3c, 0xb4, 0x89, 0x49, 0x7f, 0xec,
+	0x5a, 0x70, 0xca, 0x16, 0x51, 0x0e, 0x36, 0x75, 0xc3, 0x70, 0x30, 0x21, 0x39, 0xa9, 0x28, 0x1d,
+	0xa4, 0x34, 0x6f, 0x88, 0xf6, 0x20, 0xae, 0x13, 0x82, 0x69, 0x2e, 0xc2, 0xe6, 0xf9, 0x00, 0x3d,
+	0x81, 0x78, 0xb7, 0xaf, 0x9b, 0x56, 0x2e, 0xea, 0xce, 0xd6, 0xea, 0x5f, 0xcd, 0x0a, 0x0f, 0x7b,
+	0x26, 0xed, 0x8f, 0x3a, 0xa5, 0xae, 0x3d, 0x28, 0x73, 0x2b, 0x2c, 0x4c, 0x9f, 0xda, 0xce, 0xb9,
+	0x18, 0xdd, 0xed, 0xda, 0x0e, 0x2e, 0x4f, 0xca, 0x16, 0x9e, 0x8c, 0x48, 0x19, 0x4f, 0x86, 0xb6,
+	0x43, 0xb1, 0x51, 0xaa, 0xbb, 0x30, 0x27, 0xfa, 0x00, 0x6b, 0x1c, 0x51, 0x79, 0x00, 0xd9, 0x9a,
+	0x4e, 0xbb, 0x7d, 0x6c, 0xd4, 0xed, 0xc1, 0x40, 0xb7, 0x0c, 0xa2, 0xe1, 0xcf, 0x46, 0x98, 0x50,
+	0xd7, 0x14, 0xbe, 0x29, 0x37, 0x91, 0x0f, 0xd0, 0x0e, 0x44, 0x4c, 0x43, 0x58, 0x17, 0x31, 0x0d,
+	0xe5, 0xef, 0x51, 0xb8, 0x7d, 0x09, 0x80, 0x0c, 0x6d, 0x8b, 0x60, 0x94, 0x65, 0xb2, 0x4c, 0xbd,
+	0x96, 0x98, 0xcf, 0x0a, 0x11, 0xb5, 0xe1, 0xea, 0x20, 0x04, 0x31, 0x43, 0xa7, 0xba, 0x40, 0x61,
+	0xdf, 0xa8, 0x0a, 0x09, 0x42, 0x75, 0x3a, 0x22, 0xec, 0x8c, 0x3b, 0x95, 0x3b, 0xa5, 0xcb, 0xb7,
+	0x54, 0x5a, 0xd9, 0xe8, 0x11, 0x53, 0xd0, 0x84, 0x22, 0xea, 0x40, 0xe2, 0x1c, 0x4f, 0xdb, 0xa6,
+	0x91, 0x8b, 0xb1, 0x2d, 0x8f, 0xe7, 0xb3, 0x42, 0xfc, 0x18, 0x4f, 0xd5, 0xc6, 0x57, 0xb3, 0xc2,
+	0x83, 0x6b, 0xfa, 0x6b, 0x30, 0xba, 0xa0, 0x26, 0x31, 0x7b, 0x0b, 0x97, 0x31, 0x04, 0x2d, 0x7e,
+	0x8e, 0xa7, 0xaa, 0x81, 0x5e, 0x87, 0x2d, 0x3c, 0xc1, 0xdd, 0x11, 0xc5, 0x6d, 0x76, 0x84, 0x04,
+	0x3b, 0x42, 0x5a, 0xcc, 0x35, 0xdc, 0x93, 0x68, 0x90, 0x1b, 0x3a, 0x78, 0xdc, 0xee, 0x70, 0x63,
+	0xdb, 0x5d, 0x61, 0xad, 0x6b, 0xd8, 0x26, 0x33, 0x6c, 0x7f, 0x3e, 0x2b, 0xdc, 0x3a, 0x75, 0xf0,
+	0x78, 0xe5, 0x3c, 0x6a, 0x43, 0xbb, 0x35, 0x0c, 0x99, 0x36, 0x50, 0x19, 0xd2, 0x02, 0xa6, 0x6d,
+	0x1a, 0x24, 0x97, 0x2c, 0x46, 0x0f, 0x52, 0xb5, 0x9d, 0xf9, 0xac, 0x00, 0x42, 0x48, 0x6d, 0x10,
+	0x0d, 0x84, 0x88, 0x6a, 0x10, 0x54, 0x86, 0xf8, 0xd0, 0xb1, 0xed, 0xb3, 0x5c, 0xaa, 0x28, 0x1d,
+	0xa4, 0x2b, 0xfb, 0x61, 0xde, 0x3c, 0x75, 0x05, 0x34, 0x2e, 0x77, 0x14, 0x4b, 0xc6, 0x33, 0x09,
+	0xe5, 0x77, 0x12, 0xdc, 0x38, 0xc6, 0xd3, 0x2a, 0x8f, 0xc6, 0xf5, 0x91, 0xf0, 0x2d, 0xb8, 0xfb,
+	0x28, 0x96, 0x8c, 0x64, 0xa2, 0x47, 0xb1, 0x64, 0x34, 0x13, 0x53, 0xfe, 0x1a, 0x01, 0x14, 0xb4,
+	0x4d, 0x04, 0xd9, 0xc2, 0x0c, 0xe9, 0x1b, 0xbb, 0xf5, 0x4f, 0x21, 0x25, 0x12, 0x14, 0x93, 0x5c,
+	0xa4, 0x18, 0x3d, 0x48, 0x57, 0xee, 0x87, 0x79, 0xf4, 0xb2, 0x79, 0xa5, 0x4f, 0xb0, 0xd9, 0xeb,
+	0x53, 0x6c, 0x88, 0xf9, 0x5a, 0xec, 0xc5, 0xac, 0xb0, 0xa1, 0x2d, 0xe0, 0xd0, 0x6b, 0x90, 0xa2,
+	0x7d, 0x07, 0x93, 0xbe, 0x7d, 0x61, 0xf0, 0xfc, 0xd6, 0x16, 0x13, 0x72, 0x1d, 0x76, 0x57, 0x10,
+	0xd6, 0x90, 0x47, 0x16, 0x12, 0x4f, 0x99, 0xb0, 0xc8, 0x2c, 0x31, 0x52, 0x3e, 0x81, 0x7d, 0xc6,
+	0x3e, 0x2d, 0xfb, 0x1c, 0x5b, 0xab, 0xfe, 0xbb, 0x1a, 0xee, 0x35, 0x48, 0x75, 0x6d, 0xeb, 0xcc,
+	0x74, 0x06, 0x98, 0x67, 0x7c, 0x52, 0x5b, 0x4c, 0x7c, 0x10, 0xc9, 0x49, 0xca, 0x2f, 0x25, 0xb8,
+	0xcd, 0x90, 0x05, 0xc7, 0xb9, 0x09, 0x89, 0x05, 0xc7, 0xdd, 0x81, 0x38, 0x9d, 0x78, 0xd7, 0xb2,
+	0x55, 0xdb, 0x73, 0xcf, 0xfd, 0xcf, 0x59, 0x21, 0x76, 0xa8, 0x93, 0xfe, 0x7c, 0x56, 0x88, 0xb5,
+	0x26, 0x6a, 0x43, 0x8b, 0xd1, 0x89, 0x6a, 0xa0, 0xfb, 0xb0, 0xd3, 0x19, 0x39, 0x16, 0x76, 0xda,
+	0x9e, 0x25, 0x11, 0xa6, 0xb3, 0x2b, 0x74, 0x36, 0x3d, 0x9b, 0xb7, 0xb9, 0x98, 0x18, 0x32, 0x13,
+	0xfe, 0x26, 0xc1, 0xcd, 0xe0, 0xee, 0x5e, 0xcc, 0x3e, 0x59, 0x8a, 0xd9, 0xaf, 0x93, 0x32, 0x51,
+	0x1d, 0x12, 0x9c, 0xe4, 0x99, 0x99, 0xe9, 0xca, 0x5b, 0x61, 0xa1, 0x70, 0x85, 0x5b, 0x34, 0xa1,
+	0xca, 0x6c, 0x7f, 0x0c, 0x7b, 0xcb, 0xa6, 0x8b, 0x2b, 0x79, 0xdf, 0xe7, 0xc2, 0x08, 0xe3, 0xc2,
+	0xd7, 0xc3, 0x36, 0x08, 0x68, 0x2e, 0x38, 0x90, 0xc1, 0x3e, 0x84, 0xad, 0xe6, 0x18, 0x5b, 0x74,
+	0x7d, 0xfa, 0xee, 0x43, 0x12, 0xbb, 0x52, 0x6d, 0x9f, 0xce, 0x37, 0xd9, 0x58, 0x35, 0x94, 0x0f,
+	0x61, 0x5b, 0x00, 0x08, 0x83, 0xca, 0x10, 0x67, 0x6b, 0x39, 0xe9, 0x6a, 0x36, 0xe1, 0x1a, 0x5c,
+	0x4e, 0xb9, 0x0f, 0x32, 0x73, 0x40, 0x2d, 0x78, 0x5f, 0xaf, 0x0e, 0x39, 0xe5, 0x10, 0xb6, 0x99,
+	0xbb, 0x7d, 0xea, 0xf9, 0xbe, 0xef, 0x0a, 0x89, 0xb9, 0xa2, 0x10, 0xb6, 0x35, 0x53, 0x59, 0x76,
+	0x84, 0x32, 0x80, 0x1d, 0x0f, 0x49, 0xec, 0xfa, 0x33, 0x48, 0xb0, 0x93, 0xbb, 0x50, 0xd1, 0xaf,
+	0x2b, 0x24, 0x04, 0xa4, 0xf2, 0x00, 0x76, 0x04, 0x13, 0xaf, 0xf7, 0x7a, 0x76, 0x51, 0x3e, 0x83,
+	0x25, 0x51, 0xf9, 0x4d, 0x04, 0x76, 0x7d, 0x80, 0x57, 0x97, 0x4f, 0xb7, 0x09, 0xf1, 0xca, 0xa7,
+	0xfb, 0x8d, 0x7e, 0xe8, 0xc7, 0x64, 0x94, 0xd1, 0x53, 0x39, 0xd4, 0x4f, 0xcb, 0x1b, 0x94, 0x78,
+	0x48, 0x36, 0x2d, 0xea, 0x4c, 0x05, 0x2f, 0x09, 0x10, 0x54, 0x5c, 0xe1, 0xf6, 0x94, 0x4f, 0xaa,
+	0x1e, 0x25, 0x16, 0x61, 0x6b, 0xa0, 0x4f, 0xda, 0x3d, 0x9d, 0xb4, 0xbb, 0x36, 0xa1, 0xb9, 0x78,
+	0x51, 0x3a, 0xd8, 0xd6, 0x60, 0xa0, 0x4f, 0x3e, 0xd6, 0x49, 0xdd, 0x26, 0x54, 0x7e, 0x1f, 0xd2,
+	0x81, 0x0d, 0x50, 0x06, 0xa2, 0xe7, 0x78, 0x2a, 0xbc, 0xe1, 0x7e, 0xba, 0x1e, 0x1a, 0xeb, 0x17,
+	0x23, 0xef, 0x20, 0x7c, 0xf0, 0x41, 0xe4, 0x3d, 0x49, 0x29, 0x41, 0xf6, 0x14, 0x5b, 0x86, 0x69,
+	0xf5, 0xae, 0xd5, 0x94, 0x28, 0x7f, 0x96, 0xe0, 0xf6, 0x25, 0x05, 0xe1, 0xc5, 0x23, 0x48, 0x7a,
+	0x15, 0x98, 0x5d, 0x7c, 0xba, 0x72, 0x70, 0x65, 0xbe, 0xae, 0x38, 0x48, 0x38, 0xc5, 0xd7, 0x47,
+	0x1f, 0x01, 0x58, 0x78, 0x42, 0x79, 0x69, 0x17, 0xd9, 0xff, 0xdd, 0xd0, 0xd2, 0xba, 0x64, 0x0c,
+	0xab, 0xe7, 0x5a, 0xca, 0x55, 0x65, 0x9f, 0xca, 0x17, 0x12, 0xdc, 0x0c, 0x11, 0xf9, 0x56, 0x6a,
+	0x59, 0xb0, 0xf9, 0xda, 0x12, 0xcd, 0xd7, 0x3e, 0x24, 0x89, 0xd9, 0x6b, 0xf7, 0x75, 0xd2, 0x67,
+	0x25, 0x68, 0x4b, 0xdb, 0x24, 0x66, 0xcf, 0x65, 0x6a, 0xe5, 0xb7, 0x11, 0xd8, 0x0b, 0xf3, 0xcd,
+	0xff, 0x14, 0x9d, 0xda, 0x4a, 0x74, 0xbe, 0x7b, 0xdd, 0x1b, 0xf8, 0xff, 0x0d, 0xd1, 0x07, 0x70,
+	0x83, 0x93, 0x9b, 0x6a, 0x9d, 0xd9, 0x5e, 0x74, 0xde, 0x59, 0x26, 0xb6, 0x90, 0x0a, 0xe6, 0x33,
+	0xdd, 0x5f, 0x24, 0x40, 0x41, 0x00, 0xe1, 0xd5, 0x6f, 0xb0, 0x6c, 0x3d, 0x84, 0xb4, 0xa8, 0xb2,
+	0xa6, 0x75, 0x66, 0x8b, 0xe8, 0xcd, 0x87, 0xb6, 0xd9, 0x0b, 0xbb, 0xa0, 0xe3, 0x7f, 0x2b, 0xf7,
+	0x60, 0xbf, 0xce, 0xcb, 0xbf, 0x4e, 0x4d, 0xdb, 0x3a, 0x64, 0xcd, 0xc5, 0xfa, 0xc4, 0x7c, 0x17,
+	0xe4, 0x30, 0x15, 0x3f, 0x84, 0x12, 0x7d, 0x36, 0xc3, 0x94, 0x62, 0x9a, 0x18, 0x29, 0x77, 0xe1,
+	0xd6, 0xc7, 0x3a, 0xc5, 0x4f, 0xf5, 0x6b, 0x35, 0xa2, 0x4a, 0x05, 0xb2, 0xab, 0xe2, 0xaf, 0x2c,
+	0x34, 0x75, 0xd8, 0xad, 0x4d, 0x29, 0xee, 0xda, 0x06, 0x5e, 0x4f, 0xd8, 0xb2, 0x4b, 0x1f, 0x16,
+	0x75, 0xf4, 0xae, 0xd7, 0x55, 0xf9, 0x63, 0xa5, 0x04, 0x99, 0x05, 0x88, 0xd8, 0x52, 0x86, 0x64,
+	0x47, 0xcc, 0x09, 0x20, 0x7f, 0xac, 0xfc, 0x1c, 0x50, 0x53, 0xab, 0x57, 0xde, 0x66, 0x7d, 0xd8,
+	0x2b, 0xba, 0xeb, 0x7b, 0x81, 0x34, 0xda, 0xa9, 0x7c, 0x27, 0xec, 0x9a, 0x18, 0x4c, 0x6b, 0x3a,
+	0xc4, 0x3c, 0xcb, 0xdc, 0xe6, 0xfd, 0xe6, 0x12, 0xbe, 0x30, 0xe9, 0x18, 0x12, 0x94, 0xcd, 0x08,
+	0xfe, 0xbb, 0x1b, 0x5a, 0xbe, 0x2f, 0x2b, 0xf2, 0x0d, 0xbc, 0xb4, 0xe3, 0x10, 0xf2, 0xf7, 0x20,
+	0xce, 0xa6, 0x17, 0x2f, 0x55, 0x29, 0xf8, 0x52, 0xcd, 0x42, 0x82, 0x4c, 0x07, 0x1d, 0xfb, 0xc2,
+	0x6b, 0x41, 0xf9, 0x48, 0xf9, 0x95, 0x04, 0x19, 0xa6, 0x17, 0x4c, 0x97, 0xab, 0x4a, 0x64, 0xf0,
+	0x09, 0x7c, 0xb8, 0xe1, 0x41, 0xe7, 0x7c, 0xe8, 0xa8, 0x58, 0x10, 0x63, 0x24, 0x2f, 0xae, 0x39,
+	0x26, 0x96, 0xbc, 0x89, 0x5a, 0x0a, 0x36, 0xcf, 0x4c, 0xcb, 0x68, 0x77, 0xa6, 0xca, 0x7f, 0x24,
+	0xb8, 0x11, 0xb0, 0x41, 0x78, 0x27, 0xfc, 0x1c, 0x1f, 0xc2, 0xa6, 0x81, 0xa9, 0x6e, 0x5e, 0x78,
+	0x4d, 0x5e, 0xf1, 0xca, 0x1b, 0x68, 0x70, 0x39, 0xe1, 0x27, 0x4f, 0x2d, 0x18, 0x7b, 0xd1, 0x35,
+	0x7d, 0x75, 0x6c, 0xa5, 0xaf, 0x46, 0x05, 0x48, 0x9b, 0xa4, 0x8d, 0x27, 0x14, 0x3b, 0x96, 0x7e,
+	0xc1, 0x48, 0x2b, 0xa9, 0x81, 0x49, 0x9a, 0x62, 0x06, 0x1d, 0x40, 0x46, 0xe4, 0xb1, 0x1b, 0x54,
+	0x9c, 0xb4, 0xf9, 0x33, 0x54, 0x74, 0xd1, 0x75, 0xdb, 0xc0, 0x8c, 0xbb, 0x7f, 0x01, 0x71, 0xf6,
+	0xc6, 0x73, 0x77, 0x5c, 0xbc, 0x5f, 0x58, 0xf7, 0x13, 0x7c, 0x81, 0xe4, 0x60, 0x93, 0x3f, 0x14,
+	0xf8, 0xdb, 0x26, 0xa5, 0x79, 0xc3, 0xf5, 0x6f, 0x13, 0x94, 0x07, 0x20, 0x66, 0xcf, 0xd2, 0xe9,
+	0xc8, 0xc1, 0xae, 0xe7, 0x5d, 0xd5, 0xc0, 0x8c, 0xf2, 0x06, 0x6c, 0x8b, 0xa6, 0x77, 0x6d, 0xfa,
+	0x1e, 0xc1, 0x8e, 0x27, 0x26, 0xae, 0xe4, 0x3d, 0xbf, 0x5c, 0xf0, 0x7e, 0x53, 0x0e, 0x2d, 0xb1,
+	0x4c, 0x62, 0xb9, 0x28, 0xbc, 0xf9, 0x07, 0x09, 0xd2, 0x81, 0x6e, 0x10, 0xbd, 0x03, 0xb9, 0xfa,
+	0x61, 0x55, 0x3d, 0x69, 0x3f, 0x6a, 0x55, 0x5b, 0x8f, 0x1f, 0xb5, 0x1f, 0x9f, 0x3c, 0x3a, 0x6d,
+	0xd6, 0xd5, 0x8f, 0xd4, 0x66, 0x23, 0xb3, 0x21, 0xdf, 0x7a, 0xf6, 0xbc, 0x78, 0x83, 0x4b, 0x3e,
+	0xb6, 0xc8, 0x10, 0x77, 0xcd, 0x33, 0x13, 0x1b, 0xe8, 0x0e, 0x64, 0x97, 0x94, 0xaa, 0xf5, 0x96,
+	0xfa, 0x93, 0x6a, 0xab, 0xd9, 0xc8, 0x48, 0xf2, 0xf6, 0xb3, 0xe7, 0xc5, 0x54, 0xb5, 0x4b, 0xcd,
+	0xb1, 0x4e, 0xb1, 0x81, 0xee, 0xae, 0xe0, 0x37, 0x9a, 0x0b, 0xe1, 0x88, 0xbc, 0xfb, 0xec, 0x79,
+	0x31, 0xdd, 0xc0, 0xba, 0x27, 0x2e, 0xc7, 0x7e, 0xfd, 0xc7, 0xfc, 0xc6, 0x9b, 0x9f, 0x4b, 0x90,
+	0xf2, 0x73, 0x17, 0xbd, 0x05, 0xd9, 0xd6, 0x8f, 0x8e, 0x9b, 0x27, 0xed, 0xd6, 0x93, 0xd3, 0xe6,
+	0x8a, 0x81, 0x0c, 0x20, 0x68, 0xda, 0x1b, 0x70, 0x33, 0x20, 0xac, 0x9e, 0xb4, 0x9a, 0xda, 0x49,
+	0xf5, 0x07, 0x19, 0x49, 0xde, 0x7a, 0xf6, 0xbc, 0x98, 0x54, 0x2d, 0x11, 0x22, 0xcb, 0x62, 0xcd,
+	0x9f, 0x0a, 0xb1, 0x08, 0x17, 0xf3, 0x22, 0x49, 0x4e, 0xba, 0xe6, 0x7c, 0xf1, 0xa7, 0xbc, 0x54,
+	0x3b, 0x79, 0xf1, 0xef, 0xfc, 0xc6, 0x8b, 0x79, 0x5e, 0xfa, 0x72, 0x9e, 0x97, 0xfe, 0x35, 0xcf,
+	0x4b, 0x9f, 0xbf, 0xcc, 0x6f, 0x7c, 0xf9, 0x32, 0xbf, 0xf1, 0x8f, 0x97, 0xf9, 0x8d, 0x4f, 0xdf,
+	0xbe, 0x66, 0x05, 0x72, 0x7f, 0x83, 0xb1, 0xdf, 0x63, 0x9d, 0x04, 0xfb, 0xfd, 0xf5, 0xce, 0x7f,
+	0x07, 0x00, 0x85, 0x46, 0xa8, 0x39, 0x8b, 0x13, 0x00, 0x00,
 }
 
 func (m *DepositQueryParams) Marshal() (dAtA []byte, err error) {
@@ -2442,6 +2489,18 @@ func (m *PendingCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.NextBatch != nil {
+		{
+			size, err := m.NextBatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2459,6 +2518,50 @@ func (m *PendingCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingCommandBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommandBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommandBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigHash) > 0 {
+		i -= len(m.SigHash)
+		copy(dAtA[i:], m.SigHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommandResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3521,6 +3624,31 @@ func (m *PendingCommandsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.NextBatch != nil {
+		l = m.NextBatch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingCommandBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SigHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -6171,6 +6299,192 @@ func (m *PendingCommandsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextBatch == nil {
+				m.NextBatch = &PendingCommandBatch{}
+			}
+			if err := m.NextBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommandBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommandBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommandBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigHash = append(m.SigHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigHash == nil {
+				m.SigHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -224,6 +224,7 @@ func RandomCommand() types.Command {
 // RandomEventTransfer returns a random (valid) types.EventTransfer
 func RandomEventTransfer() types.EventTransfer {
 	return types.EventTransfer{
+		From:   RandomAddress(),
 		To:     RandomAddress(),
 		Amount: rand.UintBetween(sdk.OneUint(), sdk.NewUint(100000)),
 	}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"reflect"
 	"sort"
 	"strconv"
@@ -841,6 +842,51 @@ func packArguments(chainID sdk.Int, commandIDs []CommandID, commands []CommandTy
 	return result, nil
 }
 
+// UnpackCommandBatchData decodes the data of a command batch, i.e. the data that is signed for the gateway to execute the commands.
+// It is the inverse of how NewCommandBatchMetadata packs the commands.
+func UnpackCommandBatchData(data []byte) (sdk.Int, []Command, error) {
+	arguments := abi.Arguments{{Type: uint256Type}, {Type: bytes32ArrayType}, {Type: stringArrayType}, {Type: bytesArrayType}}
+	values, err := StrictDecode(arguments, data)
+	if err != nil {
+		return sdk.Int{}, nil, sdkerrors.Wrap(err, "invalid command batch data")
+	}
+
+	chainID := sdk.NewIntFromBigInt(values[0].(*big.Int))
+	commandIDs := values[1].([][commandIDSize]byte)
+	commandTypes := values[2].([]string)
+	commandParams := values[3].([][]byte)
+
+	if len(commandIDs) != len(commandTypes) || len(commandIDs) != len(commandParams) {
+		return sdk.Int{}, nil, fmt.Errorf("length mismatch for command arguments")
+	}
+
+	commands := make([]Command, len(commandIDs))
+	for i := range commandIDs {
+		commandType, ok := commandTypeFromString(commandTypes[i])
+		if !ok {
+			return sdk.Int{}, nil, fmt.Errorf("unknown command type %s", commandTypes[i])
+		}
+
+		commands[i] = Command{
+			ID:     commandIDs[i],
+			Type:   commandType,
+			Params: commandParams[i],
+		}
+	}
+
+	return chainID, commands, nil
+}
+
+func commandTypeFromString(s string) (CommandType, bool) {
+	for value := range CommandType_name {
+		if commandType := CommandType(value); commandType != COMMAND_TYPE_UNSPECIFIED && commandType.String() == s {
+			return commandType, true
+		}
+	}
+
+	return COMMAND_TYPE_UNSPECIFIED, false
+}
+
 // ValidateBasic does stateless validation of the object
 func (m *BurnerInfo) ValidateBasic() error {
 	if err := m.DestinationChain.Validate(); err != nil {
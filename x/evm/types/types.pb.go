@@ -539,6 +539,8 @@ var xxx_messageInfo_EventContractCallWithToken proto.InternalMessageInfo
 type EventTransfer struct {
 	To     Address                                 `protobuf:"bytes,1,opt,name=to,proto3,customtype=Address" json:"to"`
 	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	// sender of the ERC20 transfer to the deposit address
+	From Address `protobuf:"bytes,3,opt,name=from,proto3,customtype=Address" json:"from"`
 }
 
 func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
@@ -1254,160 +1256,160 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/types.proto", fileDescriptor_ea224848ef0a2f28) }
 
 var fileDescriptor_ea224848ef0a2f28 = []byte{
-	// 2439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcb, 0x6f, 0x23, 0xc7,
-	0xd1, 0xe7, 0x8c, 0xf8, 0x2c, 0x3e, 0x4c, 0xb7, 0xb5, 0x36, 0x97, 0xb6, 0x49, 0x9a, 0xf6, 0x5a,
-	0xda, 0xfd, 0x6c, 0xd2, 0x96, 0x3f, 0x27, 0x8e, 0x91, 0xd8, 0xe6, 0x6b, 0xa5, 0xd1, 0x4a, 0x24,
-	0x31, 0x9c, 0xf5, 0xeb, 0x90, 0x41, 0x93, 0xd3, 0x4b, 0x0e, 0x44, 0xce, 0x10, 0x33, 0x2d, 0x89,
-	0xcc, 0x2d, 0x97, 0xc0, 0xd0, 0xc9, 0x87, 0xdc, 0x02, 0x01, 0x01, 0x9c, 0x43, 0x90, 0x4b, 0x2e,
-	0x09, 0xe0, 0x43, 0x02, 0xe4, 0x68, 0xf8, 0xe4, 0x5b, 0x82, 0x1c, 0x98, 0x44, 0xfe, 0x17, 0x72,
-	0xf2, 0x25, 0xc1, 0xf4, 0x34, 0xc9, 0xa1, 0x44, 0x6a, 0xb5, 0xf6, 0x0a, 0x30, 0x90, 0x13, 0xd9,
-	0x53, 0xd5, 0x55, 0xbf, 0xaa, 0xae, 0x47, 0x77, 0x41, 0x06, 0x8f, 0x48, 0x1f, 0x5b, 0x45, 0x72,
-	0x34, 0x28, 0x1e, 0xbd, 0xde, 0x26, 0x14, 0xbf, 0x5e, 0xa4, 0xe3, 0x21, 0xb1, 0x0b, 0x43, 0xcb,
-	0xa4, 0x26, 0x42, 0x2e, 0xbd, 0x40, 0x8e, 0x06, 0x05, 0x4e, 0x4f, 0xdf, 0xec, 0x9a, 0x66, 0xb7,
-	0x4f, 0x8a, 0x8c, 0xa3, 0x7d, 0xf8, 0xa0, 0x88, 0x8d, 0xb1, 0xcb, 0x9e, 0x5e, 0xef, 0x9a, 0x5d,
-	0x93, 0xfd, 0x2d, 0x3a, 0xff, 0xf8, 0xd7, 0x9b, 0x1d, 0xd3, 0x1e, 0x98, 0xb6, 0xea, 0x12, 0xdc,
-	0x05, 0x27, 0xdd, 0xe6, 0xfa, 0x0d, 0x32, 0x3a, 0xb4, 0x8b, 0x64, 0x34, 0x34, 0x2d, 0x4a, 0xb4,
-	0x65, 0x50, 0xd2, 0xaf, 0x70, 0xd6, 0xc1, 0x61, 0x9f, 0xea, 0xb6, 0xde, 0xbd, 0x94, 0x3b, 0xff,
-	0x6b, 0x01, 0xe0, 0x7d, 0x93, 0x92, 0xda, 0x11, 0x31, 0xa8, 0x8d, 0x3e, 0x82, 0x40, 0xa7, 0x87,
-	0x75, 0x23, 0x25, 0xe4, 0x84, 0xcd, 0x48, 0xb9, 0xf2, 0xcd, 0x24, 0xfb, 0x6e, 0x57, 0xa7, 0xbd,
-	0xc3, 0x76, 0xa1, 0x63, 0x0e, 0x8a, 0xae, 0x68, 0x83, 0xd0, 0x63, 0xd3, 0x3a, 0xe0, 0xab, 0x57,
-	0x3b, 0xa6, 0x45, 0x8a, 0xa3, 0x73, 0xd0, 0x0a, 0x15, 0x47, 0x4c, 0x1d, 0x0f, 0x88, 0xec, 0x4a,
-	0x44, 0x3f, 0x84, 0x20, 0x61, 0x4a, 0x52, 0x62, 0x6e, 0x6d, 0x33, 0xba, 0x75, 0xb3, 0x70, 0xd1,
-	0x67, 0x05, 0x06, 0xa3, 0xec, 0xff, 0x62, 0x92, 0xf5, 0xc9, 0x9c, 0x3d, 0xff, 0x65, 0x18, 0x02,
-	0xec, 0xfb, 0x75, 0xa2, 0xbb, 0x0d, 0x01, 0x3a, 0x52, 0x75, 0x2d, 0x25, 0xe6, 0x84, 0xcd, 0x58,
-	0x79, 0xdd, 0x41, 0xf0, 0xf7, 0x49, 0xd6, 0xbf, 0x83, 0xed, 0xde, 0xd9, 0x24, 0xeb, 0x57, 0x46,
-	0x52, 0x55, 0xf6, 0xd3, 0x91, 0xa4, 0xa1, 0x75, 0x08, 0xe8, 0x86, 0x46, 0x46, 0xa9, 0xb5, 0x9c,
-	0xb0, 0xe9, 0x97, 0xdd, 0x05, 0x7a, 0x0b, 0x82, 0x36, 0xc5, 0xf4, 0xd0, 0x4e, 0xf9, 0x73, 0xc2,
-	0x66, 0x62, 0x2b, 0xb7, 0xd2, 0xbc, 0x42, 0x8b, 0xf1, 0xc9, 0x9c, 0x1f, 0x55, 0x00, 0xa8, 0x79,
-	0x40, 0x0c, 0xd5, 0x26, 0x06, 0x4d, 0x05, 0x72, 0xc2, 0x66, 0x74, 0x2b, 0xbf, 0x72, 0xb7, 0xe2,
-	0xb0, 0xb6, 0x88, 0x41, 0x77, 0x7c, 0x72, 0x84, 0x4e, 0x17, 0x68, 0x0f, 0xe2, 0x1d, 0xd3, 0xa0,
-	0x16, 0xee, 0x50, 0xb5, 0x83, 0xfb, 0xfd, 0x54, 0x90, 0xc9, 0xb9, 0xb5, 0x52, 0x4e, 0x85, 0x73,
-	0x57, 0x70, 0xbf, 0xbf, 0xe3, 0x93, 0x63, 0x1d, 0xcf, 0x1a, 0xe9, 0x90, 0x5a, 0x90, 0xa6, 0x1e,
-	0xeb, 0xb4, 0xa7, 0x32, 0x6d, 0xa9, 0x10, 0x13, 0x5c, 0xb8, 0x92, 0xe0, 0x0f, 0x74, 0xda, 0x63,
-	0x80, 0x77, 0x7c, 0xf2, 0x8d, 0xce, 0x32, 0x02, 0x7a, 0x17, 0xc2, 0xd4, 0xc2, 0x86, 0xfd, 0x80,
-	0x58, 0xa9, 0x30, 0x13, 0xfd, 0xc2, 0x6a, 0xdb, 0x39, 0xe3, 0x8e, 0x4f, 0x9e, 0x6d, 0x42, 0x0d,
-	0x48, 0xb8, 0xee, 0xd3, 0xc8, 0xb0, 0x6f, 0x8e, 0x89, 0x96, 0x8a, 0x30, 0x31, 0x2f, 0x5f, 0xee,
-	0xc2, 0x2a, 0xe7, 0xde, 0xf1, 0xc9, 0x71, 0xea, 0xfd, 0x80, 0x7e, 0x2e, 0x40, 0x66, 0x9a, 0x3c,
-	0xaa, 0x79, 0x6c, 0x10, 0xcb, 0xee, 0xe9, 0x43, 0x75, 0xaa, 0xd0, 0x22, 0x5a, 0x0a, 0x98, 0x86,
-	0x37, 0x57, 0x6a, 0xd8, 0xe7, 0xdb, 0x1b, 0xd3, 0xdd, 0xca, 0x7c, 0x73, 0x59, 0x4c, 0x09, 0x3b,
-	0x3e, 0xf9, 0xb9, 0xc1, 0x25, 0x3c, 0xe8, 0x17, 0x02, 0xbc, 0x30, 0xc7, 0x30, 0x24, 0x16, 0xa6,
-	0xe6, 0x45, 0x18, 0x51, 0x06, 0xe3, 0xad, 0x87, 0xc3, 0xf0, 0x08, 0xf0, 0x68, 0xd9, 0xf1, 0xc9,
-	0xd9, 0xc1, 0xe5, 0x2c, 0xf9, 0xcf, 0x05, 0x08, 0xba, 0xf1, 0x8a, 0x5e, 0x01, 0xd4, 0x52, 0x4a,
-	0xca, 0xfd, 0x96, 0x7a, 0xbf, 0xde, 0x6a, 0xd6, 0x2a, 0xd2, 0x5d, 0xa9, 0x56, 0x4d, 0xfa, 0xd2,
-	0xeb, 0x27, 0xa7, 0xb9, 0x24, 0xd3, 0x57, 0x37, 0x8d, 0xda, 0x48, 0xb7, 0xa9, 0x13, 0x90, 0x9b,
-	0x90, 0xe4, 0xdc, 0x95, 0x46, 0xfd, 0xae, 0x24, 0xef, 0xd7, 0xaa, 0x49, 0x21, 0x8d, 0x4e, 0x4e,
-	0x73, 0x89, 0x69, 0x98, 0x3c, 0xd0, 0xad, 0x01, 0xd1, 0x16, 0x38, 0xf7, 0x9b, 0x7b, 0x35, 0xa5,
-	0x56, 0x4d, 0x8a, 0x0b, 0x9c, 0x83, 0x61, 0x9f, 0x50, 0xa2, 0xa1, 0x3c, 0xc4, 0x39, 0xe7, 0xdd,
-	0x92, 0xb4, 0x57, 0xab, 0x26, 0xd7, 0xd2, 0x4f, 0x9c, 0x9c, 0xe6, 0xa2, 0x8c, 0xed, 0x2e, 0xd6,
-	0xfb, 0x44, 0x4b, 0x87, 0x3f, 0xf9, 0x2c, 0xe3, 0xfb, 0xed, 0x6f, 0x32, 0x42, 0x39, 0x04, 0x01,
-	0x56, 0x41, 0x76, 0xfd, 0xe1, 0x58, 0x32, 0xbe, 0xeb, 0x0f, 0xc7, 0x93, 0x89, 0xfc, 0x9f, 0x45,
-	0x48, 0x2c, 0xe6, 0x11, 0xda, 0x80, 0xa0, 0x4d, 0x0c, 0x8d, 0x58, 0xac, 0xac, 0xc4, 0xca, 0x4f,
-	0xf0, 0xdc, 0x0f, 0x95, 0x34, 0xcd, 0x22, 0xb6, 0x93, 0xa8, 0x8c, 0x8c, 0x86, 0xf0, 0xa4, 0x46,
-	0x6c, 0xaa, 0x1b, 0x98, 0xea, 0xa6, 0xa1, 0xba, 0xa5, 0x48, 0x7c, 0x7c, 0xa5, 0x28, 0xe9, 0x91,
-	0xce, 0xbe, 0xa2, 0x22, 0x3c, 0xe5, 0xd5, 0x88, 0x5d, 0x40, 0xac, 0xf0, 0x44, 0x64, 0xe4, 0x21,
-	0x71, 0xa8, 0xe8, 0x69, 0x08, 0xda, 0xe3, 0x41, 0xdb, 0xec, 0xb3, 0x2a, 0x14, 0x91, 0xf9, 0x0a,
-	0x6d, 0x43, 0x10, 0x0f, 0xcc, 0x43, 0x5e, 0x5f, 0x62, 0xe5, 0x22, 0xb7, 0x71, 0xc3, 0x83, 0xd9,
-	0x6d, 0x38, 0xfc, 0xe7, 0x55, 0x5b, 0x3b, 0xe0, 0x8d, 0xe2, 0xbe, 0x6e, 0x50, 0x99, 0x6f, 0xcf,
-	0x9f, 0x88, 0xf0, 0xe4, 0x85, 0x34, 0xff, 0x3e, 0xbb, 0xf0, 0x36, 0x24, 0x67, 0xa5, 0x6c, 0xd1,
-	0x7f, 0x4f, 0x4c, 0xbf, 0x4f, 0x9d, 0x57, 0x84, 0xd8, 0x10, 0x8f, 0xfb, 0x26, 0xd6, 0xd4, 0x1e,
-	0xb6, 0x7b, 0xcc, 0x85, 0xb1, 0x72, 0xcc, 0xdb, 0x0a, 0xe4, 0x28, 0xe7, 0x70, 0x16, 0xf9, 0x7f,
-	0x8b, 0x90, 0x5e, 0x5d, 0xf3, 0xfe, 0x47, 0xbd, 0xe2, 0x89, 0xc1, 0xc0, 0x8a, 0x18, 0x0c, 0x7e,
-	0xb7, 0x18, 0x1c, 0x43, 0x7c, 0xa1, 0x1d, 0xa0, 0x2c, 0x88, 0xd4, 0x5c, 0xe5, 0x64, 0x91, 0x9a,
-	0x1e, 0xd5, 0xe2, 0x77, 0x53, 0xdd, 0x06, 0x74, 0xb1, 0x85, 0x78, 0x2c, 0x16, 0x16, 0x2c, 0xfe,
-	0x7f, 0x70, 0x5b, 0xcb, 0xcc, 0xc5, 0xe2, 0x72, 0x88, 0x31, 0xc6, 0xc5, 0x57, 0xf9, 0x3f, 0x8a,
-	0xf0, 0xc2, 0x43, 0xbb, 0x08, 0x2a, 0x00, 0x0c, 0x2d, 0xc2, 0xfb, 0x53, 0x4a, 0xc8, 0xad, 0x2d,
-	0x13, 0x1c, 0x19, 0x5a, 0xc4, 0xdd, 0x8d, 0xde, 0x87, 0xc4, 0xd0, 0x22, 0x47, 0x2a, 0xed, 0x59,
-	0xc4, 0xee, 0x99, 0x7d, 0xed, 0xdb, 0xba, 0x22, 0xee, 0x88, 0x51, 0xa6, 0x52, 0x1c, 0x1c, 0x06,
-	0x39, 0x9e, 0xe2, 0x58, 0x5b, 0x81, 0xc3, 0x20, 0xc7, 0x1c, 0x87, 0x02, 0x71, 0x87, 0x7f, 0x0e,
-	0xc3, 0xff, 0xed, 0x60, 0xc4, 0x0c, 0x72, 0x3c, 0x43, 0xf1, 0xb6, 0x98, 0x12, 0xf2, 0x9f, 0x8a,
-	0xf0, 0xd2, 0x55, 0xda, 0x9e, 0x73, 0x2c, 0x0c, 0xf2, 0x94, 0xbc, 0x0a, 0xb5, 0xa3, 0x62, 0x26,
-	0xe3, 0x7a, 0x80, 0xa3, 0x26, 0x44, 0x1d, 0xa9, 0xc7, 0x44, 0xef, 0xf6, 0xa8, 0x9d, 0x0a, 0x30,
-	0x24, 0x8f, 0x2c, 0xd3, 0x39, 0x82, 0x0f, 0x5c, 0x11, 0xbb, 0xfe, 0xb0, 0x90, 0x14, 0x77, 0xfd,
-	0x61, 0x31, 0xb9, 0x96, 0xc7, 0x10, 0xad, 0xbb, 0xa5, 0x42, 0x32, 0x1e, 0x98, 0x08, 0x81, 0xdf,
-	0xc0, 0x03, 0xc2, 0xa3, 0x94, 0xfd, 0x47, 0xef, 0x80, 0x38, 0xbb, 0xf5, 0x16, 0xb8, 0xde, 0x97,
-	0xaf, 0xa0, 0x57, 0x32, 0xa8, 0x2c, 0xea, 0x5a, 0xfe, 0x4f, 0x22, 0x40, 0xf9, 0xd0, 0x32, 0x88,
-	0xc5, 0x54, 0xfc, 0x00, 0x12, 0x6d, 0xb6, 0x9a, 0xc5, 0xfc, 0x8a, 0xb4, 0x8c, 0xbb, 0x6c, 0xd3,
-	0x2a, 0xf3, 0xad, 0x52, 0x65, 0x79, 0xe1, 0x5c, 0xbb, 0xce, 0xc2, 0xb9, 0xaa, 0xc1, 0xae, 0x43,
-	0x00, 0xdb, 0x36, 0xa1, 0xbc, 0xe6, 0xb9, 0x0b, 0x94, 0x03, 0xbf, 0x8d, 0xfb, 0xd3, 0x82, 0xb7,
-	0x58, 0x33, 0x19, 0x25, 0xff, 0x0f, 0x11, 0x62, 0x35, 0xb9, 0xb2, 0xf5, 0x5a, 0x95, 0x0c, 0x4d,
-	0x5b, 0xa7, 0xf3, 0x87, 0x88, 0xf0, 0xd0, 0x87, 0xc8, 0xe3, 0xaa, 0x6a, 0x73, 0xf0, 0x6b, 0x5e,
-	0xf0, 0x4b, 0x9d, 0xeb, 0xbf, 0x4e, 0xe7, 0x5e, 0x0c, 0x9e, 0xc0, 0x95, 0x82, 0xe7, 0x59, 0x88,
-	0xf4, 0xcd, 0xae, 0xea, 0xbe, 0xca, 0x82, 0xec, 0x55, 0x16, 0xee, 0x9b, 0x5d, 0xc9, 0x59, 0xe7,
-	0x7f, 0xb5, 0x06, 0x88, 0x79, 0x98, 0xd5, 0xec, 0x7d, 0x42, 0xb1, 0x86, 0x29, 0x9e, 0xdb, 0x2c,
-	0x78, 0x6d, 0x56, 0x20, 0xcc, 0xec, 0x9c, 0xbf, 0x04, 0x7f, 0xf4, 0x68, 0x39, 0x71, 0x36, 0xc9,
-	0x86, 0x98, 0x31, 0x52, 0x55, 0x0e, 0x31, 0x51, 0x92, 0x86, 0xde, 0x83, 0x90, 0x46, 0x28, 0xd6,
-	0xfb, 0x6e, 0x93, 0x8d, 0x2e, 0x7f, 0x1c, 0xf2, 0x9e, 0xc2, 0xf8, 0xf8, 0x13, 0x78, 0xba, 0xed,
-	0x62, 0x7a, 0xb8, 0xe7, 0xf0, 0x90, 0xf4, 0xb8, 0x05, 0x21, 0x3a, 0x72, 0xbb, 0x36, 0x0b, 0xcb,
-	0x73, 0x11, 0x18, 0xa4, 0x23, 0xd6, 0xb0, 0xb7, 0x66, 0x4f, 0xd7, 0x10, 0x7b, 0xba, 0xa6, 0x97,
-	0xa1, 0x3b, 0xf7, 0x68, 0xcd, 0x42, 0x54, 0xb7, 0x55, 0x32, 0xa2, 0xc4, 0x32, 0x70, 0x9f, 0xbd,
-	0xdc, 0xc2, 0x32, 0xe8, 0x76, 0x8d, 0x7f, 0x71, 0x18, 0xf8, 0x59, 0x76, 0x4c, 0x8d, 0xb0, 0x37,
-	0x59, 0x4c, 0x06, 0xf7, 0x53, 0xc5, 0xd4, 0xc8, 0xae, 0x3f, 0x1c, 0x4c, 0x86, 0xf2, 0x4d, 0x78,
-	0x8a, 0x95, 0x66, 0xdc, 0x71, 0xc2, 0x60, 0x76, 0x3a, 0x39, 0x08, 0x5a, 0xf8, 0x58, 0xa5, 0x23,
-	0x9e, 0x06, 0x91, 0xb3, 0x49, 0x36, 0x20, 0xe3, 0x63, 0xe5, 0x43, 0x39, 0x60, 0xe1, 0x63, 0x65,
-	0x84, 0x9e, 0x81, 0xd0, 0xf0, 0xb0, 0xad, 0x1e, 0x90, 0xb1, 0x7b, 0x50, 0x72, 0x70, 0x78, 0xd8,
-	0xbe, 0x47, 0xc6, 0xf9, 0xcf, 0x44, 0x08, 0x55, 0xcc, 0xc1, 0x00, 0x1b, 0x1a, 0xda, 0x60, 0xc5,
-	0xcd, 0x15, 0xf1, 0x0c, 0xb7, 0x3d, 0xc2, 0x89, 0x52, 0xf5, 0x6c, 0x92, 0x15, 0xa5, 0xaa, 0x53,
-	0xc5, 0xd0, 0x73, 0x10, 0xea, 0xb8, 0x9f, 0xf9, 0xbd, 0x4b, 0x4c, 0x09, 0xf2, 0xf4, 0x93, 0x93,
-	0xf4, 0x43, 0x6c, 0xe1, 0x81, 0x7b, 0x7c, 0x8e, 0x2a, 0xb6, 0x42, 0x6d, 0x08, 0x1e, 0x90, 0xb1,
-	0x13, 0x2b, 0xee, 0x71, 0xdc, 0x73, 0x50, 0xde, 0x23, 0x63, 0xa9, 0xfa, 0xcd, 0x24, 0xfb, 0xce,
-	0x15, 0xf3, 0xe3, 0xc2, 0x9c, 0xa6, 0xc0, 0x24, 0xc8, 0x81, 0x03, 0x32, 0x96, 0x34, 0x94, 0x83,
-	0xd8, 0x00, 0x8f, 0xd4, 0x2e, 0xb6, 0xd5, 0x8e, 0x69, 0xbb, 0xf5, 0x25, 0x2e, 0xc3, 0x00, 0x8f,
-	0xb6, 0xb1, 0x5d, 0x31, 0x6d, 0x8a, 0xde, 0x00, 0xbf, 0x13, 0x7e, 0x2c, 0xf0, 0x13, 0x5b, 0xd9,
-	0x65, 0x87, 0xc7, 0x4d, 0x56, 0xc6, 0x43, 0x22, 0x33, 0xe6, 0xfc, 0x27, 0x7e, 0x58, 0xe7, 0x5f,
-	0xcb, 0x98, 0x76, 0x7a, 0x33, 0xcf, 0x3f, 0xed, 0x71, 0x59, 0xd0, 0xe3, 0xa1, 0xf7, 0x20, 0xca,
-	0xdd, 0xa1, 0xea, 0x9a, 0x3b, 0xc3, 0x89, 0x95, 0xb3, 0xcb, 0x7c, 0x0a, 0xb3, 0x85, 0x2d, 0x03,
-	0xdf, 0x23, 0x69, 0xb6, 0xd3, 0x7d, 0x1c, 0x0d, 0xdc, 0x87, 0xec, 0x3f, 0xda, 0x80, 0xb0, 0xf3,
-	0xc2, 0x5d, 0x79, 0xb1, 0x0c, 0xd9, 0x7a, 0x97, 0xc5, 0x68, 0x69, 0x16, 0xa3, 0x01, 0x66, 0xe6,
-	0xed, 0x65, 0x66, 0x32, 0x4b, 0x88, 0xc6, 0xf5, 0xdb, 0xe7, 0x42, 0x76, 0x7e, 0x5a, 0xc1, 0x6b,
-	0x3b, 0x2d, 0x19, 0x52, 0xec, 0x96, 0xd5, 0x76, 0x91, 0xa8, 0xdc, 0x7c, 0xdb, 0xd1, 0x1a, 0x62,
-	0xf6, 0xdd, 0x3c, 0x9b, 0x64, 0x6f, 0x34, 0x2d, 0x72, 0x74, 0x0e, 0xac, 0x54, 0x95, 0x6f, 0x0c,
-	0x97, 0x7c, 0xd6, 0xd0, 0x4f, 0x21, 0x62, 0xeb, 0x5d, 0x03, 0xd3, 0x43, 0x8b, 0xf0, 0x11, 0xc9,
-	0x7a, 0xc1, 0x9d, 0x2d, 0x16, 0xa6, 0xb3, 0xc5, 0x42, 0xc9, 0x18, 0x97, 0xef, 0x7c, 0xf9, 0x87,
-	0x57, 0x97, 0x96, 0x29, 0x8d, 0x74, 0x8a, 0x4d, 0x87, 0x73, 0x1f, 0x5b, 0x76, 0x0f, 0xf7, 0x89,
-	0x25, 0xcf, 0x45, 0xe6, 0xff, 0x2a, 0x40, 0xb4, 0xa5, 0x77, 0x67, 0x11, 0x50, 0xe4, 0xf1, 0x24,
-	0x30, 0x47, 0x3f, 0xbb, 0xb4, 0x18, 0xe8, 0xdd, 0x79, 0x2c, 0xcd, 0xc7, 0x72, 0xe2, 0x63, 0x1f,
-	0xcb, 0xfd, 0xd8, 0x79, 0xa7, 0xb8, 0x51, 0xc7, 0x5c, 0xea, 0xf8, 0x91, 0xc5, 0x4f, 0x19, 0x9d,
-	0x4d, 0xb2, 0x09, 0x6f, 0x04, 0x4b, 0x55, 0x39, 0xd1, 0xf1, 0xae, 0xb5, 0xfc, 0xef, 0x05, 0x88,
-	0x4e, 0x2f, 0x7e, 0xf7, 0xc8, 0xf8, 0x51, 0x7a, 0xab, 0xe9, 0xdc, 0xcb, 0x46, 0x54, 0xe5, 0x11,
-	0xe3, 0xde, 0x29, 0x1a, 0x67, 0x93, 0x6c, 0xa4, 0x4e, 0x46, 0xf4, 0x71, 0x45, 0x4d, 0xc4, 0xe0,
-	0xc2, 0x34, 0x7e, 0x61, 0x3b, 0x82, 0x40, 0x89, 0x35, 0xa2, 0x6b, 0x1c, 0x75, 0x4e, 0x6f, 0x81,
-	0xe2, 0xfc, 0x16, 0x98, 0xff, 0x5c, 0x80, 0x98, 0xb7, 0xff, 0xa0, 0xe7, 0xa7, 0x43, 0x49, 0xcf,
-	0x85, 0xd1, 0x1d, 0x37, 0x3a, 0xa2, 0x3c, 0xd7, 0x20, 0x71, 0xe1, 0x1a, 0x74, 0x0b, 0xc2, 0x1a,
-	0xe9, 0xe8, 0x03, 0xcc, 0x5b, 0x5d, 0xbc, 0x1c, 0xf9, 0x66, 0x92, 0x0d, 0x1c, 0xea, 0x06, 0x7d,
-	0x4b, 0x9e, 0x91, 0xd0, 0x2e, 0x84, 0x3b, 0x78, 0x88, 0x3b, 0x3a, 0x1d, 0xf3, 0xb4, 0x7f, 0xd4,
-	0xab, 0xe7, 0x6c, 0x7f, 0xfe, 0x6d, 0x08, 0x6d, 0x63, 0x4a, 0x8e, 0xb1, 0x73, 0xbe, 0xa1, 0x87,
-	0xdc, 0x3a, 0xa7, 0x74, 0xee, 0xee, 0x5f, 0x0a, 0x10, 0x6b, 0x9a, 0xfd, 0xfe, 0x2c, 0xf6, 0xbf,
-	0x17, 0x13, 0xe6, 0x3b, 0xbf, 0x9b, 0x0f, 0xdd, 0x36, 0x56, 0x0c, 0xdd, 0xd8, 0xdc, 0xcb, 0x3b,
-	0x6f, 0x9b, 0x33, 0x4a, 0x75, 0x49, 0x91, 0x4a, 0x7b, 0xd2, 0xc7, 0x6c, 0xe2, 0xc6, 0x18, 0x25,
-	0x43, 0xa7, 0x3a, 0xee, 0xeb, 0x3f, 0x23, 0x1a, 0xca, 0x42, 0x82, 0x33, 0x36, 0x6b, 0xf5, 0xaa,
-	0x54, 0xdf, 0x4e, 0x8a, 0xe9, 0xe8, 0xc9, 0x69, 0x2e, 0xd4, 0x24, 0x86, 0xa6, 0x1b, 0x5d, 0xf4,
-	0xe2, 0x92, 0xc9, 0x9d, 0x3f, 0x1d, 0x3f, 0x39, 0xcd, 0x45, 0x66, 0x43, 0xbb, 0xf9, 0x98, 0xed,
-	0xce, 0xa7, 0x22, 0x44, 0x3d, 0xfd, 0x05, 0x3d, 0x07, 0xa9, 0x4a, 0x63, 0x7f, 0xbf, 0x54, 0xaf,
-	0xaa, 0xca, 0x47, 0xcd, 0xda, 0x22, 0x6e, 0xf4, 0x2c, 0x3c, 0xb3, 0x40, 0xdd, 0x97, 0xea, 0x8a,
-	0xaa, 0x34, 0xee, 0xd5, 0xea, 0x49, 0x01, 0x3d, 0x0f, 0x37, 0x17, 0x88, 0xd5, 0x5a, 0x73, 0xaf,
-	0xf1, 0x11, 0x27, 0x8b, 0x17, 0xf6, 0x96, 0xef, 0xcb, 0x75, 0x4e, 0x5c, 0x43, 0x2f, 0x43, 0x7e,
-	0x81, 0xa8, 0xc8, 0xa5, 0x7a, 0xeb, 0x6e, 0x4d, 0x56, 0x1b, 0xcd, 0x9a, 0x5c, 0x52, 0x1a, 0x72,
-	0x6b, 0x47, 0x6a, 0x26, 0xfd, 0xe8, 0x35, 0x78, 0x65, 0x81, 0xaf, 0xd4, 0x6c, 0xca, 0x8d, 0xf7,
-	0x6b, 0x8e, 0xad, 0x8a, 0x5c, 0xaa, 0x28, 0x6a, 0xa5, 0xb4, 0xb7, 0xa7, 0x7e, 0x20, 0x29, 0x3b,
-	0x0c, 0x5b, 0x32, 0x70, 0x41, 0xf2, 0xd2, 0x1d, 0xc9, 0xe0, 0xcc, 0x25, 0xbe, 0x3b, 0xff, 0x11,
-	0xe0, 0xc6, 0xd2, 0x5e, 0x84, 0x7e, 0x02, 0x2f, 0x96, 0x4b, 0x4a, 0x65, 0xa7, 0x56, 0x55, 0xb9,
-	0xcc, 0x96, 0xba, 0x7a, 0xa8, 0xca, 0x64, 0x78, 0x0f, 0xf9, 0x4d, 0xc8, 0xae, 0xda, 0xde, 0x92,
-	0xb6, 0xeb, 0xce, 0x61, 0x0a, 0xe9, 0xe4, 0xc9, 0x69, 0x2e, 0xc6, 0xb6, 0xb6, 0xf4, 0xae, 0xe1,
-	0x9c, 0xe8, 0x25, 0xdb, 0x4a, 0xe5, 0x86, 0xec, 0x0e, 0x5c, 0xe7, 0xdb, 0x4a, 0x6d, 0x16, 0xc2,
-	0xe8, 0x0d, 0xc8, 0x5c, 0xa6, 0x6d, 0x3e, 0x7f, 0x9d, 0x29, 0x23, 0x5a, 0xda, 0xef, 0x78, 0xe1,
-	0xce, 0x18, 0x42, 0xbc, 0x47, 0xa0, 0x3c, 0xac, 0xb7, 0xa4, 0xed, 0x25, 0xb1, 0x90, 0x0e, 0x9f,
-	0x9c, 0xe6, 0xfc, 0x75, 0xd3, 0x20, 0x28, 0x0d, 0xd1, 0x19, 0x8f, 0xf2, 0x61, 0x52, 0x48, 0x47,
-	0x4e, 0x4e, 0x73, 0x01, 0x47, 0xc2, 0x08, 0xbd, 0x04, 0xc9, 0x19, 0x8d, 0xc3, 0x48, 0x8a, 0xe9,
-	0xc4, 0xc9, 0x69, 0x0e, 0x5a, 0x7a, 0x97, 0xfb, 0xd7, 0x13, 0x8f, 0x7f, 0x11, 0x20, 0xce, 0x1f,
-	0x53, 0xdc, 0xe9, 0x9b, 0x90, 0xae, 0xd6, 0x9a, 0x8d, 0x96, 0xa4, 0x2c, 0xf7, 0xf5, 0x1c, 0xc7,
-	0x06, 0x3c, 0x7d, 0x8e, 0x73, 0x9a, 0x23, 0xc2, 0x62, 0x8e, 0xfc, 0x1f, 0xa4, 0xce, 0x31, 0xce,
-	0x73, 0x45, 0x3c, 0x97, 0x2b, 0xe8, 0x16, 0xdc, 0x38, 0xc7, 0xec, 0x44, 0x2e, 0x73, 0x1f, 0x9c,
-	0x9c, 0xe6, 0x82, 0xec, 0xf9, 0xec, 0x9a, 0x20, 0xb0, 0xc9, 0x75, 0xfd, 0x8b, 0x7f, 0x65, 0x7c,
-	0x5f, 0x9c, 0x65, 0x84, 0xaf, 0xce, 0x32, 0xc2, 0x3f, 0xcf, 0x32, 0xc2, 0xa7, 0x5f, 0x67, 0x7c,
-	0x5f, 0x7d, 0x9d, 0xf1, 0xfd, 0xed, 0xeb, 0x8c, 0xef, 0xe3, 0xd7, 0xae, 0x58, 0x90, 0xc8, 0xd1,
-	0xc0, 0x2d, 0x98, 0xed, 0x20, 0xbb, 0x26, 0xbc, 0xf1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x36, 0xb2, 0x0c, 0xc6, 0x1c, 0x00, 0x00,
+	// 2448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8f, 0x23, 0xc5,
+	0x15, 0x77, 0xb7, 0xbf, 0x9f, 0x3f, 0x30, 0xc5, 0x2c, 0x78, 0x0d, 0xd8, 0xc6, 0xb0, 0xec, 0xec,
+	0x06, 0x6c, 0x58, 0x20, 0x21, 0x28, 0x01, 0xfc, 0xb5, 0x3b, 0x3d, 0x3b, 0x63, 0x5b, 0xed, 0x5e,
+	0xbe, 0x0e, 0x69, 0x95, 0xdd, 0xb5, 0x76, 0x6b, 0xec, 0x6e, 0xab, 0xbb, 0x66, 0xc6, 0xce, 0x2d,
+	0x97, 0x08, 0xcd, 0x89, 0x43, 0x6e, 0xd1, 0x44, 0x91, 0xc8, 0x21, 0xca, 0x25, 0x97, 0x44, 0xca,
+	0x21, 0x91, 0x72, 0x44, 0x9c, 0xb8, 0x25, 0xca, 0xc1, 0x49, 0x86, 0x7f, 0x21, 0xa7, 0xbd, 0x24,
+	0xea, 0xea, 0xb2, 0xdd, 0x9e, 0xb1, 0x67, 0x87, 0x65, 0x47, 0x42, 0xca, 0xc9, 0x5d, 0xf5, 0x7e,
+	0xf5, 0xde, 0xab, 0x57, 0xaf, 0xde, 0x7b, 0xf5, 0x0c, 0x59, 0x3c, 0x26, 0x03, 0x6c, 0x95, 0xc8,
+	0xc1, 0xb0, 0x74, 0xf0, 0x7a, 0x87, 0x50, 0xfc, 0x7a, 0x89, 0x4e, 0x46, 0xc4, 0x2e, 0x8e, 0x2c,
+	0x93, 0x9a, 0x08, 0xb9, 0xf4, 0x22, 0x39, 0x18, 0x16, 0x39, 0x3d, 0x73, 0xb5, 0x67, 0x9a, 0xbd,
+	0x01, 0x29, 0x31, 0x44, 0x67, 0xff, 0x7e, 0x09, 0x1b, 0x13, 0x17, 0x9e, 0xd9, 0xe8, 0x99, 0x3d,
+	0x93, 0x7d, 0x96, 0x9c, 0x2f, 0x3e, 0x7b, 0xb5, 0x6b, 0xda, 0x43, 0xd3, 0x56, 0x5d, 0x82, 0x3b,
+	0xe0, 0xa4, 0x1b, 0x5c, 0xbe, 0x41, 0xc6, 0xfb, 0x76, 0x89, 0x8c, 0x47, 0xa6, 0x45, 0x89, 0xb6,
+	0x4a, 0x95, 0xcc, 0x2b, 0x1c, 0x3a, 0xdc, 0x1f, 0x50, 0xdd, 0xd6, 0x7b, 0xe7, 0xa2, 0x0b, 0xbf,
+	0x16, 0x00, 0x3e, 0x30, 0x29, 0xa9, 0x1f, 0x10, 0x83, 0xda, 0xe8, 0x63, 0x08, 0x76, 0xfb, 0x58,
+	0x37, 0xd2, 0x42, 0x5e, 0xd8, 0x8c, 0x56, 0xaa, 0x0f, 0xa6, 0xb9, 0xf7, 0x7a, 0x3a, 0xed, 0xef,
+	0x77, 0x8a, 0x5d, 0x73, 0x58, 0x72, 0x59, 0x1b, 0x84, 0x1e, 0x9a, 0xd6, 0x1e, 0x1f, 0xbd, 0xda,
+	0x35, 0x2d, 0x52, 0x1a, 0x9f, 0x52, 0xad, 0x58, 0x75, 0xd8, 0x34, 0xf0, 0x90, 0xc8, 0x2e, 0x47,
+	0xf4, 0x03, 0x08, 0x11, 0x26, 0x24, 0x2d, 0xe6, 0xfd, 0x9b, 0xb1, 0x5b, 0x57, 0x8b, 0x67, 0x6d,
+	0x56, 0x64, 0x6a, 0x54, 0x02, 0x5f, 0x4c, 0x73, 0x3e, 0x99, 0xc3, 0x0b, 0x5f, 0x46, 0x20, 0xc8,
+	0xe6, 0x2f, 0x53, 0xbb, 0x1b, 0x10, 0xa4, 0x63, 0x55, 0xd7, 0xd2, 0x62, 0x5e, 0xd8, 0x8c, 0x57,
+	0x36, 0x1c, 0x0d, 0xfe, 0x31, 0xcd, 0x05, 0xb6, 0xb0, 0xdd, 0x3f, 0x99, 0xe6, 0x02, 0xca, 0x58,
+	0xaa, 0xc9, 0x01, 0x3a, 0x96, 0x34, 0xb4, 0x01, 0x41, 0xdd, 0xd0, 0xc8, 0x38, 0xed, 0xcf, 0x0b,
+	0x9b, 0x01, 0xd9, 0x1d, 0xa0, 0xb7, 0x21, 0x64, 0x53, 0x4c, 0xf7, 0xed, 0x74, 0x20, 0x2f, 0x6c,
+	0x26, 0x6f, 0xe5, 0xd7, 0x6e, 0xaf, 0xd8, 0x66, 0x38, 0x99, 0xe3, 0x51, 0x15, 0x80, 0x9a, 0x7b,
+	0xc4, 0x50, 0x6d, 0x62, 0xd0, 0x74, 0x30, 0x2f, 0x6c, 0xc6, 0x6e, 0x15, 0xd6, 0xae, 0x56, 0x1c,
+	0x68, 0x9b, 0x18, 0x74, 0xcb, 0x27, 0x47, 0xe9, 0x6c, 0x80, 0x76, 0x20, 0xd1, 0x35, 0x0d, 0x6a,
+	0xe1, 0x2e, 0x55, 0xbb, 0x78, 0x30, 0x48, 0x87, 0x18, 0x9f, 0x6b, 0x6b, 0xf9, 0x54, 0x39, 0xba,
+	0x8a, 0x07, 0x83, 0x2d, 0x9f, 0x1c, 0xef, 0x7a, 0xc6, 0x48, 0x87, 0xf4, 0x12, 0x37, 0xf5, 0x50,
+	0xa7, 0x7d, 0x95, 0x49, 0x4b, 0x87, 0x19, 0xe3, 0xe2, 0x85, 0x18, 0x7f, 0xa8, 0xd3, 0x3e, 0x53,
+	0x78, 0xcb, 0x27, 0x5f, 0xe9, 0xae, 0x22, 0xa0, 0xf7, 0x20, 0x42, 0x2d, 0x6c, 0xd8, 0xf7, 0x89,
+	0x95, 0x8e, 0x30, 0xd6, 0x2f, 0xac, 0xdf, 0x3b, 0x07, 0x6e, 0xf9, 0xe4, 0xf9, 0x22, 0xd4, 0x84,
+	0xa4, 0x6b, 0x3e, 0x8d, 0x8c, 0x06, 0xe6, 0x84, 0x68, 0xe9, 0x28, 0x63, 0xf3, 0xf2, 0xf9, 0x26,
+	0xac, 0x71, 0xf4, 0x96, 0x4f, 0x4e, 0x50, 0xef, 0x04, 0xfa, 0x99, 0x00, 0xd9, 0xd9, 0xe5, 0x51,
+	0xcd, 0x43, 0x83, 0x58, 0x76, 0x5f, 0x1f, 0xa9, 0x33, 0x81, 0x16, 0xd1, 0xd2, 0xc0, 0x24, 0xbc,
+	0xb5, 0x56, 0xc2, 0x2e, 0x5f, 0xde, 0x9c, 0xad, 0x56, 0x16, 0x8b, 0x2b, 0x62, 0x5a, 0xd8, 0xf2,
+	0xc9, 0xcf, 0x0d, 0xcf, 0xc1, 0xa0, 0x9f, 0x0b, 0xf0, 0xc2, 0x42, 0x87, 0x11, 0xb1, 0x30, 0x35,
+	0xcf, 0xaa, 0x11, 0x63, 0x6a, 0xbc, 0xfd, 0x70, 0x35, 0x3c, 0x0c, 0x3c, 0x52, 0xb6, 0x7c, 0x72,
+	0x6e, 0x78, 0x3e, 0xa4, 0xf0, 0x27, 0x01, 0x42, 0xae, 0xbf, 0xa2, 0x57, 0x00, 0xb5, 0x95, 0xb2,
+	0x72, 0xaf, 0xad, 0xde, 0x6b, 0xb4, 0x5b, 0xf5, 0xaa, 0x74, 0x5b, 0xaa, 0xd7, 0x52, 0xbe, 0xcc,
+	0xc6, 0xd1, 0x71, 0x3e, 0xc5, 0xe4, 0x35, 0x4c, 0xa3, 0x3e, 0xd6, 0x6d, 0xea, 0x38, 0xe4, 0x26,
+	0xa4, 0x38, 0xba, 0xda, 0x6c, 0xdc, 0x96, 0xe4, 0xdd, 0x7a, 0x2d, 0x25, 0x64, 0xd0, 0xd1, 0x71,
+	0x3e, 0x39, 0x73, 0x93, 0xfb, 0xba, 0x35, 0x24, 0xda, 0x12, 0x72, 0xb7, 0xb5, 0x53, 0x57, 0xea,
+	0xb5, 0x94, 0xb8, 0x84, 0x1c, 0x8e, 0x06, 0x84, 0x12, 0x0d, 0x15, 0x20, 0xc1, 0x91, 0xb7, 0xcb,
+	0xd2, 0x4e, 0xbd, 0x96, 0xf2, 0x67, 0x9e, 0x38, 0x3a, 0xce, 0xc7, 0x18, 0xec, 0x36, 0xd6, 0x07,
+	0x44, 0xcb, 0x44, 0x3e, 0xfd, 0x3c, 0xeb, 0xfb, 0xed, 0x6f, 0xb2, 0x42, 0x25, 0x0c, 0x41, 0x16,
+	0x41, 0xb6, 0x03, 0x91, 0x78, 0x2a, 0xb1, 0x1d, 0x88, 0x24, 0x52, 0xc9, 0xc2, 0x5f, 0x44, 0x48,
+	0x2e, 0xdf, 0x23, 0x74, 0x1d, 0x42, 0x36, 0x31, 0x34, 0x62, 0xb1, 0xb0, 0x12, 0xaf, 0x3c, 0xc1,
+	0xef, 0x7e, 0xb8, 0xac, 0x69, 0x16, 0xb1, 0x6d, 0x99, 0x93, 0xd1, 0x08, 0x9e, 0xd4, 0x88, 0x4d,
+	0x75, 0x03, 0x53, 0xdd, 0x34, 0x54, 0x37, 0x14, 0x89, 0x8f, 0x2f, 0x14, 0xa5, 0x3c, 0xdc, 0xd9,
+	0x2c, 0x2a, 0xc1, 0x53, 0x5e, 0x89, 0xd8, 0x55, 0x88, 0x05, 0x9e, 0xa8, 0x8c, 0x3c, 0x24, 0xae,
+	0x2a, 0x7a, 0x1a, 0x42, 0xf6, 0x64, 0xd8, 0x31, 0x07, 0x2c, 0x0a, 0x45, 0x65, 0x3e, 0x42, 0x77,
+	0x20, 0x84, 0x87, 0xe6, 0x3e, 0x8f, 0x2f, 0xf1, 0x4a, 0x89, 0xef, 0xf1, 0xba, 0x47, 0x67, 0x37,
+	0xe1, 0xf0, 0x9f, 0x57, 0x6d, 0x6d, 0x8f, 0x27, 0x8a, 0x7b, 0xba, 0x41, 0x65, 0xbe, 0xbc, 0x70,
+	0x24, 0xc2, 0x93, 0x67, 0xae, 0xf9, 0x77, 0xd9, 0x84, 0x37, 0x20, 0x35, 0x0f, 0x65, 0xcb, 0xf6,
+	0x7b, 0x62, 0x36, 0x3f, 0x33, 0x5e, 0x09, 0xe2, 0x23, 0x3c, 0x19, 0x98, 0x58, 0x53, 0xfb, 0xd8,
+	0xee, 0x33, 0x13, 0xc6, 0x2b, 0x71, 0x6f, 0x2a, 0x90, 0x63, 0x1c, 0xe1, 0x0c, 0x0a, 0xff, 0x11,
+	0x21, 0xb3, 0x3e, 0xe6, 0xfd, 0x9f, 0x5a, 0xc5, 0xe3, 0x83, 0xc1, 0x35, 0x3e, 0x18, 0xfa, 0x76,
+	0x3e, 0xf8, 0x2b, 0x01, 0x12, 0x4b, 0xf9, 0x00, 0xe5, 0x40, 0xa4, 0xe6, 0x3a, 0x2b, 0x8b, 0xd4,
+	0xf4, 0xc8, 0x16, 0xbf, 0x95, 0x6c, 0xf4, 0x22, 0x04, 0xee, 0x5b, 0xe6, 0x30, 0xed, 0x5f, 0x2d,
+	0x8b, 0x11, 0x0b, 0x1d, 0x40, 0x67, 0x13, 0x8d, 0xc7, 0x2e, 0xc2, 0x92, 0x5d, 0xde, 0x04, 0x37,
+	0x01, 0xcd, 0x0f, 0x42, 0x5c, 0xcd, 0x3b, 0xce, 0x50, 0x7c, 0x54, 0xf8, 0xa3, 0x08, 0x2f, 0x3c,
+	0x34, 0xd7, 0xa0, 0x22, 0xc0, 0xc8, 0x22, 0x3c, 0x8b, 0xa5, 0x85, 0xbc, 0x7f, 0x15, 0xe3, 0xe8,
+	0xc8, 0x22, 0xee, 0x6a, 0xf4, 0x01, 0x24, 0x47, 0x16, 0x39, 0x50, 0x69, 0xdf, 0x22, 0x76, 0xdf,
+	0x1c, 0x68, 0x8f, 0x6a, 0xaf, 0x84, 0xc3, 0x46, 0x99, 0x71, 0x71, 0xf4, 0x30, 0xc8, 0xe1, 0x4c,
+	0x0f, 0xff, 0x1a, 0x3d, 0x0c, 0x72, 0xc8, 0xf5, 0x50, 0x20, 0xe1, 0xe0, 0x17, 0x6a, 0x04, 0x1e,
+	0x4d, 0x8d, 0xb8, 0x41, 0x0e, 0xe7, 0x5a, 0xbc, 0x23, 0xa6, 0x85, 0xc2, 0x67, 0x22, 0xbc, 0x74,
+	0x91, 0xe4, 0x88, 0xde, 0x74, 0x55, 0x98, 0x27, 0xdf, 0x75, 0x5a, 0x3b, 0x22, 0xe6, 0x3c, 0x2e,
+	0x47, 0x71, 0xd4, 0x82, 0x98, 0xc3, 0xf5, 0x90, 0xe8, 0xbd, 0x3e, 0xb5, 0xd3, 0xc1, 0xbc, 0xff,
+	0x51, 0x78, 0x3a, 0x47, 0xf0, 0xa1, 0xcb, 0x62, 0x3b, 0x10, 0x11, 0x52, 0xe2, 0x76, 0x20, 0x22,
+	0xa6, 0xfc, 0x05, 0x0c, 0xb1, 0x86, 0x1b, 0x50, 0x24, 0xe3, 0xbe, 0x89, 0x10, 0x04, 0x0c, 0x3c,
+	0x24, 0xdc, 0x4b, 0xd9, 0x37, 0x7a, 0x17, 0xc4, 0x79, 0x6d, 0x5c, 0xe4, 0x72, 0x5f, 0xbe, 0x80,
+	0x5c, 0xc9, 0xa0, 0xb2, 0xa8, 0x6b, 0x85, 0x3f, 0x8b, 0x00, 0x95, 0x7d, 0xcb, 0x20, 0x16, 0x13,
+	0xf1, 0x7d, 0x48, 0x76, 0xd8, 0x68, 0xee, 0xf3, 0x6b, 0xee, 0x6e, 0xc2, 0x85, 0xf1, 0xe1, 0xa3,
+	0x5d, 0x95, 0xd5, 0xe1, 0xd5, 0x7f, 0x99, 0xe1, 0x75, 0x5d, 0x1a, 0xde, 0x80, 0x20, 0xb6, 0x6d,
+	0x42, 0x79, 0x64, 0x74, 0x07, 0x28, 0x0f, 0x01, 0x1b, 0x0f, 0x66, 0x61, 0x71, 0x39, 0xb2, 0x32,
+	0x4a, 0xe1, 0x9f, 0x22, 0xc4, 0xeb, 0x72, 0xf5, 0xd6, 0x6b, 0x35, 0x32, 0x32, 0x6d, 0x9d, 0x2e,
+	0x9e, 0x2b, 0xc2, 0x43, 0x9f, 0x2b, 0x8f, 0x2d, 0xf4, 0xcd, 0x95, 0xf7, 0x7b, 0x95, 0x5f, 0x69,
+	0xdc, 0xc0, 0x65, 0x1a, 0xf7, 0xac, 0xf3, 0x04, 0x2f, 0xe4, 0x3c, 0xcf, 0x42, 0x74, 0x60, 0xf6,
+	0x54, 0xf7, 0xed, 0x16, 0x62, 0x6f, 0xb7, 0xc8, 0xc0, 0xec, 0x49, 0xce, 0xb8, 0xf0, 0x4b, 0x3f,
+	0x20, 0x66, 0x61, 0x16, 0xb3, 0x77, 0x09, 0xc5, 0x1a, 0xa6, 0x78, 0xb1, 0x67, 0xc1, 0xbb, 0x67,
+	0x05, 0x22, 0x6c, 0x9f, 0x8b, 0xf7, 0xe2, 0x0f, 0xbf, 0xd9, 0x9d, 0x38, 0x99, 0xe6, 0xc2, 0x6c,
+	0x33, 0x52, 0x4d, 0x0e, 0x33, 0x56, 0x92, 0x86, 0xde, 0x87, 0xb0, 0x46, 0x28, 0xd6, 0x07, 0x6e,
+	0x2a, 0x8e, 0xad, 0x7e, 0x42, 0xf2, 0x9c, 0xc2, 0x70, 0xfc, 0xa1, 0x3c, 0x5b, 0x76, 0xf6, 0x7a,
+	0xb8, 0xe7, 0xf0, 0x90, 0xeb, 0x71, 0x0d, 0xc2, 0x74, 0xec, 0xe6, 0x76, 0xe6, 0x96, 0xa7, 0x3c,
+	0x30, 0x44, 0xc7, 0xce, 0x2f, 0xba, 0x35, 0x7f, 0xe0, 0x86, 0xd9, 0x03, 0x37, 0xb3, 0x4a, 0xbb,
+	0x53, 0x4f, 0xdb, 0x1c, 0xc4, 0x74, 0x5b, 0x25, 0x63, 0x4a, 0x2c, 0x03, 0x0f, 0xd8, 0xfb, 0x2e,
+	0x22, 0x83, 0x6e, 0xd7, 0xf9, 0x8c, 0x03, 0xe0, 0x67, 0xd9, 0x35, 0x35, 0xc2, 0x5e, 0x6e, 0x71,
+	0x19, 0xdc, 0xa9, 0xaa, 0xa9, 0x91, 0xed, 0x40, 0x24, 0x94, 0x0a, 0x17, 0x5a, 0xf0, 0x14, 0x0b,
+	0xcd, 0xb8, 0xeb, 0xb8, 0xc1, 0xfc, 0x74, 0xf2, 0x10, 0xb2, 0xf0, 0xa1, 0x4a, 0xc7, 0xfc, 0x1a,
+	0x44, 0x4f, 0xa6, 0xb9, 0xa0, 0x8c, 0x0f, 0x95, 0x8f, 0xe4, 0xa0, 0x85, 0x0f, 0x95, 0x31, 0x7a,
+	0x06, 0xc2, 0xa3, 0xfd, 0x8e, 0xba, 0x47, 0x26, 0xee, 0x41, 0xc9, 0xa1, 0xd1, 0x7e, 0xe7, 0x2e,
+	0x99, 0x14, 0x3e, 0x17, 0x21, 0x5c, 0x35, 0x87, 0x43, 0x6c, 0x68, 0xe8, 0x3a, 0x0b, 0x6e, 0x2e,
+	0x8b, 0x67, 0xf8, 0xde, 0xa3, 0x9c, 0x28, 0xd5, 0x4e, 0xa6, 0x39, 0x51, 0xaa, 0x39, 0x51, 0x0c,
+	0x3d, 0x07, 0xe1, 0xae, 0x3b, 0xcd, 0xab, 0x33, 0x31, 0x2d, 0xc8, 0xb3, 0x29, 0xe7, 0xd2, 0x8f,
+	0xb0, 0x85, 0x87, 0xee, 0xf1, 0xc5, 0x65, 0x3e, 0x42, 0x1d, 0x08, 0xed, 0x91, 0x89, 0xe3, 0x2b,
+	0xee, 0x71, 0xdc, 0x75, 0xb4, 0xbc, 0x4b, 0x26, 0x52, 0xed, 0xc1, 0x34, 0xf7, 0xee, 0x05, 0xef,
+	0xc7, 0x99, 0x6e, 0x4e, 0x91, 0x71, 0x90, 0x83, 0x7b, 0x64, 0x22, 0x69, 0x28, 0x0f, 0xf1, 0x21,
+	0x1e, 0xab, 0x3d, 0x6c, 0xab, 0x5d, 0xd3, 0x76, 0xe3, 0x4b, 0x42, 0x86, 0x21, 0x1e, 0xdf, 0xc1,
+	0x76, 0xd5, 0xb4, 0x29, 0x7a, 0x03, 0x02, 0x8e, 0xfb, 0x31, 0xc7, 0x4f, 0xde, 0xca, 0xad, 0x3a,
+	0x3c, 0xbe, 0x65, 0x65, 0x32, 0x22, 0x32, 0x03, 0x17, 0x3e, 0x0d, 0xc0, 0x06, 0x9f, 0xad, 0x60,
+	0xda, 0xed, 0xcf, 0x2d, 0xff, 0xb4, 0xc7, 0x64, 0x21, 0x8f, 0x85, 0xde, 0x87, 0x18, 0x37, 0x87,
+	0xaa, 0x6b, 0x6e, 0xa7, 0x27, 0x5e, 0xc9, 0xad, 0xb2, 0x29, 0xcc, 0x07, 0xb6, 0x0c, 0x7c, 0x8d,
+	0xa4, 0xd9, 0x4e, 0xf6, 0x71, 0x24, 0x70, 0x1b, 0xb2, 0x6f, 0x74, 0x1d, 0x22, 0xce, 0x3b, 0x78,
+	0x6d, 0xf9, 0x19, 0xb6, 0xf5, 0x9e, 0xf3, 0x81, 0xca, 0x73, 0x1f, 0x0d, 0xb2, 0x6d, 0xde, 0x58,
+	0xb5, 0x4d, 0xb6, 0x13, 0xa2, 0x71, 0xf9, 0xf6, 0x29, 0x97, 0x5d, 0x9c, 0x56, 0xe8, 0xd2, 0x4e,
+	0x4b, 0x86, 0x34, 0xab, 0xb2, 0x3a, 0xae, 0x26, 0x2a, 0xdf, 0xbe, 0xed, 0x48, 0x0d, 0xb3, 0xfd,
+	0x5d, 0x3d, 0x99, 0xe6, 0xae, 0xb4, 0x2c, 0x72, 0x70, 0x4a, 0x59, 0xa9, 0x26, 0x5f, 0x19, 0xad,
+	0x98, 0xd6, 0xd0, 0x4f, 0x20, 0x6a, 0xeb, 0x3d, 0x03, 0xd3, 0x7d, 0x8b, 0xf0, 0x46, 0xca, 0x46,
+	0xd1, 0xed, 0x40, 0x16, 0x67, 0x1d, 0xc8, 0x62, 0xd9, 0x98, 0x54, 0x6e, 0x7e, 0xf9, 0x87, 0x57,
+	0x57, 0x86, 0x29, 0x8d, 0x74, 0x4b, 0x2d, 0x07, 0xb9, 0x8b, 0x2d, 0xbb, 0x8f, 0x07, 0xc4, 0x92,
+	0x17, 0x2c, 0x0b, 0x7f, 0x13, 0x20, 0xd6, 0xd6, 0x7b, 0x73, 0x0f, 0x28, 0x71, 0x7f, 0x12, 0x98,
+	0xa1, 0x9f, 0x5d, 0x19, 0x0c, 0xf4, 0xde, 0xc2, 0x97, 0x16, 0xcd, 0x3b, 0xf1, 0xb1, 0x37, 0xef,
+	0x7e, 0xe4, 0xbc, 0x66, 0x5c, 0xaf, 0x63, 0x26, 0x75, 0xec, 0xe8, 0x16, 0xe8, 0xe8, 0x64, 0x9a,
+	0x4b, 0x7a, 0x3d, 0x58, 0xaa, 0xc9, 0xc9, 0xae, 0x77, 0xac, 0x15, 0x7e, 0x2f, 0x40, 0x6c, 0x56,
+	0xf8, 0xdd, 0x25, 0x93, 0x6f, 0x92, 0x5b, 0x4d, 0xa7, 0x2e, 0x1b, 0x53, 0x95, 0x7b, 0x8c, 0x5b,
+	0x53, 0x34, 0x4f, 0xa6, 0xb9, 0x68, 0x83, 0x8c, 0xe9, 0xe3, 0xf2, 0x9a, 0xa8, 0xc1, 0x99, 0x69,
+	0xbc, 0x60, 0x3b, 0x80, 0x60, 0x99, 0x25, 0xa2, 0x4b, 0x6c, 0x88, 0xce, 0xaa, 0x40, 0x71, 0x51,
+	0x05, 0x3a, 0xcd, 0xa0, 0xb8, 0x37, 0xff, 0xa0, 0xe7, 0x67, 0xad, 0x4b, 0x4f, 0xc1, 0xe8, 0x36,
+	0x25, 0x1d, 0x56, 0x9e, 0x32, 0x48, 0x5c, 0x2a, 0x83, 0xae, 0x41, 0x44, 0x23, 0x5d, 0x7d, 0x88,
+	0x79, 0xaa, 0x4b, 0x54, 0xa2, 0x0f, 0xa6, 0xb9, 0xe0, 0xbe, 0x6e, 0xd0, 0xb7, 0xe5, 0x39, 0x09,
+	0x6d, 0x43, 0xa4, 0x8b, 0x47, 0xb8, 0xab, 0xd3, 0x49, 0x3a, 0xf0, 0x48, 0xa5, 0xe7, 0x7c, 0x7d,
+	0xe1, 0x1d, 0x08, 0xdf, 0xc1, 0x94, 0x1c, 0x62, 0xe7, 0x7c, 0xc3, 0x0f, 0xa9, 0x3a, 0x67, 0x74,
+	0x6e, 0xee, 0x5f, 0x08, 0x10, 0x6f, 0x99, 0x83, 0xc1, 0xdc, 0xf7, 0xbf, 0x13, 0x7d, 0xe8, 0x9b,
+	0xbf, 0x5b, 0xb4, 0xe6, 0xae, 0xaf, 0x69, 0xcd, 0xb1, 0xee, 0x98, 0xb7, 0x2b, 0xb7, 0x00, 0x4a,
+	0x0d, 0x49, 0x91, 0xca, 0x3b, 0xd2, 0x27, 0xac, 0x2f, 0xc7, 0x80, 0x92, 0xa1, 0x53, 0x1d, 0x0f,
+	0xf4, 0x9f, 0x12, 0x0d, 0xe5, 0x20, 0xc9, 0x81, 0xad, 0x7a, 0xa3, 0x26, 0x35, 0xee, 0xa4, 0xc4,
+	0x4c, 0xec, 0xe8, 0x38, 0x1f, 0x6e, 0x11, 0x43, 0xd3, 0x8d, 0x1e, 0x7a, 0x71, 0x45, 0x7f, 0x2f,
+	0x90, 0x49, 0x1c, 0x1d, 0xe7, 0xa3, 0xf3, 0xd6, 0xde, 0xa2, 0x19, 0x77, 0xf3, 0x33, 0x11, 0x62,
+	0x9e, 0xfc, 0x82, 0x9e, 0x83, 0x74, 0xb5, 0xb9, 0xbb, 0x5b, 0x6e, 0xd4, 0x54, 0xe5, 0xe3, 0x56,
+	0x7d, 0x59, 0x6f, 0xf4, 0x2c, 0x3c, 0xb3, 0x44, 0xdd, 0x95, 0x1a, 0x8a, 0xaa, 0x34, 0xef, 0xd6,
+	0x1b, 0x29, 0x01, 0x3d, 0x0f, 0x57, 0x97, 0x88, 0xb5, 0x7a, 0x6b, 0xa7, 0xf9, 0x31, 0x27, 0x8b,
+	0x67, 0xd6, 0x56, 0xee, 0xc9, 0x0d, 0x4e, 0xf4, 0xa3, 0x97, 0xa1, 0xb0, 0x44, 0x54, 0xe4, 0x72,
+	0xa3, 0x7d, 0xbb, 0x2e, 0xab, 0xcd, 0x56, 0x5d, 0x2e, 0x2b, 0x4d, 0xb9, 0xbd, 0x25, 0xb5, 0x52,
+	0x01, 0xf4, 0x1a, 0xbc, 0xb2, 0x84, 0x2b, 0xb7, 0x5a, 0x72, 0xf3, 0x83, 0xba, 0xb3, 0x57, 0x45,
+	0x2e, 0x57, 0x15, 0xb5, 0x5a, 0xde, 0xd9, 0x51, 0x3f, 0x94, 0x94, 0x2d, 0xa6, 0x5b, 0x2a, 0x78,
+	0x86, 0xf3, 0xca, 0x15, 0xa9, 0xd0, 0xdc, 0x24, 0xbe, 0x9b, 0xff, 0x15, 0xe0, 0xca, 0xca, 0x5c,
+	0x84, 0x7e, 0x0c, 0x2f, 0x56, 0xca, 0x4a, 0x75, 0xab, 0x5e, 0x53, 0x39, 0xcf, 0xb6, 0xba, 0xbe,
+	0xf5, 0xca, 0x78, 0x78, 0x0f, 0xf9, 0x2d, 0xc8, 0xad, 0x5b, 0xde, 0x96, 0xee, 0x34, 0x9c, 0xc3,
+	0x14, 0x32, 0xa9, 0xa3, 0xe3, 0x7c, 0x9c, 0x2d, 0x6d, 0xeb, 0x3d, 0xc3, 0x39, 0xd1, 0x73, 0x96,
+	0x95, 0x2b, 0x4d, 0xd9, 0x6d, 0xcb, 0x2e, 0x96, 0x95, 0x3b, 0xcc, 0x85, 0xd1, 0x1b, 0x90, 0x3d,
+	0x4f, 0xda, 0xa2, 0x4b, 0x3b, 0x17, 0x46, 0xb4, 0x4c, 0xc0, 0xb1, 0xc2, 0xcd, 0x09, 0x84, 0x79,
+	0x8e, 0x40, 0x05, 0xd8, 0x68, 0x4b, 0x77, 0x56, 0xf8, 0x42, 0x26, 0x72, 0x74, 0x9c, 0x0f, 0x34,
+	0x4c, 0x83, 0xa0, 0x0c, 0xc4, 0xe6, 0x18, 0xe5, 0xa3, 0x94, 0x90, 0x89, 0x1e, 0x1d, 0xe7, 0x83,
+	0x0e, 0x87, 0x31, 0x7a, 0x09, 0x52, 0x73, 0x1a, 0x57, 0x23, 0x25, 0x66, 0x92, 0x47, 0xc7, 0x79,
+	0x68, 0xeb, 0x3d, 0x6e, 0x5f, 0x8f, 0x3f, 0xfe, 0x55, 0x80, 0x04, 0x7f, 0x4c, 0x71, 0xa3, 0x6f,
+	0x42, 0xa6, 0x56, 0x6f, 0x35, 0xdb, 0x92, 0xb2, 0xda, 0xd6, 0x0b, 0x3d, 0xae, 0xc3, 0xd3, 0xa7,
+	0x90, 0xb3, 0x3b, 0x22, 0x2c, 0xdf, 0x91, 0xef, 0x41, 0xfa, 0x14, 0x70, 0x71, 0x57, 0xc4, 0x53,
+	0x77, 0x05, 0x5d, 0x83, 0x2b, 0xa7, 0xc0, 0x8e, 0xe7, 0x32, 0xf3, 0xc1, 0xd1, 0x71, 0x3e, 0xc4,
+	0x9e, 0xcf, 0xee, 0x16, 0x04, 0xd6, 0xdf, 0x6e, 0x7c, 0xf1, 0xef, 0xac, 0xef, 0x8b, 0x93, 0xac,
+	0xf0, 0xd5, 0x49, 0x56, 0xf8, 0xd7, 0x49, 0x56, 0xf8, 0xec, 0xeb, 0xac, 0xef, 0xab, 0xaf, 0xb3,
+	0xbe, 0xbf, 0x7f, 0x9d, 0xf5, 0x7d, 0xf2, 0xda, 0x05, 0x03, 0x92, 0xf3, 0x8f, 0x26, 0x0b, 0x98,
+	0x9d, 0x10, 0x2b, 0x13, 0xde, 0xf8, 0xdf, 0x00, 0x34, 0x16, 0xc7, 0x07, 0xec, 0x1c, 0x00, 0x00,
 }
 
 func (m *VoteEvents) Marshal() (dAtA []byte, err error) {
@@ -1875,6 +1877,16 @@ func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.From.Size()
+		i -= size
+		if _, err := m.From.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -3006,6 +3018,8 @@ func (m *EventTransfer) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.Amount.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = m.From.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -4609,6 +4623,39 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
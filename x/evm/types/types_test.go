@@ -14,6 +14,7 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	multisigTestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
 )
@@ -38,6 +39,41 @@ func TestNewCommandBatchMetadata(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedData, common.Bytes2Hex(actual.Data))
+
+	unpackedChainID, unpacked, err := UnpackCommandBatchData(actual.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, chainID, unpackedChainID)
+	assert.Equal(t, commands, unpacked)
+}
+
+func TestUnpackCommandBatchData(t *testing.T) {
+	chainID := sdk.NewInt(rand.PosI64())
+	keyID := multisigTestutils.KeyID()
+	commands := []Command{
+		NewMintTokenCommand(keyID, nexus.TransferID(rand.PosI64()), rand.Str(3), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64())),
+		NewApproveContractCallCommand(chainID, keyID, nexus.ChainName(rand.Str(5)), Hash(common.BytesToHash(rand.Bytes(common.HashLength))), uint64(rand.PosI64()), EventContractCall{
+			Sender:           Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+			ContractAddress:  common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex(),
+			PayloadHash:      Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			DestinationChain: nexus.ChainName(rand.Str(5)),
+		}),
+	}
+
+	batch, err := NewCommandBatchMetadata(rand.PosI64(), chainID, keyID, commands)
+	assert.NoError(t, err)
+
+	actualChainID, actual, err := UnpackCommandBatchData(batch.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, chainID, actualChainID)
+	assert.Len(t, actual, len(commands))
+	for i := range commands {
+		assert.Equal(t, commands[i].ID, actual[i].ID)
+		assert.Equal(t, commands[i].Type, actual[i].Type)
+		assert.Equal(t, commands[i].Params, actual[i].Params)
+	}
+
+	_, _, err = UnpackCommandBatchData(rand.Bytes(100))
+	assert.Error(t, err)
 }
 
 func TestDeployToken(t *testing.T) {
@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/axelarnetwork/axelar-core/utils"
@@ -27,6 +29,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdNextKeyID(),
 		GetCmdKey(),
 		GetCmdKeygenSession(),
+		GetCmdSigningSession(),
 		GetParams(),
 	)
 
@@ -153,6 +156,40 @@ func GetCmdKeygenSession() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSigningSession returns the signing session info for the given signature ID
+func GetCmdSigningSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-session [sig-id]",
+		Short: "Returns the signing session info for the given signature ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sigID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid sig id")
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+			res, err := queryClient.SigningSession(cmd.Context(),
+				&types.SigningSessionRequest{
+					SigID: sigID,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParams returns the multisig params
 func GetParams() *cobra.Command {
 	cmd := &cobra.Command{
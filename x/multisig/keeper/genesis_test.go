@@ -88,7 +88,7 @@ func TestInitExportGenesis(t *testing.T) {
 
 		msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand.AccAddr(), keyID))
 		for _, v := range validators {
-			snapshotter.GetOperatorFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
+			snapshotter.GetOperatorForLaneFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
 
 			sk := funcs.Must(btcec.NewPrivateKey())
 			msgServer.SubmitPubKey(sdk.WrapSDKContext(ctx), types.NewSubmitPubKeyRequest(rand.AccAddr(), keyID, sk.PubKey().SerializeCompressed(), ecdsa.Sign(sk, []byte(keyID)).Serialize()))
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -149,6 +150,29 @@ func (q Querier) KeygenSession(c context.Context, req *types.KeygenSessionReques
 	}, nil
 }
 
+// SigningSession returns the signing session info for the given signature ID
+func (q Querier) SigningSession(c context.Context, req *types.SigningSessionRequest) (*types.SigningSessionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	session, ok := q.keeper.GetSigningSession(ctx, req.SigID)
+	if !ok {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrMultisig, fmt.Sprintf("signing session not found for sig id [%d]", req.SigID)).Error())
+	}
+
+	signers := maps.Keys(session.MultiSig.Sigs)
+	sort.Strings(signers)
+
+	return &types.SigningSessionResponse{
+		State:       session.State,
+		KeyID:       session.Key.ID,
+		ExpiresAt:   session.ExpiresAt,
+		CompletedAt: session.CompletedAt,
+		GracePeriod: session.GracePeriod,
+		Module:      session.Module,
+		Signers:     signers,
+	}, nil
+}
+
 // Params returns the reward module params
 func (q Querier) Params(c context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
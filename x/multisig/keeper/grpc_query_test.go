@@ -329,3 +329,63 @@ func TestKeygenSession(t *testing.T) {
 		}).
 		Run(t, 10)
 }
+
+func TestSigningSession(t *testing.T) {
+	var (
+		multisigKeeper *mock.KeeperMock
+		ctx            sdk.Context
+		querier        keeper.Querier
+		session        types.SigningSession
+	)
+
+	givenQuerier := Given("multisig querier", func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+		multisigKeeper = &mock.KeeperMock{}
+
+		querier = keeper.NewGRPCQuerier(multisigKeeper, &mock.StakerMock{})
+	})
+
+	givenQuerier.
+		When("signing session is not found", func() {
+			multisigKeeper.GetSigningSessionFunc = func(sdk.Context, uint64) (types.SigningSession, bool) { return types.SigningSession{}, false }
+		}).
+		Then("should return error NotFound", func(t *testing.T) {
+			res, err := querier.SigningSession(sdk.WrapSDKContext(ctx), &types.SigningSessionRequest{SigID: uint64(rand.PosI64())})
+
+			assert.Nil(t, res)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.NotFound, s.Code())
+		}).
+		Run(t)
+
+	givenQuerier.
+		When("signing session is found", func() {
+			key := typesTestutils.Key()
+			session = types.SigningSession{
+				ID:          uint64(rand.PosI64()),
+				MultiSig:    typesTestutils.MultiSig(),
+				State:       multisig.Pending,
+				Key:         key,
+				ExpiresAt:   rand.PosI64(),
+				GracePeriod: rand.PosI64(),
+				Module:      rand.Str(5),
+			}
+			multisigKeeper.GetSigningSessionFunc = func(_ sdk.Context, id uint64) (types.SigningSession, bool) {
+				return session, id == session.ID
+			}
+		}).
+		Then("should return the session", func(t *testing.T) {
+			res, err := querier.SigningSession(sdk.WrapSDKContext(ctx), &types.SigningSessionRequest{SigID: session.ID})
+
+			assert.NoError(t, err)
+			assert.Equal(t, multisig.Pending, res.State)
+			assert.Equal(t, session.Key.ID, res.KeyID)
+			assert.Equal(t, session.ExpiresAt, res.ExpiresAt)
+			assert.Equal(t, session.GracePeriod, res.GracePeriod)
+			assert.Equal(t, session.Module, res.Module)
+			assert.Len(t, res.Signers, len(session.MultiSig.Sigs))
+			assert.IsIncreasing(t, res.Signers)
+		}).
+		Run(t, 5)
+}
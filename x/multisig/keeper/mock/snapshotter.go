@@ -24,8 +24,8 @@ var _ keeper.Snapshotter = &SnapshotterMock{}
 //			CreateSnapshotFunc: func(ctx sdk.Context, threshold utils.Threshold) (snapshot.Snapshot, error) {
 //				panic("mock out the CreateSnapshot method")
 //			},
-//			GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-//				panic("mock out the GetOperator method")
+//			GetOperatorForLaneFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+//				panic("mock out the GetOperatorForLane method")
 //			},
 //		}
 //
@@ -37,8 +37,8 @@ type SnapshotterMock struct {
 	// CreateSnapshotFunc mocks the CreateSnapshot method.
 	CreateSnapshotFunc func(ctx sdk.Context, threshold utils.Threshold) (snapshot.Snapshot, error)
 
-	// GetOperatorFunc mocks the GetOperator method.
-	GetOperatorFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	// GetOperatorForLaneFunc mocks the GetOperatorForLane method.
+	GetOperatorForLaneFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -49,16 +49,16 @@ type SnapshotterMock struct {
 			// Threshold is the threshold argument value.
 			Threshold utils.Threshold
 		}
-		// GetOperator holds details about calls to the GetOperator method.
-		GetOperator []struct {
+		// GetOperatorForLane holds details about calls to the GetOperatorForLane method.
+		GetOperatorForLane []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 			// Proxy is the proxy argument value.
 			Proxy sdk.AccAddress
 		}
 	}
-	lockCreateSnapshot sync.RWMutex
-	lockGetOperator    sync.RWMutex
+	lockCreateSnapshot     sync.RWMutex
+	lockGetOperatorForLane sync.RWMutex
 }
 
 // CreateSnapshot calls CreateSnapshotFunc.
@@ -97,10 +97,10 @@ func (mock *SnapshotterMock) CreateSnapshotCalls() []struct {
 	return calls
 }
 
-// GetOperator calls GetOperatorFunc.
-func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-	if mock.GetOperatorFunc == nil {
-		panic("SnapshotterMock.GetOperatorFunc: method is nil but Snapshotter.GetOperator was just called")
+// GetOperatorForLane calls GetOperatorForLaneFunc.
+func (mock *SnapshotterMock) GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+	if mock.GetOperatorForLaneFunc == nil {
+		panic("SnapshotterMock.GetOperatorForLaneFunc: method is nil but Snapshotter.GetOperatorForLane was just called")
 	}
 	callInfo := struct {
 		Ctx   sdk.Context
@@ -109,17 +109,17 @@ func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress)
 		Ctx:   ctx,
 		Proxy: proxy,
 	}
-	mock.lockGetOperator.Lock()
-	mock.calls.GetOperator = append(mock.calls.GetOperator, callInfo)
-	mock.lockGetOperator.Unlock()
-	return mock.GetOperatorFunc(ctx, proxy)
+	mock.lockGetOperatorForLane.Lock()
+	mock.calls.GetOperatorForLane = append(mock.calls.GetOperatorForLane, callInfo)
+	mock.lockGetOperatorForLane.Unlock()
+	return mock.GetOperatorForLaneFunc(ctx, proxy)
 }
 
-// GetOperatorCalls gets all the calls that were made to GetOperator.
+// GetOperatorForLaneCalls gets all the calls that were made to GetOperatorForLane.
 // Check the length with:
 //
-//	len(mockedSnapshotter.GetOperatorCalls())
-func (mock *SnapshotterMock) GetOperatorCalls() []struct {
+//	len(mockedSnapshotter.GetOperatorForLaneCalls())
+func (mock *SnapshotterMock) GetOperatorForLaneCalls() []struct {
 	Ctx   sdk.Context
 	Proxy sdk.AccAddress
 } {
@@ -127,8 +127,8 @@ func (mock *SnapshotterMock) GetOperatorCalls() []struct {
 		Ctx   sdk.Context
 		Proxy sdk.AccAddress
 	}
-	mock.lockGetOperator.RLock()
-	calls = mock.calls.GetOperator
-	mock.lockGetOperator.RUnlock()
+	mock.lockGetOperatorForLane.RLock()
+	calls = mock.calls.GetOperatorForLane
+	mock.lockGetOperatorForLane.RUnlock()
 	return calls
 }
@@ -55,7 +55,7 @@ func (s msgServer) SubmitPubKey(c context.Context, req *types.SubmitPubKeyReques
 		return nil, fmt.Errorf("keygen session %s not found", req.KeyID)
 	}
 
-	participant := s.snapshotter.GetOperator(ctx, req.Sender)
+	participant := s.snapshotter.GetOperatorForLane(ctx, req.Sender)
 	if participant.Empty() {
 		return nil, fmt.Errorf("sender %s is not a registered proxy", req.Sender.String())
 	}
@@ -84,12 +84,12 @@ func (s msgServer) SubmitPubKey(c context.Context, req *types.SubmitPubKeyReques
 func (s msgServer) SubmitSignature(c context.Context, req *types.SubmitSignatureRequest) (*types.SubmitSignatureResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	signingSession, ok := s.getSigningSession(ctx, req.SigID)
+	signingSession, ok := s.GetSigningSession(ctx, req.SigID)
 	if !ok {
 		return nil, fmt.Errorf("signing session %d not found", req.SigID)
 	}
 
-	participant := s.snapshotter.GetOperator(ctx, req.Sender)
+	participant := s.snapshotter.GetOperatorForLane(ctx, req.Sender)
 	if participant.Empty() {
 		return nil, fmt.Errorf("sender %s is not a registered proxy", req.Sender.String())
 	}
@@ -61,7 +61,7 @@ func TestMsgServer(t *testing.T) {
 	})
 
 	whenSenderIsProxy := When("the sender is a proxy", func() {
-		snapshotter.GetOperatorFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.Sample(validators, 1)[0].Address }
+		snapshotter.GetOperatorForLaneFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return rand.Sample(validators, 1)[0].Address }
 	})
 	keySessionExists := When("a key session exists", func() {
 		keyID = exported.KeyID(rand.HexStr(5))
@@ -92,7 +92,7 @@ func TestMsgServer(t *testing.T) {
 					Then2(pubKeyFails),
 
 				When("the sender is not a proxy", func() {
-					snapshotter.GetOperatorFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return nil }
+					snapshotter.GetOperatorForLaneFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return nil }
 				}).
 					When2(keySessionExists).
 					When2(requestIsMade).
@@ -143,7 +143,7 @@ func TestMsgServer(t *testing.T) {
 				keySessionExists.
 					When("all participants submitted the public keys and the grace period does not go beyond the expires at", func() {
 						for _, v := range validators {
-							snapshotter.GetOperatorFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
+							snapshotter.GetOperatorForLaneFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
 
 							sk := funcs.Must(btcec.NewPrivateKey())
 							req = types.NewSubmitPubKeyRequest(rand2.AccAddr(), keyID, sk.PubKey().SerializeCompressed(), ecdsa.Sign(sk, []byte(keyID)).Serialize())
@@ -163,7 +163,7 @@ func TestMsgServer(t *testing.T) {
 						ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.DefaultParams().KeygenTimeout - types.DefaultParams().KeygenGracePeriod)
 
 						for _, v := range validators {
-							snapshotter.GetOperatorFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
+							snapshotter.GetOperatorForLaneFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
 
 							sk := funcs.Must(btcec.NewPrivateKey())
 							req = types.NewSubmitPubKeyRequest(rand2.AccAddr(), keyID, sk.PubKey().SerializeCompressed(), ecdsa.Sign(sk, []byte(keyID)).Serialize())
@@ -197,7 +197,7 @@ func TestMsgServer(t *testing.T) {
 
 		givenMsgServer.
 			When("proxies are all set up", func() {
-				snapshotter.GetOperatorFunc = func(_ sdk.Context, p sdk.AccAddress) sdk.ValAddress {
+				snapshotter.GetOperatorForLaneFunc = func(_ sdk.Context, p sdk.AccAddress) sdk.ValAddress {
 					for i, proxy := range proxies {
 						if proxy.Equals(p) {
 							return validators[i]
@@ -51,7 +51,7 @@ func (k Keeper) GetSigningSessionsByExpiry(ctx sdk.Context, expiry int64) []type
 		iter.UnmarshalValue(&value)
 
 		sigID := value.Value
-		result, ok := k.getSigningSession(ctx, sigID)
+		result, ok := k.GetSigningSession(ctx, sigID)
 		if !ok {
 			panic(fmt.Errorf("signing session %d not found", sigID))
 		}
@@ -87,7 +87,7 @@ func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported
 
 	k.setSigningSession(ctx, signingSession)
 
-	events.Emit(ctx, types.NewSigningStarted(signingSession.GetID(), key, payloadHash[:], module))
+	events.Emit(ctx, types.NewSigningStarted(signingSession.GetID(), key, payloadHash[:], module, signingSession.ModuleMetadata))
 	k.Logger(ctx).Info("signing session started",
 		"sig_id", signingSession.GetID(),
 		"key_id", key.GetID(),
@@ -104,7 +104,7 @@ func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported
 
 // DeleteSigningSession deletes the signing session with the given ID
 func (k Keeper) DeleteSigningSession(ctx sdk.Context, id uint64) {
-	signing, ok := k.getSigningSession(ctx, id)
+	signing, ok := k.GetSigningSession(ctx, id)
 	if !ok {
 		return
 	}
@@ -121,7 +121,8 @@ func (k Keeper) setSigningSession(ctx sdk.Context, signing types.SigningSession)
 	k.getStore(ctx).Set(getSigningSessionKey(signing.GetID()), &signing)
 }
 
-func (k Keeper) getSigningSession(ctx sdk.Context, id uint64) (signing types.SigningSession, ok bool) {
+// GetSigningSession returns the signing session with the given ID
+func (k Keeper) GetSigningSession(ctx sdk.Context, id uint64) (signing types.SigningSession, ok bool) {
 	return signing, k.getStore(ctx).Get(getSigningSessionKey(id), &signing)
 }
 
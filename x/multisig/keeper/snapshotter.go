@@ -16,7 +16,7 @@ import (
 // Snapshotter is an interface to create snapshots for multisig keygen
 type Snapshotter interface {
 	CreateSnapshot(ctx sdk.Context, threshold utils.Threshold) (snapshot.Snapshot, error)
-	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 }
 
 var _ Snapshotter = SnapshotCreator{}
@@ -39,9 +39,9 @@ func NewSnapshotCreator(keygen types.KeygenParticipator, snapshotter types.Snaps
 	}
 }
 
-// GetOperator returns the operator of the given proxy
-func (sc SnapshotCreator) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-	return sc.snapshotter.GetOperator(ctx, proxy)
+// GetOperatorForLane returns the operator of the given proxy or lane proxy
+func (sc SnapshotCreator) GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+	return sc.snapshotter.GetOperatorForLane(ctx, proxy)
 }
 
 // CreateSnapshot creates a snapshot for multisig keygen
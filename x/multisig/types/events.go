@@ -1,6 +1,7 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
@@ -43,7 +44,7 @@ func NewPubKeySubmitted(keyID exported.KeyID, participant sdk.ValAddress, pubKey
 }
 
 // NewSigningStarted is the constructor for event signing started
-func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, requestingModule string) *SigningStarted {
+func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, requestingModule string, moduleMetadata *codectypes.Any) *SigningStarted {
 	return &SigningStarted{
 		Module:           ModuleName,
 		SigID:            sigID,
@@ -51,6 +52,7 @@ func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, request
 		PubKeys:          key.GetPubKeys(),
 		PayloadHash:      payloadHash,
 		RequestingModule: requestingModule,
+		ModuleMetadata:   moduleMetadata,
 	}
 }
 
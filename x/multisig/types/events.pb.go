@@ -7,10 +7,12 @@ import (
 	fmt "fmt"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -274,6 +276,9 @@ type SigningStarted struct {
 	PubKeys          map[string]github_com_axelarnetwork_axelar_core_x_multisig_exported.PublicKey `protobuf:"bytes,4,rep,name=pub_keys,json=pubKeys,proto3,castvalue=github.com/axelarnetwork/axelar-core/x/multisig/exported.PublicKey" json:"pub_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PayloadHash      github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash                 `protobuf:"bytes,5,opt,name=payload_hash,json=payloadHash,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.Hash" json:"payload_hash,omitempty"`
 	RequestingModule string                                                                        `protobuf:"bytes,6,opt,name=requesting_module,json=requestingModule,proto3" json:"requesting_module,omitempty"`
+	// module_metadata identifies what the payload hash commits to, so signers
+	// can verify the payload before signing
+	ModuleMetadata *types.Any `protobuf:"bytes,7,opt,name=module_metadata,json=moduleMetadata,proto3" json:"module_metadata,omitempty"`
 }
 
 func (m *SigningStarted) Reset()         { *m = SigningStarted{} }
@@ -347,6 +352,13 @@ func (m *SigningStarted) GetRequestingModule() string {
 	return ""
 }
 
+func (m *SigningStarted) GetModuleMetadata() *types.Any {
+	if m != nil {
+		return m.ModuleMetadata
+	}
+	return nil
+}
+
 type SigningCompleted struct {
 	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	SigID  uint64 `protobuf:"varint,2,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
@@ -748,53 +760,58 @@ func init() {
 }
 
 var fileDescriptor_36b18b0391cba3fc = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xee, 0x36, 0x1f, 0x7d, 0xb3, 0x4d, 0xdf, 0xf6, 0xb5, 0xaa, 0x97, 0xa8, 0x87, 0x38, 0xb2,
-	0x38, 0x54, 0xaa, 0xea, 0xa8, 0x7c, 0x48, 0x28, 0x12, 0xa0, 0xa4, 0xb4, 0x22, 0x04, 0x68, 0x95,
-	0x08, 0x24, 0xb8, 0x44, 0x6b, 0x7b, 0xe5, 0xac, 0x62, 0x7b, 0x8d, 0x77, 0x5d, 0xe2, 0x7f, 0x80,
-	0x38, 0x81, 0x38, 0xc0, 0x85, 0x03, 0xfc, 0x15, 0x2e, 0x1c, 0x7b, 0xe4, 0x64, 0x50, 0xc2, 0xaf,
-	0xc8, 0x09, 0xad, 0xed, 0x36, 0x29, 0x02, 0x4c, 0x2b, 0x4a, 0x11, 0xa7, 0xec, 0xec, 0x8e, 0x9e,
-	0x99, 0x79, 0xe6, 0x99, 0x89, 0xe1, 0x79, 0x34, 0xc0, 0x16, 0xf2, 0xaa, 0xb6, 0x6f, 0x71, 0xc2,
-	0x88, 0x59, 0xdd, 0xdb, 0xd0, 0x30, 0x47, 0x1b, 0x55, 0xbc, 0x87, 0x1d, 0xce, 0x54, 0xd7, 0xa3,
-	0x9c, 0x4a, 0xe7, 0x62, 0x2f, 0xf5, 0xc0, 0x4b, 0x4d, 0xbc, 0x56, 0x96, 0x4d, 0x6a, 0xd2, 0xc8,
-	0xa7, 0x2a, 0x4e, 0xb1, 0xbb, 0x32, 0x04, 0x70, 0xa1, 0x85, 0x03, 0x13, 0x3b, 0x1d, 0x8e, 0x3c,
-	0x8e, 0x0d, 0xe9, 0x7f, 0x98, 0xb7, 0xa9, 0xe1, 0x5b, 0xb8, 0x04, 0x2a, 0x60, 0xb5, 0xd0, 0x4e,
-	0x2c, 0x49, 0x83, 0xf9, 0x3e, 0x0e, 0xba, 0xc4, 0x28, 0xcd, 0x8a, 0xfb, 0x46, 0x6b, 0x18, 0xca,
-	0xb9, 0x16, 0x0e, 0x9a, 0x37, 0xc6, 0xa1, 0x7c, 0xcd, 0x24, 0xbc, 0xe7, 0x6b, 0xaa, 0x4e, 0xed,
-	0x6a, 0x9c, 0x80, 0x83, 0xf9, 0x63, 0xea, 0xf5, 0x13, 0x6b, 0x5d, 0xa7, 0x1e, 0xae, 0x0e, 0x26,
-	0xb9, 0xe3, 0x81, 0x4b, 0x45, 0x38, 0x35, 0x42, 0x68, 0xe7, 0xfa, 0x38, 0x68, 0x1a, 0xd2, 0x3d,
-	0x58, 0x74, 0x91, 0xc7, 0x89, 0x4e, 0x5c, 0xe4, 0x70, 0x56, 0xca, 0x54, 0x32, 0xab, 0xc5, 0xc6,
-	0xc6, 0x38, 0x94, 0xd7, 0xa7, 0x02, 0xe8, 0x94, 0xd9, 0x94, 0x25, 0x3f, 0xeb, 0xcc, 0xe8, 0x57,
-	0x79, 0xe0, 0x62, 0xa6, 0xde, 0x47, 0x56, 0xdd, 0x30, 0x3c, 0xcc, 0x58, 0xfb, 0x08, 0x8c, 0xf2,
-	0x02, 0xc0, 0xc5, 0xb8, 0xc8, 0x4d, 0x6a, 0xbb, 0x16, 0x3e, 0xe3, 0x32, 0x6b, 0xd9, 0x27, 0x6f,
-	0x65, 0xa0, 0x3c, 0x3f, 0xa4, 0x7e, 0x6b, 0xe0, 0x12, 0xef, 0x8f, 0xc8, 0xe9, 0xdd, 0x2c, 0x5c,
-	0xdc, 0xf5, 0xb5, 0x16, 0x0e, 0x3a, 0xbe, 0x66, 0x13, 0x7e, 0xd6, 0x82, 0xe8, 0xc0, 0xf9, 0xa9,
-	0x4e, 0x96, 0x32, 0x15, 0x70, 0x32, 0x3d, 0x4c, 0xa3, 0x48, 0x5d, 0x38, 0xe7, 0xfa, 0x5a, 0xb7,
-	0x8f, 0x83, 0x52, 0x36, 0x02, 0xdc, 0x1e, 0x87, 0x72, 0xe3, 0xc4, 0x09, 0xef, 0xfa, 0x9a, 0x45,
-	0xf4, 0x16, 0x0e, 0xda, 0x79, 0x37, 0xa2, 0x4e, 0x79, 0x9d, 0x85, 0xff, 0x76, 0x88, 0xe9, 0x10,
-	0xc7, 0x4c, 0x9b, 0xaa, 0x0a, 0xcc, 0x33, 0x62, 0x1e, 0x90, 0x98, 0x6d, 0x14, 0x04, 0x89, 0x1d,
-	0x62, 0x0a, 0x0a, 0x18, 0x31, 0x9b, 0xc6, 0x14, 0xcd, 0x99, 0x53, 0xa3, 0xf9, 0x25, 0x80, 0xff,
-	0x24, 0x94, 0xb0, 0x52, 0xb6, 0x92, 0x59, 0x9d, 0xbf, 0x70, 0x49, 0xfd, 0xce, 0x22, 0x51, 0x8f,
-	0x56, 0xa6, 0xc6, 0x72, 0x61, 0x5b, 0x0e, 0xf7, 0x82, 0xc6, 0xf6, 0xd3, 0x8f, 0xbf, 0x84, 0xc9,
-	0xb9, 0x98, 0x49, 0x26, 0x19, 0x62, 0x23, 0x04, 0x16, 0x45, 0x46, 0xb7, 0x87, 0x58, 0xaf, 0x94,
-	0x8b, 0x1a, 0x56, 0x1f, 0x87, 0xf2, 0xd5, 0x13, 0x87, 0xb9, 0x89, 0x58, 0x4f, 0x28, 0x22, 0x82,
-	0x15, 0x86, 0xb4, 0x06, 0xff, 0xf3, 0xf0, 0x23, 0x1f, 0x33, 0x4e, 0x1c, 0xb3, 0x9b, 0x34, 0x2a,
-	0x1f, 0x35, 0x6a, 0x69, 0xf2, 0x70, 0x27, 0xba, 0x5f, 0xa9, 0xc1, 0xe2, 0x74, 0xcd, 0xd2, 0x12,
-	0xcc, 0x08, 0x29, 0xc5, 0x7d, 0x15, 0x47, 0x69, 0x19, 0xe6, 0xf6, 0x90, 0xe5, 0xe3, 0xa8, 0xa7,
-	0xc5, 0x76, 0x6c, 0xd4, 0x66, 0xaf, 0x80, 0x5a, 0xf6, 0xd5, 0x1b, 0x19, 0x28, 0xb7, 0xe1, 0x52,
-	0x42, 0x62, 0xfa, 0x3e, 0x4a, 0x15, 0x88, 0x72, 0xeb, 0x50, 0x6c, 0x69, 0x7b, 0x24, 0x1d, 0x6b,
-	0x1f, 0x40, 0x49, 0x80, 0x21, 0xee, 0x7b, 0x38, 0x7d, 0x05, 0xa4, 0xab, 0xf7, 0x54, 0x06, 0x78,
-	0x0d, 0x16, 0xd8, 0x41, 0x92, 0xc9, 0x08, 0x2f, 0x8c, 0x43, 0xb9, 0x70, 0x98, 0x79, 0x7b, 0xf2,
-	0xae, 0x7c, 0x06, 0x70, 0xbe, 0x85, 0x83, 0x3a, 0x13, 0x57, 0x3f, 0xa8, 0xe5, 0x01, 0xcc, 0xe9,
-	0x3d, 0x44, 0x9c, 0x64, 0x9b, 0x6d, 0x8e, 0x43, 0xf9, 0xfa, 0x4f, 0x4a, 0xcc, 0xc1, 0x03, 0x9f,
-	0x4d, 0xf4, 0xb5, 0x29, 0x60, 0xee, 0x22, 0x1b, 0xb7, 0x63, 0xc4, 0xdf, 0x31, 0xc2, 0xca, 0x08,
-	0x40, 0x28, 0x26, 0x87, 0x72, 0xc4, 0xff, 0xde, 0x2a, 0x75, 0x58, 0x8c, 0xff, 0x32, 0x77, 0x5c,
-	0xbe, 0xe3, 0xf3, 0xaf, 0xe5, 0x05, 0x8e, 0x25, 0xaf, 0xba, 0xae, 0x7f, 0x4b, 0x5e, 0x8a, 0x16,
-	0x09, 0x26, 0x0e, 0xd2, 0x74, 0x4e, 0x25, 0x46, 0x63, 0xe7, 0xfd, 0xb0, 0x0c, 0xf6, 0x87, 0x65,
-	0xf0, 0x69, 0x58, 0x06, 0xcf, 0x46, 0xe5, 0x99, 0xfd, 0x51, 0x79, 0xe6, 0xc3, 0xa8, 0x3c, 0xf3,
-	0xf0, 0xf2, 0x71, 0x99, 0x8a, 0x02, 0x69, 0xf9, 0xe8, 0x7b, 0xee, 0xe2, 0x97, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0xbb, 0x0a, 0xb6, 0x26, 0x0a, 0x00, 0x00,
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0xed, 0x34, 0x3f, 0x25, 0x93, 0x7c, 0x5f, 0x8b, 0x55, 0x81, 0xdb, 0x45, 0x1c, 0x59, 0x08,
+	0x45, 0x54, 0xb1, 0xd5, 0x02, 0x12, 0x8a, 0x04, 0x28, 0x29, 0xad, 0x08, 0xa1, 0xb4, 0x72, 0x04,
+	0x12, 0x6c, 0xa2, 0xb1, 0x3d, 0x38, 0xa3, 0xd8, 0x1e, 0xe3, 0x19, 0x97, 0xf8, 0x0d, 0x2a, 0x56,
+	0x20, 0x16, 0xb0, 0x85, 0x67, 0xe0, 0x0d, 0xd8, 0x20, 0x56, 0x5d, 0xb2, 0x0a, 0x28, 0xe1, 0x29,
+	0xb2, 0x42, 0xf6, 0x38, 0x3f, 0x45, 0x85, 0xd0, 0x8a, 0x52, 0xf4, 0xad, 0x32, 0x3f, 0x37, 0xe7,
+	0xde, 0x7b, 0xee, 0x99, 0x63, 0xf8, 0x12, 0x1a, 0x61, 0x17, 0x85, 0xba, 0x17, 0xb9, 0x9c, 0x30,
+	0xe2, 0xe8, 0x97, 0x87, 0x26, 0xe6, 0xe8, 0x50, 0xc7, 0x97, 0xd8, 0xe7, 0x4c, 0x0b, 0x42, 0xca,
+	0xa9, 0xf4, 0xa2, 0x88, 0xd2, 0xe6, 0x51, 0x5a, 0x16, 0xb5, 0xbf, 0xe7, 0x50, 0xea, 0xb8, 0x58,
+	0x4f, 0xc3, 0xcc, 0xe8, 0x53, 0x1d, 0xf9, 0xb1, 0xf8, 0xcf, 0xfe, 0xae, 0x43, 0x1d, 0x9a, 0x2e,
+	0xf5, 0x64, 0x95, 0x9d, 0xee, 0x59, 0x94, 0x79, 0x94, 0xf5, 0xc5, 0x85, 0xd8, 0x88, 0x2b, 0x75,
+	0x02, 0xe0, 0x93, 0x2e, 0x8e, 0x1d, 0xec, 0xf7, 0x38, 0x0a, 0x39, 0xb6, 0xa5, 0x17, 0x60, 0xd1,
+	0xa3, 0x76, 0xe4, 0x62, 0x19, 0xd4, 0x40, 0xbd, 0x64, 0x64, 0x3b, 0xc9, 0x84, 0xc5, 0x21, 0x8e,
+	0xfb, 0xc4, 0x96, 0x37, 0x93, 0xf3, 0x76, 0x77, 0x32, 0x56, 0x0a, 0x5d, 0x1c, 0x77, 0xde, 0x99,
+	0x8d, 0x95, 0xb7, 0x1c, 0xc2, 0x07, 0x91, 0xa9, 0x59, 0xd4, 0xd3, 0x45, 0xd9, 0x3e, 0xe6, 0x9f,
+	0xd3, 0x70, 0x98, 0xed, 0x1a, 0x16, 0x0d, 0xb1, 0x3e, 0x5a, 0x76, 0x8c, 0x47, 0x01, 0x4d, 0xd2,
+	0x69, 0x29, 0x82, 0x51, 0x18, 0xe2, 0xb8, 0x63, 0x4b, 0x1f, 0xc2, 0x4a, 0x80, 0x42, 0x4e, 0x2c,
+	0x12, 0x20, 0x9f, 0x33, 0x39, 0x57, 0xcb, 0xd5, 0x2b, 0xed, 0xc3, 0xd9, 0x58, 0x69, 0xac, 0x24,
+	0x10, 0x0d, 0x64, 0x3f, 0x0d, 0x66, 0x0f, 0x75, 0x1e, 0x07, 0x98, 0x69, 0x1f, 0x21, 0xb7, 0x65,
+	0xdb, 0x21, 0x66, 0xcc, 0xb8, 0x01, 0xa3, 0x7e, 0x0d, 0xe0, 0xb6, 0x68, 0xf2, 0x98, 0x7a, 0x81,
+	0x8b, 0x1f, 0xb9, 0xcd, 0x66, 0xfe, 0xea, 0x7b, 0x05, 0xa8, 0x5f, 0x2d, 0xa8, 0x3f, 0x19, 0x05,
+	0x24, 0xfc, 0x5f, 0xd4, 0xf4, 0xe3, 0x26, 0xdc, 0xbe, 0x88, 0xcc, 0x2e, 0x8e, 0x7b, 0x91, 0xe9,
+	0x11, 0xfe, 0xd8, 0x82, 0xe8, 0xc1, 0xf2, 0xca, 0x24, 0xe5, 0x5c, 0x0d, 0xdc, 0x4f, 0x0f, 0xab,
+	0x28, 0x52, 0x1f, 0x6e, 0x05, 0x91, 0xd9, 0x1f, 0xe2, 0x58, 0xce, 0xa7, 0x80, 0xa7, 0xb3, 0xb1,
+	0xd2, 0xbe, 0x77, 0xc1, 0x17, 0x91, 0xe9, 0x12, 0xab, 0x8b, 0x63, 0xa3, 0x18, 0xa4, 0xd4, 0xa9,
+	0x57, 0x05, 0xf8, 0xb4, 0x47, 0x1c, 0x9f, 0xf8, 0xce, 0xba, 0x57, 0x55, 0x83, 0x45, 0x46, 0x9c,
+	0x39, 0x89, 0xf9, 0x76, 0x29, 0x21, 0xb1, 0x47, 0x9c, 0x84, 0x02, 0x46, 0x9c, 0x8e, 0xbd, 0x42,
+	0x73, 0xee, 0xc1, 0x68, 0xfe, 0x06, 0xc0, 0xe7, 0x32, 0x4a, 0x98, 0x9c, 0xaf, 0xe5, 0xea, 0xe5,
+	0xa3, 0xd7, 0xb4, 0xbf, 0xb0, 0x1f, 0xed, 0x66, 0x67, 0x9a, 0x90, 0x0b, 0x3b, 0xf1, 0x79, 0x18,
+	0xb7, 0x4f, 0xbf, 0xf8, 0xf5, 0x5f, 0x61, 0x72, 0x4b, 0x30, 0xc9, 0x24, 0x3b, 0x71, 0x84, 0xd8,
+	0xa5, 0xc8, 0xee, 0x0f, 0x10, 0x1b, 0xc8, 0x85, 0x74, 0x60, 0xad, 0xd9, 0x58, 0x79, 0xf3, 0xde,
+	0x69, 0xde, 0x45, 0x6c, 0x60, 0x94, 0x33, 0xd8, 0x64, 0x23, 0x1d, 0xc0, 0xe7, 0x43, 0xfc, 0x59,
+	0x84, 0x19, 0x27, 0xbe, 0xd3, 0xcf, 0x06, 0x55, 0x4c, 0x07, 0xb5, 0xb3, 0xbc, 0x38, 0x13, 0x23,
+	0x23, 0x70, 0x5b, 0x44, 0xf4, 0x3d, 0xcc, 0x91, 0x8d, 0x38, 0x92, 0xb7, 0x6a, 0xa0, 0x5e, 0x3e,
+	0xda, 0xd5, 0x84, 0x31, 0x6b, 0x73, 0x63, 0xd6, 0x5a, 0x7e, 0xdc, 0x7e, 0xe5, 0xe7, 0x1f, 0x1a,
+	0x2f, 0xdf, 0xa6, 0x56, 0x1b, 0x5b, 0xfa, 0x45, 0x12, 0x79, 0x86, 0x42, 0x36, 0x40, 0x2e, 0x0e,
+	0x8d, 0xa7, 0x02, 0xf8, 0x2c, 0xc3, 0xdd, 0x6f, 0xc2, 0xca, 0x2a, 0xbd, 0xd2, 0x0e, 0xcc, 0x25,
+	0xaa, 0x15, 0x12, 0x4a, 0x96, 0xd2, 0x2e, 0x2c, 0x5c, 0x22, 0x37, 0xc2, 0xa9, 0x7c, 0x2a, 0x86,
+	0xd8, 0x34, 0x37, 0xdf, 0x00, 0xcd, 0xfc, 0xb7, 0xdf, 0x29, 0x40, 0x7d, 0x1f, 0xee, 0x64, 0xf3,
+	0x5a, 0x6f, 0x7d, 0x6b, 0xb5, 0xa8, 0xbe, 0xb7, 0xd0, 0xf5, 0x3a, 0xcb, 0x5a, 0x8f, 0x75, 0x0d,
+	0xa0, 0x94, 0x80, 0x21, 0x1e, 0x85, 0x78, 0xbd, 0xdb, 0xac, 0x7f, 0x28, 0x0f, 0xe2, 0x15, 0x07,
+	0xb0, 0xc4, 0xe6, 0x45, 0x66, 0x6e, 0xf1, 0x64, 0x36, 0x56, 0x4a, 0x8b, 0xca, 0x8d, 0xe5, 0xbd,
+	0xfa, 0x3b, 0x80, 0xe5, 0x2e, 0x8e, 0x5b, 0x2c, 0x39, 0xfa, 0x9b, 0x5e, 0x3e, 0x86, 0x05, 0x6b,
+	0x80, 0x88, 0x9f, 0x19, 0xe7, 0xf1, 0x6c, 0xac, 0xbc, 0xfd, 0x0f, 0xd5, 0xec, 0xe3, 0x51, 0xc4,
+	0x96, 0x52, 0x3e, 0x4e, 0x60, 0x3e, 0x40, 0x1e, 0x36, 0x04, 0xe2, 0x7f, 0xe1, 0x16, 0xea, 0x14,
+	0x40, 0x98, 0x3c, 0x52, 0xca, 0x11, 0x7f, 0x76, 0xbb, 0xb4, 0x60, 0x45, 0x7c, 0x9d, 0xcf, 0x03,
+	0x7e, 0x1e, 0xf1, 0x3f, 0xcb, 0x0b, 0xdc, 0x49, 0x5e, 0x2d, 0xcb, 0xba, 0x4d, 0x5e, 0xaa, 0x09,
+	0xcb, 0x8b, 0x24, 0x1d, 0xff, 0x41, 0x72, 0xb4, 0xcf, 0x7f, 0x9a, 0x54, 0xc1, 0xf5, 0xa4, 0x0a,
+	0x7e, 0x9b, 0x54, 0xc1, 0x97, 0xd3, 0xea, 0xc6, 0xf5, 0xb4, 0xba, 0xf1, 0xcb, 0xb4, 0xba, 0xf1,
+	0xc9, 0xeb, 0x77, 0x65, 0x2a, 0x4d, 0x64, 0x16, 0x53, 0x7f, 0x7b, 0xf5, 0x8f, 0x01, 0x00, 0x10,
+	0x16, 0x0c, 0xcb, 0xc7, 0x0a, 0x00, 0x00,
 }
 
 func (m *KeygenStarted) Marshal() (dAtA []byte, err error) {
@@ -988,6 +1005,18 @@ func (m *SigningStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ModuleMetadata != nil {
+		{
+			size, err := m.ModuleMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.RequestingModule) > 0 {
 		i -= len(m.RequestingModule)
 		copy(dAtA[i:], m.RequestingModule)
@@ -1447,6 +1476,10 @@ func (m *SigningStarted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ModuleMetadata != nil {
+		l = m.ModuleMetadata.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -2442,6 +2475,42 @@ func (m *SigningStarted) Unmarshal(dAtA []byte) error {
 			}
 			m.RequestingModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModuleMetadata == nil {
+				m.ModuleMetadata = &types.Any{}
+			}
+			if err := m.ModuleMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
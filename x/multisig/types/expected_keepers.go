@@ -25,6 +25,7 @@ type Keeper interface {
 	GetKey(ctx sdk.Context, keyID exported.KeyID) (exported.Key, bool)
 	SetKey(ctx sdk.Context, key Key)
 	DeleteKeygenSession(ctx sdk.Context, id exported.KeyID)
+	GetSigningSession(ctx sdk.Context, id uint64) (SigningSession, bool)
 	GetSigningSessionsByExpiry(ctx sdk.Context, expiry int64) []SigningSession
 	DeleteSigningSession(ctx sdk.Context, id uint64)
 	GetSigRouter() SigRouter
@@ -45,7 +46,7 @@ type Snapshotter interface {
 		threshold utils.Threshold,
 	) (snapshot.Snapshot, error)
 	GetProxy(ctx sdk.Context, operator sdk.ValAddress) (addr sdk.AccAddress, active bool)
-	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 }
 
 // Staker provides staking keeper functionality
@@ -53,6 +53,9 @@ var _ types.Keeper = &KeeperMock{}
 //			GetSigRouterFunc: func() types.SigRouter {
 //				panic("mock out the GetSigRouter method")
 //			},
+//			GetSigningSessionFunc: func(ctx sdk.Context, id uint64) (types.SigningSession, bool) {
+//				panic("mock out the GetSigningSession method")
+//			},
 //			GetSigningSessionsByExpiryFunc: func(ctx sdk.Context, expiry int64) []types.SigningSession {
 //				panic("mock out the GetSigningSessionsByExpiry method")
 //			},
@@ -96,6 +99,9 @@ type KeeperMock struct {
 	// GetSigRouterFunc mocks the GetSigRouter method.
 	GetSigRouterFunc func() types.SigRouter
 
+	// GetSigningSessionFunc mocks the GetSigningSession method.
+	GetSigningSessionFunc func(ctx sdk.Context, id uint64) (types.SigningSession, bool)
+
 	// GetSigningSessionsByExpiryFunc mocks the GetSigningSessionsByExpiry method.
 	GetSigningSessionsByExpiryFunc func(ctx sdk.Context, expiry int64) []types.SigningSession
 
@@ -164,6 +170,13 @@ type KeeperMock struct {
 		// GetSigRouter holds details about calls to the GetSigRouter method.
 		GetSigRouter []struct {
 		}
+		// GetSigningSession holds details about calls to the GetSigningSession method.
+		GetSigningSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// ID is the id argument value.
+			ID uint64
+		}
 		// GetSigningSessionsByExpiry holds details about calls to the GetSigningSessionsByExpiry method.
 		GetSigningSessionsByExpiry []struct {
 			// Ctx is the ctx argument value.
@@ -193,6 +206,7 @@ type KeeperMock struct {
 	lockGetNextKeyID               sync.RWMutex
 	lockGetParams                  sync.RWMutex
 	lockGetSigRouter               sync.RWMutex
+	lockGetSigningSession          sync.RWMutex
 	lockGetSigningSessionsByExpiry sync.RWMutex
 	lockLogger                     sync.RWMutex
 	lockSetKey                     sync.RWMutex
@@ -509,6 +523,42 @@ func (mock *KeeperMock) GetSigRouterCalls() []struct {
 	return calls
 }
 
+// GetSigningSession calls GetSigningSessionFunc.
+func (mock *KeeperMock) GetSigningSession(ctx sdk.Context, id uint64) (types.SigningSession, bool) {
+	if mock.GetSigningSessionFunc == nil {
+		panic("KeeperMock.GetSigningSessionFunc: method is nil but Keeper.GetSigningSession was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+		ID  uint64
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetSigningSession.Lock()
+	mock.calls.GetSigningSession = append(mock.calls.GetSigningSession, callInfo)
+	mock.lockGetSigningSession.Unlock()
+	return mock.GetSigningSessionFunc(ctx, id)
+}
+
+// GetSigningSessionCalls gets all the calls that were made to GetSigningSession.
+// Check the length with:
+//
+//	len(mockedKeeper.GetSigningSessionCalls())
+func (mock *KeeperMock) GetSigningSessionCalls() []struct {
+	Ctx sdk.Context
+	ID  uint64
+} {
+	var calls []struct {
+		Ctx sdk.Context
+		ID  uint64
+	}
+	mock.lockGetSigningSession.RLock()
+	calls = mock.calls.GetSigningSession
+	mock.lockGetSigningSession.RUnlock()
+	return calls
+}
+
 // GetSigningSessionsByExpiry calls GetSigningSessionsByExpiryFunc.
 func (mock *KeeperMock) GetSigningSessionsByExpiry(ctx sdk.Context, expiry int64) []types.SigningSession {
 	if mock.GetSigningSessionsByExpiryFunc == nil {
@@ -626,8 +676,8 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //			CreateSnapshotFunc: func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, weightFunc func(consensusPower sdk.Uint) sdk.Uint, threshold utils.Threshold) (exported.Snapshot, error) {
 //				panic("mock out the CreateSnapshot method")
 //			},
-//			GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-//				panic("mock out the GetOperator method")
+//			GetOperatorForLaneFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+//				panic("mock out the GetOperatorForLane method")
 //			},
 //			GetProxyFunc: func(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool) {
 //				panic("mock out the GetProxy method")
@@ -642,8 +692,8 @@ type SnapshotterMock struct {
 	// CreateSnapshotFunc mocks the CreateSnapshot method.
 	CreateSnapshotFunc func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, weightFunc func(consensusPower sdk.Uint) sdk.Uint, threshold utils.Threshold) (exported.Snapshot, error)
 
-	// GetOperatorFunc mocks the GetOperator method.
-	GetOperatorFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	// GetOperatorForLaneFunc mocks the GetOperatorForLane method.
+	GetOperatorForLaneFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 
 	// GetProxyFunc mocks the GetProxy method.
 	GetProxyFunc func(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, bool)
@@ -663,8 +713,8 @@ type SnapshotterMock struct {
 			// Threshold is the threshold argument value.
 			Threshold utils.Threshold
 		}
-		// GetOperator holds details about calls to the GetOperator method.
-		GetOperator []struct {
+		// GetOperatorForLane holds details about calls to the GetOperatorForLane method.
+		GetOperatorForLane []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 			// Proxy is the proxy argument value.
@@ -678,9 +728,9 @@ type SnapshotterMock struct {
 			Operator sdk.ValAddress
 		}
 	}
-	lockCreateSnapshot sync.RWMutex
-	lockGetOperator    sync.RWMutex
-	lockGetProxy       sync.RWMutex
+	lockCreateSnapshot     sync.RWMutex
+	lockGetOperatorForLane sync.RWMutex
+	lockGetProxy           sync.RWMutex
 }
 
 // CreateSnapshot calls CreateSnapshotFunc.
@@ -731,10 +781,10 @@ func (mock *SnapshotterMock) CreateSnapshotCalls() []struct {
 	return calls
 }
 
-// GetOperator calls GetOperatorFunc.
-func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-	if mock.GetOperatorFunc == nil {
-		panic("SnapshotterMock.GetOperatorFunc: method is nil but Snapshotter.GetOperator was just called")
+// GetOperatorForLane calls GetOperatorForLaneFunc.
+func (mock *SnapshotterMock) GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+	if mock.GetOperatorForLaneFunc == nil {
+		panic("SnapshotterMock.GetOperatorForLaneFunc: method is nil but Snapshotter.GetOperatorForLane was just called")
 	}
 	callInfo := struct {
 		Ctx   sdk.Context
@@ -743,17 +793,17 @@ func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress)
 		Ctx:   ctx,
 		Proxy: proxy,
 	}
-	mock.lockGetOperator.Lock()
-	mock.calls.GetOperator = append(mock.calls.GetOperator, callInfo)
-	mock.lockGetOperator.Unlock()
-	return mock.GetOperatorFunc(ctx, proxy)
+	mock.lockGetOperatorForLane.Lock()
+	mock.calls.GetOperatorForLane = append(mock.calls.GetOperatorForLane, callInfo)
+	mock.lockGetOperatorForLane.Unlock()
+	return mock.GetOperatorForLaneFunc(ctx, proxy)
 }
 
-// GetOperatorCalls gets all the calls that were made to GetOperator.
+// GetOperatorForLaneCalls gets all the calls that were made to GetOperatorForLane.
 // Check the length with:
 //
-//	len(mockedSnapshotter.GetOperatorCalls())
-func (mock *SnapshotterMock) GetOperatorCalls() []struct {
+//	len(mockedSnapshotter.GetOperatorForLaneCalls())
+func (mock *SnapshotterMock) GetOperatorForLaneCalls() []struct {
 	Ctx   sdk.Context
 	Proxy sdk.AccAddress
 } {
@@ -761,9 +811,9 @@ func (mock *SnapshotterMock) GetOperatorCalls() []struct {
 		Ctx   sdk.Context
 		Proxy sdk.AccAddress
 	}
-	mock.lockGetOperator.RLock()
-	calls = mock.calls.GetOperator
-	mock.lockGetOperator.RUnlock()
+	mock.lockGetOperatorForLane.RLock()
+	calls = mock.calls.GetOperatorForLane
+	mock.lockGetOperatorForLane.RUnlock()
 	return calls
 }
 
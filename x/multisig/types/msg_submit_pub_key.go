@@ -51,3 +51,8 @@ func (m SubmitPubKeyRequest) ValidateBasic() error {
 func (m SubmitPubKeyRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// SetSender replaces the sender of the msg
+func (m *SubmitPubKeyRequest) SetSender(sender sdk.AccAddress) {
+	m.Sender = sender
+}
@@ -33,3 +33,8 @@ func (m SubmitSignatureRequest) ValidateBasic() error {
 func (m SubmitSignatureRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// SetSender replaces the sender of the msg
+func (m *SubmitSignatureRequest) SetSender(sender sdk.AccAddress) {
+	m.Sender = sender
+}
@@ -388,6 +388,89 @@ func (m *KeygenSessionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KeygenSessionResponse proto.InternalMessageInfo
 
+type SigningSessionRequest struct {
+	SigID uint64 `protobuf:"varint,1,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
+}
+
+func (m *SigningSessionRequest) Reset()         { *m = SigningSessionRequest{} }
+func (m *SigningSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionRequest) ProtoMessage()    {}
+func (*SigningSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{9}
+}
+func (m *SigningSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionRequest.Merge(m, src)
+}
+func (m *SigningSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionRequest proto.InternalMessageInfo
+
+// SigningSessionResponse contains the signing session info for a given
+// signature ID. Sessions are only found until their grace period has passed
+type SigningSessionResponse struct {
+	State       exported.MultisigState                                         `protobuf:"varint,1,opt,name=state,proto3,enum=axelar.multisig.exported.v1beta1.MultisigState" json:"state,omitempty"`
+	KeyID       github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
+	ExpiresAt   int64                                                          `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CompletedAt int64                                                          `protobuf:"varint,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	GracePeriod int64                                                          `protobuf:"varint,5,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	Module      string                                                         `protobuf:"bytes,6,opt,name=module,proto3" json:"module,omitempty"`
+	// Addresses of the participants that submitted their signature
+	Signers []string `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *SigningSessionResponse) Reset()         { *m = SigningSessionResponse{} }
+func (m *SigningSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionResponse) ProtoMessage()    {}
+func (*SigningSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{10}
+}
+func (m *SigningSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionResponse.Merge(m, src)
+}
+func (m *SigningSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionResponse proto.InternalMessageInfo
+
 // ParamsRequest represents a message that queries the params
 type ParamsRequest struct {
 }
@@ -396,7 +479,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{9}
+	return fileDescriptor_4c5266980cca9f48, []int{11}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +516,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{10}
+	return fileDescriptor_4c5266980cca9f48, []int{12}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,6 +555,8 @@ func init() {
 	proto.RegisterType((*KeyResponse)(nil), "axelar.multisig.v1beta1.KeyResponse")
 	proto.RegisterType((*KeygenSessionRequest)(nil), "axelar.multisig.v1beta1.KeygenSessionRequest")
 	proto.RegisterType((*KeygenSessionResponse)(nil), "axelar.multisig.v1beta1.KeygenSessionResponse")
+	proto.RegisterType((*SigningSessionRequest)(nil), "axelar.multisig.v1beta1.SigningSessionRequest")
+	proto.RegisterType((*SigningSessionResponse)(nil), "axelar.multisig.v1beta1.SigningSessionResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.multisig.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.multisig.v1beta1.ParamsResponse")
 }
@@ -481,55 +566,60 @@ func init() {
 }
 
 var fileDescriptor_4c5266980cca9f48 = []byte{
-	// 766 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x8d, 0x9b, 0x57, 0x3b, 0x49, 0x5f, 0x56, 0x4a, 0xa3, 0x4a, 0x4d, 0x42, 0xa8, 0x44, 0x84,
-	0xa8, 0xad, 0x16, 0xb1, 0x04, 0xd1, 0x08, 0x84, 0xaa, 0x08, 0xa8, 0xdc, 0x00, 0x52, 0x37, 0x91,
-	0x1d, 0x5f, 0x9c, 0x51, 0x12, 0x8f, 0xeb, 0x19, 0xd3, 0x98, 0x9f, 0xa0, 0xdf, 0xc2, 0x92, 0x2f,
-	0xe8, 0xb2, 0x4b, 0xc4, 0x22, 0x40, 0xfa, 0x17, 0xac, 0x50, 0xc6, 0x13, 0x37, 0x0f, 0xaa, 0x96,
-	0x00, 0x59, 0x25, 0x73, 0x7d, 0xee, 0x39, 0xd7, 0x77, 0xce, 0x91, 0xd1, 0x1d, 0xbd, 0x03, 0x2d,
-	0xdd, 0x55, 0xdb, 0x5e, 0x8b, 0x61, 0x8a, 0x2d, 0xf5, 0xfd, 0x8e, 0x01, 0x4c, 0xdf, 0x51, 0x8f,
-	0x3d, 0x70, 0x7d, 0xc5, 0x71, 0x09, 0x23, 0xf2, 0x7a, 0x00, 0x52, 0x06, 0x20, 0x45, 0x80, 0x36,
-	0x32, 0x16, 0xb1, 0x08, 0xc7, 0xa8, 0xfd, 0x7f, 0x01, 0x7c, 0x23, 0x6f, 0x11, 0x62, 0xb5, 0x40,
-	0xe5, 0x27, 0xc3, 0x7b, 0xa7, 0x32, 0xdc, 0x06, 0xca, 0xf4, 0xb6, 0x23, 0x00, 0xf7, 0xc7, 0x45,
-	0xa1, 0xe3, 0x10, 0x97, 0x81, 0x19, 0xaa, 0x33, 0xdf, 0x01, 0x2a, 0xd0, 0x57, 0x8e, 0x38, 0x0c,
-	0xda, 0x12, 0x20, 0x8f, 0xe1, 0x16, 0xbd, 0x44, 0x34, 0x5c, 0xa0, 0x0d, 0xd2, 0x32, 0xc7, 0x50,
-	0x13, 0x54, 0x8e, 0xee, 0xea, 0x6d, 0xc1, 0x55, 0xdc, 0x42, 0xe9, 0x0a, 0xf8, 0xfb, 0x4f, 0x35,
-	0x38, 0xf6, 0x80, 0x32, 0x39, 0x83, 0xe2, 0xf5, 0x86, 0x8e, 0xed, 0xac, 0x54, 0x90, 0x4a, 0x0b,
-	0x5a, 0x70, 0x28, 0x52, 0xb4, 0x28, 0x50, 0xd4, 0x21, 0x36, 0x05, 0xd9, 0x40, 0x89, 0x26, 0xf8,
-	0x35, 0x6c, 0x06, 0xb8, 0x72, 0xa5, 0xd7, 0xcd, 0xc7, 0x39, 0xe4, 0x67, 0x37, 0xff, 0xd8, 0xc2,
-	0xac, 0xe1, 0x19, 0x4a, 0x9d, 0xb4, 0xd5, 0x60, 0x08, 0x1b, 0xd8, 0x09, 0x71, 0x9b, 0xe2, 0xb4,
-	0x5d, 0x27, 0x2e, 0xa8, 0x9d, 0xc9, 0x95, 0x28, 0x81, 0x48, 0xbc, 0x09, 0xfe, 0xbe, 0x59, 0x2c,
-	0xa1, 0x95, 0x97, 0xd0, 0x61, 0x37, 0x18, 0xef, 0x04, 0xad, 0x0e, 0x21, 0x67, 0x38, 0xa2, 0x83,
-	0x50, 0x05, 0xfc, 0xc1, 0x70, 0xb3, 0x50, 0xfc, 0x28, 0xa1, 0xd5, 0x0a, 0xf8, 0x16, 0xd8, 0x07,
-	0xba, 0xcb, 0x70, 0x1d, 0x3b, 0xba, 0xcd, 0xe4, 0x2c, 0x4a, 0xea, 0xa6, 0xe9, 0x02, 0xa5, 0x62,
-	0x31, 0x83, 0xa3, 0xfc, 0x1c, 0x25, 0x4e, 0x00, 0x5b, 0x0d, 0x96, 0x9d, 0x2b, 0x48, 0xa5, 0x74,
-	0x59, 0x3d, 0xeb, 0xe6, 0x23, 0x5f, 0xbb, 0xf9, 0xbb, 0x43, 0xe3, 0xd4, 0x09, 0x6d, 0x13, 0x2a,
-	0x7e, 0xb6, 0xa9, 0xd9, 0x14, 0x6e, 0x7b, 0x8d, 0x6d, 0xa6, 0x89, 0x76, 0x79, 0x1d, 0x25, 0x1d,
-	0xcf, 0xa8, 0x35, 0xc1, 0xcf, 0x46, 0xb9, 0x44, 0xc2, 0xf1, 0x8c, 0x0a, 0xf8, 0xc5, 0x4f, 0x31,
-	0x94, 0xe2, 0x4b, 0x98, 0xdd, 0xde, 0xe5, 0x27, 0x28, 0x4e, 0x99, 0xce, 0x80, 0xbf, 0xd4, 0xd2,
-	0xee, 0x3d, 0x65, 0x3c, 0xb4, 0x61, 0x9b, 0x30, 0x7d, 0xbf, 0xfd, 0xb0, 0xdf, 0xa1, 0x05, 0x8d,
-	0xf2, 0x26, 0x42, 0x94, 0xe9, 0x7d, 0x48, 0x4d, 0x67, 0xfc, 0x8d, 0xa2, 0xda, 0x82, 0xa8, 0xec,
-	0x31, 0xf9, 0x0d, 0xca, 0x5c, 0x3e, 0xae, 0x85, 0x99, 0xce, 0xc6, 0x0a, 0x52, 0x29, 0xb5, 0xbb,
-	0xa1, 0x04, 0xa9, 0x57, 0x06, 0xa9, 0x57, 0xaa, 0x03, 0x44, 0x79, 0xbe, 0xbf, 0xe0, 0xd3, 0x6f,
-	0x79, 0x49, 0x93, 0x43, 0xba, 0xf0, 0xa9, 0x7c, 0x84, 0x56, 0xc2, 0x9c, 0xd6, 0xc4, 0xc5, 0xc4,
-	0xa7, 0xbb, 0x98, 0xe5, 0x90, 0xe8, 0x6d, 0x70, 0x43, 0x55, 0xb4, 0x68, 0x10, 0xdb, 0x84, 0x90,
-	0x38, 0x31, 0x1d, 0x71, 0x3a, 0x60, 0x09, 0x59, 0xd3, 0xce, 0xa5, 0xd3, 0x68, 0x36, 0x59, 0x88,
-	0x96, 0x52, 0xbf, 0xd9, 0xf8, 0xd0, 0xa2, 0x47, 0xcd, 0x59, 0x8e, 0xf5, 0x07, 0xd0, 0x46, 0x58,
-	0x8a, 0x1f, 0x50, 0x26, 0x00, 0x1e, 0x02, 0xa5, 0x98, 0xd8, 0xb3, 0x8c, 0xd0, 0xe7, 0x38, 0x5a,
-	0x1b, 0x13, 0x17, 0xd6, 0x1d, 0x35, 0x85, 0x74, 0x53, 0x53, 0xcc, 0xfd, 0xa5, 0x29, 0x36, 0x11,
-	0x82, 0x8e, 0x83, 0x5d, 0xa0, 0x43, 0x5e, 0x14, 0x95, 0x3d, 0x26, 0xdf, 0x46, 0xe9, 0x3a, 0x69,
-	0x3b, 0x2d, 0x10, 0x73, 0xc5, 0x38, 0x20, 0x15, 0xd6, 0x02, 0x88, 0xe5, 0xea, 0x75, 0xa8, 0x39,
-	0xe0, 0x62, 0x62, 0x72, 0x4b, 0x45, 0xb5, 0x14, 0xaf, 0x1d, 0xf0, 0x92, 0xfc, 0x6c, 0x10, 0x99,
-	0x04, 0x8f, 0x8c, 0x7a, 0x7d, 0x64, 0x5e, 0x88, 0x27, 0x23, 0xb9, 0xb1, 0xd0, 0x7a, 0x93, 0xef,
-	0xae, 0x36, 0xe1, 0xe3, 0xe4, 0x74, 0x76, 0x5b, 0x0b, 0xf8, 0xaa, 0x63, 0x6e, 0xc6, 0x28, 0x4b,
-	0xb1, 0x65, 0x63, 0xdb, 0x9a, 0x54, 0x9a, 0x9f, 0x4e, 0xe9, 0x96, 0x20, 0xac, 0x5e, 0x17, 0x9c,
-	0x85, 0xff, 0x11, 0x1c, 0xf4, 0x4f, 0x82, 0xb3, 0x8c, 0x16, 0x0f, 0xf8, 0xf7, 0x5b, 0x24, 0xa6,
-	0xf8, 0x0a, 0x2d, 0x0d, 0x0a, 0xc2, 0xc5, 0x8f, 0x50, 0x22, 0xf8, 0xc4, 0x73, 0x07, 0xa7, 0x76,
-	0xf3, 0x57, 0x4a, 0x06, 0x8d, 0x42, 0x47, 0x34, 0x95, 0x0f, 0xcf, 0x7e, 0xe4, 0x22, 0x67, 0xbd,
-	0x9c, 0x74, 0xde, 0xcb, 0x49, 0xdf, 0x7b, 0x39, 0xe9, 0xf4, 0x22, 0x17, 0x39, 0xbf, 0xc8, 0x45,
-	0xbe, 0x5c, 0xe4, 0x22, 0x47, 0x0f, 0xff, 0x34, 0x83, 0x7c, 0x3f, 0x46, 0x82, 0x87, 0xe2, 0xc1,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xaa, 0x7c, 0x73, 0x83, 0x09, 0x00, 0x00,
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5d, 0x6f, 0xdb, 0x54,
+	0x18, 0x8e, 0xdb, 0xc4, 0x5d, 0xde, 0xa4, 0xfb, 0xb0, 0xfa, 0x11, 0x55, 0x5a, 0x12, 0xcc, 0x24,
+	0x22, 0xc4, 0x6c, 0xad, 0x88, 0x0b, 0x2e, 0x40, 0x2c, 0x02, 0xa1, 0x2a, 0x02, 0x2a, 0xa7, 0x80,
+	0xb4, 0x9b, 0xc8, 0x8e, 0x5f, 0x9c, 0xa3, 0xc4, 0x3e, 0x9e, 0xcf, 0x31, 0x8d, 0xf9, 0x13, 0xec,
+	0xb7, 0x70, 0xc9, 0x2f, 0xe8, 0xe5, 0x2e, 0xd1, 0x2e, 0x0a, 0xb4, 0xff, 0x82, 0x2b, 0xe4, 0x73,
+	0x8e, 0xdd, 0x34, 0x65, 0xda, 0xc8, 0xb6, 0x5c, 0xb5, 0xef, 0xeb, 0xe7, 0xfd, 0xf0, 0x73, 0x9e,
+	0xe7, 0xc4, 0xf0, 0xbe, 0x3b, 0xc7, 0x99, 0x9b, 0xd8, 0x61, 0x3a, 0xe3, 0x84, 0x91, 0xc0, 0xfe,
+	0xf9, 0x91, 0x87, 0xdc, 0x7d, 0x64, 0x3f, 0x4d, 0x31, 0xc9, 0xac, 0x38, 0xa1, 0x9c, 0x1a, 0xfb,
+	0x12, 0x64, 0x15, 0x20, 0x4b, 0x81, 0x0e, 0x76, 0x02, 0x1a, 0x50, 0x81, 0xb1, 0xf3, 0xff, 0x24,
+	0xfc, 0xa0, 0x13, 0x50, 0x1a, 0xcc, 0xd0, 0x16, 0x91, 0x97, 0xfe, 0x64, 0x73, 0x12, 0x22, 0xe3,
+	0x6e, 0x18, 0x2b, 0xc0, 0x47, 0xcb, 0x43, 0x71, 0x1e, 0xd3, 0x84, 0xa3, 0x5f, 0x4e, 0xe7, 0x59,
+	0x8c, 0x4c, 0xa1, 0x5f, 0xba, 0xe2, 0x22, 0xe8, 0x81, 0x02, 0xa5, 0x9c, 0xcc, 0xd8, 0x15, 0x62,
+	0x92, 0x20, 0x9b, 0xd0, 0x99, 0xbf, 0x84, 0xba, 0xd1, 0x2a, 0x76, 0x13, 0x37, 0x54, 0xbd, 0xcc,
+	0x07, 0xd0, 0x1c, 0x60, 0x76, 0xf4, 0xa5, 0x83, 0x4f, 0x53, 0x64, 0xdc, 0xd8, 0x81, 0xda, 0x78,
+	0xe2, 0x92, 0xa8, 0xa5, 0x75, 0xb5, 0x5e, 0xdd, 0x91, 0x81, 0xc9, 0x60, 0x5b, 0xa1, 0x58, 0x4c,
+	0x23, 0x86, 0x86, 0x07, 0xfa, 0x14, 0xb3, 0x11, 0xf1, 0x25, 0xae, 0x3f, 0xb8, 0x38, 0xef, 0xd4,
+	0x04, 0xe4, 0x9f, 0xf3, 0xce, 0xe7, 0x01, 0xe1, 0x93, 0xd4, 0xb3, 0xc6, 0x34, 0xb4, 0xe5, 0x12,
+	0x11, 0xf2, 0x53, 0x9a, 0x4c, 0x55, 0xf4, 0x70, 0x4c, 0x13, 0xb4, 0xe7, 0x37, 0x29, 0xb1, 0xe4,
+	0x90, 0xda, 0x14, 0xb3, 0x23, 0xdf, 0xec, 0xc1, 0xdd, 0x6f, 0x71, 0xce, 0x5f, 0x63, 0xbd, 0x53,
+	0xb8, 0xb7, 0x80, 0x5c, 0xe3, 0x8a, 0x31, 0xc0, 0x00, 0xb3, 0x62, 0xb9, 0x75, 0x4c, 0xfc, 0x55,
+	0x83, 0x7b, 0x03, 0xcc, 0x02, 0x8c, 0x8e, 0xdd, 0x84, 0x93, 0x31, 0x89, 0xdd, 0x88, 0x1b, 0x2d,
+	0xd8, 0x72, 0x7d, 0x3f, 0x41, 0xc6, 0x14, 0x31, 0x45, 0x68, 0x7c, 0x0d, 0xfa, 0x29, 0x92, 0x60,
+	0xc2, 0x5b, 0x1b, 0x5d, 0xad, 0xd7, 0xec, 0xdb, 0x67, 0xe7, 0x9d, 0xca, 0x8b, 0xf3, 0xce, 0x07,
+	0x0b, 0xeb, 0x8c, 0x29, 0x0b, 0x29, 0x53, 0x7f, 0x1e, 0x32, 0x7f, 0xaa, 0xd4, 0xf6, 0x3d, 0x89,
+	0xb8, 0xa3, 0xca, 0x8d, 0x7d, 0xd8, 0x8a, 0x53, 0x6f, 0x34, 0xc5, 0xac, 0xb5, 0x29, 0x46, 0xe8,
+	0x71, 0xea, 0x0d, 0x30, 0x33, 0x7f, 0xab, 0x42, 0x43, 0x90, 0xb0, 0x3e, 0xde, 0x8d, 0x2f, 0xa0,
+	0xc6, 0xb8, 0xcb, 0x51, 0xbc, 0xd4, 0xed, 0xc3, 0x0f, 0xad, 0x65, 0xd3, 0x96, 0x65, 0x4a, 0xf4,
+	0x79, 0xf9, 0x30, 0xaf, 0x70, 0x64, 0xa1, 0x71, 0x1f, 0x80, 0x71, 0x37, 0x87, 0x8c, 0x5c, 0x2e,
+	0xde, 0x68, 0xd3, 0xa9, 0xab, 0xcc, 0x63, 0x6e, 0xfc, 0x00, 0x3b, 0x57, 0x8f, 0x47, 0xa5, 0xa7,
+	0x5b, 0xd5, 0xae, 0xd6, 0x6b, 0x1c, 0x1e, 0x58, 0xd2, 0xf5, 0x56, 0xe1, 0x7a, 0xeb, 0xa4, 0x40,
+	0xf4, 0x6f, 0xe5, 0x04, 0x3f, 0xfb, 0xb3, 0xa3, 0x39, 0x46, 0xd9, 0xae, 0x7c, 0x6a, 0x3c, 0x81,
+	0xbb, 0xa5, 0x4f, 0x47, 0xea, 0x60, 0x6a, 0xab, 0x1d, 0xcc, 0x9d, 0xb2, 0xd1, 0x8f, 0xf2, 0x84,
+	0x4e, 0x60, 0xdb, 0xa3, 0x91, 0x8f, 0x65, 0x63, 0x7d, 0xb5, 0xc6, 0x4d, 0xd9, 0xa5, 0xec, 0xda,
+	0x8c, 0xaf, 0x94, 0xc6, 0x5a, 0x5b, 0xdd, 0xcd, 0x5e, 0xe3, 0x3f, 0x18, 0x5f, 0x20, 0xfa, 0xba,
+	0x38, 0xfb, 0xd5, 0x7c, 0x01, 0xe7, 0x5a, 0x17, 0xf3, 0x17, 0xd8, 0x91, 0xc0, 0x21, 0x32, 0x46,
+	0x68, 0xb4, 0x4e, 0x0b, 0xfd, 0x5e, 0x83, 0xdd, 0xa5, 0xe1, 0x4a, 0xba, 0xd7, 0x45, 0xa1, 0xbd,
+	0xae, 0x28, 0x36, 0xde, 0x50, 0x14, 0xf7, 0x01, 0x70, 0x1e, 0x93, 0x04, 0xd9, 0x82, 0x16, 0x55,
+	0xe6, 0x31, 0x37, 0xde, 0x83, 0xe6, 0x98, 0x86, 0xf1, 0x0c, 0xd5, 0x5e, 0x55, 0x01, 0x68, 0x94,
+	0x39, 0x09, 0x09, 0x12, 0x77, 0x8c, 0xa3, 0x18, 0x13, 0x42, 0x7d, 0x21, 0xa9, 0x4d, 0xa7, 0x21,
+	0x72, 0xc7, 0x22, 0x65, 0x7c, 0x55, 0x58, 0x46, 0x17, 0x96, 0xb1, 0x5f, 0x6d, 0x99, 0x6f, 0xd4,
+	0x93, 0x6b, 0xbe, 0x09, 0x60, 0x7f, 0x2a, 0xb8, 0x1b, 0xdd, 0xd0, 0xf1, 0xd6, 0x6a, 0x72, 0xdb,
+	0x95, 0xfd, 0x4e, 0x96, 0xd4, 0x4c, 0xa0, 0xc5, 0x48, 0x10, 0x91, 0x28, 0xb8, 0x39, 0xe9, 0xd6,
+	0x6a, 0x93, 0xf6, 0x54, 0xc3, 0x93, 0x57, 0x19, 0xa7, 0xfe, 0x2e, 0x8c, 0x03, 0x6f, 0xc5, 0x38,
+	0x9f, 0xc2, 0xee, 0x50, 0xbe, 0xc5, 0x92, 0x73, 0xba, 0xa0, 0x33, 0x12, 0x14, 0xce, 0xa9, 0xf6,
+	0xeb, 0xb9, 0x73, 0x86, 0x24, 0xc8, 0x75, 0xcf, 0x48, 0x70, 0xe4, 0x9b, 0x2f, 0x36, 0x60, 0x6f,
+	0xb9, 0x56, 0x09, 0xbf, 0x14, 0x87, 0xf6, 0x46, 0xe2, 0xb8, 0x72, 0xef, 0xc6, 0x3b, 0xbb, 0xfa,
+	0xd7, 0x62, 0x96, 0x3d, 0xd0, 0x43, 0xea, 0xa7, 0x33, 0xe9, 0x96, 0xba, 0xa3, 0xa2, 0xfc, 0x77,
+	0x36, 0xd7, 0x10, 0x26, 0xf2, 0x1e, 0xac, 0x3b, 0x45, 0x68, 0xde, 0x81, 0xed, 0x63, 0xf1, 0x5d,
+	0xa5, 0xce, 0xc3, 0xfc, 0x0e, 0x6e, 0x17, 0x09, 0x45, 0xf2, 0x67, 0xa0, 0xcb, 0x4f, 0x2f, 0xc1,
+	0x72, 0xe3, 0xb0, 0xf3, 0x52, 0x29, 0xc8, 0x42, 0x75, 0xfe, 0xaa, 0xa8, 0x3f, 0x3c, 0xfb, 0xbb,
+	0x5d, 0x39, 0xbb, 0x68, 0x6b, 0xcf, 0x2f, 0xda, 0xda, 0x5f, 0x17, 0x6d, 0xed, 0xd9, 0x65, 0xbb,
+	0xf2, 0xfc, 0xb2, 0x5d, 0xf9, 0xe3, 0xb2, 0x5d, 0x79, 0xf2, 0xc9, 0xff, 0x65, 0x57, 0xe8, 0xd6,
+	0xd3, 0xc5, 0x65, 0xf5, 0xf1, 0xbf, 0x03, 0x00, 0xf4, 0xe3, 0xe8, 0xef, 0x1b, 0x0b, 0x00, 0x00,
 }
 
 func (m *KeyIDRequest) Marshal() (dAtA []byte, err error) {
@@ -941,6 +1031,100 @@ func (m *KeygenSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigningSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SigID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1174,6 +1358,53 @@ func (m *KeygenSessionResponse) Size() (n int) {
 	return n
 }
 
+func (m *SigningSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigID != 0 {
+		n += 1 + sovQuery(uint64(m.SigID))
+	}
+	return n
+}
+
+func (m *SigningSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedAt))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.GracePeriod))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2403,6 +2634,297 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SigningSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigID", wireType)
+			}
+			m.SigID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= exported.MultisigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
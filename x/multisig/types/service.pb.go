@@ -37,47 +37,50 @@ func init() {
 }
 
 var fileDescriptor_2f253d13b0297bdf = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7b, 0xa0, 0x16, 0xf5, 0x5a, 0x84, 0x74, 0x42, 0x42, 0x2a, 0xc5, 0x6d, 0xdd, 0x34,
-	0xa1, 0x69, 0xea, 0x23, 0x01, 0x16, 0x46, 0xd4, 0x25, 0x8a, 0xa0, 0xa5, 0xde, 0x58, 0x22, 0x3b,
-	0x9c, 0xdc, 0x53, 0x13, 0x9f, 0xeb, 0x3b, 0x87, 0x44, 0x88, 0xa5, 0x0b, 0x12, 0x13, 0x82, 0x01,
-	0x24, 0x06, 0x24, 0x76, 0xfe, 0x07, 0x46, 0xc6, 0x0a, 0x16, 0x46, 0x94, 0xf0, 0x87, 0xa0, 0x3b,
-	0x9f, 0x83, 0x4b, 0xe5, 0x1f, 0x6c, 0x89, 0xf2, 0xb9, 0x7b, 0x9f, 0xbc, 0xf7, 0x7d, 0x36, 0xdc,
-	0x72, 0x46, 0xa4, 0xef, 0x84, 0x78, 0x10, 0xf5, 0x05, 0xe5, 0xd4, 0xc3, 0xc3, 0xa6, 0x4b, 0x84,
-	0xd3, 0xc4, 0x9c, 0x84, 0x43, 0xda, 0x23, 0x56, 0x10, 0x32, 0xc1, 0xd0, 0x8d, 0x18, 0xb3, 0x12,
-	0xcc, 0xd2, 0xd8, 0xca, 0x75, 0x8f, 0x79, 0x4c, 0x31, 0x58, 0x7e, 0x8a, 0xf1, 0x95, 0x55, 0x8f,
-	0x31, 0xaf, 0x4f, 0xb0, 0x13, 0x50, 0xec, 0xf8, 0x3e, 0x13, 0x8e, 0xa0, 0xcc, 0xe7, 0xfa, 0xd7,
-	0xf5, 0xac, 0x9a, 0x62, 0xa4, 0x89, 0xcd, 0x2c, 0xe2, 0x24, 0x22, 0xe1, 0x38, 0x86, 0x5a, 0x5f,
-	0xae, 0x40, 0xf8, 0x88, 0x7b, 0x76, 0x2c, 0x8a, 0xde, 0x02, 0xb8, 0x64, 0x0b, 0x27, 0x14, 0x1d,
-	0x32, 0xf6, 0x88, 0x8f, 0x76, 0xac, 0x0c, 0x67, 0x2b, 0x45, 0x1d, 0x92, 0x93, 0x88, 0x70, 0xb1,
-	0xd2, 0x28, 0x07, 0xf3, 0x80, 0xf9, 0x9c, 0x98, 0xb7, 0x4f, 0x7f, 0xfc, 0x7e, 0x77, 0xc9, 0x34,
-	0x6f, 0xe1, 0x7f, 0x3d, 0xb9, 0xa4, 0xbb, 0xc7, 0x0a, 0x7f, 0x00, 0xea, 0xe8, 0x3d, 0x80, 0xcb,
-	0x76, 0xe4, 0x0e, 0xa8, 0x38, 0x88, 0xdc, 0x0e, 0x19, 0xa3, 0x9c, 0x42, 0x29, 0x2c, 0xd1, 0xda,
-	0x2d, 0x49, 0x6b, 0xaf, 0xba, 0xf2, 0xaa, 0x98, 0x6b, 0x17, 0xbd, 0x14, 0xde, 0x0d, 0x22, 0x57,
-	0xca, 0x49, 0xb3, 0xcf, 0x00, 0x5e, 0x8b, 0x2f, 0xb1, 0xa9, 0xe7, 0x3b, 0x22, 0x0a, 0x09, 0xc2,
-	0x05, 0xe5, 0x66, 0x64, 0xe2, 0x77, 0xa7, 0xfc, 0x01, 0xad, 0xd8, 0x50, 0x8a, 0x55, 0x73, 0x23,
-	0x4b, 0x91, 0x27, 0x47, 0xa4, 0xe4, 0x6b, 0x00, 0x17, 0x0f, 0x65, 0x7a, 0x88, 0xec, 0xdd, 0x76,
-	0x66, 0xb5, 0x19, 0x93, 0x88, 0xd5, 0xcb, 0xa0, 0x5a, 0xa9, 0xaa, 0x94, 0xd6, 0xcd, 0x9b, 0x17,
-	0x94, 0x42, 0xc5, 0x26, 0x1d, 0xfb, 0x04, 0xe0, 0x72, 0x1c, 0x84, 0xfd, 0x40, 0xec, 0x47, 0x22,
-	0x67, 0x96, 0x69, 0xac, 0x78, 0x96, 0xe7, 0x69, 0x6d, 0xd5, 0x52, 0x56, 0x0d, 0xb3, 0x86, 0xb3,
-	0x76, 0x21, 0x4e, 0x59, 0x97, 0x05, 0xa2, 0xcb, 0x22, 0x21, 0x0d, 0x3f, 0x02, 0xb8, 0x34, 0xbb,
-	0xac, 0x9d, 0xb7, 0x02, 0x29, 0xaa, 0x78, 0x05, 0xce, 0xc1, 0x5a, 0xaf, 0xa9, 0xf4, 0x76, 0xcc,
-	0x6a, 0x19, 0x3d, 0x2a, 0x77, 0xa1, 0xf5, 0x7d, 0x1e, 0x2e, 0x3f, 0x91, 0xfb, 0x9b, 0x6c, 0xec,
-	0x2b, 0x00, 0xe7, 0x3b, 0x64, 0xdc, 0xde, 0x43, 0x5b, 0x79, 0xb5, 0xdb, 0x7b, 0x89, 0x62, 0xb5,
-	0x08, 0xd3, 0x72, 0x58, 0xc9, 0x6d, 0xa3, 0xdc, 0xde, 0x75, 0xe9, 0x33, 0xfc, 0xa2, 0x77, 0xe4,
-	0x50, 0xff, 0x25, 0xfa, 0x00, 0xe0, 0xe2, 0x63, 0x32, 0x12, 0xb1, 0x4d, 0x76, 0xce, 0x66, 0x4c,
-	0x71, 0xce, 0x52, 0xa8, 0xb6, 0xba, 0xa7, 0xac, 0x2c, 0xd4, 0xc8, 0xb4, 0xf2, 0xc9, 0x48, 0x3d,
-	0x3c, 0xd2, 0x6a, 0x43, 0x78, 0x59, 0x66, 0x7f, 0x33, 0xef, 0xaf, 0x27, 0x36, 0x95, 0x7c, 0x48,
-	0x7b, 0x54, 0x94, 0x87, 0x81, 0x56, 0xf3, 0xba, 0x23, 0xd3, 0x7e, 0x35, 0x1e, 0xbc, 0x4d, 0x38,
-	0xa7, 0xcc, 0x47, 0x45, 0x01, 0xd6, 0x5c, 0x22, 0x63, 0x95, 0xc5, 0xff, 0x67, 0x68, 0x32, 0x51,
-	0x5c, 0xfb, 0x9c, 0x02, 0xb8, 0x70, 0xe0, 0x84, 0xce, 0x80, 0xa3, 0xec, 0x60, 0xc4, 0x40, 0xe2,
-	0x54, 0x2b, 0xe4, 0xb4, 0x4c, 0x4d, 0xc9, 0x6c, 0xa0, 0xb5, 0x4c, 0x99, 0x40, 0x1d, 0x78, 0x68,
-	0x7f, 0x9b, 0x18, 0xe0, 0x6c, 0x62, 0x80, 0x5f, 0x13, 0x03, 0xbc, 0x99, 0x1a, 0x73, 0x5f, 0xa7,
-	0x06, 0x38, 0x9b, 0x1a, 0x73, 0x3f, 0xa7, 0xc6, 0xdc, 0xd3, 0xfb, 0x1e, 0x15, 0x47, 0x91, 0x6b,
-	0xf5, 0xd8, 0x40, 0x5f, 0xe4, 0x13, 0xf1, 0x9c, 0x85, 0xc7, 0xfa, 0xdb, 0x6e, 0x8f, 0x85, 0x04,
-	0x8f, 0xfe, 0xde, 0x2e, 0xc6, 0x01, 0xe1, 0xee, 0x82, 0x7a, 0xc1, 0xdd, 0xfd, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0xd3, 0xd0, 0x4a, 0x9d, 0x07, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd5, 0x3f, 0x4f, 0x14, 0x4d,
+	0x1c, 0xc0, 0x71, 0xe6, 0x79, 0x22, 0x84, 0x01, 0x35, 0x99, 0x98, 0x98, 0x20, 0x2e, 0xb0, 0xc0,
+	0x21, 0xc7, 0xb1, 0xe3, 0x81, 0x16, 0x52, 0x1a, 0x1a, 0x42, 0x14, 0xe4, 0x3a, 0x9b, 0xcd, 0xde,
+	0x31, 0x59, 0x26, 0x70, 0x3b, 0xcb, 0xce, 0x2c, 0xde, 0x85, 0x50, 0x48, 0x63, 0x62, 0x65, 0xb4,
+	0xd0, 0xc4, 0xc2, 0xc4, 0x5a, 0xdf, 0x83, 0xa5, 0x25, 0x89, 0x8d, 0xa5, 0xe1, 0x7c, 0x21, 0x66,
+	0xe7, 0xcf, 0x79, 0x40, 0x66, 0x77, 0xed, 0x20, 0x7c, 0x67, 0xe7, 0xc3, 0xce, 0x6f, 0xee, 0xe0,
+	0x7c, 0xd0, 0x21, 0x07, 0x41, 0x82, 0xdb, 0xe9, 0x81, 0xa0, 0x9c, 0x86, 0xf8, 0xa8, 0xde, 0x24,
+	0x22, 0xa8, 0x63, 0x4e, 0x92, 0x23, 0xda, 0x22, 0x5e, 0x9c, 0x30, 0xc1, 0xd0, 0x6d, 0x95, 0x79,
+	0x26, 0xf3, 0x74, 0x36, 0x71, 0x2b, 0x64, 0x21, 0x93, 0x0d, 0xce, 0x7e, 0x52, 0xf9, 0xc4, 0x64,
+	0xc8, 0x58, 0x78, 0x40, 0x70, 0x10, 0x53, 0x1c, 0x44, 0x11, 0x13, 0x81, 0xa0, 0x2c, 0xe2, 0xfa,
+	0xaf, 0xd3, 0xb6, 0x3d, 0x45, 0x47, 0x17, 0xb3, 0xb6, 0xe2, 0x30, 0x25, 0x49, 0x57, 0x45, 0x2b,
+	0x5f, 0x47, 0x20, 0x7c, 0xc2, 0xc3, 0x86, 0x82, 0xa2, 0xb7, 0x00, 0x8e, 0x35, 0x44, 0x90, 0x88,
+	0x4d, 0xd2, 0x0d, 0x49, 0x84, 0x96, 0x3c, 0x8b, 0xd9, 0x1b, 0xa8, 0x76, 0xc8, 0x61, 0x4a, 0xb8,
+	0x98, 0xa8, 0x95, 0x8b, 0x79, 0xcc, 0x22, 0x4e, 0xdc, 0x7b, 0xa7, 0x3f, 0x7e, 0xbf, 0xfb, 0xcf,
+	0x5d, 0x03, 0x55, 0xf7, 0x2e, 0xbe, 0x4c, 0xe5, 0xd9, 0x02, 0x7f, 0x5f, 0x21, 0xde, 0x03, 0x38,
+	0xde, 0x48, 0x9b, 0x6d, 0x2a, 0xb6, 0xd3, 0xe6, 0x26, 0xe9, 0xa2, 0x9c, 0x8d, 0x06, 0x32, 0xc3,
+	0x5a, 0x2e, 0x59, 0x6b, 0x57, 0x55, 0xba, 0xe6, 0xdc, 0xa9, 0xab, 0x28, 0x99, 0xfb, 0x71, 0xda,
+	0xcc, 0x64, 0x6b, 0xa0, 0x8a, 0x3e, 0x03, 0x78, 0x53, 0x3d, 0xa4, 0x41, 0xc3, 0x28, 0x10, 0x69,
+	0x42, 0x10, 0x2e, 0xd8, 0xae, 0x5f, 0x1a, 0xdf, 0xfd, 0xf2, 0x0b, 0x34, 0xb1, 0x26, 0x89, 0x15,
+	0x77, 0xc6, 0x46, 0xe4, 0x66, 0x49, 0x86, 0x7c, 0x0d, 0xe0, 0xe8, 0x4e, 0x36, 0x3d, 0x24, 0x7b,
+	0x77, 0x8b, 0xd6, 0xdd, 0xfa, 0x8d, 0x81, 0x55, 0xcb, 0xa4, 0x9a, 0x54, 0x91, 0xa4, 0x69, 0xf7,
+	0xce, 0x15, 0x52, 0x22, 0x5b, 0xf3, 0xc6, 0x3e, 0x01, 0x38, 0xae, 0x06, 0x61, 0x2b, 0x16, 0x5b,
+	0xa9, 0xc8, 0x39, 0xcb, 0xc1, 0xac, 0xf8, 0x2c, 0x2f, 0xd6, 0x5a, 0xb5, 0x22, 0x55, 0x35, 0x77,
+	0x01, 0xdb, 0xee, 0x82, 0x1a, 0x31, 0x9f, 0xc5, 0xc2, 0x67, 0xa9, 0xc8, 0x84, 0x1f, 0x01, 0x1c,
+	0xeb, 0x3f, 0x6c, 0x23, 0xef, 0x0a, 0x0c, 0x54, 0xc5, 0x57, 0xe0, 0x42, 0xac, 0x79, 0x75, 0xc9,
+	0x5b, 0x72, 0x2b, 0x65, 0x78, 0x34, 0x5a, 0x03, 0xd5, 0x95, 0x97, 0x23, 0x70, 0xfc, 0x59, 0x76,
+	0x7f, 0xcd, 0x8d, 0x7d, 0x05, 0xe0, 0xb5, 0x4d, 0xd2, 0xdd, 0x58, 0x47, 0xf3, 0x79, 0x7b, 0x6f,
+	0xac, 0x1b, 0x62, 0xa5, 0x28, 0xd3, 0x38, 0x2c, 0x71, 0x8b, 0x28, 0xf7, 0xdd, 0xf9, 0x74, 0x17,
+	0x1f, 0xb7, 0xf6, 0x02, 0x1a, 0x9d, 0xa0, 0x0f, 0x00, 0x8e, 0x3e, 0x25, 0x1d, 0xa1, 0x34, 0xf6,
+	0x39, 0xeb, 0x37, 0xc5, 0x73, 0x36, 0x90, 0x6a, 0xd5, 0x03, 0xa9, 0xf2, 0x50, 0xcd, 0xaa, 0x8a,
+	0x48, 0x47, 0xf8, 0x97, 0x68, 0x47, 0xf0, 0xff, 0x6c, 0xf6, 0x67, 0xf3, 0xfe, 0x75, 0xa3, 0x99,
+	0xcb, 0x8f, 0xb4, 0x63, 0x4e, 0x3a, 0x1c, 0x34, 0x99, 0xf7, 0x76, 0xb2, 0x69, 0xbf, 0xae, 0x0e,
+	0xbe, 0x41, 0x38, 0xa7, 0x2c, 0x42, 0x45, 0x03, 0xac, 0x3b, 0x83, 0xf1, 0xca, 0xe6, 0xff, 0x72,
+	0x68, 0xd9, 0x44, 0x71, 0xed, 0xf9, 0x02, 0xe0, 0x8d, 0xec, 0x03, 0x86, 0x46, 0xa1, 0x21, 0xda,
+	0xf7, 0xbc, 0x18, 0x1a, 0x23, 0x2e, 0xdd, 0x6b, 0xe4, 0x23, 0x89, 0x5c, 0x45, 0x75, 0x2b, 0x92,
+	0xab, 0x85, 0x46, 0x89, 0x8f, 0x39, 0x0d, 0x7d, 0xba, 0x7b, 0x82, 0x4e, 0x01, 0x1c, 0xde, 0x0e,
+	0x92, 0xa0, 0xcd, 0x91, 0x7d, 0x8e, 0x55, 0x60, 0x78, 0x0b, 0x85, 0x9d, 0x66, 0x2d, 0x48, 0xd6,
+	0x0c, 0x9a, 0xb2, 0xb2, 0x62, 0xb9, 0xe0, 0x71, 0xe3, 0xfb, 0xb9, 0x03, 0xce, 0xce, 0x1d, 0xf0,
+	0xeb, 0xdc, 0x01, 0x6f, 0x7a, 0xce, 0xd0, 0xb7, 0x9e, 0x03, 0xce, 0x7a, 0xce, 0xd0, 0xcf, 0x9e,
+	0x33, 0xf4, 0xfc, 0x61, 0x48, 0xc5, 0x5e, 0xda, 0xf4, 0x5a, 0xac, 0xad, 0x1f, 0x14, 0x11, 0xf1,
+	0x82, 0x25, 0xfb, 0xfa, 0xb7, 0xe5, 0x16, 0x4b, 0x08, 0xee, 0xfc, 0x7d, 0xba, 0xe8, 0xc6, 0x84,
+	0x37, 0x87, 0xe5, 0xf7, 0xf1, 0xea, 0x9f, 0x01, 0x00, 0x71, 0xc9, 0x96, 0x3d, 0x4c, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -357,6 +360,9 @@ type QueryServiceClient interface {
 	// KeygenSession returns the keygen session info for a given key ID.
 	// If no key is found, it returns the grpc NOT_FOUND error.
 	KeygenSession(ctx context.Context, in *KeygenSessionRequest, opts ...grpc.CallOption) (*KeygenSessionResponse, error)
+	// SigningSession returns the signing session info for a given signature ID.
+	// If no session is found, it returns the grpc NOT_FOUND error.
+	SigningSession(ctx context.Context, in *SigningSessionRequest, opts ...grpc.CallOption) (*SigningSessionResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
@@ -404,6 +410,15 @@ func (c *queryServiceClient) KeygenSession(ctx context.Context, in *KeygenSessio
 	return out, nil
 }
 
+func (c *queryServiceClient) SigningSession(ctx context.Context, in *SigningSessionRequest, opts ...grpc.CallOption) (*SigningSessionResponse, error) {
+	out := new(SigningSessionResponse)
+	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/SigningSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/Params", in, out, opts...)
@@ -428,6 +443,9 @@ type QueryServiceServer interface {
 	// KeygenSession returns the keygen session info for a given key ID.
 	// If no key is found, it returns the grpc NOT_FOUND error.
 	KeygenSession(context.Context, *KeygenSessionRequest) (*KeygenSessionResponse, error)
+	// SigningSession returns the signing session info for a given signature ID.
+	// If no session is found, it returns the grpc NOT_FOUND error.
+	SigningSession(context.Context, *SigningSessionRequest) (*SigningSessionResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
@@ -447,6 +465,9 @@ func (*UnimplementedQueryServiceServer) Key(ctx context.Context, req *KeyRequest
 func (*UnimplementedQueryServiceServer) KeygenSession(ctx context.Context, req *KeygenSessionRequest) (*KeygenSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KeygenSession not implemented")
 }
+func (*UnimplementedQueryServiceServer) SigningSession(ctx context.Context, req *SigningSessionRequest) (*SigningSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningSession not implemented")
+}
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -527,6 +548,24 @@ func _QueryService_KeygenSession_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_SigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SigningSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.multisig.v1beta1.QueryService/SigningSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SigningSession(ctx, req.(*SigningSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -565,6 +604,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KeygenSession",
 			Handler:    _QueryService_KeygenSession_Handler,
 		},
+		{
+			MethodName: "SigningSession",
+			Handler:    _QueryService_SigningSession_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
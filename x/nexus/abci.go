@@ -14,15 +14,23 @@ import (
 // on every begin block
 func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ types.Nexus) {}
 
+// maxExpiredMessagesPerBlock bounds the number of expired failed messages pruned in a single block
+const maxExpiredMessagesPerBlock = 100
+
 // EndBlocker called every block, checking the chain maintainers of all activated chains
 // - if a chain maintainer has missed voting for too many polls, then it will be de-registered
 // - if a chain maintainer has voted incorrectly for too many polls, then it will be de-registered
 // - if a chain maintainer does not active proxy set, then it will be de-registered
+// It also prunes failed general messages that have expired
 func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, n types.Nexus, r types.RewardKeeper, s types.Snapshotter) ([]abci.ValidatorUpdate, error) {
 	if err := checkChainMaintainers(ctx, n, r, s); err != nil {
 		return nil, err
 	}
 
+	if pruned := n.PruneExpiredMessages(ctx, maxExpiredMessagesPerBlock); pruned > 0 {
+		n.Logger(ctx).Info(fmt.Sprintf("pruned %d expired failed general messages", pruned))
+	}
+
 	return nil, nil
 }
 
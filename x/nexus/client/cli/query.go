@@ -17,6 +17,11 @@ import (
 const (
 	activated   = "activated"
 	deactivated = "deactivated"
+
+	flagSourceChain      = "source-chain"
+	flagDestinationChain = "destination-chain"
+	flagSender           = "sender"
+	flagStatus           = "status"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -41,7 +46,10 @@ func GetQueryCmd() *cobra.Command {
 		getCmdChainsByAsset(),
 		getCmdRecipientAddress(),
 		getCmdTransferRateLimit(),
+		getCmdAddressRateLimit(),
+		getCmdMessageRateLimit(),
 		getCmdMessage(),
+		getCmdMessages(),
 		getParams(),
 	)
 
@@ -445,6 +453,72 @@ func getCmdTransferRateLimit() *cobra.Command {
 	return cmd
 }
 
+func getCmdAddressRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-rate-limit [chain] [address] [asset]",
+		Short: "Returns the transfer rate limit for a given address and asset",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.AddressRateLimit(cmd.Context(),
+				&types.AddressRateLimitRequest{
+					Chain:   args[0],
+					Address: args[1],
+					Asset:   args[2],
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getCmdMessageRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-rate-limit [chain]",
+		Short: "Returns the rate limit on the general messages routed to a given chain, or sent from an address on the chain",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	address := cmd.Flags().String(flagAddress, "", "the address on the chain the general messages are sent from")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+
+		res, err := queryClient.MessageRateLimit(cmd.Context(),
+			&types.MessageRateLimitRequest{
+				Chain:   args[0],
+				Address: *address,
+			})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getCmdMessage() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "message [id]",
@@ -475,6 +549,83 @@ func getCmdMessage() *cobra.Command {
 	return cmd
 }
 
+func getCmdMessages() *cobra.Command {
+	cmdName := "messages"
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: "Returns the cross-chain messages that match the given source chain, destination chain, sender and status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+			if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+				pageReq.Key = nil
+			}
+
+			sourceChain, err := cmd.Flags().GetString(flagSourceChain)
+			if err != nil {
+				return err
+			}
+
+			destinationChain, err := cmd.Flags().GetString(flagDestinationChain)
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+
+			status := nexus.NonExistent
+			if statusStr != "" {
+				if status = nexus.GeneralMessageStatusFromString(statusStr); status == nexus.NonExistent {
+					return fmt.Errorf("invalid message status %s provided", statusStr)
+				}
+			}
+
+			res, err := queryClient.Messages(cmd.Context(),
+				&types.MessagesRequest{
+					SourceChain:      nexus.ChainName(sourceChain),
+					DestinationChain: nexus.ChainName(destinationChain),
+					Sender:           sender,
+					Status:           status,
+					Pagination:       pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSourceChain, "", "only return messages sent from this chain")
+	cmd.Flags().String(flagDestinationChain, "", "only return messages sent to this chain")
+	cmd.Flags().String(flagSender, "", "only return messages sent by this address")
+	cmd.Flags().String(flagStatus, "", "only return messages with this status (approved|processing|executed|failed)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+
+	return cmd
+}
+
 func getParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
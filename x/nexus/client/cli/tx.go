@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,10 +11,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
 
+const (
+	flagMode    = "mode"
+	flagAddress = "address"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -32,6 +39,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDeactivateChain(),
 		GetCmdRegisterAssetFee(),
 		GetCmdSetTransferRateLimit(),
+		GetCmdSetAddressRateLimit(),
+		GetCmdSetMessageRateLimit(),
+		GetCmdRetryFailedMessage(),
 	)
 
 	return txCmd
@@ -187,23 +197,151 @@ func GetCmdSetTransferRateLimit() *cobra.Command {
 		Use:   "set-transfer-rate-limit [chain] [limit] [window]",
 		Short: "set transfer rate limit for an asset on a chain",
 		Args:  cobra.ExactArgs(3),
+	}
+
+	mode := cmd.Flags().String(flagMode, "fixed-window", "how transfers are limited [fixed-window|sliding-window|token-bucket]")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		cliCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		limit, err := sdk.ParseCoinNormalized(args[1])
+		if err != nil {
+			return err
+		}
+
+		window, err := time.ParseDuration(args[2])
+		if err != nil {
+			return err
+		}
+
+		rateLimitMode, err := types.RateLimitModeFromString(*mode)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewSetTransferRateLimitRequest(cliCtx.GetFromAddress(), exported.ChainName(args[0]), limit, window, rateLimitMode)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAddressRateLimit returns the cli command to set the asset transfer rate limit for an address
+func GetCmdSetAddressRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-address-rate-limit [chain] [address] [limit] [window]",
+		Short: "set transfer rate limit for an asset sent from or to an address on a chain",
+		Long: "Set the transfer rate limit for an asset sent from or to an address on a chain. " +
+			"Anyone can link new deposit addresses, so limiting a deposit address does not limit its depositors. " +
+			"EVM deposits are also limited by the address that sent the tokens to the deposit address, so limit that address instead.",
+		Args: cobra.ExactArgs(4),
+	}
+
+	mode := cmd.Flags().String(flagMode, "fixed-window", "how transfers are limited [fixed-window|sliding-window|token-bucket]")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		cliCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		limit, err := sdk.ParseCoinNormalized(args[2])
+		if err != nil {
+			return err
+		}
+
+		window, err := time.ParseDuration(args[3])
+		if err != nil {
+			return err
+		}
+
+		rateLimitMode, err := types.RateLimitModeFromString(*mode)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewSetAddressRateLimitRequest(cliCtx.GetFromAddress(), exported.ChainName(args[0]), args[1], limit, window, rateLimitMode)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetMessageRateLimit returns the cli command to set the rate limit on the general messages routed to a chain
+func GetCmdSetMessageRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-message-rate-limit [chain] [limit] [window]",
+		Short: "set rate limit on the number of general messages routed to a chain, or sent from an address on the chain",
+		Args:  cobra.ExactArgs(3),
+	}
+
+	address := cmd.Flags().String(flagAddress, "", "limit the general messages sent from this address on the chain instead")
+	mode := cmd.Flags().String(flagMode, "fixed-window", "how messages are limited [fixed-window|sliding-window|token-bucket]")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		cliCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		limit, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		window, err := time.ParseDuration(args[2])
+		if err != nil {
+			return err
+		}
+
+		rateLimitMode, err := types.RateLimitModeFromString(*mode)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewSetMessageRateLimitRequest(cliCtx.GetFromAddress(), exported.ChainName(args[0]), *address, limit, window, rateLimitMode)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRetryFailedMessage returns the cli command to retry a failed general message
+func GetCmdRetryFailedMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-failed-message [message ID] [payload]",
+		Short: "Route a failed general message to the destination chain again",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			limit, err := sdk.ParseCoinNormalized(args[1])
-			if err != nil {
-				return err
-			}
-
-			window, err := time.ParseDuration(args[2])
+			payload, err := utils.HexDecode(args[1])
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewSetTransferRateLimitRequest(cliCtx.GetFromAddress(), exported.ChainName(args[0]), limit, window)
+			msg := types.NewRetryFailedMessageRequest(cliCtx.GetFromAddress(), utils.NormalizeString(args[0]), payload)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
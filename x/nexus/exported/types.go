@@ -42,6 +42,17 @@ func TransferStateFromString(s string) TransferState {
 	return TransferState(state)
 }
 
+// GeneralMessageStatusFromString converts a describing status string to the corresponding GeneralMessage_Status
+func GeneralMessageStatusFromString(s string) GeneralMessage_Status {
+	status, ok := GeneralMessage_Status_value["STATUS_"+strings.ToUpper(s)]
+
+	if !ok {
+		return NonExistent
+	}
+
+	return GeneralMessage_Status(status)
+}
+
 // Validate validates the TransferState
 func (m TransferState) Validate() error {
 	_, ok := TransferState_name[int32(m)]
@@ -11,8 +11,8 @@ import (
 )
 
 // NewHandler returns the handler of the nexus module
-func NewHandler(k types.Nexus, snapshotter types.Snapshotter, slashing types.SlashingKeeper, staking types.StakingKeeper, axelarnet types.AxelarnetKeeper) sdk.Handler {
-	server := keeper.NewMsgServerImpl(k, snapshotter, slashing, staking, axelarnet)
+func NewHandler(k types.Nexus, snapshotter types.Snapshotter, slashing types.SlashingKeeper, staking types.StakingKeeper, axelarnet types.AxelarnetKeeper, permission types.PermissionKeeper) sdk.Handler {
+	server := keeper.NewMsgServerImpl(k, snapshotter, slashing, staking, axelarnet, permission)
 	h := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
@@ -34,6 +34,15 @@ func NewHandler(k types.Nexus, snapshotter types.Snapshotter, slashing types.Sla
 		case *types.SetTransferRateLimitRequest:
 			res, err := server.SetTransferRateLimit(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.SetAddressRateLimitRequest:
+			res, err := server.SetAddressRateLimit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.SetMessageRateLimitRequest:
+			res, err := server.SetMessageRateLimit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.RetryFailedMessageRequest:
+			res, err := server.RetryFailedMessage(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg))
@@ -50,7 +50,7 @@ func (k Keeper) RegisterAsset(ctx sdk.Context, chain exported.Chain, asset expor
 
 	k.setChainState(ctx, chainState)
 
-	if err := k.SetRateLimit(ctx, chain.Name, sdk.NewCoin(asset.Denom, sdk.NewIntFromBigInt(limit.BigInt())), window); err != nil {
+	if err := k.SetRateLimit(ctx, chain.Name, sdk.NewCoin(asset.Denom, sdk.NewIntFromBigInt(limit.BigInt())), window, types.FixedWindow); err != nil {
 		return err
 	}
 
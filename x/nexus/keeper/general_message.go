@@ -27,6 +27,35 @@ func getProcessingMessageKey(destinationChain exported.ChainName, id string) key
 	return processingMessagePrefix.Append(key.From(destinationChain)).Append(key.FromStr(id))
 }
 
+func getMessageBySourceChainKey(sourceChain exported.ChainName, id string) key.Key {
+	return messageBySourceChainPrefix.Append(key.From(sourceChain)).Append(key.FromStr(id))
+}
+
+func getMessageByDestChainKey(destinationChain exported.ChainName, id string) key.Key {
+	return messageByDestChainPrefix.Append(key.From(destinationChain)).Append(key.FromStr(id))
+}
+
+// the sender is hashed because source addresses are arbitrary strings of unbounded length
+func getMessageBySenderPrefix(sender string) key.Key {
+	return messageBySenderPrefix.Append(key.FromStrHashed(sender))
+}
+
+func getMessageBySenderKey(sender string, id string) key.Key {
+	return getMessageBySenderPrefix(sender).Append(key.FromStr(id))
+}
+
+func getMessageByStatusKey(status exported.GeneralMessage_Status, id string) key.Key {
+	return messageByStatusPrefix.Append(key.FromUInt(uint64(status))).Append(key.FromStr(id))
+}
+
+func getFailedMessageKey(id string) key.Key {
+	return failedMessagePrefix.Append(key.FromStr(id))
+}
+
+func getFailedMessageExpiryKey(failedAt int64, id string) key.Key {
+	return failedMessageExpiryPrefix.Append(key.FromUInt(uint64(failedAt))).Append(key.FromStr(id))
+}
+
 // GenerateMessageID generates a unique general message ID, and returns the message ID, current transacation ID and a unique integer nonce
 // The message ID is just a concatenation of the transaction ID and the nonce
 func (k Keeper) GenerateMessageID(ctx sdk.Context) (string, []byte, uint64) {
@@ -83,7 +112,80 @@ func (k Keeper) GetMessage(ctx sdk.Context, id string) (m exported.GeneralMessag
 }
 
 func (k Keeper) setMessage(ctx sdk.Context, m exported.GeneralMessage) error {
-	return k.getStore(ctx).SetNewValidated(getMessageKey(m.ID), &m)
+	old, found := k.GetMessage(ctx, m.ID)
+	if err := k.getStore(ctx).SetNewValidated(getMessageKey(m.ID), &m); err != nil {
+		return err
+	}
+
+	// chains and sender of a message never change, so only the status index needs to be updated for existing messages
+	if !found {
+		k.setMessageChainIndexes(ctx, m)
+	}
+
+	if !found || old.Status != m.Status {
+		if found {
+			k.getStore(ctx).DeleteNew(getMessageByStatusKey(old.Status, m.ID))
+		}
+		k.getStore(ctx).SetRawNew(getMessageByStatusKey(m.Status, m.ID), []byte(m.ID))
+	}
+
+	wasFailed := found && old.Is(exported.Failed)
+	switch {
+	case m.Is(exported.Failed) && !wasFailed:
+		k.setMessageFailedAt(ctx, m, ctx.BlockHeight())
+	case !m.Is(exported.Failed) && wasFailed:
+		k.deleteMessageExpiry(ctx, m.ID)
+	}
+
+	return nil
+}
+
+func (k Keeper) getFailedMessage(ctx sdk.Context, id string) (failed types.FailedMessage, found bool) {
+	return failed, k.getStore(ctx).GetNew(getFailedMessageKey(id), &failed)
+}
+
+func (k Keeper) setFailedMessage(ctx sdk.Context, failed types.FailedMessage) {
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getFailedMessageKey(failed.ID), &failed))
+}
+
+func (k Keeper) getFailedMessages(ctx sdk.Context) (failedMessages []types.FailedMessage) {
+	iter := k.getStore(ctx).IteratorNew(failedMessagePrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var failed types.FailedMessage
+		iter.UnmarshalValue(&failed)
+
+		failedMessages = append(failedMessages, failed)
+	}
+
+	return failedMessages
+}
+
+// setMessageFailedAt records when the message failed, so it can expire.
+// Messages that carry tokens never expire, because pruning them would strand the escrowed tokens
+func (k Keeper) setMessageFailedAt(ctx sdk.Context, m exported.GeneralMessage, height int64) {
+	failed, _ := k.getFailedMessage(ctx, m.ID)
+	failed.ID = m.ID
+	failed.FailedAt = height
+
+	k.setFailedMessage(ctx, failed)
+	if m.Asset == nil {
+		k.getStore(ctx).SetRawNew(getFailedMessageExpiryKey(failed.FailedAt, m.ID), []byte(m.ID))
+	}
+}
+
+// deleteMessageExpiry removes the message from the expiry queue while it is not failed
+func (k Keeper) deleteMessageExpiry(ctx sdk.Context, id string) {
+	if failed, ok := k.getFailedMessage(ctx, id); ok {
+		k.getStore(ctx).DeleteNew(getFailedMessageExpiryKey(failed.FailedAt, id))
+	}
+}
+
+func (k Keeper) setMessageChainIndexes(ctx sdk.Context, m exported.GeneralMessage) {
+	k.getStore(ctx).SetRawNew(getMessageBySourceChainKey(m.GetSourceChain(), m.ID), []byte(m.ID))
+	k.getStore(ctx).SetRawNew(getMessageByDestChainKey(m.GetDestinationChain(), m.ID), []byte(m.ID))
+	k.getStore(ctx).SetRawNew(getMessageBySenderKey(m.GetSourceAddress(), m.ID), []byte(m.ID))
 }
 
 func (k Keeper) setProcessingMessageID(ctx sdk.Context, m exported.GeneralMessage) error {
@@ -100,7 +202,6 @@ func (k Keeper) deleteProcessingMessageID(ctx sdk.Context, m exported.GeneralMes
 	k.getStore(ctx).DeleteNew(getProcessingMessageKey(m.GetDestinationChain(), m.ID))
 }
 
-//nolint:unused // TODO: add genesis import/export
 func (k Keeper) getMessages(ctx sdk.Context) (generalMessages []exported.GeneralMessage) {
 	iter := k.getStore(ctx).IteratorNew(generalMessagePrefix)
 	defer utils.CloseLogError(iter, k.Logger(ctx))
@@ -139,6 +240,55 @@ func (k Keeper) GetProcessingMessages(ctx sdk.Context, chain exported.ChainName,
 	})
 }
 
+// GetMessagesPaginated returns a page of the general messages that pass the filter.
+// Messages are looked up through the most selective index the filter allows.
+func (k Keeper) GetMessagesPaginated(ctx sdk.Context, filter types.MessageFilter, pageRequest *query.PageRequest) ([]exported.GeneralMessage, *query.PageResponse, error) {
+	var indexPrefix key.Key
+	switch {
+	case filter.Sender != "":
+		indexPrefix = getMessageBySenderPrefix(filter.Sender)
+	case filter.Status == exported.Processing && filter.DestinationChain != "":
+		indexPrefix = processingMessagePrefix.Append(key.From(filter.DestinationChain))
+	case filter.DestinationChain != "":
+		indexPrefix = messageByDestChainPrefix.Append(key.From(filter.DestinationChain))
+	case filter.SourceChain != "":
+		indexPrefix = messageBySourceChainPrefix.Append(key.From(filter.SourceChain))
+	case filter.Status != exported.NonExistent:
+		indexPrefix = messageByStatusPrefix.Append(key.FromUInt(uint64(filter.Status)))
+	}
+
+	var messages []exported.GeneralMessage
+	if indexPrefix == nil {
+		store := prefix.NewStore(k.getStore(ctx).KVStore, append(generalMessagePrefix.Bytes(), []byte(key.DefaultDelimiter)...))
+		resp, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+			var msg exported.GeneralMessage
+			k.cdc.MustUnmarshalLengthPrefixed(value, &msg)
+
+			messages = append(messages, msg)
+			return nil
+		})
+
+		return messages, resp, err
+	}
+
+	store := prefix.NewStore(k.getStore(ctx).KVStore, append(indexPrefix.Bytes(), []byte(key.DefaultDelimiter)...))
+	resp, err := query.FilteredPaginate(store, pageRequest, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		// index keys are case-insensitive and might match other values with the same prefix, so all filters are checked on the message itself
+		msg, ok := k.GetMessage(ctx, string(value))
+		if !ok || !filter.Matches(msg) {
+			return false, nil
+		}
+
+		if accumulate {
+			messages = append(messages, msg)
+		}
+
+		return true, nil
+	})
+
+	return messages, resp, err
+}
+
 // SetNewMessage sets the given general messsage as approved
 func (k Keeper) SetNewMessage(ctx sdk.Context, msg exported.GeneralMessage) error {
 	if _, ok := k.GetMessage(ctx, msg.ID); ok {
@@ -161,17 +311,12 @@ func (k Keeper) SetNewMessage(ctx sdk.Context, msg exported.GeneralMessage) erro
 
 // setMessageProcessing sets the given general message as processing and perform
 // validations on the message
-func (k Keeper) setMessageProcessing(ctx sdk.Context, id string) error {
-	msg, ok := k.GetMessage(ctx, id)
-	if !ok {
-		return fmt.Errorf("general message %s not found", id)
-	}
-
-	if !(msg.Is(exported.Approved) || msg.Is(exported.Failed)) {
-		return fmt.Errorf("general message has to be approved or failed")
+func (k Keeper) setMessageProcessing(ctx sdk.Context, msg exported.GeneralMessage) error {
+	if err := k.validateMessage(ctx, msg); err != nil {
+		return err
 	}
 
-	if err := k.validateMessage(ctx, msg); err != nil {
+	if err := k.rateLimitMessage(ctx, msg); err != nil {
 		return err
 	}
 
@@ -186,6 +331,88 @@ func (k Keeper) setMessageProcessing(ctx sdk.Context, id string) error {
 	return nil
 }
 
+// RetryFailedMessage routes the failed message again and counts the retry against the retry limit.
+// This is the only way to route a failed message again
+func (k Keeper) RetryFailedMessage(ctx sdk.Context, id string, routingCtx exported.RoutingContext) error {
+	msg, ok := k.GetMessage(ctx, id)
+	if !ok {
+		return fmt.Errorf("general message %s not found", id)
+	}
+
+	if !msg.Is(exported.Failed) {
+		return fmt.Errorf("general message %s is not failed", id)
+	}
+
+	if err := k.incrMessageRetries(ctx, id); err != nil {
+		return err
+	}
+
+	return k.routeMessage(ctx, msg, routingCtx)
+}
+
+// incrMessageRetries counts a retry of the failed message, unless it reached the retry limit
+func (k Keeper) incrMessageRetries(ctx sdk.Context, id string) error {
+	failed, _ := k.getFailedMessage(ctx, id)
+	failed.ID = id
+
+	limit := k.GetParams(ctx).MessageRetryLimit
+	if failed.Retries >= limit {
+		return fmt.Errorf("general message %s reached the retry limit of %d", id, limit)
+	}
+
+	failed.Retries++
+	k.setFailedMessage(ctx, failed)
+	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MessageRetried{ID: id, Retries: failed.Retries}))
+
+	return nil
+}
+
+// PruneExpiredMessages archives and deletes up to #limit failed messages that have not been retried within the expiry period.
+// Failed messages that carry tokens are never pruned
+func (k Keeper) PruneExpiredMessages(ctx sdk.Context, limit int) int {
+	expiry := k.GetParams(ctx).FailedMessageExpiry
+	if expiry == 0 {
+		return 0
+	}
+
+	var expired []string
+	iter := k.getStore(ctx).IteratorNew(failedMessageExpiryPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	// the expiry queue is ordered by the height the messages failed at
+	for ; iter.Valid() && len(expired) < limit; iter.Next() {
+		id := string(iter.Value())
+		failed := funcs.MustOk(k.getFailedMessage(ctx, id))
+		if failed.FailedAt+expiry > ctx.BlockHeight() {
+			break
+		}
+
+		expired = append(expired, id)
+	}
+
+	for _, id := range expired {
+		k.deleteMessage(ctx, funcs.MustOk(k.GetMessage(ctx, id)))
+	}
+
+	return len(expired)
+}
+
+// deleteMessage archives the message in an event and removes it and all its indexes from the store
+func (k Keeper) deleteMessage(ctx sdk.Context, m exported.GeneralMessage) {
+	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MessageArchived{Message: m}))
+
+	k.deleteMessageExpiry(ctx, m.ID)
+	k.deleteProcessingMessageID(ctx, m)
+
+	store := k.getStore(ctx)
+	store.DeleteNew(getFailedMessageKey(m.ID))
+	store.DeleteNew(getMessageByStatusKey(m.Status, m.ID))
+	store.DeleteNew(getMessageBySourceChainKey(m.GetSourceChain(), m.ID))
+	store.DeleteNew(getMessageByDestChainKey(m.GetDestinationChain(), m.ID))
+	store.DeleteNew(getMessageBySenderKey(m.GetSourceAddress(), m.ID))
+	store.DeleteNew(getMessageKey(m.ID))
+}
+
 func (k Keeper) validateMessage(ctx sdk.Context, msg exported.GeneralMessage) error {
 	// only validate sender and asset if it's not from wasm.
 	// the nexus module doesn't know how to validate wasm chains and addresses.
@@ -232,23 +459,39 @@ func (k Keeper) validateAddressAndAsset(ctx sdk.Context, address exported.CrossC
 	return k.validateAsset(ctx, address.Chain, asset.Denom)
 }
 
-// RouteMessage routes the given general message to the corresponding module and
-// set the message status to processing
+// RouteMessage routes the given approved general message to the corresponding module and
+// set the message status to processing. Failed messages can only be routed again with RetryFailedMessage
 func (k Keeper) RouteMessage(ctx sdk.Context, id string, routingCtx ...exported.RoutingContext) error {
-	err := k.setMessageProcessing(ctx, id)
-	if err != nil {
+	msg, ok := k.GetMessage(ctx, id)
+	if !ok {
+		return fmt.Errorf("general message %s not found", id)
+	}
+
+	if msg.Is(exported.Failed) {
+		return fmt.Errorf("general message %s failed and can only be retried", id)
+	}
+
+	if !msg.Is(exported.Approved) {
+		return fmt.Errorf("general message has to be approved")
+	}
+
+	return k.routeMessage(ctx, msg, routingCtx...)
+}
+
+func (k Keeper) routeMessage(ctx sdk.Context, msg exported.GeneralMessage, routingCtx ...exported.RoutingContext) error {
+	if err := k.setMessageProcessing(ctx, msg); err != nil {
 		return err
 	}
 
-	k.Logger(ctx).Debug("set general message status to processing", "messageID", id)
+	k.Logger(ctx).Debug("set general message status to processing", "messageID", msg.ID)
 
 	if len(routingCtx) == 0 {
 		routingCtx = []exported.RoutingContext{{}}
 	}
 
-	msg := funcs.MustOk(k.GetMessage(ctx, id))
+	msg = funcs.MustOk(k.GetMessage(ctx, msg.ID))
 	if err := k.getMessageRouter().Route(ctx, routingCtx[0], msg); err != nil {
-		return sdkerrors.Wrapf(err, "failed to route message %s to the %s module", id, msg.Recipient.Chain.Module)
+		return sdkerrors.Wrapf(err, "failed to route message %s to the %s module", msg.ID, msg.Recipient.Chain.Module)
 	}
 
 	return nil
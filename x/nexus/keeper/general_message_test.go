@@ -5,16 +5,24 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	axelarnetkeeper "github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	axelarnettypes "github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	axelarnetmock "github.com/axelarnetwork/axelar-core/x/axelarnet/types/mock"
 	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
 	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	evmtestutils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
@@ -22,6 +30,8 @@ import (
 	nexustestutils "github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -133,7 +143,7 @@ func TestRouteMessage(t *testing.T) {
 			keeper.RouteMessage(ctx, msg.ID)
 		}).
 		Then("should return error", func(t *testing.T) {
-			assert.ErrorContains(t, keeper.RouteMessage(ctx, msg.ID), "general message has to be approved or failed")
+			assert.ErrorContains(t, keeper.RouteMessage(ctx, msg.ID), "general message has to be approved")
 		}).
 		Run(t)
 
@@ -423,7 +433,7 @@ func TestStatusTransitions(t *testing.T) {
 	assert.NoError(t, err)
 
 	err = k.RouteMessage(ctx, msg.ID)
-	assert.Error(t, err, "general message is not approved or failed")
+	assert.Error(t, err, "general message is not approved")
 
 	err = k.SetMessageFailed(ctx, msg.ID)
 	assert.NoError(t, err)
@@ -432,6 +442,9 @@ func TestStatusTransitions(t *testing.T) {
 	assert.Error(t, err, "general message is not processed")
 
 	err = k.RouteMessage(ctx, msg.ID)
+	assert.Error(t, err, "general message failed and can only be retried")
+
+	err = k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{})
 	assert.NoError(t, err)
 
 	err = k.SetMessageExecuted(ctx, msg.ID)
@@ -441,7 +454,166 @@ func TestStatusTransitions(t *testing.T) {
 	assert.Error(t, err, "general message is not processed")
 
 	err = k.RouteMessage(ctx, msg.ID)
-	assert.Error(t, err, "general message is not approved or failed")
+	assert.Error(t, err, "general message is not approved")
+}
+
+func TestFailedMessageRetryAndExpiry(t *testing.T) {
+	cfg := app.MakeEncodingConfig()
+	k, ctx := setup(cfg)
+	sourceChain := nexustestutils.RandomChain()
+	sourceChain.Module = axelarnet.ModuleName
+	destinationChain := nexustestutils.RandomChain()
+	destinationChain.Module = evmtypes.ModuleName
+	k.SetChain(ctx, sourceChain)
+	k.SetChain(ctx, destinationChain)
+	k.ActivateChain(ctx, sourceChain)
+	k.ActivateChain(ctx, destinationChain)
+	k.SetMessageRouter(types.NewMessageRouter().AddRoute(destinationChain.Module, func(_ sdk.Context, _ exported.RoutingContext, _ exported.GeneralMessage) error {
+		return nil
+	}))
+
+	params := types.DefaultParams()
+	params.MessageRetryLimit = 2
+	params.FailedMessageExpiry = 10
+	k.SetParams(ctx, params)
+
+	asset := rand.Denom(5, 10)
+	funcs.MustNoErr(k.RegisterAsset(ctx, sourceChain, exported.NewAsset(asset, true), utils.MaxUint, time.Hour))
+	funcs.MustNoErr(k.RegisterAsset(ctx, destinationChain, exported.NewAsset(asset, false), utils.MaxUint, time.Hour))
+
+	newFailedMessage := func(ctx sdk.Context, withAsset ...bool) exported.GeneralMessage {
+		id, txID, nonce := k.GenerateMessageID(ctx)
+		msg := exported.GeneralMessage{
+			ID:            id,
+			Sender:        exported.CrossChainAddress{Chain: sourceChain, Address: genCosmosAddr(sourceChain.Name.String())},
+			Recipient:     exported.CrossChainAddress{Chain: destinationChain, Address: evmtestutils.RandomAddress().Hex()},
+			Status:        exported.Approved,
+			PayloadHash:   crypto.Keccak256Hash(rand.Bytes(int(rand.I64Between(1, 100)))).Bytes(),
+			SourceTxID:    txID,
+			SourceTxIndex: nonce,
+		}
+		if len(withAsset) > 0 && withAsset[0] {
+			coin := sdk.NewCoin(asset, sdk.NewInt(rand.PosI64()))
+			msg.Asset = &coin
+		}
+		assert.NoError(t, k.SetNewMessage(ctx, msg))
+		assert.NoError(t, k.RouteMessage(ctx, msg.ID))
+		assert.NoError(t, k.SetMessageFailed(ctx, msg.ID))
+
+		return msg
+	}
+
+	t.Run("should only retry failed messages up to the retry limit", func(t *testing.T) {
+		msg := newFailedMessage(ctx)
+
+		for i := 0; i < int(params.MessageRetryLimit); i++ {
+			assert.NoError(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}))
+			assert.NoError(t, k.SetMessageFailed(ctx, msg.ID))
+		}
+
+		assert.ErrorContains(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}), "retry limit")
+		assert.True(t, funcs.MustOk(k.GetMessage(ctx, msg.ID)).Is(exported.Failed))
+	})
+
+	t.Run("should not route failed messages in other ways", func(t *testing.T) {
+		msg := newFailedMessage(ctx)
+
+		assert.ErrorContains(t, k.RouteMessage(ctx, msg.ID), "can only be retried")
+		assert.True(t, funcs.MustOk(k.GetMessage(ctx, msg.ID)).Is(exported.Failed))
+
+		for i := 0; i < int(params.MessageRetryLimit); i++ {
+			assert.NoError(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}))
+			assert.NoError(t, k.SetMessageFailed(ctx, msg.ID))
+		}
+
+		assert.ErrorContains(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}), "retry limit")
+		assert.ErrorContains(t, k.RouteMessage(ctx, msg.ID), "can only be retried")
+	})
+
+	t.Run("should prune failed messages after the expiry period", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(100)
+		// the messages of the previous tests failed at height 0
+		assert.Equal(t, 2, k.PruneExpiredMessages(ctx, 100))
+
+		expiring := newFailedMessage(ctx)
+		retried := newFailedMessage(ctx)
+		assert.NoError(t, k.RetryFailedMessage(ctx, retried.ID, exported.RoutingContext{}))
+
+		ctx = ctx.WithBlockHeight(105)
+		later := newFailedMessage(ctx)
+
+		ctx = ctx.WithBlockHeight(109)
+		assert.Zero(t, k.PruneExpiredMessages(ctx, 100))
+
+		ctx = ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+		assert.Equal(t, 1, k.PruneExpiredMessages(ctx, 100))
+		assert.Len(t, ctx.EventManager().Events(), 1)
+		assert.Equal(t, "axelar.nexus.v1beta1.MessageArchived", ctx.EventManager().Events()[0].Type)
+
+		_, ok := k.GetMessage(ctx, expiring.ID)
+		assert.False(t, ok)
+		_, ok = k.GetMessage(ctx, retried.ID)
+		assert.True(t, ok)
+		_, ok = k.GetMessage(ctx, later.ID)
+		assert.True(t, ok)
+
+		messages, _, err := k.GetMessagesPaginated(ctx, types.MessageFilter{SourceChain: sourceChain.Name}, &query.PageRequest{Limit: 100})
+		assert.NoError(t, err)
+		assert.NotContains(t, slices.Map(messages, func(m exported.GeneralMessage) string { return m.ID }), expiring.ID)
+
+		ctx = ctx.WithBlockHeight(115)
+		assert.Equal(t, 1, k.PruneExpiredMessages(ctx, 100))
+		_, ok = k.GetMessage(ctx, later.ID)
+		assert.False(t, ok)
+	})
+
+	t.Run("should not prune failed messages that carry tokens", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(150)
+		msg := newFailedMessage(ctx, true)
+
+		assert.Zero(t, k.PruneExpiredMessages(ctx.WithBlockHeight(1000), 100))
+		_, ok := k.GetMessage(ctx, msg.ID)
+		assert.True(t, ok)
+
+		assert.NoError(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}))
+	})
+
+	t.Run("should not prune failed messages without expiry", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(200)
+		msg := newFailedMessage(ctx)
+
+		params := k.GetParams(ctx)
+		params.FailedMessageExpiry = 0
+		k.SetParams(ctx, params)
+
+		assert.Zero(t, k.PruneExpiredMessages(ctx.WithBlockHeight(1000), 100))
+		_, ok := k.GetMessage(ctx, msg.ID)
+		assert.True(t, ok)
+	})
+
+	t.Run("should not bypass the retry limit through the axelarnet RouteMessage", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(300)
+		msg := newFailedMessage(ctx)
+
+		subspace := paramstypes.NewSubspace(cfg.Codec, cfg.Amino, sdk.NewKVStoreKey("axelarnetKey"), sdk.NewKVStoreKey("tAxelarnetKey"), "axelarnet")
+		axelarnetK := axelarnetkeeper.NewKeeper(cfg.Codec, sdk.NewKVStoreKey(axelarnettypes.StoreKey), subspace, &axelarnetmock.ChannelKeeperMock{}, &axelarnetmock.FeegrantKeeperMock{})
+		server := axelarnetkeeper.NewMsgServerImpl(axelarnetK, k, &axelarnetmock.BankKeeperMock{}, &axelarnetmock.AccountKeeperMock{},
+			axelarnetkeeper.NewIBCKeeper(axelarnetK, &axelarnetmock.IBCTransferKeeperMock{}))
+		routeMessage := func() error {
+			_, err := server.RouteMessage(sdk.WrapSDKContext(ctx), axelarnettypes.NewRouteMessage(rand.AccAddr(), nil, msg.ID, nil))
+			return err
+		}
+
+		for i := 0; i < int(params.MessageRetryLimit); i++ {
+			assert.ErrorContains(t, routeMessage(), "can only be retried")
+			assert.NoError(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}))
+			assert.NoError(t, k.SetMessageFailed(ctx, msg.ID))
+		}
+
+		assert.ErrorContains(t, k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{}), "retry limit")
+		assert.ErrorContains(t, routeMessage(), "can only be retried")
+		assert.True(t, funcs.MustOk(k.GetMessage(ctx, msg.ID)).Is(exported.Failed))
+	})
 }
 
 func TestGetMessage(t *testing.T) {
@@ -598,7 +770,7 @@ func TestGetSentMessages(t *testing.T) {
 	checkForExistence(msgs)
 
 	//resend the failed message
-	err = k.RouteMessage(ctx, msg.ID)
+	err = k.RetryFailedMessage(ctx, msg.ID, exported.RoutingContext{})
 	assert.NoError(t, err)
 	sent = consumeSent(destinationChainName, 1)
 	assert.Equal(t, len(sent), 1)
@@ -649,3 +821,76 @@ func TestGetSentMessages(t *testing.T) {
 	assert.Equal(t, dest3Msgs, toMap(consumeSent(chain3.Name, 100)))
 	assert.Equal(t, dest4Msgs, toMap(consumeSent(chain4.Name, 100)))
 }
+
+func TestGetMessagesPaginated(t *testing.T) {
+	cfg := app.MakeEncodingConfig()
+	k, ctx := setup(cfg)
+	sourceChain := nexustestutils.RandomChain()
+	sourceChain.Module = axelarnet.ModuleName
+	destinationChain := nexustestutils.RandomChain()
+	destinationChain.Module = evmtypes.ModuleName
+	k.SetChain(ctx, sourceChain)
+	k.SetChain(ctx, destinationChain)
+	k.ActivateChain(ctx, sourceChain)
+	k.ActivateChain(ctx, destinationChain)
+	k.SetMessageRouter(types.NewMessageRouter().AddRoute(destinationChain.Module, func(_ sdk.Context, _ exported.RoutingContext, _ exported.GeneralMessage) error {
+		return nil
+	}))
+
+	newMsg := func(sender, recipient exported.CrossChainAddress) exported.GeneralMessage {
+		msg := randMsg(exported.Approved)
+		msg.Sender = sender
+		msg.Recipient = recipient
+		assert.NoError(t, k.SetNewMessage(ctx, msg))
+
+		return msg
+	}
+
+	cosmosSender := exported.CrossChainAddress{Chain: sourceChain, Address: genCosmosAddr(sourceChain.Name.String())}
+	evmSender := exported.CrossChainAddress{Chain: destinationChain, Address: evmtestutils.RandomAddress().Hex()}
+	for i := 0; i < 3; i++ {
+		newMsg(cosmosSender, exported.CrossChainAddress{Chain: destinationChain, Address: evmtestutils.RandomAddress().Hex()})
+	}
+	for i := 0; i < 2; i++ {
+		newMsg(evmSender, exported.CrossChainAddress{Chain: sourceChain, Address: genCosmosAddr(sourceChain.Name.String())})
+	}
+
+	longSender := exported.CrossChainAddress{Chain: sourceChain, Address: rand.StrBetween(200, 300)}
+	newMsg(longSender, exported.CrossChainAddress{Chain: destinationChain, Address: evmtestutils.RandomAddress().Hex()})
+
+	executed := newMsg(cosmosSender, exported.CrossChainAddress{Chain: destinationChain, Address: evmtestutils.RandomAddress().Hex()})
+	assert.NoError(t, k.RouteMessage(ctx, executed.ID))
+	assert.NoError(t, k.SetMessageExecuted(ctx, executed.ID))
+
+	count := func(filter types.MessageFilter) int {
+		messages, _, err := k.GetMessagesPaginated(ctx, filter, &query.PageRequest{Limit: 100})
+		assert.NoError(t, err)
+		for _, msg := range messages {
+			assert.True(t, filter.Matches(msg))
+		}
+
+		return len(messages)
+	}
+
+	assert.Equal(t, 7, count(types.MessageFilter{}))
+	assert.Equal(t, 5, count(types.MessageFilter{SourceChain: sourceChain.Name}))
+	assert.Equal(t, 1, count(types.MessageFilter{Sender: longSender.Address}))
+	assert.Equal(t, 2, count(types.MessageFilter{DestinationChain: sourceChain.Name}))
+	assert.Equal(t, 2, count(types.MessageFilter{Sender: strings.ToLower(evmSender.Address)}))
+	assert.Equal(t, 6, count(types.MessageFilter{Status: exported.Approved}))
+	assert.Equal(t, 4, count(types.MessageFilter{SourceChain: sourceChain.Name, Status: exported.Approved}))
+	assert.Equal(t, 0, count(types.MessageFilter{Status: exported.Processing}))
+	assert.Equal(t, 0, count(types.MessageFilter{DestinationChain: destinationChain.Name, Status: exported.Processing}))
+	assert.Equal(t, 1, count(types.MessageFilter{Sender: cosmosSender.Address, Status: exported.Executed}))
+
+	page, res, err := k.GetMessagesPaginated(ctx, types.MessageFilter{SourceChain: sourceChain.Name}, &query.PageRequest{Limit: 3, CountTotal: true})
+	assert.NoError(t, err)
+	assert.Len(t, page, 3)
+	assert.EqualValues(t, 5, res.Total)
+	assert.NotNil(t, res.NextKey)
+
+	page, res, err = k.GetMessagesPaginated(ctx, types.MessageFilter{SourceChain: sourceChain.Name}, &query.PageRequest{Key: res.NextKey, Limit: 3})
+	assert.NoError(t, err)
+	assert.Len(t, page, 2)
+	assert.Nil(t, res.NextKey)
+}
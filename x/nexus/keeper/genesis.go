@@ -77,7 +77,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 			panic(fmt.Errorf("rate limit for chain %s and asset %s already registered", rateLimit.Chain, rateLimit.Limit.Denom))
 		}
 
-		funcs.MustNoErr(k.SetRateLimit(ctx, rateLimit.Chain, rateLimit.Limit, rateLimit.Window))
+		funcs.MustNoErr(k.SetRateLimit(ctx, rateLimit.Chain, rateLimit.Limit, rateLimit.Window, rateLimit.Mode))
 	}
 
 	for _, transferEpoch := range genState.TransferEpochs {
@@ -92,10 +92,76 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		k.setTransferEpoch(ctx, transferEpoch)
 	}
 
+	for _, addressRateLimit := range genState.AddressRateLimits {
+		rateLimit := addressRateLimit.RateLimit
+		if _, found := k.getAddressRateLimit(ctx, rateLimit.Chain, addressRateLimit.Address, rateLimit.Limit.Denom); found {
+			panic(fmt.Errorf("rate limit for address %s on chain %s and asset %s already registered", addressRateLimit.Address, rateLimit.Chain, rateLimit.Limit.Denom))
+		}
+
+		address := exported.CrossChainAddress{Chain: exported.Chain{Name: rateLimit.Chain}, Address: addressRateLimit.Address}
+		funcs.MustNoErr(k.SetAddressRateLimit(ctx, address, rateLimit.Limit, rateLimit.Window, rateLimit.Mode))
+	}
+
+	for _, transferWindow := range genState.TransferWindows {
+		if _, ok := k.GetChain(ctx, transferWindow.Chain); !ok {
+			panic(fmt.Errorf("chain %s not found", transferWindow.Chain))
+		}
+
+		if transferWindow.Address == "" {
+			if _, found := k.getTransferWindow(ctx, transferWindow.Chain, transferWindow.Asset, transferWindow.Direction); found {
+				panic(fmt.Errorf("transfer window for chain %s (%s) and asset %s already registered", transferWindow.Chain, transferWindow.Direction, transferWindow.Asset))
+			}
+		} else if _, found := k.getAddressTransferWindow(ctx, transferWindow.Chain, transferWindow.Address, transferWindow.Asset, transferWindow.Direction); found {
+			panic(fmt.Errorf("transfer window for address %s on chain %s (%s) and asset %s already registered", transferWindow.Address, transferWindow.Chain, transferWindow.Direction, transferWindow.Asset))
+		}
+
+		k.setTransferWindow(ctx, transferWindow)
+	}
+
+	for _, messageRateLimit := range genState.MessageRateLimits {
+		if _, found := k.getMessageRateLimit(ctx, messageRateLimit.Chain, messageRateLimit.Address); found {
+			panic(fmt.Errorf("message rate limit for chain %s and address %s already registered", messageRateLimit.Chain, messageRateLimit.Address))
+		}
+
+		funcs.MustNoErr(k.SetMessageRateLimit(ctx, messageRateLimit.Chain, messageRateLimit.Address, messageRateLimit.Limit, messageRateLimit.Window, messageRateLimit.Mode))
+	}
+
+	for _, messageWindow := range genState.MessageWindows {
+		if _, ok := k.GetChain(ctx, messageWindow.Chain); !ok {
+			panic(fmt.Errorf("chain %s not found", messageWindow.Chain))
+		}
+
+		if _, found := k.getMessageWindow(ctx, messageWindow.Chain, messageWindow.Address); found {
+			panic(fmt.Errorf("message window for chain %s and address %s already registered", messageWindow.Chain, messageWindow.Address))
+		}
+
+		k.setMessageWindow(ctx, messageWindow)
+	}
+
 	for _, msg := range genState.Messages {
 		funcs.MustNoErr(k.setMessage(ctx, msg))
 	}
 
+	failedMessageSeen := make(map[string]bool)
+	for _, failed := range genState.FailedMessages {
+		if failedMessageSeen[failed.ID] {
+			panic(fmt.Errorf("failed message %s already set", failed.ID))
+		}
+		failedMessageSeen[failed.ID] = true
+
+		msg, ok := k.GetMessage(ctx, failed.ID)
+		if !ok {
+			panic(fmt.Errorf("general message %s not found", failed.ID))
+		}
+
+		// setting the messages started the expiry period of failed messages at the genesis height, so restore the exported one
+		k.deleteMessageExpiry(ctx, failed.ID)
+		k.setFailedMessage(ctx, failed)
+		if msg.Is(exported.Failed) {
+			k.setMessageFailedAt(ctx, msg, failed.FailedAt)
+		}
+	}
+
 	utils.NewCounter[uint64](messageNonceKey, k.getStore(ctx)).Set(ctx, genState.MessageNonce)
 }
 
@@ -114,5 +180,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getTransferEpochs(ctx),
 		k.getMessages(ctx),
 		utils.NewCounter[uint64](messageNonceKey, k.getStore(ctx)).Curr(ctx),
+		k.getTransferWindows(ctx),
+		k.getAddressRateLimits(ctx),
+		k.getMessageRateLimits(ctx),
+		k.getMessageWindows(ctx),
+		k.getFailedMessages(ctx),
 	)
 }
@@ -97,7 +97,10 @@ func assertChainStatesEqual(t *testing.T, expected, actual *types.GenesisState)
 	assert.Equal(t, expected.Fee, actual.Fee)
 	assert.ElementsMatch(t, expected.FeeInfos, actual.FeeInfos)
 	assert.ElementsMatch(t, expected.RateLimits, actual.RateLimits)
+	assert.ElementsMatch(t, expected.AddressRateLimits, actual.AddressRateLimits)
+	assert.ElementsMatch(t, expected.MessageRateLimits, actual.MessageRateLimits)
 	assert.ElementsMatch(t, expected.Messages, actual.Messages)
+	assert.ElementsMatch(t, expected.FailedMessages, actual.FailedMessages)
 	assert.Equal(t, expected.MessageNonce, actual.MessageNonce)
 	// TODO: Track this with some random transfers
 	// assert.ElementsMatch(t, expected.TransferEpochs, actual.TransferEpochs)
@@ -122,9 +125,17 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 	}
 
 	rateLimit := testutils.RandRateLimit(axelarnet.Axelarnet.Name, axelarnet.NativeAsset)
-	funcs.MustNoErr(keeper.SetRateLimit(ctx, rateLimit.Chain, rateLimit.Limit, rateLimit.Window))
+	funcs.MustNoErr(keeper.SetRateLimit(ctx, rateLimit.Chain, rateLimit.Limit, rateLimit.Window, rateLimit.Mode))
 	expected.RateLimits = keeper.getRateLimits(ctx)
 
+	addressRateLimit := testutils.RandRateLimit(evm.Ethereum.Name, axelarnet.NativeAsset)
+	funcs.MustNoErr(keeper.SetAddressRateLimit(ctx, getRandomEthereumAddress(), addressRateLimit.Limit, addressRateLimit.Window, addressRateLimit.Mode))
+	expected.AddressRateLimits = keeper.getAddressRateLimits(ctx)
+
+	funcs.MustNoErr(keeper.SetMessageRateLimit(ctx, evm.Ethereum.Name, "", uint64(rand.I64Between(1, 1000)), time.Hour, types.SlidingWindow))
+	funcs.MustNoErr(keeper.SetMessageRateLimit(ctx, evm.Ethereum.Name, getRandomEthereumAddress().Address, uint64(rand.I64Between(1, 1000)), time.Hour, types.TokenBucket))
+	expected.MessageRateLimits = keeper.getMessageRateLimits(ctx)
+
 	for _, chain := range expected.Chains {
 		keeper.ActivateChain(ctx, chain)
 	}
@@ -209,17 +220,91 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 		expected.Messages = append(expected.Messages, msg)
 		funcs.MustNoErr(keeper.setMessage(ctx, msg))
 	}
-	expected.MessageNonce = uint64(messageCount)
+
+	failedMessageCount := rand.I64Between(10, 50)
+	for i := 0; i < int(failedMessageCount); i++ {
+		id, _, _ := keeper.GenerateMessageID(ctx)
+		msg := getRandomMessage(id)
+		msg.Status = exported.Failed
+		if rand.Bools(0.5).Next() {
+			asset := sdk.NewCoin(axelarnet.NativeAsset, sdk.NewInt(rand.PosI64()))
+			msg.Asset = &asset
+		}
+		funcs.MustNoErr(keeper.setMessage(ctx.WithBlockHeight(rand.I64Between(1, 1000)), msg))
+
+		failed := funcs.MustOk(keeper.getFailedMessage(ctx, id))
+		failed.Retries = uint64(rand.I64Between(0, 10))
+		keeper.setFailedMessage(ctx, failed)
+
+		// retried messages keep their retries
+		if rand.Bools(0.3).Next() {
+			msg.Status = exported.Processing
+			funcs.MustNoErr(keeper.setMessage(ctx, msg))
+		}
+		expected.Messages = append(expected.Messages, msg)
+	}
+	expected.FailedMessages = keeper.getFailedMessages(ctx)
+	expected.MessageNonce = uint64(messageCount + failedMessageCount)
 
 	actual := keeper.ExportGenesis(ctx)
 
 	assert.NoError(t, actual.Validate())
 	assertChainStatesEqual(t, expected, actual)
+	assertFailedMessageExpiries(t, ctx, keeper, expected)
 
 	ctx, keeper = setup()
+	ctx = ctx.WithBlockHeight(rand.I64Between(2000, 3000))
 	keeper.InitGenesis(ctx, expected)
 	actual = keeper.ExportGenesis(ctx)
 
 	assert.NoError(t, actual.Validate())
 	assertChainStatesEqual(t, expected, actual)
+	assertFailedMessageExpiries(t, ctx, keeper, expected)
+}
+
+// assertFailedMessageExpiries asserts that exactly the failed messages without tokens are queued to expire at the height they failed
+func assertFailedMessageExpiries(t *testing.T, ctx sdk.Context, keeper Keeper, expected *types.GenesisState) {
+	messages := make(map[string]exported.GeneralMessage)
+	for _, msg := range expected.Messages {
+		messages[msg.ID] = msg
+	}
+
+	expiring := 0
+	for _, failed := range expected.FailedMessages {
+		msg := messages[failed.ID]
+		isExpiring := msg.Is(exported.Failed) && msg.Asset == nil
+		assert.Equal(t, isExpiring, keeper.getStore(ctx).HasNew(getFailedMessageExpiryKey(failed.FailedAt, failed.ID)))
+
+		if isExpiring {
+			expiring++
+		}
+	}
+
+	iter := keeper.getStore(ctx).IteratorNew(failedMessageExpiryPrefix)
+	defer utils.CloseLogError(iter, keeper.Logger(ctx))
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	assert.Equal(t, expiring, count)
+}
+
+func TestGenesisState_ValidateFailedMessages(t *testing.T) {
+	msg := getRandomMessage(rand.Str(10))
+	msg.Status = exported.Failed
+
+	genState := types.DefaultGenesisState()
+	genState.Messages = []exported.GeneralMessage{msg}
+	genState.FailedMessages = []types.FailedMessage{{ID: msg.ID, Retries: 1, FailedAt: 10}}
+	assert.NoError(t, genState.Validate())
+
+	genState.FailedMessages = []types.FailedMessage{{ID: rand.Str(10), Retries: 1, FailedAt: 10}}
+	assert.ErrorContains(t, genState.Validate(), "not found")
+
+	genState.FailedMessages = []types.FailedMessage{{ID: msg.ID, FailedAt: 10}, {ID: msg.ID, FailedAt: 11}}
+	assert.ErrorContains(t, genState.Validate(), "duplicate")
+
+	genState.FailedMessages = []types.FailedMessage{{ID: msg.ID, FailedAt: -1}}
+	assert.Error(t, genState.Validate())
 }
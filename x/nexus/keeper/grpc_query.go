@@ -273,23 +273,123 @@ func (q Querier) TransferRateLimit(c context.Context, req *types.TransferRateLim
 		return &types.TransferRateLimitResponse{}, nil
 	}
 
+	if rateLimit.Mode != types.FixedWindow {
+		incoming := q.keeper.getTransferWindowOrNew(ctx, chain.Name, req.Asset, nexus.Incoming)
+		outgoing := q.keeper.getTransferWindowOrNew(ctx, chain.Name, req.Asset, nexus.Outgoing)
+
+		return &types.TransferRateLimitResponse{TransferRateLimit: transferWindowRateLimit(ctx, rateLimit, incoming, outgoing)}, nil
+	}
+
 	incomingEpoch := q.keeper.getCurrentTransferEpoch(ctx, chain.Name, req.Asset, nexus.Incoming, rateLimit.Window)
 	outgoingEpoch := q.keeper.getCurrentTransferEpoch(ctx, chain.Name, req.Asset, nexus.Outgoing, rateLimit.Window)
 
 	// time left = (epoch + 1) * window - current time
 	timeLeft := time.Duration(int64(incomingEpoch.Epoch+1)*int64(rateLimit.Window) - ctx.BlockTime().UnixNano())
 
+	// the full limit is available again at the start of the next epoch
+	refillTime := func(epoch types.TransferEpoch) time.Duration {
+		if epoch.Amount.IsZero() {
+			return 0
+		}
+
+		return timeLeft
+	}
+
 	return &types.TransferRateLimitResponse{
 		TransferRateLimit: &types.TransferRateLimit{
-			Limit:    rateLimit.Limit.Amount,
-			Window:   rateLimit.Window,
-			Incoming: incomingEpoch.Amount.Amount,
-			Outgoing: outgoingEpoch.Amount.Amount,
-			TimeLeft: timeLeft,
+			Limit:              rateLimit.Limit.Amount,
+			Window:             rateLimit.Window,
+			Incoming:           incomingEpoch.Amount.Amount,
+			Outgoing:           outgoingEpoch.Amount.Amount,
+			TimeLeft:           timeLeft,
+			Mode:               rateLimit.Mode,
+			IncomingRemaining:  remainingCapacity(rateLimit, incomingEpoch.Amount.Amount),
+			OutgoingRemaining:  remainingCapacity(rateLimit, outgoingEpoch.Amount.Amount),
+			IncomingRefillTime: refillTime(incomingEpoch),
+			OutgoingRefillTime: refillTime(outgoingEpoch),
 		},
 	}, nil
 }
 
+// AddressRateLimit queries the transfer rate limit for a given address and asset
+func (q Querier) AddressRateLimit(c context.Context, req *types.AddressRateLimitRequest) (*types.AddressRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.keeper.GetChain(ctx, nexus.ChainName(req.Chain))
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, fmt.Errorf("chain %s not found", req.Chain).Error())
+	}
+
+	addressRateLimit, found := q.keeper.getAddressRateLimit(ctx, chain.Name, req.Address, req.Asset)
+	if !found {
+		return &types.AddressRateLimitResponse{}, nil
+	}
+
+	address := nexus.CrossChainAddress{Chain: chain, Address: req.Address}
+	incoming := q.keeper.getAddressTransferWindowOrNew(ctx, address, req.Asset, nexus.Incoming)
+	outgoing := q.keeper.getAddressTransferWindowOrNew(ctx, address, req.Asset, nexus.Outgoing)
+
+	return &types.AddressRateLimitResponse{TransferRateLimit: transferWindowRateLimit(ctx, addressRateLimit.RateLimit, incoming, outgoing)}, nil
+}
+
+// MessageRateLimit queries the rate limit on the general messages routed to a given chain, or sent from a given address of the chain
+func (q Querier) MessageRateLimit(c context.Context, req *types.MessageRateLimitRequest) (*types.MessageRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.keeper.GetChain(ctx, nexus.ChainName(req.Chain))
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, fmt.Errorf("chain %s not found", req.Chain).Error())
+	}
+
+	messageRateLimit, found := q.keeper.getMessageRateLimit(ctx, chain.Name, req.Address)
+	if !found {
+		return &types.MessageRateLimitResponse{}, nil
+	}
+
+	now := ctx.BlockTime()
+	rateLimit := messageRateLimit.AsRateLimit()
+	messageWindow := q.keeper.getMessageWindowOrNew(ctx, chain.Name, req.Address)
+	count := messageWindow.Used(rateLimit, now)
+
+	return &types.MessageRateLimitResponse{
+		RateLimit:  &messageRateLimit,
+		Count:      count.Uint64(),
+		Remaining:  remainingCapacity(rateLimit, count).Uint64(),
+		TimeLeft:   fixedWindowTimeLeft(ctx, rateLimit),
+		RefillTime: messageWindow.RefillTime(rateLimit, now),
+	}, nil
+}
+
+func transferWindowRateLimit(ctx sdk.Context, rateLimit types.RateLimit, incoming types.TransferWindow, outgoing types.TransferWindow) *types.TransferRateLimit {
+	now := ctx.BlockTime()
+
+	return &types.TransferRateLimit{
+		Limit:              rateLimit.Limit.Amount,
+		Window:             rateLimit.Window,
+		Incoming:           incoming.Used(rateLimit, now),
+		Outgoing:           outgoing.Used(rateLimit, now),
+		TimeLeft:           fixedWindowTimeLeft(ctx, rateLimit),
+		Mode:               rateLimit.Mode,
+		IncomingRemaining:  remainingCapacity(rateLimit, incoming.Used(rateLimit, now)),
+		OutgoingRemaining:  remainingCapacity(rateLimit, outgoing.Used(rateLimit, now)),
+		IncomingRefillTime: incoming.RefillTime(rateLimit, now),
+		OutgoingRefillTime: outgoing.RefillTime(rateLimit, now),
+	}
+}
+
+// fixedWindowTimeLeft returns the time left in the current epoch of a fixed window rate limit, and 0 for any other mode
+func fixedWindowTimeLeft(ctx sdk.Context, rateLimit types.RateLimit) time.Duration {
+	if rateLimit.Mode != types.FixedWindow {
+		return 0
+	}
+
+	return time.Duration(int64(computeEpoch(ctx, rateLimit.Window)+1)*int64(rateLimit.Window) - ctx.BlockTime().UnixNano())
+}
+
+func remainingCapacity(rateLimit types.RateLimit, used sdk.Int) sdk.Int {
+	return sdk.MaxInt(rateLimit.Limit.Amount.Sub(used), sdk.ZeroInt())
+}
+
 // Message queries the general message for a given message ID
 func (q Querier) Message(c context.Context, req *types.MessageRequest) (*types.MessageResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -305,3 +405,26 @@ func (q Querier) Message(c context.Context, req *types.MessageRequest) (*types.M
 		Message: msg,
 	}, nil
 }
+
+// Messages returns the general messages that pass the filters of the request
+func (q Querier) Messages(c context.Context, req *types.MessagesRequest) (*types.MessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, ok := nexus.GeneralMessage_Status_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message status %d", req.Status)
+	}
+
+	filter := types.MessageFilter{
+		SourceChain:      req.SourceChain,
+		DestinationChain: req.DestinationChain,
+		Sender:           req.Sender,
+		Status:           req.Status,
+	}
+
+	messages, pagination, err := q.keeper.GetMessagesPaginated(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.MessagesResponse{Messages: messages, Pagination: pagination}, nil
+}
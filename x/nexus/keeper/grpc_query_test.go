@@ -29,6 +29,7 @@ import (
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -229,3 +230,44 @@ func TestKeeper_Message(t *testing.T) {
 	)
 
 }
+
+func TestKeeper_Messages(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		k      nexusKeeper.Keeper
+		q      nexusKeeper.Querier
+		chain  exported.Chain
+		msgIDs []string
+	)
+
+	Given("keeper and context", func() {
+		cfg := app.MakeEncodingConfig()
+		k, ctx = setup(cfg)
+		q = nexusKeeper.NewGRPCQuerier(k, nil)
+	}).
+		When("messages from different chains exist", func() {
+			chain = nexustestutils.RandomChain()
+			msgIDs = nil
+			for i := 0; i < 5; i++ {
+				msg := randMsg(exported.Approved)
+				if i%2 == 0 {
+					msg.Sender.Chain = chain
+					msgIDs = append(msgIDs, msg.ID)
+				}
+
+				assert.NoError(t, k.SetNewMessage(ctx, msg))
+			}
+		}).
+		Branch(
+			Then("should return the messages of the source chain", func(t *testing.T) {
+				response, err := q.Messages(sdk.WrapSDKContext(ctx), &types.MessagesRequest{SourceChain: chain.Name, Status: exported.Approved})
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, msgIDs, slices.Map(response.Messages, func(msg exported.GeneralMessage) string { return msg.ID }))
+			}),
+			Then("should fail with an invalid status", func(t *testing.T) {
+				_, err := q.Messages(sdk.WrapSDKContext(ctx), &types.MessagesRequest{Status: 10})
+				assert.Error(t, err)
+			}),
+		).
+		Run(t)
+}
@@ -25,12 +25,25 @@ var (
 	transferFee              = utils.KeyFromStr("fee")
 	assetFeePrefix           = utils.KeyFromStr("asset_fee")
 
-	chainMaintainerStatePrefix = key.RegisterStaticKey(types.ModuleName, 1)
-	rateLimitPrefix            = key.RegisterStaticKey(types.ModuleName, 2)
-	transferEpochPrefix        = key.RegisterStaticKey(types.ModuleName, 3)
-	generalMessagePrefix       = key.RegisterStaticKey(types.ModuleName, 4)
-	processingMessagePrefix    = key.RegisterStaticKey(types.ModuleName, 5)
-	messageNonceKey            = key.RegisterStaticKey(types.ModuleName, 6)
+	chainMaintainerStatePrefix  = key.RegisterStaticKey(types.ModuleName, 1)
+	rateLimitPrefix             = key.RegisterStaticKey(types.ModuleName, 2)
+	transferEpochPrefix         = key.RegisterStaticKey(types.ModuleName, 3)
+	generalMessagePrefix        = key.RegisterStaticKey(types.ModuleName, 4)
+	processingMessagePrefix     = key.RegisterStaticKey(types.ModuleName, 5)
+	messageNonceKey             = key.RegisterStaticKey(types.ModuleName, 6)
+	messageBySourceChainPrefix  = key.RegisterStaticKey(types.ModuleName, 7)
+	messageByDestChainPrefix    = key.RegisterStaticKey(types.ModuleName, 8)
+	messageBySenderPrefix       = key.RegisterStaticKey(types.ModuleName, 9)
+	messageByStatusPrefix       = key.RegisterStaticKey(types.ModuleName, 10)
+	failedMessagePrefix         = key.RegisterStaticKey(types.ModuleName, 11)
+	failedMessageExpiryPrefix   = key.RegisterStaticKey(types.ModuleName, 12)
+	transferWindowPrefix        = key.RegisterStaticKey(types.ModuleName, 13)
+	addressRateLimitPrefix      = key.RegisterStaticKey(types.ModuleName, 14)
+	addressTransferWindowPrefix = key.RegisterStaticKey(types.ModuleName, 15)
+	messageRateLimitPrefix      = key.RegisterStaticKey(types.ModuleName, 16)
+	messageWindowPrefix         = key.RegisterStaticKey(types.ModuleName, 17)
+	chainMessageRateLimitPrefix = key.RegisterStaticKey(types.ModuleName, 18)
+	chainMessageWindowPrefix    = key.RegisterStaticKey(types.ModuleName, 19)
 
 	// temporary
 	// TODO: add description about what temporary means
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
 
@@ -10,6 +11,9 @@ import (
 func Migrate6to7(k Keeper) func(ctx sdk.Context) error {
 	return func(ctx sdk.Context) error {
 		addModuleParamGateway(ctx, k)
+		addGeneralMessageIndexes(ctx, k)
+		addModuleParamsFailedMessages(ctx, k)
+		addFailedMessageExpiries(ctx, k)
 
 		return nil
 	}
@@ -18,3 +22,25 @@ func Migrate6to7(k Keeper) func(ctx sdk.Context) error {
 func addModuleParamGateway(ctx sdk.Context, k Keeper) {
 	k.params.Set(ctx, types.KeyGateway, types.DefaultParams().Gateway)
 }
+
+func addModuleParamsFailedMessages(ctx sdk.Context, k Keeper) {
+	k.params.Set(ctx, types.KeyMessageRetryLimit, types.DefaultParams().MessageRetryLimit)
+	k.params.Set(ctx, types.KeyFailedMessageExpiry, types.DefaultParams().FailedMessageExpiry)
+}
+
+// addGeneralMessageIndexes indexes all existing general messages by source chain, destination chain, hashed sender and status
+func addGeneralMessageIndexes(ctx sdk.Context, k Keeper) {
+	for _, msg := range k.getMessages(ctx) {
+		k.setMessageChainIndexes(ctx, msg)
+		k.getStore(ctx).SetRawNew(getMessageByStatusKey(msg.Status, msg.ID), []byte(msg.ID))
+	}
+}
+
+// addFailedMessageExpiries starts the expiry period of all existing failed general messages at the current block height
+func addFailedMessageExpiries(ctx sdk.Context, k Keeper) {
+	for _, msg := range k.getMessages(ctx) {
+		if msg.Is(exported.Failed) {
+			k.setMessageFailedAt(ctx, msg, ctx.BlockHeight())
+		}
+	}
+}
@@ -1,25 +1,29 @@
-package keeper_test
+package keeper
 
 import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
-	"github.com/axelarnetwork/axelar-core/app"
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
-	"github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
 
 func TestMigrate6to7(t *testing.T) {
-	encCfg := app.MakeEncodingConfig()
+	encCfg := appParams.MakeEncodingConfig()
 	subspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("nexusKey"), sdk.NewKVStoreKey("tNexusKey"), "nexus")
-	k := keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("nexus"), subspace)
+	k := NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("nexus"), subspace)
 	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
 
 	Given("subspace is setup with params before migration", func() {
@@ -39,7 +43,7 @@ func TestMigrate6to7(t *testing.T) {
 				k.GetParams(ctx)
 			})
 
-			keeper.Migrate6to7(k)(ctx)
+			funcs.MustNoErr(Migrate6to7(k)(ctx))
 
 			assert.NotPanics(t, func() {
 				subspace.Get(ctx, types.KeyGateway, &actual)
@@ -50,7 +54,87 @@ func TestMigrate6to7(t *testing.T) {
 
 			assert.Equal(t, types.DefaultParams().Gateway, actual)
 			assert.Equal(t, types.DefaultParams().Gateway, k.GetParams(ctx).Gateway)
+			assert.Equal(t, types.DefaultParams().MessageRetryLimit, k.GetParams(ctx).MessageRetryLimit)
+			assert.Equal(t, types.DefaultParams().FailedMessageExpiry, k.GetParams(ctx).FailedMessageExpiry)
 		}).
 		Run(t)
+}
+
+func TestMigrate6to7_GeneralMessages(t *testing.T) {
+	var (
+		k   Keeper
+		ctx sdk.Context
+	)
+
+	var messages []exported.GeneralMessage
+	statuses := []exported.GeneralMessage_Status{exported.Approved, exported.Processing, exported.Executed, exported.Failed}
+	for i := 0; i < int(rand.I64Between(20, 50)); i++ {
+		msg := getRandomMessage(rand.Str(10))
+		msg.Status = statuses[i%len(statuses)]
+		if rand.Bools(0.3).Next() {
+			coin := rand.Coin()
+			msg.Asset = &coin
+		}
+		messages = append(messages, msg)
+	}
+
+	msgIDs := func(msgs []exported.GeneralMessage) []string {
+		return slices.Map(msgs, func(msg exported.GeneralMessage) string { return msg.ID })
+	}
+	getMessages := func(filter types.MessageFilter) []exported.GeneralMessage {
+		msgs, _, err := k.GetMessagesPaginated(ctx, filter, &query.PageRequest{Limit: 1000})
+		assert.NoError(t, err)
+
+		return msgs
+	}
+
+	givenMigration := Given("general messages stored without indexes before the migration", func() {
+		encCfg := appParams.MakeEncodingConfig()
+		subspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("nexusKey"), sdk.NewKVStoreKey("tNexusKey"), "nexus")
+		k = NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("nexus"), subspace)
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.I64Between(100, 1000)}, false, log.TestingLogger())
+
+		for _, msg := range messages {
+			// messages used to be stored without any index or expiry
+			funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getMessageKey(msg.ID), &msg))
+		}
+	}).
+		When("migrating", func() {
+			funcs.MustNoErr(Migrate6to7(k)(ctx))
+		})
 
+	givenMigration.
+		Then("the messages should be indexed by chains, sender and status", func(t *testing.T) {
+			for _, msg := range messages {
+				for _, filter := range []types.MessageFilter{
+					{SourceChain: msg.GetSourceChain()},
+					{DestinationChain: msg.GetDestinationChain()},
+					{Sender: msg.GetSourceAddress()},
+					{Status: msg.Status},
+				} {
+					assert.Contains(t, msgIDs(getMessages(filter)), msg.ID)
+				}
+			}
+
+			for _, status := range statuses {
+				expected := slices.Filter(messages, func(msg exported.GeneralMessage) bool { return msg.Is(status) })
+				assert.ElementsMatch(t, msgIDs(expected), msgIDs(getMessages(types.MessageFilter{Status: status})))
+			}
+		}).
+		Run(t)
+
+	givenMigration.
+		Then("failed messages without tokens should start to expire at the migration height", func(t *testing.T) {
+			for _, msg := range messages {
+				failed, ok := k.getFailedMessage(ctx, msg.ID)
+				assert.Equal(t, msg.Is(exported.Failed), ok)
+				if !ok {
+					continue
+				}
+
+				assert.Equal(t, types.FailedMessage{ID: msg.ID, FailedAt: ctx.BlockHeight()}, failed)
+				assert.Equal(t, msg.Asset == nil, k.getStore(ctx).HasNew(getFailedMessageExpiryKey(ctx.BlockHeight(), msg.ID)))
+			}
+		}).
+		Run(t)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/utils/funcs"
 )
@@ -23,16 +24,18 @@ type msgServer struct {
 	slashing    types.SlashingKeeper
 	staking     types.StakingKeeper
 	axelarnet   types.AxelarnetKeeper
+	permission  types.PermissionKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the nexus MsgServiceServer interface for the provided Keeper.
-func NewMsgServerImpl(k types.Nexus, snapshotter types.Snapshotter, slashing types.SlashingKeeper, staking types.StakingKeeper, axelarnet types.AxelarnetKeeper) types.MsgServiceServer {
+func NewMsgServerImpl(k types.Nexus, snapshotter types.Snapshotter, slashing types.SlashingKeeper, staking types.StakingKeeper, axelarnet types.AxelarnetKeeper, permission types.PermissionKeeper) types.MsgServiceServer {
 	return msgServer{
 		Nexus:       k,
 		snapshotter: snapshotter,
 		slashing:    slashing,
 		staking:     staking,
 		axelarnet:   axelarnet,
+		permission:  permission,
 	}
 }
 
@@ -267,9 +270,79 @@ func (s msgServer) RegisterAssetFee(c context.Context, req *types.RegisterAssetF
 func (s msgServer) SetTransferRateLimit(c context.Context, req *types.SetTransferRateLimitRequest) (*types.SetTransferRateLimitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := s.SetRateLimit(ctx, req.Chain, req.Limit, req.Window); err != nil {
+	if err := s.SetRateLimit(ctx, req.Chain, req.Limit, req.Window, req.Mode); err != nil {
 		return nil, err
 	}
 
 	return &types.SetTransferRateLimitResponse{}, nil
 }
+
+// SetAddressRateLimit sets a rate limit on the transfers from and to a single address.
+// Only governance or the proxy of a maintainer of the address's chain are allowed to set it.
+func (s msgServer) SetAddressRateLimit(c context.Context, req *types.SetAddressRateLimitRequest) (*types.SetAddressRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := s.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if s.permission.GetRole(ctx, req.Sender) != permission.ROLE_ACCESS_CONTROL && !s.IsChainMaintainer(ctx, chain, s.snapshotter.GetOperator(ctx, req.Sender)) {
+		return nil, fmt.Errorf("account %s is not authorized to set rate limits for addresses on chain %s", req.Sender.String(), chain.Name)
+	}
+
+	address := exported.CrossChainAddress{Chain: chain, Address: req.Address}
+	if err := s.Nexus.SetAddressRateLimit(ctx, address, req.Limit, req.Window, req.Mode); err != nil {
+		return nil, err
+	}
+
+	return &types.SetAddressRateLimitResponse{}, nil
+}
+
+// SetMessageRateLimit handles setting the rate limit on the general messages routed to a chain, or sent from a single address of the chain
+func (s msgServer) SetMessageRateLimit(c context.Context, req *types.SetMessageRateLimitRequest) (*types.SetMessageRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.Nexus.SetMessageRateLimit(ctx, req.Chain, req.Address, req.Limit, req.Window, req.Mode); err != nil {
+		return nil, err
+	}
+
+	return &types.SetMessageRateLimitResponse{}, nil
+}
+
+// RetryFailedMessage routes a failed general message again, as long as it has not reached the retry limit.
+// Only the sender of the message, the access control role and maintainers of its chains may retry it,
+// so no one else can use up its retries
+func (s msgServer) RetryFailedMessage(c context.Context, req *types.RetryFailedMessageRequest) (*types.RetryFailedMessageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	msg, ok := s.GetMessage(ctx, req.ID)
+	if !ok {
+		return nil, fmt.Errorf("general message %s not found", req.ID)
+	}
+
+	if !s.canRetry(ctx, msg, req.Sender) {
+		return nil, fmt.Errorf("account %s is not allowed to retry general message %s", req.Sender.String(), req.ID)
+	}
+
+	if err := s.Nexus.RetryFailedMessage(ctx, req.ID, exported.RoutingContext{Sender: req.Sender, Payload: req.Payload}); err != nil {
+		return nil, err
+	}
+
+	return &types.RetryFailedMessageResponse{}, nil
+}
+
+func (s msgServer) canRetry(ctx sdk.Context, msg exported.GeneralMessage, sender sdk.AccAddress) bool {
+	if strings.EqualFold(msg.GetSourceAddress(), sender.String()) || s.permission.GetRole(ctx, sender) == permission.ROLE_ACCESS_CONTROL {
+		return true
+	}
+
+	operator := s.snapshotter.GetOperator(ctx, sender)
+	for _, chainName := range []exported.ChainName{msg.GetSourceChain(), msg.GetDestinationChain()} {
+		if chain, ok := s.GetChain(ctx, chainName); ok && s.IsChainMaintainer(ctx, chain, operator) {
+			return true
+		}
+	}
+
+	return false
+}
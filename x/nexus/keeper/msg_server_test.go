@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evmtestutils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustestutils "github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types/mock"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMsgServer_RetryFailedMessage(t *testing.T) {
+	var (
+		nexusK      *mock.NexusMock
+		snapshotter *mock.SnapshotterMock
+		permissionK *mock.PermissionKeeperMock
+		msg         exported.GeneralMessage
+		req         *types.RetryFailedMessageRequest
+		maintainer  sdk.ValAddress
+	)
+
+	ctx := rand.Context(fake.NewMultiStore())
+
+	retry := func() error {
+		server := keeper.NewMsgServerImpl(nexusK, snapshotter, &mock.SlashingKeeperMock{}, nil, &mock.AxelarnetKeeperMock{}, permissionK)
+		_, err := server.RetryFailedMessage(sdk.WrapSDKContext(ctx), req)
+
+		return err
+	}
+
+	givenFailedMessage := Given("a failed general message", func() {
+		sender := rand.AccAddr()
+		msg = exported.GeneralMessage{
+			ID:        rand.Str(10),
+			Sender:    exported.CrossChainAddress{Chain: nexustestutils.RandomChain(), Address: sender.String()},
+			Recipient: exported.CrossChainAddress{Chain: nexustestutils.RandomChain(), Address: evmtestutils.RandomAddress().Hex()},
+			Status:    exported.Failed,
+		}
+		maintainer = rand.ValAddr()
+
+		nexusK = &mock.NexusMock{
+			GetMessageFunc: func(_ sdk.Context, id string) (exported.GeneralMessage, bool) {
+				return msg, id == msg.ID
+			},
+			GetChainFunc: func(_ sdk.Context, chain exported.ChainName) (exported.Chain, bool) {
+				return exported.Chain{Name: chain}, true
+			},
+			IsChainMaintainerFunc: func(_ sdk.Context, chain exported.Chain, address sdk.ValAddress) bool {
+				return chain.Name == msg.GetDestinationChain() && address.Equals(maintainer)
+			},
+			RetryFailedMessageFunc: func(sdk.Context, string, exported.RoutingContext) error { return nil },
+		}
+		snapshotter = &mock.SnapshotterMock{
+			GetOperatorFunc: func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return nil },
+		}
+		permissionK = &mock.PermissionKeeperMock{
+			GetRoleFunc: func(sdk.Context, sdk.AccAddress) permission.Role { return permission.ROLE_UNRESTRICTED },
+		}
+	})
+
+	givenFailedMessage.
+		When("the sender of the message retries it", func() {
+			req = types.NewRetryFailedMessageRequest(sdk.MustAccAddressFromBech32(msg.GetSourceAddress()), msg.ID, nil)
+		}).
+		Then("should retry the message", func(t *testing.T) {
+			assert.NoError(t, retry())
+			assert.Len(t, nexusK.RetryFailedMessageCalls(), 1)
+			assert.Equal(t, req.Sender, nexusK.RetryFailedMessageCalls()[0].RoutingCtx.Sender)
+		}).
+		Run(t)
+
+	givenFailedMessage.
+		When("a maintainer of the destination chain retries it", func() {
+			req = types.NewRetryFailedMessageRequest(rand.AccAddr(), msg.ID, nil)
+			snapshotter.GetOperatorFunc = func(_ sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+				if proxy.Equals(req.Sender) {
+					return maintainer
+				}
+
+				return nil
+			}
+		}).
+		Then("should retry the message", func(t *testing.T) {
+			assert.NoError(t, retry())
+			assert.Len(t, nexusK.RetryFailedMessageCalls(), 1)
+		}).
+		Run(t)
+
+	givenFailedMessage.
+		When("the access control role retries it", func() {
+			req = types.NewRetryFailedMessageRequest(rand.AccAddr(), msg.ID, nil)
+			permissionK.GetRoleFunc = func(sdk.Context, sdk.AccAddress) permission.Role { return permission.ROLE_ACCESS_CONTROL }
+		}).
+		Then("should retry the message", func(t *testing.T) {
+			assert.NoError(t, retry())
+			assert.Len(t, nexusK.RetryFailedMessageCalls(), 1)
+		}).
+		Run(t)
+
+	givenFailedMessage.
+		When("any other account retries it", func() {
+			req = types.NewRetryFailedMessageRequest(rand.AccAddr(), msg.ID, nil)
+		}).
+		Then("should not retry the message", func(t *testing.T) {
+			assert.ErrorContains(t, retry(), "not allowed to retry")
+			assert.Len(t, nexusK.RetryFailedMessageCalls(), 0)
+		}).
+		Run(t)
+}
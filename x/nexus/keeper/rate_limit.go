@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -23,6 +25,10 @@ func (k Keeper) RateLimitTransfer(ctx sdk.Context, chain exported.ChainName, ass
 		return nil
 	}
 
+	if rateLimit.Mode != types.FixedWindow {
+		return k.rateLimitTransferWindow(ctx, rateLimit, k.getTransferWindowOrNew(ctx, chain, asset.Denom, direction), asset)
+	}
+
 	transferEpoch := k.getCurrentTransferEpoch(ctx, chain, asset.Denom, direction, rateLimit.Window)
 	transferEpoch.Amount = transferEpoch.Amount.Add(asset)
 
@@ -42,9 +48,45 @@ func (k Keeper) RateLimitTransfer(ctx sdk.Context, chain exported.ChainName, ass
 	return nil
 }
 
-// SetRateLimit sets a rate limit for the given chain and asset.
+// RateLimitAddressTransfer applies the rate limit of the given address to transfers, and returns an error if the rate limit is exceeded.
+// Incoming transfers are sent by the address, outgoing transfers are received by it.
+func (k Keeper) RateLimitAddressTransfer(ctx sdk.Context, address exported.CrossChainAddress, asset sdk.Coin, direction exported.TransferDirection) error {
+	addressRateLimit, found := k.getAddressRateLimit(ctx, address.Chain.Name, address.Address, asset.Denom)
+	// If a rate limit is not set, it is treated as unbounded
+	if !found {
+		return nil
+	}
+
+	return k.rateLimitTransferWindow(ctx, addressRateLimit.RateLimit, k.getAddressTransferWindowOrNew(ctx, address, asset.Denom, direction), asset)
+}
+
+func (k Keeper) rateLimitTransferWindow(ctx sdk.Context, rateLimit types.RateLimit, transferWindow types.TransferWindow, asset sdk.Coin) error {
+	used := transferWindow.Used(rateLimit, ctx.BlockTime()).Add(asset.Amount)
+
+	if used.GT(rateLimit.Limit.Amount) {
+		source := fmt.Sprintf("chain %s", rateLimit.Chain)
+		if transferWindow.Address != "" {
+			source = fmt.Sprintf("address %s on chain %s", transferWindow.Address, rateLimit.Chain)
+		}
+
+		err := fmt.Errorf("transfer %s for %s (%s) exceeded rate limit %s (%s) with transfer rate %s", asset, source, transferWindow.Direction, rateLimit.Limit, rateLimit.Mode, sdk.NewCoin(asset.Denom, used))
+		k.Logger(ctx).Error(err.Error(),
+			types.AttributeKeyChain, rateLimit.Chain,
+			types.AttributeKeyAsset, asset,
+			types.AttributeKeyLimit, rateLimit.Limit,
+			types.AttributeKeyTransferEpoch, used,
+		)
+		return sdkerrors.Wrap(types.ErrRateLimitExceeded, err.Error())
+	}
+
+	k.setTransferWindow(ctx, transferWindow.Add(rateLimit, ctx.BlockTime(), asset.Amount))
+
+	return nil
+}
+
+// SetRateLimit sets a rate limit for the given chain and asset, which resets the tracked transfers.
 // If max uint256 is provided as a limit, it's treated as a rate limit being infinite/not being set.
-func (k Keeper) SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limit sdk.Coin, window time.Duration) error {
+func (k Keeper) SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limit sdk.Coin, window time.Duration, mode types.RateLimitMode) error {
 	chain, ok := k.GetChain(ctx, chainName)
 	if !ok {
 		return fmt.Errorf("%s is not a registered chain", chainName)
@@ -60,8 +102,12 @@ func (k Keeper) SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limi
 		Chain:  chain.Name,
 		Limit:  limit,
 		Window: window,
+		Mode:   mode,
 	})
 
+	k.deleteTransferWindow(ctx, chain.Name, limit.Denom, exported.Incoming)
+	k.deleteTransferWindow(ctx, chain.Name, limit.Denom, exported.Outgoing)
+
 	// delete any rate limit info if provided limit is max uint256
 	if limit.Amount.Equal(sdk.NewIntFromBigInt(utils.MaxUint.BigInt())) {
 		k.getStore(ctx).DeleteNew(getRateLimitKey(chain.Name, limit.Denom))
@@ -74,6 +120,7 @@ func (k Keeper) SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limi
 		Chain:  chain.Name,
 		Limit:  limit,
 		Window: window,
+		Mode:   mode,
 	}); err != nil {
 		return err
 	}
@@ -83,7 +130,138 @@ func (k Keeper) SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limi
 	k.setTransferEpoch(ctx, types.NewTransferEpoch(chain.Name, limit.Denom, epoch, exported.Incoming))
 	k.setTransferEpoch(ctx, types.NewTransferEpoch(chain.Name, limit.Denom, epoch, exported.Outgoing))
 
-	k.Logger(ctx).Info(fmt.Sprintf("transfer rate limit %s set for chain %s with window %s", chain.Name, limit, window))
+	k.Logger(ctx).Info(fmt.Sprintf("transfer rate limit %s set for chain %s with window %s (%s)", limit, chain.Name, window, mode))
+
+	return nil
+}
+
+// SetAddressRateLimit sets a rate limit for the transfers of an asset from and to the given address, which resets the tracked transfers.
+// If max uint256 is provided as a limit, it's treated as a rate limit being infinite/not being set.
+func (k Keeper) SetAddressRateLimit(ctx sdk.Context, address exported.CrossChainAddress, limit sdk.Coin, window time.Duration, mode types.RateLimitMode) error {
+	chain, ok := k.GetChain(ctx, address.Chain.Name)
+	if !ok {
+		return fmt.Errorf("%s is not a registered chain", address.Chain.Name)
+	}
+
+	if !k.IsAssetRegistered(ctx, chain, limit.Denom) {
+		return fmt.Errorf("%s is not a registered asset for chain %s", limit.Denom, chain.Name)
+	}
+
+	address.Chain = chain
+	if err := k.ValidateAddress(ctx, address); err != nil {
+		return err
+	}
+
+	events.Emit(ctx, &types.AddressRateLimitUpdated{
+		Chain:   chain.Name,
+		Address: address.Address,
+		Limit:   limit,
+		Window:  window,
+		Mode:    mode,
+	})
+
+	k.deleteAddressTransferWindow(ctx, chain.Name, address.Address, limit.Denom, exported.Incoming)
+	k.deleteAddressTransferWindow(ctx, chain.Name, address.Address, limit.Denom, exported.Outgoing)
+
+	// delete any rate limit info if provided limit is max uint256
+	if limit.Amount.Equal(sdk.NewIntFromBigInt(utils.MaxUint.BigInt())) {
+		k.getStore(ctx).DeleteNew(getAddressRateLimitKey(chain.Name, address.Address, limit.Denom))
+		return nil
+	}
+
+	addressRateLimit := types.NewAddressRateLimit(address, limit, window, mode)
+	if err := k.getStore(ctx).SetNewValidated(getAddressRateLimitKey(chain.Name, address.Address, limit.Denom), &addressRateLimit); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("transfer rate limit %s set for address %s on chain %s with window %s (%s)", limit, address.Address, chain.Name, window, mode))
+
+	return nil
+}
+
+// SetMessageRateLimit sets a rate limit on the general messages routed to the given chain, or sent from the given address of the chain
+// if the address is not empty, which resets the tracked messages.
+// If max uint64 is provided as a limit, it's treated as a rate limit being infinite/not being set.
+func (k Keeper) SetMessageRateLimit(ctx sdk.Context, chainName exported.ChainName, address string, limit uint64, window time.Duration, mode types.RateLimitMode) error {
+	chain, ok := k.GetChain(ctx, chainName)
+	if !ok {
+		return fmt.Errorf("%s is not a registered chain", chainName)
+	}
+
+	// the nexus module doesn't know how to validate wasm addresses
+	if address != "" && !chain.IsFrom(wasm.ModuleName) {
+		if err := k.ValidateAddress(ctx, exported.CrossChainAddress{Chain: chain, Address: address}); err != nil {
+			return err
+		}
+	}
+
+	events.Emit(ctx, &types.MessageRateLimitUpdated{
+		Chain:   chain.Name,
+		Address: address,
+		Limit:   limit,
+		Window:  window,
+		Mode:    mode,
+	})
+
+	k.deleteMessageWindow(ctx, chain.Name, address)
+
+	// delete any rate limit info if provided limit is max uint64
+	if limit == math.MaxUint64 {
+		k.getStore(ctx).DeleteNew(getMessageRateLimitKey(chain.Name, address))
+		return nil
+	}
+
+	messageRateLimit := types.NewMessageRateLimit(chain.Name, address, limit, window, mode)
+	if err := k.getStore(ctx).SetNewValidated(getMessageRateLimitKey(chain.Name, address), &messageRateLimit); err != nil {
+		return err
+	}
+
+	if address == "" {
+		k.Logger(ctx).Info(fmt.Sprintf("message rate limit %d set for chain %s with window %s (%s)", limit, chain.Name, window, mode))
+	} else {
+		k.Logger(ctx).Info(fmt.Sprintf("message rate limit %d set for address %s on chain %s with window %s (%s)", limit, address, chain.Name, window, mode))
+	}
+
+	return nil
+}
+
+// rateLimitMessage applies the rate limits on the general messages routed to the message's destination chain
+// and on the ones sent from the message's source address, and returns an error if any of them is exceeded
+func (k Keeper) rateLimitMessage(ctx sdk.Context, msg exported.GeneralMessage) error {
+	var rateLimits []types.MessageRateLimit
+	if rateLimit, found := k.getMessageRateLimit(ctx, msg.GetDestinationChain(), ""); found {
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	if rateLimit, found := k.getMessageRateLimit(ctx, msg.GetSourceChain(), msg.GetSourceAddress()); found {
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	// check all rate limits before counting the message against any of them
+	for _, rateLimit := range rateLimits {
+		count := k.getMessageWindowOrNew(ctx, rateLimit.Chain, rateLimit.Address).Used(rateLimit.AsRateLimit(), ctx.BlockTime()).AddRaw(1)
+		if count.LTE(sdk.NewIntFromUint64(rateLimit.Limit)) {
+			continue
+		}
+
+		source := fmt.Sprintf("to chain %s", rateLimit.Chain)
+		if rateLimit.Address != "" {
+			source = fmt.Sprintf("from address %s on chain %s", rateLimit.Address, rateLimit.Chain)
+		}
+
+		err := fmt.Errorf("general message %s %s exceeded rate limit %d (%s) with message count %s", msg.ID, source, rateLimit.Limit, rateLimit.Mode, count)
+		k.Logger(ctx).Error(err.Error(),
+			types.AttributeKeyChain, rateLimit.Chain,
+			types.AttributeKeyLimit, rateLimit.Limit,
+			"messageID", msg.ID,
+		)
+		return sdkerrors.Wrap(types.ErrRateLimitExceeded, err.Error())
+	}
+
+	for _, rateLimit := range rateLimits {
+		messageWindow := k.getMessageWindowOrNew(ctx, rateLimit.Chain, rateLimit.Address)
+		k.setMessageWindow(ctx, messageWindow.Add(rateLimit.AsRateLimit(), ctx.BlockTime(), sdk.OneInt()))
+	}
 
 	return nil
 }
@@ -112,6 +290,31 @@ func (k Keeper) getRateLimits(ctx sdk.Context) (rateLimits []types.RateLimit) {
 	return rateLimits
 }
 
+func getAddressRateLimitKey(chain exported.ChainName, address string, asset string) key.Key {
+	return addressRateLimitPrefix.
+		Append(key.From(chain)).
+		Append(key.FromStr(address)).
+		Append(key.FromStr(asset))
+}
+
+func (k Keeper) getAddressRateLimit(ctx sdk.Context, chain exported.ChainName, address string, asset string) (addressRateLimit types.AddressRateLimit, found bool) {
+	return addressRateLimit, k.getStore(ctx).GetNew(getAddressRateLimitKey(chain, address, asset), &addressRateLimit)
+}
+
+func (k Keeper) getAddressRateLimits(ctx sdk.Context) (addressRateLimits []types.AddressRateLimit) {
+	iter := k.getStore(ctx).IteratorNew(addressRateLimitPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var addressRateLimit types.AddressRateLimit
+		iter.UnmarshalValue(&addressRateLimit)
+
+		addressRateLimits = append(addressRateLimits, addressRateLimit)
+	}
+
+	return addressRateLimits
+}
+
 func getTransferEpochKey(chain exported.ChainName, asset string, direction exported.TransferDirection) key.Key {
 	return transferEpochPrefix.
 		Append(key.From(chain)).
@@ -158,3 +361,162 @@ func (k Keeper) getTransferEpochs(ctx sdk.Context) (transferEpochs []types.Trans
 
 	return transferEpochs
 }
+
+func getTransferWindowKey(chain exported.ChainName, asset string, direction exported.TransferDirection) key.Key {
+	return transferWindowPrefix.
+		Append(key.From(chain)).
+		Append(key.FromStr(asset)).
+		Append(key.FromUInt(uint(direction)))
+}
+
+func (k Keeper) getTransferWindow(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection) (transferWindow types.TransferWindow, found bool) {
+	return transferWindow, k.getStore(ctx).GetNew(getTransferWindowKey(chain, asset, direction), &transferWindow)
+}
+
+func (k Keeper) getTransferWindowOrNew(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection) types.TransferWindow {
+	if transferWindow, found := k.getTransferWindow(ctx, chain, asset, direction); found {
+		return transferWindow
+	}
+
+	return types.NewTransferWindow(chain, asset, direction)
+}
+
+func getAddressTransferWindowKey(chain exported.ChainName, address string, asset string, direction exported.TransferDirection) key.Key {
+	return addressTransferWindowPrefix.
+		Append(key.From(chain)).
+		Append(key.FromStr(address)).
+		Append(key.FromStr(asset)).
+		Append(key.FromUInt(uint(direction)))
+}
+
+func (k Keeper) getAddressTransferWindow(ctx sdk.Context, chain exported.ChainName, address string, asset string, direction exported.TransferDirection) (transferWindow types.TransferWindow, found bool) {
+	return transferWindow, k.getStore(ctx).GetNew(getAddressTransferWindowKey(chain, address, asset, direction), &transferWindow)
+}
+
+func (k Keeper) getAddressTransferWindowOrNew(ctx sdk.Context, address exported.CrossChainAddress, asset string, direction exported.TransferDirection) types.TransferWindow {
+	if transferWindow, found := k.getAddressTransferWindow(ctx, address.Chain.Name, address.Address, asset, direction); found {
+		return transferWindow
+	}
+
+	return types.NewAddressTransferWindow(address, asset, direction)
+}
+
+func (k Keeper) deleteAddressTransferWindow(ctx sdk.Context, chain exported.ChainName, address string, asset string, direction exported.TransferDirection) {
+	k.getStore(ctx).DeleteNew(getAddressTransferWindowKey(chain, address, asset, direction))
+}
+
+// setTransferWindow stores the transfer window of a chain, or of a single address if the window has one
+func (k Keeper) setTransferWindow(ctx sdk.Context, transferWindow types.TransferWindow) {
+	transferWindowKey := getTransferWindowKey(transferWindow.Chain, transferWindow.Asset, transferWindow.Direction)
+	if transferWindow.Address != "" {
+		transferWindowKey = getAddressTransferWindowKey(transferWindow.Chain, transferWindow.Address, transferWindow.Asset, transferWindow.Direction)
+	}
+
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(transferWindowKey, &transferWindow))
+}
+
+func (k Keeper) deleteTransferWindow(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection) {
+	k.getStore(ctx).DeleteNew(getTransferWindowKey(chain, asset, direction))
+}
+
+// getTransferWindows returns the transfer windows of all chains and addresses
+func (k Keeper) getTransferWindows(ctx sdk.Context) (transferWindows []types.TransferWindow) {
+	for _, prefix := range []key.Key{transferWindowPrefix, addressTransferWindowPrefix} {
+		transferWindows = append(transferWindows, k.getTransferWindowsByPrefix(ctx, prefix)...)
+	}
+
+	return transferWindows
+}
+
+func (k Keeper) getTransferWindowsByPrefix(ctx sdk.Context, prefix key.Key) (transferWindows []types.TransferWindow) {
+	iter := k.getStore(ctx).IteratorNew(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var transferWindow types.TransferWindow
+		iter.UnmarshalValue(&transferWindow)
+
+		transferWindows = append(transferWindows, transferWindow)
+	}
+
+	return transferWindows
+}
+
+// getMessageRateLimitKey returns the key of the rate limit on the messages sent from the given address,
+// or of the one on the messages routed to the given chain if the address is empty.
+// Addresses are hashed because they are arbitrary strings of unbounded length
+func getMessageRateLimitKey(chain exported.ChainName, address string) key.Key {
+	if address == "" {
+		return chainMessageRateLimitPrefix.Append(key.From(chain))
+	}
+
+	return messageRateLimitPrefix.
+		Append(key.From(chain)).
+		Append(key.FromStrHashed(address))
+}
+
+func (k Keeper) getMessageRateLimit(ctx sdk.Context, chain exported.ChainName, address string) (messageRateLimit types.MessageRateLimit, found bool) {
+	return messageRateLimit, k.getStore(ctx).GetNew(getMessageRateLimitKey(chain, address), &messageRateLimit)
+}
+
+func (k Keeper) getMessageRateLimits(ctx sdk.Context) (messageRateLimits []types.MessageRateLimit) {
+	for _, prefix := range []key.Key{chainMessageRateLimitPrefix, messageRateLimitPrefix} {
+		messageRateLimits = append(messageRateLimits, k.getMessageRateLimitsByPrefix(ctx, prefix)...)
+	}
+
+	return messageRateLimits
+}
+
+func (k Keeper) getMessageRateLimitsByPrefix(ctx sdk.Context, prefix key.Key) (messageRateLimits []types.MessageRateLimit) {
+	iter := k.getStore(ctx).IteratorNew(prefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var messageRateLimit types.MessageRateLimit
+		iter.UnmarshalValue(&messageRateLimit)
+
+		messageRateLimits = append(messageRateLimits, messageRateLimit)
+	}
+
+	return messageRateLimits
+}
+
+// getMessageWindowKey returns the key of the window of the messages sent from the given address,
+// or of the one of the messages routed to the given chain if the address is empty
+func getMessageWindowKey(chain exported.ChainName, address string) key.Key {
+	if address == "" {
+		return chainMessageWindowPrefix.Append(key.From(chain))
+	}
+
+	return messageWindowPrefix.
+		Append(key.From(chain)).
+		Append(key.FromStrHashed(address))
+}
+
+func (k Keeper) getMessageWindow(ctx sdk.Context, chain exported.ChainName, address string) (messageWindow types.TransferWindow, found bool) {
+	return messageWindow, k.getStore(ctx).GetNew(getMessageWindowKey(chain, address), &messageWindow)
+}
+
+func (k Keeper) getMessageWindowOrNew(ctx sdk.Context, chain exported.ChainName, address string) types.TransferWindow {
+	if messageWindow, found := k.getMessageWindow(ctx, chain, address); found {
+		return messageWindow
+	}
+
+	return types.NewMessageWindow(chain, address)
+}
+
+func (k Keeper) setMessageWindow(ctx sdk.Context, messageWindow types.TransferWindow) {
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getMessageWindowKey(messageWindow.Chain, messageWindow.Address), &messageWindow))
+}
+
+func (k Keeper) deleteMessageWindow(ctx sdk.Context, chain exported.ChainName, address string) {
+	k.getStore(ctx).DeleteNew(getMessageWindowKey(chain, address))
+}
+
+func (k Keeper) getMessageWindows(ctx sdk.Context) (messageWindows []types.TransferWindow) {
+	for _, prefix := range []key.Key{chainMessageWindowPrefix, messageWindowPrefix} {
+		messageWindows = append(messageWindows, k.getTransferWindowsByPrefix(ctx, prefix)...)
+	}
+
+	return messageWindows
+}
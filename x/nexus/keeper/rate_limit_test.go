@@ -4,18 +4,22 @@ import (
 	"fmt"
 	"math"
 	mathrand "math/rand"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/utils"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexustestutils "github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/utils/funcs"
 	. "github.com/axelarnetwork/utils/test"
 )
@@ -45,7 +49,7 @@ func TestSetRateLimit(t *testing.T) {
 
 	setRateLimitFails := func(msg string) ThenStatement {
 		return Then(fmt.Sprintf("set rate limit will fail due to: %s", msg), func(t *testing.T) {
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.ErrorContains(t, err, msg)
 		})
 	}
@@ -101,19 +105,19 @@ func TestSetRateLimit(t *testing.T) {
 		}).
 		When2(whenAssetIsRegistered).
 		Then("set rate limit succeeds", func(t *testing.T) {
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 		}).
 		Then("set rate limit overwrite succeeds", func(t *testing.T) {
 			limit = sdk.NewInt64Coin(asset, mathrand.Int63())
 			window = rand.Duration()
 
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 		}).
 		Then("remove rate limit", func(t *testing.T) {
 			limit.Amount = sdk.Int(utils.MaxUint)
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 		}).
 		Run(t, repeated)
@@ -165,7 +169,7 @@ func TestRateLimitTransfer(t *testing.T) {
 			window = rand.Duration()
 			direction = nexustestutils.RandomDirection()
 
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 		}).
 		When("transfer amount is within rate limit", func() {
@@ -184,7 +188,7 @@ func TestRateLimitTransfer(t *testing.T) {
 			limit = sdk.NewInt64Coin(denom, mathrand.Int63())
 			window = rand.Duration()
 
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 		}).
 		When("transfer amount is exactly the rate limit", func() {
@@ -207,7 +211,7 @@ func TestRateLimitTransfer(t *testing.T) {
 		}).
 		Then("reset rate limit and rate limit transfer succeeds", func(t *testing.T) {
 			limit.Amount = sdk.Int(utils.MaxUint)
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 
 			err = k.RateLimitTransfer(ctx, chain, asset, direction)
@@ -222,7 +226,7 @@ func TestRateLimitTransfer(t *testing.T) {
 			window = rand.Duration()
 			direction = nexustestutils.RandomDirection()
 
-			err := k.SetRateLimit(ctx, chain, limit, window)
+			err := k.SetRateLimit(ctx, chain, limit, window, types.FixedWindow)
 			assert.NoError(t, err)
 		}).
 		When("transfer amount is above the rate limit", func() {
@@ -239,4 +243,272 @@ func TestRateLimitTransfer(t *testing.T) {
 			assert.NoError(t, err)
 		}).
 		Run(t)
+
+	givenKeeper.
+		When2(whenAssetIsRegistered).
+		When("a sliding window rate limit is set", func() {
+			limit = sdk.NewInt64Coin(denom, 1000)
+			window = time.Hour
+			ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(window - time.Second))
+
+			err := k.SetRateLimit(ctx, chain, limit, window, types.SlidingWindow)
+			assert.NoError(t, err)
+		}).
+		When("the limit is transferred right before the end of a fixed window", func() {
+			assert.NoError(t, k.RateLimitTransfer(ctx, chain, limit, exported.Incoming))
+		}).
+		Then("rate limit transfer fails after the end of the fixed window", func(t *testing.T) {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Second))
+			err := k.RateLimitTransfer(ctx, chain, sdk.NewInt64Coin(denom, 1), exported.Incoming)
+			assert.ErrorContains(t, err, "exceeded rate limit")
+		}).
+		Then("rate limit transfer succeeds in the other direction", func(t *testing.T) {
+			assert.NoError(t, k.RateLimitTransfer(ctx, chain, limit, exported.Outgoing))
+		}).
+		Then("rate limit transfer succeeds once the transfer left the sliding window", func(t *testing.T) {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window + window/types.SlidingWindowBuckets))
+			assert.NoError(t, k.RateLimitTransfer(ctx, chain, limit, exported.Incoming))
+		}).
+		Run(t)
+
+	givenKeeper.
+		When2(whenAssetIsRegistered).
+		When("a token bucket rate limit is set", func() {
+			limit = sdk.NewInt64Coin(denom, 1000)
+			window = time.Hour
+			ctx = ctx.WithBlockTime(time.Unix(rand.I64Between(0, math.MaxInt32), 0))
+
+			err := k.SetRateLimit(ctx, chain, limit, window, types.TokenBucket)
+			assert.NoError(t, err)
+		}).
+		When("the limit is transferred", func() {
+			assert.NoError(t, k.RateLimitTransfer(ctx, chain, limit, exported.Outgoing))
+		}).
+		Then("rate limit transfer fails until capacity is refilled", func(t *testing.T) {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window / 4))
+			err := k.RateLimitTransfer(ctx, chain, sdk.NewInt64Coin(denom, 251), exported.Outgoing)
+			assert.ErrorContains(t, err, "exceeded rate limit")
+
+			assert.NoError(t, k.RateLimitTransfer(ctx, chain, sdk.NewInt64Coin(denom, 250), exported.Outgoing))
+		}).
+		Then("rate limit transfer succeeds once capacity is fully refilled", func(t *testing.T) {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window))
+			assert.NoError(t, k.RateLimitTransfer(ctx, chain, limit, exported.Outgoing))
+		}).
+		Run(t)
+}
+
+func TestRateLimitAddressTransfer(t *testing.T) {
+	cfg := app.MakeEncodingConfig()
+
+	var (
+		k       nexusKeeper.Keeper
+		ctx     sdk.Context
+		address exported.CrossChainAddress
+		denom   string
+		limit   sdk.Coin
+		window  time.Duration
+	)
+
+	givenKeeper := Given("a keeper", func() {
+		k, ctx = setup(cfg)
+		ctx = ctx.WithBlockTime(time.Unix(rand.I64Between(0, math.MaxInt32), 0))
+	})
+
+	whenAssetIsRegistered := When("asset is registered", func() {
+		chain := funcs.MustOk(k.GetChain(ctx, evm.Ethereum.Name))
+		address = exported.CrossChainAddress{Chain: chain, Address: genEvmAddr()}
+		denom = rand.Denom(3, 20)
+		funcs.MustNoErr(k.RegisterAsset(ctx, chain, exported.NewAsset(denom, false), utils.MaxUint, time.Hour))
+	})
+
+	givenKeeper.
+		When2(whenAssetIsRegistered).
+		When("the address is invalid", func() {
+			address.Address = rand.StrBetween(1, 20)
+		}).
+		Then("set address rate limit fails", func(t *testing.T) {
+			err := k.SetAddressRateLimit(ctx, address, sdk.NewInt64Coin(denom, 1000), time.Hour, types.FixedWindow)
+			assert.Error(t, err)
+		}).
+		Run(t)
+
+	givenKeeper.
+		When2(whenAssetIsRegistered).
+		When("a fixed window address rate limit is set", func() {
+			limit = sdk.NewInt64Coin(denom, 1000)
+			window = time.Hour
+
+			funcs.MustNoErr(k.SetAddressRateLimit(ctx, address, limit, window, types.FixedWindow))
+		}).
+		When("the limit is transferred from the address", func() {
+			assert.NoError(t, k.RateLimitAddressTransfer(ctx, address, limit, exported.Incoming))
+		}).
+		Then("rate limit transfer fails for the address regardless of its case", func(t *testing.T) {
+			err := k.RateLimitAddressTransfer(ctx, address, sdk.NewInt64Coin(denom, 1), exported.Incoming)
+			assert.ErrorContains(t, err, "exceeded rate limit")
+
+			lowercase := exported.CrossChainAddress{Chain: address.Chain, Address: strings.ToLower(address.Address)}
+			err = k.RateLimitAddressTransfer(ctx, lowercase, sdk.NewInt64Coin(denom, 1), exported.Incoming)
+			assert.ErrorContains(t, err, "exceeded rate limit")
+		}).
+		Then("rate limit transfer succeeds to the address and for other addresses", func(t *testing.T) {
+			assert.NoError(t, k.RateLimitAddressTransfer(ctx, address, limit, exported.Outgoing))
+
+			other := exported.CrossChainAddress{Chain: address.Chain, Address: genEvmAddr()}
+			assert.NoError(t, k.RateLimitAddressTransfer(ctx, other, limit, exported.Incoming))
+		}).
+		Then("rate limit transfer succeeds in the next window", func(t *testing.T) {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window))
+			assert.NoError(t, k.RateLimitAddressTransfer(ctx, address, limit, exported.Incoming))
+		}).
+		Then("rate limit transfer succeeds after the rate limit is removed", func(t *testing.T) {
+			limit.Amount = sdk.Int(utils.MaxUint)
+			funcs.MustNoErr(k.SetAddressRateLimit(ctx, address, limit, window, types.FixedWindow))
+
+			assert.NoError(t, k.RateLimitAddressTransfer(ctx, address, sdk.NewInt64Coin(denom, 1), exported.Incoming))
+		}).
+		Run(t)
+
+	givenKeeper.
+		When2(whenAssetIsRegistered).
+		When("a rate limit is set for the sender", func() {
+			funcs.MustNoErr(k.SetAddressRateLimit(ctx, address, sdk.NewInt64Coin(denom, 1), time.Hour, types.TokenBucket))
+		}).
+		Then("enqueue transfer fails", func(t *testing.T) {
+			_, recipient := makeRandAddressesForChain(address.Chain, address.Chain)
+			_, err := k.EnqueueTransfer(ctx, address, recipient, sdk.NewInt64Coin(denom, 2))
+			assert.ErrorContains(t, err, "exceeded rate limit")
+		}).
+		Run(t)
+}
+
+func TestRateLimitMessage(t *testing.T) {
+	cfg := app.MakeEncodingConfig()
+
+	var (
+		k                nexusKeeper.Keeper
+		ctx              sdk.Context
+		sourceChain      exported.Chain
+		destinationChain exported.Chain
+		sender           exported.CrossChainAddress
+		window           time.Duration
+	)
+
+	newMessage := func(sender exported.CrossChainAddress) exported.GeneralMessage {
+		id, txID, txIndex := k.GenerateMessageID(ctx)
+		recipient := exported.CrossChainAddress{Chain: destinationChain, Address: genEvmAddr()}
+		msg := exported.NewGeneralMessage(id, sender, recipient, rand.Bytes(32), txID, txIndex, nil)
+		funcs.MustNoErr(k.SetNewMessage(ctx, msg))
+
+		return msg
+	}
+
+	givenKeeper := Given("a keeper with two chains", func() {
+		k, ctx = setup(cfg)
+		ctx = ctx.WithBlockTime(time.Unix(rand.I64Between(0, math.MaxInt32), 0))
+
+		sourceChain = nexustestutils.RandomChain()
+		sourceChain.Module = evm.Ethereum.Module
+		destinationChain = nexustestutils.RandomChain()
+		destinationChain.Module = evm.Ethereum.Module
+		for _, chain := range []exported.Chain{sourceChain, destinationChain} {
+			k.SetChain(ctx, chain)
+			k.ActivateChain(ctx, chain)
+		}
+
+		k.SetMessageRouter(types.NewMessageRouter().AddRoute(evm.Ethereum.Module, func(sdk.Context, exported.RoutingContext, exported.GeneralMessage) error {
+			return nil
+		}))
+
+		sender = exported.CrossChainAddress{Chain: sourceChain, Address: genEvmAddr()}
+		window = time.Hour
+	})
+
+	givenKeeper.
+		When("the sender is invalid", func() {
+			sender.Address = rand.StrBetween(1, 20)
+		}).
+		Then("setting a message rate limit fails", func(t *testing.T) {
+			assert.Error(t, k.SetMessageRateLimit(ctx, sourceChain.Name, sender.Address, 1, window, types.FixedWindow))
+			assert.Error(t, k.SetMessageRateLimit(ctx, nexustestutils.RandomChainName(), "", 1, window, types.FixedWindow))
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("a fixed window rate limit is set for the destination chain", func() {
+			funcs.MustNoErr(k.SetMessageRateLimit(ctx, destinationChain.Name, "", 2, window, types.FixedWindow))
+		}).
+		When("the limit of messages is routed", func() {
+			ctx = ctx.WithBlockTime(time.Unix(ctx.BlockTime().Unix()/int64(window.Seconds())*int64(window.Seconds()), 0))
+
+			for range [2]int{} {
+				assert.NoError(t, k.RouteMessage(ctx, newMessage(sender).ID))
+			}
+		}).
+		Then("routing another message fails and leaves it approved until the next window", func(t *testing.T) {
+			msg := newMessage(exported.CrossChainAddress{Chain: sourceChain, Address: genEvmAddr()})
+			assert.ErrorContains(t, k.RouteMessage(ctx, msg.ID), "exceeded rate limit")
+			assert.True(t, funcs.MustOk(k.GetMessage(ctx, msg.ID)).Is(exported.Approved))
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window))
+			assert.NoError(t, k.RouteMessage(ctx, msg.ID))
+		}).
+		Then("routing messages succeeds after the rate limit is removed", func(t *testing.T) {
+			funcs.MustNoErr(k.SetMessageRateLimit(ctx, destinationChain.Name, "", math.MaxUint64, window, types.FixedWindow))
+
+			for range [3]int{} {
+				assert.NoError(t, k.RouteMessage(ctx, newMessage(sender).ID))
+			}
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("a sliding window rate limit is set for the sender", func() {
+			funcs.MustNoErr(k.SetMessageRateLimit(ctx, sourceChain.Name, sender.Address, 1, window, types.SlidingWindow))
+		}).
+		When("a message is routed from the sender", func() {
+			assert.NoError(t, k.RouteMessage(ctx, newMessage(sender).ID))
+		}).
+		Then("routing another message from the sender fails regardless of its case", func(t *testing.T) {
+			assert.ErrorContains(t, k.RouteMessage(ctx, newMessage(sender).ID), "exceeded rate limit")
+
+			lowercase := exported.CrossChainAddress{Chain: sourceChain, Address: strings.ToLower(sender.Address)}
+			assert.ErrorContains(t, k.RouteMessage(ctx, newMessage(lowercase).ID), "exceeded rate limit")
+		}).
+		Then("routing a message from another sender succeeds", func(t *testing.T) {
+			other := exported.CrossChainAddress{Chain: sourceChain, Address: genEvmAddr()}
+			assert.NoError(t, k.RouteMessage(ctx, newMessage(other).ID))
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("rate limits are set for the destination chain and the sender", func() {
+			funcs.MustNoErr(k.SetMessageRateLimit(ctx, destinationChain.Name, "", 1, window, types.TokenBucket))
+			funcs.MustNoErr(k.SetMessageRateLimit(ctx, sourceChain.Name, sender.Address, 0, window, types.TokenBucket))
+		}).
+		Then("a message rejected by the sender's rate limit does not count against the destination chain's", func(t *testing.T) {
+			assert.ErrorContains(t, k.RouteMessage(ctx, newMessage(sender).ID), "exceeded rate limit")
+
+			other := exported.CrossChainAddress{Chain: sourceChain, Address: genEvmAddr()}
+			assert.NoError(t, k.RouteMessage(ctx, newMessage(other).ID))
+			assert.ErrorContains(t, k.RouteMessage(ctx, newMessage(other).ID), "exceeded rate limit")
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("a rate limit is set for a long sender on a wasm chain", func() {
+			wasmChain := nexustestutils.RandomChain()
+			wasmChain.Module = wasm.ModuleName
+			k.SetChain(ctx, wasmChain)
+			k.ActivateChain(ctx, wasmChain)
+
+			sender = exported.CrossChainAddress{Chain: wasmChain, Address: rand.StrBetween(200, 300)}
+			funcs.MustNoErr(k.SetMessageRateLimit(ctx, wasmChain.Name, sender.Address, 1, window, types.FixedWindow))
+		}).
+		Then("routing messages from the sender is rate limited", func(t *testing.T) {
+			assert.NoError(t, k.RouteMessage(ctx, newMessage(sender).ID))
+			assert.ErrorContains(t, k.RouteMessage(ctx, newMessage(sender).ID), "exceeded rate limit")
+		}).
+		Run(t)
 }
@@ -98,8 +98,9 @@ func (k Keeper) ComputeTransferFee(ctx sdk.Context, sourceChain exported.Chain,
 	return sdk.NewCoin(asset.Denom, fee), nil
 }
 
-// EnqueueTransfer enqueues an asset transfer to the given recipient address
-func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, recipient exported.CrossChainAddress, asset sdk.Coin) (exported.TransferID, error) {
+// EnqueueTransfer enqueues an asset transfer from the given sender to the given recipient address
+func (k Keeper) EnqueueTransfer(ctx sdk.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress, asset sdk.Coin) (exported.TransferID, error) {
+	senderChain := sender.Chain
 	if err := k.validateAsset(ctx, senderChain, asset.Denom); err != nil {
 		return 0, err
 	}
@@ -116,6 +117,10 @@ func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, rec
 		return 0, err
 	}
 
+	if err := k.RateLimitAddressTransfer(ctx, sender, asset, exported.Incoming); err != nil {
+		return 0, err
+	}
+
 	// merging transfers below minimum for the specified recipient
 	insufficientAmountTransfer, found := k.getTransfer(ctx, recipient, asset.Denom, exported.InsufficientAmount)
 	if found {
@@ -155,6 +160,10 @@ func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, rec
 		return 0, err
 	}
 
+	if err := k.RateLimitAddressTransfer(ctx, recipient, asset, exported.Outgoing); err != nil {
+		return 0, err
+	}
+
 	// merging transfers for the specified recipient
 	previousTransfer, found := k.getTransfer(ctx, recipient, asset.Denom, exported.Pending)
 	if found {
@@ -201,7 +210,7 @@ func (k Keeper) EnqueueForTransfer(ctx sdk.Context, sender exported.CrossChainAd
 		return 0, fmt.Errorf("no recipient linked to sender %s", sender.String())
 	}
 
-	return k.EnqueueTransfer(ctx, sender.Chain, recipient, asset)
+	return k.EnqueueTransfer(ctx, sender, recipient, asset)
 }
 
 func (k Keeper) getTransfer(ctx sdk.Context, recipient exported.CrossChainAddress, denom string, state exported.TransferState) (exported.CrossChainTransfer, bool) {
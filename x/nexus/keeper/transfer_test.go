@@ -187,7 +187,7 @@ func TestTransfer(t *testing.T) {
 	validateTransferAssetFails := Then("validate transfer asset fails",
 		func(t *testing.T) {
 			sender, recipient = makeRandAddressesForChain(source, dest)
-			_, err := k.EnqueueTransfer(ctx, sender.Chain, recipient, makeRandAmount(asset))
+			_, err := k.EnqueueTransfer(ctx, sender, recipient, makeRandAmount(asset))
 			assert.ErrorContains(t, err, "does not support foreign asset")
 		},
 	)
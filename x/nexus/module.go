@@ -88,10 +88,11 @@ type AppModule struct {
 	staking     types.StakingKeeper
 	axelarnet   types.AxelarnetKeeper
 	reward      types.RewardKeeper
+	permission  types.PermissionKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k keeper.Keeper, snapshotter types.Snapshotter, slashing types.SlashingKeeper, staking types.StakingKeeper, axelarnet types.AxelarnetKeeper, reward types.RewardKeeper) AppModule {
+func NewAppModule(k keeper.Keeper, snapshotter types.Snapshotter, slashing types.SlashingKeeper, staking types.StakingKeeper, axelarnet types.AxelarnetKeeper, reward types.RewardKeeper, permission types.PermissionKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
@@ -100,6 +101,7 @@ func NewAppModule(k keeper.Keeper, snapshotter types.Snapshotter, slashing types
 		staking:        staking,
 		axelarnet:      axelarnet,
 		reward:         reward,
+		permission:     permission,
 	}
 }
 
@@ -148,7 +150,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 // Route returns the module's route
 // Deprecated
 func (am AppModule) Route() sdk.Route {
-	return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper, am.snapshotter, am.slashing, am.staking, am.axelarnet))
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper, am.snapshotter, am.slashing, am.staking, am.axelarnet, am.permission))
 }
 
 // QuerierRoute returns this module's query route
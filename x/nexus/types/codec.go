@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&DeactivateChainRequest{}, "nexus/DeactivateChain", nil)
 	cdc.RegisterConcrete(&RegisterAssetFeeRequest{}, "nexus/RegisterAssetFee", nil)
 	cdc.RegisterConcrete(&SetTransferRateLimitRequest{}, "nexus/SetTransferRateLimit", nil)
+	cdc.RegisterConcrete(&SetAddressRateLimitRequest{}, "nexus/SetAddressRateLimit", nil)
+	cdc.RegisterConcrete(&SetMessageRateLimitRequest{}, "nexus/SetMessageRateLimit", nil)
+	cdc.RegisterConcrete(&RetryFailedMessageRequest{}, "nexus/RetryFailedMessage", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -26,6 +29,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&DeactivateChainRequest{},
 		&RegisterAssetFeeRequest{},
 		&SetTransferRateLimitRequest{},
+		&SetAddressRateLimitRequest{},
+		&SetMessageRateLimitRequest{},
+		&RetryFailedMessageRequest{},
 	)
 }
 
@@ -194,6 +194,7 @@ type RateLimitUpdated struct {
 	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Limit  types.Coin                                                      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
 	Window time.Duration                                                   `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	Mode   RateLimitMode                                                   `protobuf:"varint,4,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
 }
 
 func (m *RateLimitUpdated) Reset()         { *m = RateLimitUpdated{} }
@@ -250,10 +251,177 @@ func (m *RateLimitUpdated) GetWindow() time.Duration {
 	return 0
 }
 
+func (m *RateLimitUpdated) GetMode() RateLimitMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FixedWindow
+}
+
 func (*RateLimitUpdated) XXX_MessageName() string {
 	return "axelar.nexus.v1beta1.RateLimitUpdated"
 }
 
+type AddressRateLimitUpdated struct {
+	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Address string                                                          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   types.Coin                                                      `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit"`
+	Window  time.Duration                                                   `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window"`
+	Mode    RateLimitMode                                                   `protobuf:"varint,5,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
+}
+
+func (m *AddressRateLimitUpdated) Reset()         { *m = AddressRateLimitUpdated{} }
+func (m *AddressRateLimitUpdated) String() string { return proto.CompactTextString(m) }
+func (*AddressRateLimitUpdated) ProtoMessage()    {}
+func (*AddressRateLimitUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{3}
+}
+func (m *AddressRateLimitUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRateLimitUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressRateLimitUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressRateLimitUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRateLimitUpdated.Merge(m, src)
+}
+func (m *AddressRateLimitUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRateLimitUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRateLimitUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRateLimitUpdated proto.InternalMessageInfo
+
+func (m *AddressRateLimitUpdated) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *AddressRateLimitUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressRateLimitUpdated) GetLimit() types.Coin {
+	if m != nil {
+		return m.Limit
+	}
+	return types.Coin{}
+}
+
+func (m *AddressRateLimitUpdated) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *AddressRateLimitUpdated) GetMode() RateLimitMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FixedWindow
+}
+
+func (*AddressRateLimitUpdated) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.AddressRateLimitUpdated"
+}
+
+type MessageRateLimitUpdated struct {
+	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Address string                                                          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   uint64                                                          `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Window  time.Duration                                                   `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window"`
+	Mode    RateLimitMode                                                   `protobuf:"varint,5,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
+}
+
+func (m *MessageRateLimitUpdated) Reset()         { *m = MessageRateLimitUpdated{} }
+func (m *MessageRateLimitUpdated) String() string { return proto.CompactTextString(m) }
+func (*MessageRateLimitUpdated) ProtoMessage()    {}
+func (*MessageRateLimitUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{4}
+}
+func (m *MessageRateLimitUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRateLimitUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRateLimitUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRateLimitUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRateLimitUpdated.Merge(m, src)
+}
+func (m *MessageRateLimitUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRateLimitUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRateLimitUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRateLimitUpdated proto.InternalMessageInfo
+
+func (m *MessageRateLimitUpdated) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *MessageRateLimitUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MessageRateLimitUpdated) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *MessageRateLimitUpdated) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *MessageRateLimitUpdated) GetMode() RateLimitMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FixedWindow
+}
+
+func (*MessageRateLimitUpdated) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.MessageRateLimitUpdated"
+}
+
 type MessageReceived struct {
 	ID          string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	PayloadHash []byte                     `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
@@ -265,7 +433,7 @@ func (m *MessageReceived) Reset()         { *m = MessageReceived{} }
 func (m *MessageReceived) String() string { return proto.CompactTextString(m) }
 func (*MessageReceived) ProtoMessage()    {}
 func (*MessageReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{3}
+	return fileDescriptor_4433ea5171b09eb9, []int{5}
 }
 func (m *MessageReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +502,7 @@ func (m *MessageProcessing) Reset()         { *m = MessageProcessing{} }
 func (m *MessageProcessing) String() string { return proto.CompactTextString(m) }
 func (*MessageProcessing) ProtoMessage()    {}
 func (*MessageProcessing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{4}
+	return fileDescriptor_4433ea5171b09eb9, []int{6}
 }
 func (m *MessageProcessing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +550,7 @@ func (m *MessageExecuted) Reset()         { *m = MessageExecuted{} }
 func (m *MessageExecuted) String() string { return proto.CompactTextString(m) }
 func (*MessageExecuted) ProtoMessage()    {}
 func (*MessageExecuted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{5}
+	return fileDescriptor_4433ea5171b09eb9, []int{7}
 }
 func (m *MessageExecuted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +598,7 @@ func (m *MessageFailed) Reset()         { *m = MessageFailed{} }
 func (m *MessageFailed) String() string { return proto.CompactTextString(m) }
 func (*MessageFailed) ProtoMessage()    {}
 func (*MessageFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{6}
+	return fileDescriptor_4433ea5171b09eb9, []int{8}
 }
 func (m *MessageFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,6 +638,112 @@ func (*MessageFailed) XXX_MessageName() string {
 	return "axelar.nexus.v1beta1.MessageFailed"
 }
 
+type MessageRetried struct {
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Retries uint64 `protobuf:"varint,2,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (m *MessageRetried) Reset()         { *m = MessageRetried{} }
+func (m *MessageRetried) String() string { return proto.CompactTextString(m) }
+func (*MessageRetried) ProtoMessage()    {}
+func (*MessageRetried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{9}
+}
+func (m *MessageRetried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRetried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRetried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRetried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRetried.Merge(m, src)
+}
+func (m *MessageRetried) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRetried) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRetried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRetried proto.InternalMessageInfo
+
+func (m *MessageRetried) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *MessageRetried) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (*MessageRetried) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.MessageRetried"
+}
+
+// MessageArchived is emitted with the full message before a failed message is
+// pruned from state
+type MessageArchived struct {
+	Message exported.GeneralMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+}
+
+func (m *MessageArchived) Reset()         { *m = MessageArchived{} }
+func (m *MessageArchived) String() string { return proto.CompactTextString(m) }
+func (*MessageArchived) ProtoMessage()    {}
+func (*MessageArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{10}
+}
+func (m *MessageArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageArchived.Merge(m, src)
+}
+func (m *MessageArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageArchived proto.InternalMessageInfo
+
+func (m *MessageArchived) GetMessage() exported.GeneralMessage {
+	if m != nil {
+		return m.Message
+	}
+	return exported.GeneralMessage{}
+}
+
+func (*MessageArchived) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.MessageArchived"
+}
+
 type WasmMessageRouted struct {
 	Message exported.WasmMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 }
@@ -478,7 +752,7 @@ func (m *WasmMessageRouted) Reset()         { *m = WasmMessageRouted{} }
 func (m *WasmMessageRouted) String() string { return proto.CompactTextString(m) }
 func (*WasmMessageRouted) ProtoMessage()    {}
 func (*WasmMessageRouted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{7}
+	return fileDescriptor_4433ea5171b09eb9, []int{11}
 }
 func (m *WasmMessageRouted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,59 +795,70 @@ func init() {
 	proto.RegisterType((*FeeDeducted)(nil), "axelar.nexus.v1beta1.FeeDeducted")
 	proto.RegisterType((*InsufficientFee)(nil), "axelar.nexus.v1beta1.InsufficientFee")
 	proto.RegisterType((*RateLimitUpdated)(nil), "axelar.nexus.v1beta1.RateLimitUpdated")
+	proto.RegisterType((*AddressRateLimitUpdated)(nil), "axelar.nexus.v1beta1.AddressRateLimitUpdated")
+	proto.RegisterType((*MessageRateLimitUpdated)(nil), "axelar.nexus.v1beta1.MessageRateLimitUpdated")
 	proto.RegisterType((*MessageReceived)(nil), "axelar.nexus.v1beta1.MessageReceived")
 	proto.RegisterType((*MessageProcessing)(nil), "axelar.nexus.v1beta1.MessageProcessing")
 	proto.RegisterType((*MessageExecuted)(nil), "axelar.nexus.v1beta1.MessageExecuted")
 	proto.RegisterType((*MessageFailed)(nil), "axelar.nexus.v1beta1.MessageFailed")
+	proto.RegisterType((*MessageRetried)(nil), "axelar.nexus.v1beta1.MessageRetried")
+	proto.RegisterType((*MessageArchived)(nil), "axelar.nexus.v1beta1.MessageArchived")
 	proto.RegisterType((*WasmMessageRouted)(nil), "axelar.nexus.v1beta1.WasmMessageRouted")
 }
 
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/events.proto", fileDescriptor_4433ea5171b09eb9) }
 
 var fileDescriptor_4433ea5171b09eb9 = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xee, 0xb6, 0xd0, 0xdf, 0x8f, 0x29, 0x0a, 0x6c, 0x88, 0xa9, 0x1c, 0xb6, 0xd0, 0x8b, 0x20,
-	0x71, 0x57, 0x30, 0xc6, 0x83, 0x07, 0xb5, 0x54, 0x62, 0x8d, 0x12, 0xb2, 0xc1, 0x18, 0xbd, 0x34,
-	0xd3, 0x9d, 0xd7, 0xed, 0xc4, 0xee, 0x4c, 0x33, 0x33, 0x0b, 0xe5, 0x4f, 0xf0, 0x60, 0xe2, 0xd1,
-	0x3f, 0x89, 0x23, 0x47, 0x4f, 0x55, 0xdb, 0xff, 0xc0, 0x23, 0x27, 0xb3, 0xb3, 0xb3, 0x8b, 0x98,
-	0xa0, 0x48, 0x4c, 0xbc, 0x78, 0x9b, 0xbe, 0x7e, 0xef, 0x7b, 0xdf, 0xfb, 0xde, 0xeb, 0x2b, 0x5a,
-	0xc1, 0x43, 0xe8, 0x63, 0xe1, 0x31, 0x18, 0xc6, 0xd2, 0xdb, 0xdf, 0xe8, 0x80, 0xc2, 0x1b, 0x1e,
-	0xec, 0x03, 0x53, 0xd2, 0x1d, 0x08, 0xae, 0xb8, 0xbd, 0x98, 0x42, 0x5c, 0x0d, 0x71, 0x0d, 0x64,
-	0xc9, 0x09, 0x39, 0x0f, 0xfb, 0xe0, 0x69, 0x4c, 0x27, 0xee, 0x7a, 0x24, 0x16, 0x58, 0x51, 0xce,
-	0xd2, 0xac, 0xa5, 0xc5, 0x90, 0x87, 0x5c, 0x3f, 0xbd, 0xe4, 0x65, 0xa2, 0x4e, 0xc0, 0x65, 0xc4,
-	0xa5, 0xd7, 0xc1, 0x12, 0xf2, 0x6a, 0x01, 0xa7, 0x59, 0xd6, 0xda, 0x19, 0x39, 0x30, 0x1c, 0x70,
-	0xa1, 0x80, 0xe4, 0x48, 0x75, 0x38, 0x00, 0x23, 0xab, 0xfe, 0xb6, 0x84, 0x2a, 0xdb, 0x00, 0x4d,
-	0x20, 0x71, 0xa0, 0x80, 0xd8, 0x12, 0x55, 0x94, 0xc0, 0x4c, 0x76, 0x41, 0xb4, 0x29, 0xa9, 0x5a,
-	0xcb, 0xd6, 0xea, 0x54, 0xc3, 0x1f, 0x8f, 0x6a, 0x68, 0xcf, 0x84, 0x5b, 0xcd, 0x93, 0x51, 0xed,
-	0x61, 0x48, 0x55, 0x2f, 0xee, 0xb8, 0x01, 0x8f, 0xbc, 0xb4, 0x18, 0x03, 0x75, 0xc0, 0xc5, 0x1b,
-	0xf3, 0xe9, 0x56, 0xc0, 0x05, 0x78, 0xc3, 0x1f, 0x14, 0xb8, 0xa7, 0x1c, 0x3e, 0xca, 0xca, 0xb4,
-	0x88, 0xdd, 0x47, 0x73, 0x02, 0x02, 0x3a, 0xa0, 0xc0, 0x54, 0x3b, 0xe8, 0x61, 0xca, 0xaa, 0xc5,
-	0x65, 0x6b, 0x75, 0xa6, 0xb1, 0x75, 0x32, 0xaa, 0x3d, 0xb8, 0x5c, 0xa9, 0xad, 0x84, 0x66, 0x07,
-	0x47, 0xe0, 0x5f, 0xcd, 0xb9, 0x75, 0xcc, 0x5e, 0x47, 0x0b, 0xa7, 0xd5, 0x30, 0x21, 0x02, 0xa4,
-	0xac, 0x96, 0x92, 0x7a, 0xfe, 0x7c, 0xfe, 0xc5, 0xa3, 0x34, 0x6e, 0xdf, 0x43, 0x65, 0x1c, 0xf1,
-	0x98, 0xa9, 0xea, 0xd4, 0xb2, 0xb5, 0x5a, 0xd9, 0xbc, 0xee, 0xa6, 0xde, 0xbb, 0x89, 0xf7, 0xd9,
-	0x18, 0xdd, 0x2d, 0x4e, 0x59, 0x63, 0xea, 0x68, 0x54, 0x2b, 0xf8, 0x06, 0x6e, 0x6f, 0xa0, 0x52,
-	0x17, 0xa0, 0x3a, 0x7d, 0xb1, 0xac, 0x04, 0x5b, 0x7f, 0x57, 0x42, 0x73, 0x2d, 0x26, 0xe3, 0x6e,
-	0x97, 0x06, 0x89, 0x86, 0x6d, 0x80, 0x7f, 0xf3, 0xf8, 0x8b, 0xf3, 0xf8, 0x62, 0xa1, 0x79, 0x1f,
-	0x2b, 0x78, 0x46, 0x23, 0xaa, 0x5e, 0x0c, 0x08, 0x4e, 0x7e, 0x20, 0xaf, 0xd0, 0x74, 0xea, 0x88,
-	0xf5, 0xe7, 0x1c, 0x49, 0x19, 0xed, 0xbb, 0x68, 0xba, 0x9f, 0x94, 0xd2, 0x66, 0x5f, 0x40, 0x64,
-	0x8a, 0xb6, 0xef, 0xa3, 0xf2, 0x01, 0x65, 0x84, 0x1f, 0x68, 0xd3, 0x92, 0xbc, 0xf4, 0xa8, 0xb8,
-	0xd9, 0x51, 0x71, 0x9b, 0xe6, 0xa8, 0x34, 0xfe, 0x4f, 0xf2, 0x3e, 0x7c, 0xaa, 0x59, 0xbe, 0x49,
-	0xa9, 0x7f, 0xb5, 0xd0, 0xdc, 0x73, 0x90, 0x12, 0x87, 0xe0, 0x43, 0x00, 0x74, 0x1f, 0x88, 0x7d,
-	0x0d, 0x15, 0xcd, 0xaa, 0xcd, 0x34, 0xca, 0xe3, 0x51, 0xad, 0xd8, 0x6a, 0xfa, 0x45, 0x4a, 0xec,
-	0x15, 0x34, 0x3b, 0xc0, 0x87, 0x7d, 0x8e, 0x49, 0xbb, 0x87, 0x65, 0x4f, 0xcb, 0x9c, 0xf5, 0x2b,
-	0x26, 0xf6, 0x04, 0xcb, 0x9e, 0xbd, 0x83, 0xca, 0x12, 0x18, 0x01, 0x61, 0xb4, 0xdc, 0x76, 0xcf,
-	0x9c, 0xbd, 0xbc, 0xf7, 0xbc, 0x1b, 0xc1, 0xa5, 0xd4, 0x46, 0x98, 0x01, 0x67, 0x53, 0x4b, 0x59,
-	0xec, 0x3d, 0x34, 0x93, 0xaf, 0x80, 0x99, 0xf8, 0x65, 0x29, 0x4f, 0x89, 0xea, 0xeb, 0x68, 0xc1,
-	0xf4, 0xbc, 0x2b, 0x78, 0x00, 0x52, 0x52, 0x16, 0x9e, 0xd7, 0x75, 0x7d, 0x2d, 0x37, 0xe8, 0xf1,
-	0x10, 0x82, 0x58, 0x9d, 0x6f, 0x50, 0xfd, 0x06, 0xba, 0x62, 0xa0, 0xdb, 0x98, 0xf6, 0x7f, 0x02,
-	0x6c, 0xa3, 0x85, 0x97, 0x58, 0x46, 0x99, 0xf1, 0x5c, 0xb3, 0x3e, 0x45, 0xff, 0x45, 0x69, 0x40,
-	0x67, 0x54, 0x36, 0x6f, 0xfe, 0xa2, 0xd3, 0xef, 0x28, 0x4c, 0x8f, 0x19, 0x41, 0x63, 0xf7, 0x68,
-	0xec, 0x58, 0xc7, 0x63, 0xc7, 0xfa, 0x3c, 0x76, 0xac, 0xf7, 0x13, 0xa7, 0x70, 0x34, 0x71, 0xac,
-	0xe3, 0x89, 0x53, 0xf8, 0x38, 0x71, 0x0a, 0xaf, 0x37, 0x7f, 0x6b, 0x61, 0xf5, 0xdf, 0x45, 0xa7,
-	0xac, 0xb7, 0xe9, 0xce, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x79, 0x15, 0x2b, 0xeb, 0x06,
-	0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x63, 0xc7, 0x49, 0xe9, 0x64, 0x69, 0xb7, 0x56, 0xc5, 0x9a, 0x1e, 0x9c, 0xac, 0x39,
+	0xd0, 0x65, 0xb5, 0x36, 0x0d, 0x42, 0x7b, 0xe0, 0x00, 0x9b, 0x86, 0x42, 0x10, 0x5d, 0xad, 0xac,
+	0x45, 0x08, 0x2e, 0x61, 0xe2, 0x79, 0x49, 0x46, 0xc4, 0x9e, 0x68, 0x66, 0xdc, 0xa6, 0x7f, 0x02,
+	0x07, 0x24, 0x8e, 0xa8, 0xff, 0x0a, 0xff, 0x40, 0x8f, 0x3d, 0x72, 0x0a, 0x28, 0xf9, 0x0f, 0x38,
+	0xf6, 0x84, 0x6c, 0x8f, 0x9d, 0xb6, 0xea, 0x6f, 0x10, 0xe5, 0xb0, 0x37, 0xcf, 0xcb, 0xf7, 0xfd,
+	0x98, 0xcf, 0x7b, 0xf3, 0x14, 0xf4, 0x18, 0x4f, 0x60, 0x84, 0xb9, 0x17, 0xc1, 0x24, 0x16, 0xde,
+	0xde, 0x56, 0x0f, 0x24, 0xde, 0xf2, 0x60, 0x0f, 0x22, 0x29, 0xdc, 0x31, 0x67, 0x92, 0x99, 0xeb,
+	0x99, 0xc4, 0x4d, 0x25, 0xae, 0x92, 0x6c, 0xd8, 0x03, 0xc6, 0x06, 0x23, 0xf0, 0x52, 0x4d, 0x2f,
+	0xee, 0x7b, 0x24, 0xe6, 0x58, 0x52, 0x16, 0x65, 0x5e, 0x1b, 0xeb, 0x03, 0x36, 0x60, 0xe9, 0xa7,
+	0x97, 0x7c, 0x29, 0xab, 0x1d, 0x30, 0x11, 0x32, 0xe1, 0xf5, 0xb0, 0x80, 0x22, 0x5b, 0xc0, 0x68,
+	0xee, 0xf5, 0xe4, 0x4c, 0x39, 0x30, 0x19, 0x33, 0x2e, 0x81, 0x14, 0x4a, 0x79, 0x30, 0x06, 0x55,
+	0xd6, 0x46, 0xe3, 0xc2, 0xca, 0x4f, 0x29, 0x9c, 0x9f, 0xca, 0xa8, 0xb6, 0x03, 0xd0, 0x06, 0x12,
+	0x07, 0x12, 0x88, 0x29, 0x50, 0x4d, 0x72, 0x1c, 0x89, 0x3e, 0xf0, 0x2e, 0x25, 0x96, 0xd6, 0xd0,
+	0x36, 0x8d, 0x96, 0x3f, 0x9b, 0xd6, 0xd1, 0x6b, 0x65, 0xee, 0xb4, 0x4f, 0xa6, 0xf5, 0xcf, 0x06,
+	0x54, 0x0e, 0xe3, 0x9e, 0x1b, 0xb0, 0xd0, 0xcb, 0x72, 0x44, 0x20, 0xf7, 0x19, 0xff, 0x51, 0x9d,
+	0x9e, 0x05, 0x8c, 0x83, 0x37, 0x39, 0x57, 0xa3, 0xbb, 0x88, 0xe1, 0xa3, 0x3c, 0x4d, 0x87, 0x98,
+	0x23, 0xb4, 0xca, 0x21, 0xa0, 0x63, 0x0a, 0x91, 0xec, 0x06, 0x43, 0x4c, 0x23, 0x4b, 0x6f, 0x68,
+	0x9b, 0xcb, 0xad, 0xed, 0x93, 0x69, 0xfd, 0xd3, 0xbb, 0xa5, 0xda, 0x4e, 0xc2, 0xbc, 0xc4, 0x21,
+	0xf8, 0x2b, 0x45, 0xec, 0xd4, 0x66, 0x3e, 0x45, 0x6b, 0x8b, 0x6c, 0x98, 0x10, 0x0e, 0x42, 0x58,
+	0xe5, 0x24, 0x9f, 0xff, 0xb0, 0xf8, 0xe1, 0x45, 0x66, 0x37, 0x9f, 0xa3, 0x2a, 0x0e, 0x59, 0x1c,
+	0x49, 0xcb, 0x68, 0x68, 0x9b, 0xb5, 0xe6, 0xbb, 0x6e, 0xd6, 0x1d, 0x37, 0xe9, 0x4e, 0xde, 0x68,
+	0x77, 0x9b, 0xd1, 0xa8, 0x65, 0x1c, 0x4d, 0xeb, 0x25, 0x5f, 0xc9, 0xcd, 0x2d, 0x54, 0xee, 0x03,
+	0x58, 0x95, 0x9b, 0x79, 0x25, 0x5a, 0xe7, 0xe7, 0x32, 0x5a, 0xed, 0x44, 0x22, 0xee, 0xf7, 0x69,
+	0x90, 0xd4, 0xb0, 0x03, 0xf0, 0xa6, 0x1f, 0xf7, 0xd8, 0x8f, 0x43, 0x1d, 0x3d, 0xf4, 0xb1, 0x84,
+	0xaf, 0x69, 0x48, 0xe5, 0x37, 0x63, 0x82, 0x93, 0x07, 0xf2, 0x1d, 0xaa, 0x64, 0x44, 0xb4, 0x7f,
+	0x8f, 0x48, 0x16, 0xd1, 0xfc, 0x18, 0x55, 0x46, 0x49, 0x2a, 0x4b, 0xbf, 0x59, 0x91, 0x99, 0xda,
+	0xfc, 0x04, 0x55, 0xf7, 0x69, 0x44, 0xd8, 0xbe, 0x55, 0x56, 0x7e, 0xd9, 0xda, 0x71, 0xf3, 0xb5,
+	0xe3, 0xb6, 0xd5, 0xda, 0x69, 0xbd, 0x95, 0xf8, 0xfd, 0xfa, 0x47, 0x5d, 0xf3, 0x95, 0x8b, 0xf9,
+	0x1c, 0x19, 0x21, 0x23, 0x90, 0xd2, 0x5c, 0x69, 0xbe, 0xe7, 0x5e, 0xb4, 0xc7, 0xdc, 0x02, 0xc2,
+	0x2e, 0x23, 0xe0, 0xa7, 0x0e, 0xce, 0x6f, 0x3a, 0x7a, 0xa4, 0x9a, 0xf2, 0x5f, 0x32, 0xb2, 0xd0,
+	0x52, 0x3e, 0x22, 0xe9, 0x48, 0xfa, 0xf9, 0x71, 0x41, 0xaf, 0x7c, 0x47, 0x7a, 0xc6, 0xdd, 0xe9,
+	0x55, 0x6e, 0x4b, 0xef, 0x50, 0x47, 0x8f, 0x76, 0x41, 0x08, 0x3c, 0x80, 0xff, 0x07, 0xbd, 0xf5,
+	0xd3, 0xf4, 0x8c, 0xfb, 0x85, 0xf3, 0x97, 0x86, 0x56, 0x73, 0x38, 0x10, 0x00, 0xdd, 0x03, 0x62,
+	0xbe, 0x83, 0x74, 0xb5, 0xfe, 0x96, 0x5b, 0xd5, 0xd9, 0xb4, 0xae, 0x77, 0xda, 0xbe, 0x4e, 0x89,
+	0xf9, 0x18, 0x3d, 0x18, 0xe3, 0x83, 0x11, 0xc3, 0xa4, 0x3b, 0xc4, 0x62, 0x98, 0x5e, 0xeb, 0x81,
+	0x5f, 0x53, 0xb6, 0x2f, 0xb1, 0x18, 0x9a, 0x2f, 0x51, 0x55, 0x40, 0x44, 0x80, 0xab, 0xc9, 0xf8,
+	0xf0, 0x6c, 0x25, 0x05, 0xad, 0x62, 0x46, 0x38, 0x13, 0x22, 0x45, 0xa7, 0xe6, 0x3b, 0xdf, 0x24,
+	0x59, 0x14, 0xf3, 0x35, 0x5a, 0x2e, 0xd6, 0x92, 0x65, 0xfc, 0xa3, 0x90, 0x8b, 0x40, 0xce, 0x53,
+	0xb4, 0xa6, 0xee, 0xfc, 0x8a, 0xb3, 0x00, 0x84, 0xa0, 0xd1, 0xe0, 0xb2, 0x5b, 0x3b, 0x4f, 0x0a,
+	0x40, 0x9f, 0x4f, 0x20, 0x88, 0xe5, 0xe5, 0x80, 0x9c, 0xf7, 0xd1, 0xdb, 0x4a, 0xba, 0x83, 0xe9,
+	0xe8, 0x0a, 0x61, 0x0b, 0xad, 0x14, 0xd0, 0x25, 0xa7, 0x57, 0x30, 0xb7, 0xd0, 0x12, 0x4f, 0x25,
+	0xd9, 0x14, 0x19, 0x7e, 0x7e, 0x74, 0x7e, 0x28, 0xea, 0x7a, 0xc1, 0x83, 0x61, 0xda, 0xb8, 0x5d,
+	0xb4, 0x14, 0x66, 0xa6, 0x34, 0x52, 0xad, 0xf9, 0xec, 0x1a, 0x56, 0x5f, 0x40, 0x04, 0x1c, 0x8f,
+	0x54, 0x1c, 0x05, 0x2a, 0x8f, 0xe1, 0x74, 0xd1, 0xda, 0xb7, 0x58, 0x84, 0x79, 0xa5, 0x2c, 0xbd,
+	0xfb, 0x57, 0xe7, 0x73, 0x7c, 0x70, 0x4d, 0x8e, 0x53, 0x21, 0xce, 0x25, 0x68, 0xbd, 0x3a, 0x9a,
+	0xd9, 0xda, 0xf1, 0xcc, 0xd6, 0xfe, 0x9c, 0xd9, 0xda, 0x2f, 0x73, 0xbb, 0x74, 0x34, 0xb7, 0xb5,
+	0xe3, 0xb9, 0x5d, 0xfa, 0x7d, 0x6e, 0x97, 0xbe, 0x6f, 0xde, 0xea, 0x21, 0xa6, 0x7f, 0xb4, 0x7a,
+	0xd5, 0xf4, 0xb1, 0x7c, 0xf4, 0xf7, 0x00, 0x20, 0x6b, 0xed, 0xf9, 0x47, 0x0a, 0x00, 0x00,
 }
 
 func (m *FeeDeducted) Marshal() (dAtA []byte, err error) {
@@ -720,6 +1005,11 @@ func (m *RateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
 	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
 	if err5 != nil {
 		return 0, err5
@@ -748,7 +1038,7 @@ func (m *RateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageReceived) Marshal() (dAtA []byte, err error) {
+func (m *AddressRateLimitUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -758,28 +1048,31 @@ func (m *MessageReceived) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageReceived) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddressRateLimitUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddressRateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.Mode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x28
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
 	}
+	i -= n7
+	i = encodeVarintEvents(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x22
 	{
-		size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -788,24 +1081,24 @@ func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.PayloadHash) > 0 {
-		i -= len(m.PayloadHash)
-		copy(dAtA[i:], m.PayloadHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PayloadHash)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageProcessing) Marshal() (dAtA []byte, err error) {
+func (m *MessageRateLimitUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -815,57 +1108,52 @@ func (m *MessageProcessing) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageProcessing) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessageRateLimitUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageProcessing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessageRateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+	if m.Mode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Mode))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MessageExecuted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err9 != nil {
+		return 0, err9
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MessageExecuted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MessageExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+	i -= n9
+	i = encodeVarintEvents(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.Limit != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageFailed) Marshal() (dAtA []byte, err error) {
+func (m *MessageReceived) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -875,17 +1163,169 @@ func (m *MessageFailed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageFailed) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessageReceived) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
+	{
+		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageProcessing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageProcessing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageProcessing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageRetried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRetried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRetried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
 		i -= len(m.ID)
 		copy(dAtA[i:], m.ID)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
@@ -895,6 +1335,39 @@ func (m *MessageFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WasmMessageRouted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1001,6 +1474,58 @@ func (m *RateLimitUpdated) Size() (n int) {
 	n += 1 + l + sovEvents(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
 	n += 1 + l + sovEvents(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovEvents(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *AddressRateLimitUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovEvents(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MessageRateLimitUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEvents(uint64(m.Limit))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovEvents(uint64(m.Mode))
+	}
 	return n
 }
 
@@ -1064,6 +1589,33 @@ func (m *MessageFailed) Size() (n int) {
 	return n
 }
 
+func (m *MessageRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovEvents(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *MessageArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Message.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *WasmMessageRouted) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1360,9 +1912,356 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 			}
 			m.RecipientChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRateLimitUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRateLimitUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,11 +2289,11 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1421,13 +2320,13 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1454,10 +2353,29 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1479,7 +2397,7 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
+func (m *MessageRateLimitUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,10 +2420,10 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimitUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageRateLimitUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageRateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1542,9 +2460,9 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1554,26 +2472,44 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
@@ -1606,6 +2542,25 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2055,6 +3010,190 @@ func (m *MessageFailed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessageRetried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRetried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageArchived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageArchived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WasmMessageRouted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,11 +10,12 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 )
 
-//go:generate moq -out ./mock/expected_keepers.go -pkg mock . Nexus Snapshotter AxelarnetKeeper RewardKeeper SlashingKeeper WasmKeeper AccountKeeper
+//go:generate moq -out ./mock/expected_keepers.go -pkg mock . Nexus Snapshotter AxelarnetKeeper RewardKeeper SlashingKeeper WasmKeeper AccountKeeper PermissionKeeper
 
 // Nexus provides functionality to manage cross-chain transfers
 type Nexus interface {
@@ -39,11 +40,16 @@ type Nexus interface {
 	DeactivateChain(ctx sdk.Context, chain exported.Chain)
 	RegisterFee(ctx sdk.Context, chain exported.Chain, feeInfo exported.FeeInfo) error
 	GetFeeInfo(ctx sdk.Context, chain exported.Chain, asset string) exported.FeeInfo
-	SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limit sdk.Coin, window time.Duration) error
+	SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limit sdk.Coin, window time.Duration, mode RateLimitMode) error
 	RateLimitTransfer(ctx sdk.Context, chain exported.ChainName, asset sdk.Coin, direction exported.TransferDirection) error
+	SetAddressRateLimit(ctx sdk.Context, address exported.CrossChainAddress, limit sdk.Coin, window time.Duration, mode RateLimitMode) error
+	SetMessageRateLimit(ctx sdk.Context, chainName exported.ChainName, address string, limit uint64, window time.Duration, mode RateLimitMode) error
 	GenerateMessageID(ctx sdk.Context) (string, []byte, uint64)
 	SetNewMessage(ctx sdk.Context, msg exported.GeneralMessage) error
+	GetMessage(ctx sdk.Context, id string) (exported.GeneralMessage, bool)
 	RouteMessage(ctx sdk.Context, id string, routingCtx ...exported.RoutingContext) error
+	RetryFailedMessage(ctx sdk.Context, id string, routingCtx exported.RoutingContext) error
+	PruneExpiredMessages(ctx sdk.Context, limit int) int
 }
 
 // Snapshotter provides functionality to the snapshot module
@@ -84,3 +90,8 @@ type WasmKeeper interface {
 type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// PermissionKeeper provides functionality to the permission module
+type PermissionKeeper interface {
+	GetRole(ctx sdk.Context, address sdk.AccAddress) permission.Role
+}
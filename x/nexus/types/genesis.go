@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,20 +26,30 @@ func NewGenesisState(
 	transferEpochs []TransferEpoch,
 	messages []exported.GeneralMessage,
 	messageNonce uint64,
+	transferWindows []TransferWindow,
+	addressRateLimits []AddressRateLimit,
+	messageRateLimits []MessageRateLimit,
+	messageWindows []TransferWindow,
+	failedMessages []FailedMessage,
 ) *GenesisState {
 	return &GenesisState{
-		Params:          params,
-		Nonce:           nonce,
-		Chains:          chains,
-		ChainStates:     chainStates,
-		LinkedAddresses: linkedAddresses,
-		Transfers:       transfers,
-		Fee:             fee,
-		FeeInfos:        feeInfos,
-		RateLimits:      rateLimits,
-		TransferEpochs:  transferEpochs,
-		Messages:        messages,
-		MessageNonce:    messageNonce,
+		Params:            params,
+		Nonce:             nonce,
+		Chains:            chains,
+		ChainStates:       chainStates,
+		LinkedAddresses:   linkedAddresses,
+		Transfers:         transfers,
+		Fee:               fee,
+		FeeInfos:          feeInfos,
+		RateLimits:        rateLimits,
+		TransferEpochs:    transferEpochs,
+		Messages:          messages,
+		MessageNonce:      messageNonce,
+		TransferWindows:   transferWindows,
+		AddressRateLimits: addressRateLimits,
+		MessageRateLimits: messageRateLimits,
+		MessageWindows:    messageWindows,
+		FailedMessages:    failedMessages,
 	}
 }
 
@@ -60,6 +71,11 @@ func DefaultGenesisState() *GenesisState {
 		[]TransferEpoch{},
 		[]exported.GeneralMessage{},
 		0,
+		[]TransferWindow{},
+		[]AddressRateLimit{},
+		[]MessageRateLimit{},
+		[]TransferWindow{},
+		[]FailedMessage{},
 	)
 }
 
@@ -115,10 +131,52 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	for _, transferWindow := range m.TransferWindows {
+		if err := transferWindow.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
+	for _, addressRateLimit := range m.AddressRateLimits {
+		if err := addressRateLimit.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
+	for _, messageRateLimit := range m.MessageRateLimits {
+		if err := messageRateLimit.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
+	for _, messageWindow := range m.MessageWindows {
+		if err := messageWindow.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
+	messages := make(map[string]bool)
 	for _, m := range m.Messages {
 		if err := m.ValidateBasic(); err != nil {
 			return getValidateError(err)
 		}
+		messages[m.ID] = true
+	}
+
+	failedMessages := make(map[string]bool)
+	for _, failed := range m.FailedMessages {
+		if err := failed.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+
+		if !messages[failed.ID] {
+			return getValidateError(fmt.Errorf("general message %s of failed message not found", failed.ID))
+		}
+
+		if failedMessages[failed.ID] {
+			return getValidateError(fmt.Errorf("duplicate failed message %s", failed.ID))
+		}
+		failedMessages[failed.ID] = true
 	}
 
 	return nil
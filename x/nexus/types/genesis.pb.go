@@ -26,18 +26,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState represents the genesis state
 type GenesisState struct {
-	Params          Params                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Nonce           uint64                        `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Chains          []exported.Chain              `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
-	ChainStates     []ChainState                  `protobuf:"bytes,4,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
-	LinkedAddresses []LinkedAddresses             `protobuf:"bytes,5,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses"`
-	Transfers       []exported.CrossChainTransfer `protobuf:"bytes,6,rep,name=transfers,proto3" json:"transfers"`
-	Fee             exported.TransferFee          `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
-	FeeInfos        []exported.FeeInfo            `protobuf:"bytes,8,rep,name=fee_infos,json=feeInfos,proto3" json:"fee_infos"`
-	RateLimits      []RateLimit                   `protobuf:"bytes,9,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
-	TransferEpochs  []TransferEpoch               `protobuf:"bytes,10,rep,name=transfer_epochs,json=transferEpochs,proto3" json:"transfer_epochs"`
-	Messages        []exported.GeneralMessage     `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages"`
-	MessageNonce    uint64                        `protobuf:"varint,12,opt,name=message_nonce,json=messageNonce,proto3" json:"message_nonce,omitempty"`
+	Params            Params                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Nonce             uint64                        `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Chains            []exported.Chain              `protobuf:"bytes,3,rep,name=chains,proto3" json:"chains"`
+	ChainStates       []ChainState                  `protobuf:"bytes,4,rep,name=chain_states,json=chainStates,proto3" json:"chain_states"`
+	LinkedAddresses   []LinkedAddresses             `protobuf:"bytes,5,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses"`
+	Transfers         []exported.CrossChainTransfer `protobuf:"bytes,6,rep,name=transfers,proto3" json:"transfers"`
+	Fee               exported.TransferFee          `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	FeeInfos          []exported.FeeInfo            `protobuf:"bytes,8,rep,name=fee_infos,json=feeInfos,proto3" json:"fee_infos"`
+	RateLimits        []RateLimit                   `protobuf:"bytes,9,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	TransferEpochs    []TransferEpoch               `protobuf:"bytes,10,rep,name=transfer_epochs,json=transferEpochs,proto3" json:"transfer_epochs"`
+	Messages          []exported.GeneralMessage     `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages"`
+	MessageNonce      uint64                        `protobuf:"varint,12,opt,name=message_nonce,json=messageNonce,proto3" json:"message_nonce,omitempty"`
+	TransferWindows   []TransferWindow              `protobuf:"bytes,13,rep,name=transfer_windows,json=transferWindows,proto3" json:"transfer_windows"`
+	AddressRateLimits []AddressRateLimit            `protobuf:"bytes,14,rep,name=address_rate_limits,json=addressRateLimits,proto3" json:"address_rate_limits"`
+	MessageRateLimits []MessageRateLimit            `protobuf:"bytes,15,rep,name=message_rate_limits,json=messageRateLimits,proto3" json:"message_rate_limits"`
+	MessageWindows    []TransferWindow              `protobuf:"bytes,16,rep,name=message_windows,json=messageWindows,proto3" json:"message_windows"`
+	FailedMessages    []FailedMessage               `protobuf:"bytes,17,rep,name=failed_messages,json=failedMessages,proto3" json:"failed_messages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,40 +87,47 @@ func init() {
 }
 
 var fileDescriptor_e1baa72d54b23810 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x8f, 0x12, 0x4d,
-	0x10, 0x87, 0x99, 0x17, 0x96, 0x17, 0x1a, 0x74, 0x4d, 0x87, 0x43, 0x87, 0x98, 0x59, 0xdc, 0x55,
-	0x83, 0x26, 0x3b, 0x13, 0xf0, 0xe6, 0x4d, 0x8c, 0x18, 0x92, 0x55, 0x37, 0xf8, 0xe7, 0xe0, 0x65,
-	0xd2, 0x0c, 0x35, 0x30, 0x59, 0x98, 0x9e, 0x74, 0xf5, 0x2a, 0x7e, 0x0b, 0xbf, 0x91, 0x57, 0x8e,
-	0x7b, 0xf4, 0x64, 0x14, 0xbe, 0x88, 0x99, 0x9e, 0x6e, 0x5c, 0x92, 0x49, 0xb8, 0x75, 0x57, 0x9e,
-	0x7a, 0xa8, 0x1f, 0xd3, 0x45, 0x4e, 0xf9, 0x0a, 0x16, 0x5c, 0xfa, 0x09, 0xac, 0xae, 0xd1, 0xff,
-	0xd2, 0x9b, 0x80, 0xe2, 0x3d, 0x7f, 0x06, 0x09, 0x60, 0x8c, 0x5e, 0x2a, 0x85, 0x12, 0xb4, 0x95,
-	0x33, 0x9e, 0x66, 0x3c, 0xc3, 0xb4, 0x5b, 0x33, 0x31, 0x13, 0x1a, 0xf0, 0xb3, 0x53, 0xce, 0xb6,
-	0x1f, 0x14, 0xfa, 0x52, 0x2e, 0xf9, 0xd2, 0xe8, 0xda, 0x4f, 0xf6, 0x10, 0x58, 0xa5, 0x42, 0x2a,
-	0x98, 0xee, 0x58, 0xf5, 0x2d, 0x05, 0x8b, 0x76, 0x0a, 0x6d, 0xb7, 0x88, 0xd3, 0x1f, 0x55, 0xd2,
-	0x7c, 0x9d, 0x4f, 0xfb, 0x5e, 0x71, 0x05, 0xf4, 0x39, 0xa9, 0xe6, 0xbf, 0xc6, 0x9c, 0x8e, 0xd3,
-	0x6d, 0xf4, 0xef, 0x7b, 0x45, 0xd3, 0x7b, 0x97, 0x9a, 0x19, 0x54, 0xd6, 0xbf, 0x4e, 0x4a, 0x63,
-	0xd3, 0x41, 0x5b, 0xe4, 0x28, 0x11, 0x49, 0x08, 0xec, 0xbf, 0x8e, 0xd3, 0xad, 0x8c, 0xf3, 0x0b,
-	0x1d, 0x90, 0x6a, 0x38, 0xe7, 0x71, 0x82, 0xac, 0xdc, 0x29, 0x77, 0x1b, 0xfd, 0x87, 0xfb, 0x46,
-	0x1b, 0x60, 0xa7, 0x7e, 0x99, 0xc1, 0xd6, 0x9c, 0x77, 0xd2, 0x11, 0x69, 0xea, 0x53, 0x80, 0xd9,
-	0x90, 0xc8, 0x2a, 0xda, 0xd4, 0x29, 0x9e, 0x4d, 0x0b, 0x74, 0x1a, 0x63, 0x69, 0x84, 0xbb, 0x0a,
-	0xd2, 0x4f, 0xe4, 0xde, 0x22, 0x4e, 0xae, 0x60, 0x1a, 0xf0, 0xe9, 0x54, 0x02, 0x22, 0x20, 0x3b,
-	0xd2, 0xba, 0x47, 0xc5, 0xba, 0x0b, 0x4d, 0xbf, 0xb0, 0xb0, 0x71, 0x1e, 0x2f, 0xf6, 0xcb, 0xf4,
-	0x23, 0xa9, 0x2b, 0xc9, 0x13, 0x8c, 0x40, 0x22, 0xab, 0x6a, 0x61, 0xef, 0x50, 0x52, 0x29, 0x10,
-	0xf5, 0xb4, 0x1f, 0x4c, 0xa7, 0x91, 0xff, 0x33, 0xd1, 0x01, 0x29, 0x47, 0x00, 0xec, 0x7f, 0xfd,
-	0x31, 0x9e, 0x1e, 0x10, 0x5a, 0xcd, 0x10, 0x6c, 0xf4, 0xac, 0x99, 0x8e, 0x48, 0x3d, 0x02, 0x08,
-	0xe2, 0x24, 0x12, 0xc8, 0x6a, 0x7a, 0xb4, 0xc7, 0x07, 0x4c, 0x43, 0x80, 0x51, 0x12, 0x09, 0x63,
-	0xa9, 0x45, 0xf9, 0x15, 0xe9, 0x90, 0x34, 0x24, 0x57, 0x10, 0x2c, 0xe2, 0x65, 0xac, 0x90, 0xd5,
-	0xb5, 0xec, 0xa4, 0xf8, 0x8f, 0x1b, 0x73, 0x05, 0x17, 0x19, 0x67, 0x2c, 0x44, 0xda, 0x02, 0xd2,
-	0x31, 0x39, 0xb6, 0x19, 0x03, 0x48, 0x45, 0x38, 0x47, 0x46, 0xb4, 0xeb, 0xac, 0xd8, 0x65, 0x93,
-	0xbd, 0xca, 0x58, 0xe3, 0xbb, 0xab, 0x6e, 0x17, 0x91, 0xbe, 0x23, 0xb5, 0x25, 0x20, 0xf2, 0x19,
-	0x20, 0x6b, 0x68, 0xd9, 0xf9, 0x81, 0x94, 0xd9, 0xcb, 0x97, 0x7c, 0xf1, 0x26, 0xef, 0xb2, 0x61,
-	0xad, 0x84, 0x9e, 0x91, 0x3b, 0xe6, 0x1c, 0xe4, 0xef, 0xba, 0xa9, 0xdf, 0x75, 0xd3, 0x14, 0xdf,
-	0x66, 0xb5, 0xc1, 0xe5, 0xfa, 0x8f, 0x5b, 0x5a, 0x6f, 0x5c, 0xe7, 0x66, 0xe3, 0x3a, 0xbf, 0x37,
-	0xae, 0xf3, 0x7d, 0xeb, 0x96, 0x6e, 0xb6, 0x6e, 0xe9, 0xe7, 0xd6, 0x2d, 0x7d, 0xee, 0xcf, 0x62,
-	0x35, 0xbf, 0x9e, 0x78, 0xa1, 0x58, 0xfa, 0xf9, 0x2c, 0x09, 0xa8, 0xaf, 0x42, 0x5e, 0x99, 0xdb,
-	0x79, 0x28, 0x24, 0xf8, 0x2b, 0xb3, 0xa1, 0x7a, 0x33, 0x27, 0x55, 0xbd, 0x9a, 0xcf, 0xfe, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xad, 0xf4, 0x1b, 0xf4, 0x5c, 0x04, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x5b, 0x81, 0x4a, 0xa7, 0xa5, 0x85, 0x91, 0xc3, 0x84, 0x98, 0xa5, 0x82, 0x1a, 0x34,
+	0x61, 0x37, 0xe0, 0xcd, 0x9b, 0x35, 0xd6, 0x90, 0xa0, 0x92, 0x22, 0x9a, 0x18, 0x93, 0xcd, 0xb0,
+	0xfb, 0xb6, 0x6c, 0xd8, 0xee, 0x34, 0xf3, 0x06, 0xa9, 0xdf, 0xc2, 0x8f, 0xc5, 0x91, 0xa3, 0x27,
+	0xa3, 0xf4, 0x63, 0x78, 0x31, 0x3b, 0x3b, 0x53, 0xdb, 0xba, 0xa1, 0xf1, 0xb6, 0xf3, 0xf2, 0x7f,
+	0xbf, 0x7d, 0xff, 0x37, 0xff, 0x0c, 0xd9, 0xe2, 0x43, 0x48, 0xb8, 0xf4, 0x52, 0x18, 0x5e, 0xa0,
+	0xf7, 0x65, 0xef, 0x14, 0x14, 0xdf, 0xf3, 0x7a, 0x90, 0x02, 0xc6, 0xe8, 0x0e, 0xa4, 0x50, 0x82,
+	0xae, 0xe7, 0x1a, 0x57, 0x6b, 0x5c, 0xa3, 0xd9, 0x58, 0xef, 0x89, 0x9e, 0xd0, 0x02, 0x2f, 0xfb,
+	0xca, 0xb5, 0x1b, 0x0f, 0x0a, 0x79, 0x03, 0x2e, 0x79, 0xdf, 0xe0, 0x36, 0x9e, 0x4c, 0x49, 0x60,
+	0x38, 0x10, 0x52, 0x41, 0x38, 0xd6, 0xaa, 0xaf, 0x03, 0xb0, 0xd2, 0x56, 0x21, 0x6d, 0x42, 0xb1,
+	0xf5, 0xbb, 0x4a, 0xea, 0xaf, 0xf3, 0x69, 0x8f, 0x15, 0x57, 0x40, 0x9f, 0x93, 0x4a, 0xfe, 0x37,
+	0x56, 0x6e, 0x95, 0x77, 0x6a, 0xfb, 0xf7, 0xdd, 0xa2, 0xe9, 0xdd, 0x23, 0xad, 0x69, 0x2f, 0x5e,
+	0xfd, 0xd8, 0x2c, 0x75, 0x4d, 0x07, 0x5d, 0x27, 0x4b, 0xa9, 0x48, 0x03, 0x60, 0x77, 0x5a, 0xe5,
+	0x9d, 0xc5, 0x6e, 0x7e, 0xa0, 0x6d, 0x52, 0x09, 0xce, 0x78, 0x9c, 0x22, 0x5b, 0x68, 0x2d, 0xec,
+	0xd4, 0xf6, 0x1f, 0x4e, 0x13, 0xad, 0x81, 0x31, 0xfa, 0x65, 0x26, 0xb6, 0xe4, 0xbc, 0x93, 0x1e,
+	0x90, 0xba, 0xfe, 0xf2, 0x31, 0x1b, 0x12, 0xd9, 0xa2, 0x26, 0xb5, 0x8a, 0x67, 0xd3, 0x00, 0xed,
+	0xc6, 0x50, 0x6a, 0xc1, 0xb8, 0x82, 0xf4, 0x03, 0x59, 0x4d, 0xe2, 0xf4, 0x1c, 0x42, 0x9f, 0x87,
+	0xa1, 0x04, 0x44, 0x40, 0xb6, 0xa4, 0x71, 0x8f, 0x8a, 0x71, 0x87, 0x5a, 0xfd, 0xc2, 0x8a, 0x0d,
+	0xb3, 0x99, 0x4c, 0x97, 0xe9, 0x09, 0xa9, 0x2a, 0xc9, 0x53, 0x8c, 0x40, 0x22, 0xab, 0x68, 0xe0,
+	0xde, 0x3c, 0xa7, 0x52, 0x20, 0xea, 0x69, 0xdf, 0x9b, 0x4e, 0x03, 0xff, 0x4b, 0xa2, 0x6d, 0xb2,
+	0x10, 0x01, 0xb0, 0xbb, 0xfa, 0x32, 0x9e, 0xce, 0x01, 0x5a, 0x4c, 0x07, 0xac, 0xf5, 0xac, 0x99,
+	0x1e, 0x90, 0x6a, 0x04, 0xe0, 0xc7, 0x69, 0x24, 0x90, 0x2d, 0xeb, 0xd1, 0x1e, 0xcf, 0x21, 0x75,
+	0x00, 0x0e, 0xd2, 0x48, 0x18, 0xca, 0x72, 0x94, 0x1f, 0x91, 0x76, 0x48, 0x4d, 0x72, 0x05, 0x7e,
+	0x12, 0xf7, 0x63, 0x85, 0xac, 0xaa, 0x61, 0x9b, 0xc5, 0x8b, 0xeb, 0x72, 0x05, 0x87, 0x99, 0xce,
+	0x50, 0x88, 0xb4, 0x05, 0xa4, 0x5d, 0xd2, 0xb4, 0x1e, 0x7d, 0x18, 0x88, 0xe0, 0x0c, 0x19, 0xd1,
+	0xac, 0xed, 0x62, 0x96, 0x75, 0xf6, 0x2a, 0xd3, 0x1a, 0x5e, 0x43, 0x4d, 0x16, 0x91, 0xbe, 0x23,
+	0xcb, 0x7d, 0x40, 0xe4, 0x3d, 0x40, 0x56, 0xd3, 0xb0, 0xdd, 0x39, 0x2e, 0xb3, 0xe4, 0x4b, 0x9e,
+	0xbc, 0xc9, 0xbb, 0xac, 0x59, 0x0b, 0xa1, 0xdb, 0x64, 0xc5, 0x7c, 0xfb, 0x79, 0xae, 0xeb, 0x3a,
+	0xd7, 0x75, 0x53, 0x7c, 0xab, 0xe3, 0x7d, 0x42, 0x56, 0xc7, 0x4e, 0x2e, 0xe3, 0x34, 0x14, 0x97,
+	0xc8, 0x56, 0x8a, 0x82, 0x3e, 0x6b, 0xe5, 0xa3, 0x16, 0xdb, 0x38, 0xa9, 0xa9, 0x2a, 0xd2, 0xcf,
+	0xe4, 0x9e, 0xc9, 0xa7, 0x3f, 0xb9, 0xf0, 0x46, 0xd1, 0xed, 0x59, 0xb2, 0x09, 0xe3, 0xec, 0xde,
+	0xd7, 0xf8, 0x4c, 0x5d, 0xd3, 0xad, 0xb3, 0x49, 0x7a, 0xf3, 0x36, 0xba, 0xd9, 0xd2, 0x3f, 0xf4,
+	0xfe, 0x4c, 0x1d, 0xe9, 0x31, 0x69, 0x5a, 0xba, 0xdd, 0xc8, 0xea, 0x7f, 0x6f, 0xa4, 0x61, 0x10,
+	0x76, 0x21, 0x5d, 0xd2, 0x8c, 0x78, 0x9c, 0x40, 0xe8, 0x8f, 0x2f, 0x79, 0xed, 0xb6, 0xc4, 0x74,
+	0xb4, 0x78, 0xfa, 0x6a, 0x1b, 0xd1, 0x64, 0x11, 0xdb, 0x47, 0x57, 0xbf, 0x9c, 0xd2, 0xd5, 0x8d,
+	0x53, 0xbe, 0xbe, 0x71, 0xca, 0x3f, 0x6f, 0x9c, 0xf2, 0xb7, 0x91, 0x53, 0xba, 0x1e, 0x39, 0xa5,
+	0xef, 0x23, 0xa7, 0xf4, 0x69, 0xbf, 0x17, 0xab, 0xb3, 0x8b, 0x53, 0x37, 0x10, 0x7d, 0x2f, 0xff,
+	0x45, 0x0a, 0xea, 0x52, 0xc8, 0x73, 0x73, 0xda, 0x0d, 0x84, 0x04, 0x6f, 0x68, 0x5e, 0x57, 0xfd,
+	0xaa, 0x9e, 0x56, 0xf4, 0xb3, 0xfa, 0xec, 0xcf, 0x00, 0xc2, 0xca, 0x92, 0xb9, 0x18, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +150,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedMessages) > 0 {
+		for iNdEx := len(m.FailedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MessageWindows) > 0 {
+		for iNdEx := len(m.MessageWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MessageRateLimits) > 0 {
+		for iNdEx := len(m.MessageRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.AddressRateLimits) > 0 {
+		for iNdEx := len(m.AddressRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TransferWindows) > 0 {
+		for iNdEx := len(m.TransferWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.MessageNonce != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MessageNonce))
 		i--
@@ -358,6 +444,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.MessageNonce != 0 {
 		n += 1 + sovGenesis(uint64(m.MessageNonce))
 	}
+	if len(m.TransferWindows) > 0 {
+		for _, e := range m.TransferWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressRateLimits) > 0 {
+		for _, e := range m.AddressRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MessageRateLimits) > 0 {
+		for _, e := range m.MessageRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MessageWindows) > 0 {
+		for _, e := range m.MessageWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedMessages) > 0 {
+		for _, e := range m.FailedMessages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -772,6 +888,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferWindows = append(m.TransferWindows, TransferWindow{})
+			if err := m.TransferWindows[len(m.TransferWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressRateLimits = append(m.AddressRateLimits, AddressRateLimit{})
+			if err := m.AddressRateLimits[len(m.AddressRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageRateLimits = append(m.MessageRateLimits, MessageRateLimit{})
+			if err := m.MessageRateLimits[len(m.MessageRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageWindows = append(m.MessageWindows, TransferWindow{})
+			if err := m.MessageWindows[len(m.MessageWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedMessages = append(m.FailedMessages, FailedMessage{})
+			if err := m.FailedMessages[len(m.FailedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	utils "github.com/axelarnetwork/axelar-core/utils"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -56,6 +57,9 @@ var _ nexustypes.Nexus = &NexusMock{}
 //			GetFeeInfoFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset string) github_com_axelarnetwork_axelar_core_x_nexus_exported.FeeInfo {
 //				panic("mock out the GetFeeInfo method")
 //			},
+//			GetMessageFunc: func(ctx cosmossdktypes.Context, id string) (github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage, bool) {
+//				panic("mock out the GetMessage method")
+//			},
 //			GetParamsFunc: func(ctx cosmossdktypes.Context) nexustypes.Params {
 //				panic("mock out the GetParams method")
 //			},
@@ -74,6 +78,9 @@ var _ nexustypes.Nexus = &NexusMock{}
 //			LoggerFunc: func(ctx cosmossdktypes.Context) log.Logger {
 //				panic("mock out the Logger method")
 //			},
+//			PruneExpiredMessagesFunc: func(ctx cosmossdktypes.Context, limit int) int {
+//				panic("mock out the PruneExpiredMessages method")
+//			},
 //			RateLimitTransferFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
 //				panic("mock out the RateLimitTransfer method")
 //			},
@@ -83,16 +90,25 @@ var _ nexustypes.Nexus = &NexusMock{}
 //			RemoveChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, validator cosmossdktypes.ValAddress) error {
 //				panic("mock out the RemoveChainMaintainer method")
 //			},
+//			RetryFailedMessageFunc: func(ctx cosmossdktypes.Context, id string, routingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext) error {
+//				panic("mock out the RetryFailedMessage method")
+//			},
 //			RouteMessageFunc: func(ctx cosmossdktypes.Context, id string, routingCtx ...github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext) error {
 //				panic("mock out the RouteMessage method")
 //			},
+//			SetAddressRateLimitFunc: func(ctx cosmossdktypes.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, limit cosmossdktypes.Coin, window time.Duration, mode nexustypes.RateLimitMode) error {
+//				panic("mock out the SetAddressRateLimit method")
+//			},
+//			SetMessageRateLimitFunc: func(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, address string, limit uint64, window time.Duration, mode nexustypes.RateLimitMode) error {
+//				panic("mock out the SetMessageRateLimit method")
+//			},
 //			SetNewMessageFunc: func(ctx cosmossdktypes.Context, msg github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error {
 //				panic("mock out the SetNewMessage method")
 //			},
 //			SetParamsFunc: func(ctx cosmossdktypes.Context, p nexustypes.Params)  {
 //				panic("mock out the SetParams method")
 //			},
-//			SetRateLimitFunc: func(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration) error {
+//			SetRateLimitFunc: func(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration, mode nexustypes.RateLimitMode) error {
 //				panic("mock out the SetRateLimit method")
 //			},
 //		}
@@ -132,6 +148,9 @@ type NexusMock struct {
 	// GetFeeInfoFunc mocks the GetFeeInfo method.
 	GetFeeInfoFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset string) github_com_axelarnetwork_axelar_core_x_nexus_exported.FeeInfo
 
+	// GetMessageFunc mocks the GetMessage method.
+	GetMessageFunc func(ctx cosmossdktypes.Context, id string) (github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage, bool)
+
 	// GetParamsFunc mocks the GetParams method.
 	GetParamsFunc func(ctx cosmossdktypes.Context) nexustypes.Params
 
@@ -150,6 +169,9 @@ type NexusMock struct {
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx cosmossdktypes.Context) log.Logger
 
+	// PruneExpiredMessagesFunc mocks the PruneExpiredMessages method.
+	PruneExpiredMessagesFunc func(ctx cosmossdktypes.Context, limit int) int
+
 	// RateLimitTransferFunc mocks the RateLimitTransfer method.
 	RateLimitTransferFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error
 
@@ -159,9 +181,18 @@ type NexusMock struct {
 	// RemoveChainMaintainerFunc mocks the RemoveChainMaintainer method.
 	RemoveChainMaintainerFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, validator cosmossdktypes.ValAddress) error
 
+	// RetryFailedMessageFunc mocks the RetryFailedMessage method.
+	RetryFailedMessageFunc func(ctx cosmossdktypes.Context, id string, routingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext) error
+
 	// RouteMessageFunc mocks the RouteMessage method.
 	RouteMessageFunc func(ctx cosmossdktypes.Context, id string, routingCtx ...github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext) error
 
+	// SetAddressRateLimitFunc mocks the SetAddressRateLimit method.
+	SetAddressRateLimitFunc func(ctx cosmossdktypes.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, limit cosmossdktypes.Coin, window time.Duration, mode nexustypes.RateLimitMode) error
+
+	// SetMessageRateLimitFunc mocks the SetMessageRateLimit method.
+	SetMessageRateLimitFunc func(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, address string, limit uint64, window time.Duration, mode nexustypes.RateLimitMode) error
+
 	// SetNewMessageFunc mocks the SetNewMessage method.
 	SetNewMessageFunc func(ctx cosmossdktypes.Context, msg github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error
 
@@ -169,7 +200,7 @@ type NexusMock struct {
 	SetParamsFunc func(ctx cosmossdktypes.Context, p nexustypes.Params)
 
 	// SetRateLimitFunc mocks the SetRateLimit method.
-	SetRateLimitFunc func(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration) error
+	SetRateLimitFunc func(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration, mode nexustypes.RateLimitMode) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -241,6 +272,13 @@ type NexusMock struct {
 			// Asset is the asset argument value.
 			Asset string
 		}
+		// GetMessage holds details about calls to the GetMessage method.
+		GetMessage []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// GetParams holds details about calls to the GetParams method.
 		GetParams []struct {
 			// Ctx is the ctx argument value.
@@ -283,6 +321,13 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// PruneExpiredMessages holds details about calls to the PruneExpiredMessages method.
+		PruneExpiredMessages []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// RateLimitTransfer holds details about calls to the RateLimitTransfer method.
 		RateLimitTransfer []struct {
 			// Ctx is the ctx argument value.
@@ -312,6 +357,15 @@ type NexusMock struct {
 			// Validator is the validator argument value.
 			Validator cosmossdktypes.ValAddress
 		}
+		// RetryFailedMessage holds details about calls to the RetryFailedMessage method.
+		RetryFailedMessage []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ID is the id argument value.
+			ID string
+			// RoutingCtx is the routingCtx argument value.
+			RoutingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext
+		}
 		// RouteMessage holds details about calls to the RouteMessage method.
 		RouteMessage []struct {
 			// Ctx is the ctx argument value.
@@ -321,6 +375,34 @@ type NexusMock struct {
 			// RoutingCtx is the routingCtx argument value.
 			RoutingCtx []github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext
 		}
+		// SetAddressRateLimit holds details about calls to the SetAddressRateLimit method.
+		SetAddressRateLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Address is the address argument value.
+			Address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+			// Limit is the limit argument value.
+			Limit cosmossdktypes.Coin
+			// Window is the window argument value.
+			Window time.Duration
+			// Mode is the mode argument value.
+			Mode nexustypes.RateLimitMode
+		}
+		// SetMessageRateLimit holds details about calls to the SetMessageRateLimit method.
+		SetMessageRateLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// ChainName is the chainName argument value.
+			ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+			// Address is the address argument value.
+			Address string
+			// Limit is the limit argument value.
+			Limit uint64
+			// Window is the window argument value.
+			Window time.Duration
+			// Mode is the mode argument value.
+			Mode nexustypes.RateLimitMode
+		}
 		// SetNewMessage holds details about calls to the SetNewMessage method.
 		SetNewMessage []struct {
 			// Ctx is the ctx argument value.
@@ -345,6 +427,8 @@ type NexusMock struct {
 			Limit cosmossdktypes.Coin
 			// Window is the window argument value.
 			Window time.Duration
+			// Mode is the mode argument value.
+			Mode nexustypes.RateLimitMode
 		}
 	}
 	lockActivateChain            sync.RWMutex
@@ -357,16 +441,21 @@ type NexusMock struct {
 	lockGetChainMaintainers      sync.RWMutex
 	lockGetChains                sync.RWMutex
 	lockGetFeeInfo               sync.RWMutex
+	lockGetMessage               sync.RWMutex
 	lockGetParams                sync.RWMutex
 	lockInitGenesis              sync.RWMutex
 	lockIsChainActivated         sync.RWMutex
 	lockIsChainMaintainer        sync.RWMutex
 	lockLinkAddresses            sync.RWMutex
 	lockLogger                   sync.RWMutex
+	lockPruneExpiredMessages     sync.RWMutex
 	lockRateLimitTransfer        sync.RWMutex
 	lockRegisterFee              sync.RWMutex
 	lockRemoveChainMaintainer    sync.RWMutex
+	lockRetryFailedMessage       sync.RWMutex
 	lockRouteMessage             sync.RWMutex
+	lockSetAddressRateLimit      sync.RWMutex
+	lockSetMessageRateLimit      sync.RWMutex
 	lockSetNewMessage            sync.RWMutex
 	lockSetParams                sync.RWMutex
 	lockSetRateLimit             sync.RWMutex
@@ -728,6 +817,42 @@ func (mock *NexusMock) GetFeeInfoCalls() []struct {
 	return calls
 }
 
+// GetMessage calls GetMessageFunc.
+func (mock *NexusMock) GetMessage(ctx cosmossdktypes.Context, id string) (github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage, bool) {
+	if mock.GetMessageFunc == nil {
+		panic("NexusMock.GetMessageFunc: method is nil but Nexus.GetMessage was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetMessage.Lock()
+	mock.calls.GetMessage = append(mock.calls.GetMessage, callInfo)
+	mock.lockGetMessage.Unlock()
+	return mock.GetMessageFunc(ctx, id)
+}
+
+// GetMessageCalls gets all the calls that were made to GetMessage.
+// Check the length with:
+//
+//	len(mockedNexus.GetMessageCalls())
+func (mock *NexusMock) GetMessageCalls() []struct {
+	Ctx cosmossdktypes.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+		ID  string
+	}
+	mock.lockGetMessage.RLock()
+	calls = mock.calls.GetMessage
+	mock.lockGetMessage.RUnlock()
+	return calls
+}
+
 // GetParams calls GetParamsFunc.
 func (mock *NexusMock) GetParams(ctx cosmossdktypes.Context) nexustypes.Params {
 	if mock.GetParamsFunc == nil {
@@ -944,6 +1069,42 @@ func (mock *NexusMock) LoggerCalls() []struct {
 	return calls
 }
 
+// PruneExpiredMessages calls PruneExpiredMessagesFunc.
+func (mock *NexusMock) PruneExpiredMessages(ctx cosmossdktypes.Context, limit int) int {
+	if mock.PruneExpiredMessagesFunc == nil {
+		panic("NexusMock.PruneExpiredMessagesFunc: method is nil but Nexus.PruneExpiredMessages was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Limit int
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockPruneExpiredMessages.Lock()
+	mock.calls.PruneExpiredMessages = append(mock.calls.PruneExpiredMessages, callInfo)
+	mock.lockPruneExpiredMessages.Unlock()
+	return mock.PruneExpiredMessagesFunc(ctx, limit)
+}
+
+// PruneExpiredMessagesCalls gets all the calls that were made to PruneExpiredMessages.
+// Check the length with:
+//
+//	len(mockedNexus.PruneExpiredMessagesCalls())
+func (mock *NexusMock) PruneExpiredMessagesCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Limit int
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Limit int
+	}
+	mock.lockPruneExpiredMessages.RLock()
+	calls = mock.calls.PruneExpiredMessages
+	mock.lockPruneExpiredMessages.RUnlock()
+	return calls
+}
+
 // RateLimitTransfer calls RateLimitTransferFunc.
 func (mock *NexusMock) RateLimitTransfer(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, asset cosmossdktypes.Coin, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
 	if mock.RateLimitTransferFunc == nil {
@@ -1068,6 +1229,46 @@ func (mock *NexusMock) RemoveChainMaintainerCalls() []struct {
 	return calls
 }
 
+// RetryFailedMessage calls RetryFailedMessageFunc.
+func (mock *NexusMock) RetryFailedMessage(ctx cosmossdktypes.Context, id string, routingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext) error {
+	if mock.RetryFailedMessageFunc == nil {
+		panic("NexusMock.RetryFailedMessageFunc: method is nil but Nexus.RetryFailedMessage was just called")
+	}
+	callInfo := struct {
+		Ctx        cosmossdktypes.Context
+		ID         string
+		RoutingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		RoutingCtx: routingCtx,
+	}
+	mock.lockRetryFailedMessage.Lock()
+	mock.calls.RetryFailedMessage = append(mock.calls.RetryFailedMessage, callInfo)
+	mock.lockRetryFailedMessage.Unlock()
+	return mock.RetryFailedMessageFunc(ctx, id, routingCtx)
+}
+
+// RetryFailedMessageCalls gets all the calls that were made to RetryFailedMessage.
+// Check the length with:
+//
+//	len(mockedNexus.RetryFailedMessageCalls())
+func (mock *NexusMock) RetryFailedMessageCalls() []struct {
+	Ctx        cosmossdktypes.Context
+	ID         string
+	RoutingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext
+} {
+	var calls []struct {
+		Ctx        cosmossdktypes.Context
+		ID         string
+		RoutingCtx github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext
+	}
+	mock.lockRetryFailedMessage.RLock()
+	calls = mock.calls.RetryFailedMessage
+	mock.lockRetryFailedMessage.RUnlock()
+	return calls
+}
+
 // RouteMessage calls RouteMessageFunc.
 func (mock *NexusMock) RouteMessage(ctx cosmossdktypes.Context, id string, routingCtx ...github_com_axelarnetwork_axelar_core_x_nexus_exported.RoutingContext) error {
 	if mock.RouteMessageFunc == nil {
@@ -1108,6 +1309,106 @@ func (mock *NexusMock) RouteMessageCalls() []struct {
 	return calls
 }
 
+// SetAddressRateLimit calls SetAddressRateLimitFunc.
+func (mock *NexusMock) SetAddressRateLimit(ctx cosmossdktypes.Context, address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress, limit cosmossdktypes.Coin, window time.Duration, mode nexustypes.RateLimitMode) error {
+	if mock.SetAddressRateLimitFunc == nil {
+		panic("NexusMock.SetAddressRateLimitFunc: method is nil but Nexus.SetAddressRateLimit was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		Address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Limit   cosmossdktypes.Coin
+		Window  time.Duration
+		Mode    nexustypes.RateLimitMode
+	}{
+		Ctx:     ctx,
+		Address: address,
+		Limit:   limit,
+		Window:  window,
+		Mode:    mode,
+	}
+	mock.lockSetAddressRateLimit.Lock()
+	mock.calls.SetAddressRateLimit = append(mock.calls.SetAddressRateLimit, callInfo)
+	mock.lockSetAddressRateLimit.Unlock()
+	return mock.SetAddressRateLimitFunc(ctx, address, limit, window, mode)
+}
+
+// SetAddressRateLimitCalls gets all the calls that were made to SetAddressRateLimit.
+// Check the length with:
+//
+//	len(mockedNexus.SetAddressRateLimitCalls())
+func (mock *NexusMock) SetAddressRateLimitCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	Address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+	Limit   cosmossdktypes.Coin
+	Window  time.Duration
+	Mode    nexustypes.RateLimitMode
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		Address github_com_axelarnetwork_axelar_core_x_nexus_exported.CrossChainAddress
+		Limit   cosmossdktypes.Coin
+		Window  time.Duration
+		Mode    nexustypes.RateLimitMode
+	}
+	mock.lockSetAddressRateLimit.RLock()
+	calls = mock.calls.SetAddressRateLimit
+	mock.lockSetAddressRateLimit.RUnlock()
+	return calls
+}
+
+// SetMessageRateLimit calls SetMessageRateLimitFunc.
+func (mock *NexusMock) SetMessageRateLimit(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, address string, limit uint64, window time.Duration, mode nexustypes.RateLimitMode) error {
+	if mock.SetMessageRateLimitFunc == nil {
+		panic("NexusMock.SetMessageRateLimitFunc: method is nil but Nexus.SetMessageRateLimit was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Address   string
+		Limit     uint64
+		Window    time.Duration
+		Mode      nexustypes.RateLimitMode
+	}{
+		Ctx:       ctx,
+		ChainName: chainName,
+		Address:   address,
+		Limit:     limit,
+		Window:    window,
+		Mode:      mode,
+	}
+	mock.lockSetMessageRateLimit.Lock()
+	mock.calls.SetMessageRateLimit = append(mock.calls.SetMessageRateLimit, callInfo)
+	mock.lockSetMessageRateLimit.Unlock()
+	return mock.SetMessageRateLimitFunc(ctx, chainName, address, limit, window, mode)
+}
+
+// SetMessageRateLimitCalls gets all the calls that were made to SetMessageRateLimit.
+// Check the length with:
+//
+//	len(mockedNexus.SetMessageRateLimitCalls())
+func (mock *NexusMock) SetMessageRateLimitCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+	Address   string
+	Limit     uint64
+	Window    time.Duration
+	Mode      nexustypes.RateLimitMode
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Address   string
+		Limit     uint64
+		Window    time.Duration
+		Mode      nexustypes.RateLimitMode
+	}
+	mock.lockSetMessageRateLimit.RLock()
+	calls = mock.calls.SetMessageRateLimit
+	mock.lockSetMessageRateLimit.RUnlock()
+	return calls
+}
+
 // SetNewMessage calls SetNewMessageFunc.
 func (mock *NexusMock) SetNewMessage(ctx cosmossdktypes.Context, msg github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error {
 	if mock.SetNewMessageFunc == nil {
@@ -1181,7 +1482,7 @@ func (mock *NexusMock) SetParamsCalls() []struct {
 }
 
 // SetRateLimit calls SetRateLimitFunc.
-func (mock *NexusMock) SetRateLimit(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration) error {
+func (mock *NexusMock) SetRateLimit(ctx cosmossdktypes.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration, mode nexustypes.RateLimitMode) error {
 	if mock.SetRateLimitFunc == nil {
 		panic("NexusMock.SetRateLimitFunc: method is nil but Nexus.SetRateLimit was just called")
 	}
@@ -1190,16 +1491,18 @@ func (mock *NexusMock) SetRateLimit(ctx cosmossdktypes.Context, chainName github
 		ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
 		Limit     cosmossdktypes.Coin
 		Window    time.Duration
+		Mode      nexustypes.RateLimitMode
 	}{
 		Ctx:       ctx,
 		ChainName: chainName,
 		Limit:     limit,
 		Window:    window,
+		Mode:      mode,
 	}
 	mock.lockSetRateLimit.Lock()
 	mock.calls.SetRateLimit = append(mock.calls.SetRateLimit, callInfo)
 	mock.lockSetRateLimit.Unlock()
-	return mock.SetRateLimitFunc(ctx, chainName, limit, window)
+	return mock.SetRateLimitFunc(ctx, chainName, limit, window, mode)
 }
 
 // SetRateLimitCalls gets all the calls that were made to SetRateLimit.
@@ -1211,12 +1514,14 @@ func (mock *NexusMock) SetRateLimitCalls() []struct {
 	ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
 	Limit     cosmossdktypes.Coin
 	Window    time.Duration
+	Mode      nexustypes.RateLimitMode
 } {
 	var calls []struct {
 		Ctx       cosmossdktypes.Context
 		ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
 		Limit     cosmossdktypes.Coin
 		Window    time.Duration
+		Mode      nexustypes.RateLimitMode
 	}
 	mock.lockSetRateLimit.RLock()
 	calls = mock.calls.SetRateLimit
@@ -2479,3 +2784,75 @@ func (mock *AccountKeeperMock) GetModuleAddressCalls() []struct {
 	mock.lockGetModuleAddress.RUnlock()
 	return calls
 }
+
+// Ensure, that PermissionKeeperMock does implement nexustypes.PermissionKeeper.
+// If this is not the case, regenerate this file with moq.
+var _ nexustypes.PermissionKeeper = &PermissionKeeperMock{}
+
+// PermissionKeeperMock is a mock implementation of nexustypes.PermissionKeeper.
+//
+//	func TestSomethingThatUsesPermissionKeeper(t *testing.T) {
+//
+//		// make and configure a mocked nexustypes.PermissionKeeper
+//		mockedPermissionKeeper := &PermissionKeeperMock{
+//			GetRoleFunc: func(ctx cosmossdktypes.Context, address cosmossdktypes.AccAddress) permission.Role {
+//				panic("mock out the GetRole method")
+//			},
+//		}
+//
+//		// use mockedPermissionKeeper in code that requires nexustypes.PermissionKeeper
+//		// and then make assertions.
+//
+//	}
+type PermissionKeeperMock struct {
+	// GetRoleFunc mocks the GetRole method.
+	GetRoleFunc func(ctx cosmossdktypes.Context, address cosmossdktypes.AccAddress) permission.Role
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetRole holds details about calls to the GetRole method.
+		GetRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Address is the address argument value.
+			Address cosmossdktypes.AccAddress
+		}
+	}
+	lockGetRole sync.RWMutex
+}
+
+// GetRole calls GetRoleFunc.
+func (mock *PermissionKeeperMock) GetRole(ctx cosmossdktypes.Context, address cosmossdktypes.AccAddress) permission.Role {
+	if mock.GetRoleFunc == nil {
+		panic("PermissionKeeperMock.GetRoleFunc: method is nil but PermissionKeeper.GetRole was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		Address cosmossdktypes.AccAddress
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockGetRole.Lock()
+	mock.calls.GetRole = append(mock.calls.GetRole, callInfo)
+	mock.lockGetRole.Unlock()
+	return mock.GetRoleFunc(ctx, address)
+}
+
+// GetRoleCalls gets all the calls that were made to GetRole.
+// Check the length with:
+//
+//	len(mockedPermissionKeeper.GetRoleCalls())
+func (mock *PermissionKeeperMock) GetRoleCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	Address cosmossdktypes.AccAddress
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		Address cosmossdktypes.AccAddress
+	}
+	mock.lockGetRole.RLock()
+	calls = mock.calls.GetRole
+	mock.lockGetRole.RUnlock()
+	return calls
+}
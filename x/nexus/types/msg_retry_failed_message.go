@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+)
+
+// NewRetryFailedMessageRequest creates a message of type RetryFailedMessageRequest
+func NewRetryFailedMessageRequest(sender sdk.AccAddress, id string, payload []byte) *RetryFailedMessageRequest {
+	return &RetryFailedMessageRequest{
+		Sender:  sender,
+		ID:      id,
+		Payload: payload,
+	}
+}
+
+// Route implements sdk.Msg
+func (m RetryFailedMessageRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m RetryFailedMessageRequest) Type() string {
+	return "RetryFailedMessage"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m RetryFailedMessageRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := utils.ValidateString(m.ID); err != nil {
+		return sdkerrors.Wrap(err, "invalid message id")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m RetryFailedMessageRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m RetryFailedMessageRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// NewSetAddressRateLimitRequest creates a message of type SetAddressRateLimitRequest
+func NewSetAddressRateLimitRequest(sender sdk.AccAddress, chain exported.ChainName, address string, limit sdk.Coin, window time.Duration, mode RateLimitMode) *SetAddressRateLimitRequest {
+	return &SetAddressRateLimitRequest{
+		Sender:  sender,
+		Chain:   chain,
+		Address: address,
+		Limit:   limit,
+		Window:  window,
+		Mode:    mode,
+	}
+}
+
+// Route implements sdk.Msg
+func (m SetAddressRateLimitRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m SetAddressRateLimitRequest) Type() string {
+	return "SetAddressRateLimit"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m SetAddressRateLimitRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if err := utils.ValidateString(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "invalid address")
+	}
+
+	if err := m.Limit.Validate(); err != nil {
+		return err
+	}
+
+	if m.Window.Nanoseconds() <= 0 {
+		return fmt.Errorf("rate limit window must be positive")
+	}
+
+	if err := m.Mode.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m SetAddressRateLimitRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m SetAddressRateLimitRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
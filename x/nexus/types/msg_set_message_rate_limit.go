@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// NewSetMessageRateLimitRequest creates a message of type SetMessageRateLimitRequest
+func NewSetMessageRateLimitRequest(sender sdk.AccAddress, chain exported.ChainName, address string, limit uint64, window time.Duration, mode RateLimitMode) *SetMessageRateLimitRequest {
+	return &SetMessageRateLimitRequest{
+		Sender:  sender,
+		Chain:   chain,
+		Address: address,
+		Limit:   limit,
+		Window:  window,
+		Mode:    mode,
+	}
+}
+
+// Route implements sdk.Msg
+func (m SetMessageRateLimitRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m SetMessageRateLimitRequest) Type() string {
+	return "SetMessageRateLimit"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m SetMessageRateLimitRequest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sdkerrors.Wrap(err, "sender").Error())
+	}
+
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if err := utils.ValidateStringAllowEmpty(m.Address, utils.DefaultDelimiter); err != nil {
+		return sdkerrors.Wrap(err, "invalid address")
+	}
+
+	if m.Window.Nanoseconds() <= 0 {
+		return fmt.Errorf("rate limit window must be positive")
+	}
+
+	if err := m.Mode.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m SetMessageRateLimitRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m SetMessageRateLimitRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
@@ -11,12 +11,13 @@ import (
 )
 
 // NewSetTransferRateLimitRequest creates a message of type SetTransferRateLimitRequest
-func NewSetTransferRateLimitRequest(sender sdk.AccAddress, chain exported.ChainName, limit sdk.Coin, window time.Duration) *SetTransferRateLimitRequest {
+func NewSetTransferRateLimitRequest(sender sdk.AccAddress, chain exported.ChainName, limit sdk.Coin, window time.Duration, mode RateLimitMode) *SetTransferRateLimitRequest {
 	return &SetTransferRateLimitRequest{
 		Sender: sender,
 		Chain:  chain,
 		Limit:  limit,
 		Window: window,
+		Mode:   mode,
 	}
 }
 
@@ -48,6 +49,10 @@ func (m SetTransferRateLimitRequest) ValidateBasic() error {
 		return fmt.Errorf("rate limit window must be positive")
 	}
 
+	if err := m.Mode.ValidateBasic(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
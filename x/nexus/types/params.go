@@ -22,6 +22,10 @@ var (
 	KeyChainMaintainerCheckWindow = []byte("chainMaintainerCheckWindow")
 	// KeyGateway represents the key for the gateway's address
 	KeyGateway = []byte("gateway")
+	// KeyMessageRetryLimit represents the key for the number of times a failed general message can be retried
+	KeyMessageRetryLimit = []byte("messageRetryLimit")
+	// KeyFailedMessageExpiry represents the key for the number of blocks after which failed general messages are pruned
+	KeyFailedMessageExpiry = []byte("failedMessageExpiry")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -37,6 +41,8 @@ func DefaultParams() Params {
 		ChainMaintainerIncorrectVoteThreshold: utils.NewThreshold(15, 100),
 		ChainMaintainerCheckWindow:            500,
 		Gateway:                               sdk.AccAddress{},
+		MessageRetryLimit:                     5,
+		FailedMessageExpiry:                   0,
 	}
 }
 
@@ -55,6 +61,8 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyChainMaintainerIncorrectVoteThreshold, &m.ChainMaintainerIncorrectVoteThreshold, validateThresholdWith("ChainMaintainerIncorrectVoteThreshold")),
 		params.NewParamSetPair(KeyChainMaintainerCheckWindow, &m.ChainMaintainerCheckWindow, validateChainMaintainerCheckWindow),
 		params.NewParamSetPair(KeyGateway, &m.Gateway, validateGateway),
+		params.NewParamSetPair(KeyMessageRetryLimit, &m.MessageRetryLimit, validateMessageRetryLimit),
+		params.NewParamSetPair(KeyFailedMessageExpiry, &m.FailedMessageExpiry, validateFailedMessageExpiry),
 	}
 }
 
@@ -80,6 +88,14 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateMessageRetryLimit(m.MessageRetryLimit); err != nil {
+		return err
+	}
+
+	if err := validateFailedMessageExpiry(m.FailedMessageExpiry); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -131,3 +147,24 @@ func validateGateway(i interface{}) error {
 
 	return nil
 }
+
+func validateMessageRetryLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type for MessageRetryLimit: %T", i)
+	}
+
+	return nil
+}
+
+func validateFailedMessageExpiry(i interface{}) error {
+	val, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for FailedMessageExpiry: %T", i)
+	}
+
+	if val < 0 {
+		return fmt.Errorf("FailedMessageExpiry must be >=0")
+	}
+
+	return nil
+}
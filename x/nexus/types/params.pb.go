@@ -32,6 +32,13 @@ type Params struct {
 	ChainMaintainerIncorrectVoteThreshold utils.Threshold                               `protobuf:"bytes,3,opt,name=chain_maintainer_incorrect_vote_threshold,json=chainMaintainerIncorrectVoteThreshold,proto3" json:"chain_maintainer_incorrect_vote_threshold"`
 	ChainMaintainerCheckWindow            int32                                         `protobuf:"varint,4,opt,name=chain_maintainer_check_window,json=chainMaintainerCheckWindow,proto3" json:"chain_maintainer_check_window,omitempty"`
 	Gateway                               github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=gateway,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"gateway,omitempty"`
+	// number of times a failed general message can be retried, 0 disables
+	// retries
+	MessageRetryLimit uint64 `protobuf:"varint,6,opt,name=message_retry_limit,json=messageRetryLimit,proto3" json:"message_retry_limit,omitempty"`
+	// number of blocks after which failed general messages are archived and
+	// pruned, 0 keeps them forever. Messages that carry tokens never expire, so
+	// the tokens are not stranded
+	FailedMessageExpiry int64 `protobuf:"varint,7,opt,name=failed_message_expiry,json=failedMessageExpiry,proto3" json:"failed_message_expiry,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,32 +81,36 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/params.proto", fileDescriptor_c78ca34850cdc1ef) }
 
 var fileDescriptor_c78ca34850cdc1ef = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6a, 0xea, 0x40,
-	0x00, 0x86, 0x93, 0xe3, 0xe5, 0x40, 0xce, 0x59, 0x05, 0x17, 0x21, 0xd0, 0x68, 0x6f, 0xd4, 0x2e,
-	0x4c, 0xd0, 0x3e, 0x41, 0xec, 0xaa, 0x14, 0x41, 0xa4, 0xb4, 0xd0, 0x4d, 0x18, 0x27, 0x43, 0x32,
-	0x68, 0x66, 0x64, 0x66, 0x34, 0x4a, 0x17, 0x7d, 0x85, 0x3e, 0x96, 0x4b, 0x97, 0x5d, 0x49, 0xab,
-	0x6f, 0xd1, 0x6e, 0x8a, 0x93, 0x8b, 0x56, 0x57, 0xae, 0x92, 0x90, 0x8f, 0xef, 0xfb, 0x19, 0x46,
-	0x3b, 0x05, 0x53, 0x34, 0x04, 0xcc, 0x21, 0x68, 0x3a, 0xe6, 0xce, 0xa4, 0xd9, 0x47, 0x02, 0x34,
-	0x9d, 0x11, 0x60, 0x20, 0xe2, 0xf6, 0x88, 0x51, 0x41, 0xf5, 0x4a, 0x82, 0xd8, 0x12, 0xb1, 0x53,
-	0xc4, 0xac, 0x04, 0x34, 0xa0, 0x12, 0x70, 0x36, 0x6f, 0x09, 0x6b, 0x5e, 0xa4, 0xba, 0xb1, 0xc0,
-	0xc3, 0xad, 0x4e, 0x84, 0x0c, 0xf1, 0x90, 0x0e, 0xfd, 0x84, 0x3a, 0xfb, 0x2e, 0x68, 0xe5, 0xae,
-	0x4c, 0xe8, 0x50, 0x33, 0x61, 0x08, 0x30, 0xf1, 0x00, 0x14, 0x78, 0x02, 0x04, 0xa6, 0xc4, 0xcb,
-	0x71, 0x43, 0xad, 0xa9, 0xf5, 0x7f, 0xad, 0xaa, 0x9d, 0x2e, 0x90, 0xd6, 0x6c, 0x81, 0xfd, 0x90,
-	0x61, 0xed, 0xe2, 0x7c, 0x59, 0x55, 0x7a, 0x86, 0x14, 0xb9, 0xb9, 0x27, 0xff, 0xaf, 0xbf, 0x68,
-	0x57, 0x49, 0x24, 0x02, 0x98, 0x08, 0x80, 0x09, 0x62, 0x5e, 0x84, 0x39, 0xc7, 0x24, 0xf0, 0x26,
-	0x54, 0xa0, 0x9d, 0xe2, 0x9f, 0x63, 0x8a, 0xe7, 0xd2, 0xda, 0xc9, 0xa5, 0x9d, 0xc4, 0xf9, 0x48,
-	0x05, 0xda, 0xc6, 0x5f, 0xb5, 0xeb, 0x83, 0x38, 0x26, 0x90, 0x32, 0x86, 0xa0, 0xd8, 0xcf, 0x17,
-	0x8e, 0xc9, 0x5f, 0xee, 0xe5, 0xef, 0x32, 0xeb, 0xef, 0x01, 0xae, 0x76, 0x72, 0x30, 0x00, 0x86,
-	0x08, 0x0e, 0xbc, 0x18, 0x13, 0x9f, 0xc6, 0x46, 0xb1, 0xa6, 0xd6, 0x4b, 0x3d, 0x73, 0xcf, 0x76,
-	0xbb, 0x41, 0x9e, 0x24, 0xa1, 0xdf, 0x6b, 0x7f, 0x03, 0x20, 0x50, 0x0c, 0x66, 0x46, 0xa9, 0xa6,
-	0xd6, 0xff, 0xb7, 0x9b, 0x5f, 0xcb, 0x6a, 0x23, 0xc0, 0x22, 0x1c, 0xf7, 0x6d, 0x48, 0x23, 0x07,
-	0x52, 0x1e, 0x51, 0x9e, 0x3e, 0x1a, 0xdc, 0x1f, 0x38, 0x62, 0x36, 0x42, 0xdc, 0x76, 0x21, 0x74,
-	0x7d, 0x9f, 0x21, 0xce, 0x7b, 0x99, 0xa1, 0xdd, 0x9d, 0x7f, 0x5a, 0xca, 0x7c, 0x65, 0xa9, 0x8b,
-	0x95, 0xa5, 0x7e, 0xac, 0x2c, 0xf5, 0x6d, 0x6d, 0x29, 0x8b, 0xb5, 0xa5, 0xbc, 0xaf, 0x2d, 0xe5,
-	0xb9, 0xb5, 0x63, 0x4d, 0x4e, 0x81, 0x20, 0x11, 0x53, 0x36, 0x48, 0xbf, 0x1a, 0x90, 0x32, 0xe4,
-	0x4c, 0xd3, 0x0b, 0x2b, 0x2b, 0xfd, 0xb2, 0xbc, 0x56, 0x37, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xba, 0xba, 0x37, 0xf6, 0xcd, 0x02, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc6, 0x33, 0x6e, 0xb7, 0x0b, 0xa3, 0x17, 0xb3, 0x2b, 0x84, 0x82, 0x69, 0x7c, 0xc3, 0x78,
+	0x68, 0x42, 0xeb, 0x27, 0x68, 0xc5, 0x83, 0x68, 0x61, 0x09, 0xa2, 0xe0, 0x25, 0x4c, 0x27, 0x7f,
+	0x93, 0xa1, 0xc9, 0x4c, 0x99, 0x99, 0xbe, 0xe1, 0xc1, 0xaf, 0xe0, 0xc7, 0xea, 0x71, 0x8f, 0x9e,
+	0x56, 0x6d, 0xbf, 0x85, 0x27, 0xe9, 0xe4, 0x65, 0xd7, 0xee, 0xa9, 0xa7, 0x24, 0x3c, 0xbf, 0xfc,
+	0x9e, 0x27, 0x21, 0xc1, 0x4f, 0xc8, 0x0a, 0x72, 0x22, 0x43, 0x0e, 0xab, 0xb9, 0x0a, 0x17, 0xfd,
+	0x09, 0x68, 0xd2, 0x0f, 0x67, 0x44, 0x92, 0x42, 0x05, 0x33, 0x29, 0xb4, 0xb0, 0x2f, 0x4a, 0x24,
+	0x30, 0x48, 0x50, 0x21, 0x9d, 0x8b, 0x54, 0xa4, 0xc2, 0x00, 0xe1, 0xfe, 0xac, 0x64, 0x3b, 0xcf,
+	0x2b, 0xdd, 0x5c, 0xb3, 0xfc, 0x46, 0xa7, 0x33, 0x09, 0x2a, 0x13, 0x79, 0x52, 0x52, 0x4f, 0x7f,
+	0xb5, 0x70, 0xfb, 0xd2, 0x54, 0xd8, 0x14, 0x77, 0x68, 0x46, 0x18, 0x8f, 0x09, 0xd5, 0x6c, 0x41,
+	0x34, 0x13, 0x3c, 0x6e, 0x70, 0x07, 0x79, 0xc8, 0xbf, 0x3f, 0xe8, 0x06, 0xd5, 0x02, 0x63, 0xad,
+	0x17, 0x04, 0x1f, 0x6b, 0x6c, 0xd4, 0xda, 0x5c, 0x77, 0xad, 0xc8, 0x31, 0xa2, 0x61, 0xe3, 0x69,
+	0x72, 0xfb, 0x1b, 0x7e, 0x59, 0x96, 0x14, 0x84, 0x71, 0x4d, 0x18, 0x07, 0x19, 0x17, 0x4c, 0x29,
+	0xc6, 0xd3, 0x78, 0x21, 0x34, 0xdc, 0x6a, 0xbc, 0x77, 0x4c, 0xe3, 0x33, 0x63, 0x1d, 0x37, 0xd2,
+	0x71, 0xe9, 0xfc, 0x24, 0x34, 0xdc, 0x94, 0x7f, 0xc7, 0xaf, 0xee, 0x94, 0x33, 0x4e, 0x85, 0x94,
+	0x40, 0xf5, 0x61, 0xfd, 0xc9, 0x31, 0xf5, 0x2f, 0x0e, 0xea, 0xdf, 0xd5, 0xd6, 0xff, 0x07, 0x0c,
+	0xf1, 0xe3, 0x3b, 0x03, 0x68, 0x06, 0x74, 0x1a, 0x2f, 0x19, 0x4f, 0xc4, 0xd2, 0x69, 0x79, 0xc8,
+	0x3f, 0x8d, 0x3a, 0x07, 0xb6, 0x37, 0x7b, 0xe4, 0xb3, 0x21, 0xec, 0xf7, 0xf8, 0x2c, 0x25, 0x1a,
+	0x96, 0x64, 0xed, 0x9c, 0x7a, 0xc8, 0x7f, 0x30, 0xea, 0xff, 0xbd, 0xee, 0xf6, 0x52, 0xa6, 0xb3,
+	0xf9, 0x24, 0xa0, 0xa2, 0x08, 0xa9, 0x50, 0x85, 0x50, 0xd5, 0xa1, 0xa7, 0x92, 0x69, 0xa8, 0xd7,
+	0x33, 0x50, 0xc1, 0x90, 0xd2, 0x61, 0x92, 0x48, 0x50, 0x2a, 0xaa, 0x0d, 0x76, 0x80, 0xcf, 0x0b,
+	0x50, 0x8a, 0xa4, 0x10, 0x4b, 0xd0, 0x72, 0x1d, 0xe7, 0xac, 0x60, 0xda, 0x69, 0x7b, 0xc8, 0x6f,
+	0x45, 0x0f, 0xab, 0x28, 0xda, 0x27, 0x1f, 0xf6, 0x81, 0x3d, 0xc0, 0x8f, 0xbe, 0x12, 0x96, 0x43,
+	0x12, 0xd7, 0xb7, 0xc1, 0x6a, 0xc6, 0xe4, 0xda, 0x39, 0xf3, 0x90, 0x7f, 0x12, 0x9d, 0x97, 0xe1,
+	0xb8, 0xcc, 0xde, 0x9a, 0x68, 0x74, 0xb9, 0xf9, 0xe3, 0x5a, 0x9b, 0xad, 0x8b, 0xae, 0xb6, 0x2e,
+	0xfa, 0xbd, 0x75, 0xd1, 0x8f, 0x9d, 0x6b, 0x5d, 0xed, 0x5c, 0xeb, 0xe7, 0xce, 0xb5, 0xbe, 0x0c,
+	0x6e, 0x2d, 0x2f, 0xdf, 0x34, 0x07, 0xbd, 0x14, 0x72, 0x5a, 0x5d, 0xf5, 0xa8, 0x90, 0x10, 0xae,
+	0xaa, 0x9f, 0xc2, 0x3c, 0xc9, 0xa4, 0x6d, 0x3e, 0xdd, 0xd7, 0xff, 0x06, 0x00, 0xbc, 0xbe, 0xed,
+	0xf4, 0x31, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +133,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailedMessageExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FailedMessageExpiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MessageRetryLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MessageRetryLimit))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Gateway) > 0 {
 		i -= len(m.Gateway)
 		copy(dAtA[i:], m.Gateway)
@@ -197,6 +218,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MessageRetryLimit != 0 {
+		n += 1 + sovParams(uint64(m.MessageRetryLimit))
+	}
+	if m.FailedMessageExpiry != 0 {
+		n += 1 + sovParams(uint64(m.FailedMessageExpiry))
+	}
 	return n
 }
 
@@ -387,6 +414,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				m.Gateway = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageRetryLimit", wireType)
+			}
+			m.MessageRetryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageRetryLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMessageExpiry", wireType)
+			}
+			m.FailedMessageExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedMessageExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
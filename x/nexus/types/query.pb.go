@@ -907,20 +907,195 @@ func (m *TransferRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferRateLimitResponse proto.InternalMessageInfo
 
+// AddressRateLimitRequest represents a message that queries the registered
+// transfer rate limit and current transfer amounts for a given address and
+// asset
+type AddressRateLimitRequest struct {
+	Chain   string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Asset   string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (m *AddressRateLimitRequest) Reset()         { *m = AddressRateLimitRequest{} }
+func (m *AddressRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressRateLimitRequest) ProtoMessage()    {}
+func (*AddressRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{22}
+}
+func (m *AddressRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRateLimitRequest.Merge(m, src)
+}
+func (m *AddressRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRateLimitRequest proto.InternalMessageInfo
+
+// AddressRateLimitResponse contains the transfers from the address as
+// incoming and the transfers to the address as outgoing
+type AddressRateLimitResponse struct {
+	TransferRateLimit *TransferRateLimit `protobuf:"bytes,1,opt,name=transfer_rate_limit,json=transferRateLimit,proto3" json:"transfer_rate_limit,omitempty"`
+}
+
+func (m *AddressRateLimitResponse) Reset()         { *m = AddressRateLimitResponse{} }
+func (m *AddressRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressRateLimitResponse) ProtoMessage()    {}
+func (*AddressRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{23}
+}
+func (m *AddressRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRateLimitResponse.Merge(m, src)
+}
+func (m *AddressRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRateLimitResponse proto.InternalMessageInfo
+
+// MessageRateLimitRequest represents a message that queries the registered
+// rate limit and current message count for the general messages routed to a
+// chain, or sent from a single address of the chain if the address is set
+type MessageRateLimitRequest struct {
+	Chain   string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MessageRateLimitRequest) Reset()         { *m = MessageRateLimitRequest{} }
+func (m *MessageRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*MessageRateLimitRequest) ProtoMessage()    {}
+func (*MessageRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{24}
+}
+func (m *MessageRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRateLimitRequest.Merge(m, src)
+}
+func (m *MessageRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRateLimitRequest proto.InternalMessageInfo
+
+type MessageRateLimitResponse struct {
+	RateLimit *MessageRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Count     uint64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// remaining number of messages that can be routed right now
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// time_left indicates the time left in the epoch of a fixed window rate
+	// limit
+	TimeLeft time.Duration `protobuf:"bytes,4,opt,name=time_left,json=timeLeft,proto3,stdduration" json:"time_left"`
+	// time until the full limit is available again
+	RefillTime time.Duration `protobuf:"bytes,5,opt,name=refill_time,json=refillTime,proto3,stdduration" json:"refill_time"`
+}
+
+func (m *MessageRateLimitResponse) Reset()         { *m = MessageRateLimitResponse{} }
+func (m *MessageRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MessageRateLimitResponse) ProtoMessage()    {}
+func (*MessageRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{25}
+}
+func (m *MessageRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRateLimitResponse.Merge(m, src)
+}
+func (m *MessageRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRateLimitResponse proto.InternalMessageInfo
+
 type TransferRateLimit struct {
 	Limit    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
 	Window   time.Duration                          `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
 	Incoming github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=incoming,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"incoming"`
 	Outgoing github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outgoing,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outgoing"`
-	// time_left indicates the time left in the rate limit window
+	// time_left indicates the time left in the epoch of a fixed window rate
+	// limit
 	TimeLeft time.Duration `protobuf:"bytes,5,opt,name=time_left,json=timeLeft,proto3,stdduration" json:"time_left"`
+	Mode     RateLimitMode `protobuf:"varint,6,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
+	// remaining amounts that can be transferred right now
+	IncomingRemaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=incoming_remaining,json=incomingRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"incoming_remaining"`
+	OutgoingRemaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=outgoing_remaining,json=outgoingRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outgoing_remaining"`
+	// time until the full limit is available again
+	IncomingRefillTime time.Duration `protobuf:"bytes,9,opt,name=incoming_refill_time,json=incomingRefillTime,proto3,stdduration" json:"incoming_refill_time"`
+	OutgoingRefillTime time.Duration `protobuf:"bytes,10,opt,name=outgoing_refill_time,json=outgoingRefillTime,proto3,stdduration" json:"outgoing_refill_time"`
 }
 
 func (m *TransferRateLimit) Reset()         { *m = TransferRateLimit{} }
 func (m *TransferRateLimit) String() string { return proto.CompactTextString(m) }
 func (*TransferRateLimit) ProtoMessage()    {}
 func (*TransferRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{22}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{26}
 }
 func (m *TransferRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1132,7 @@ func (m *MessageRequest) Reset()         { *m = MessageRequest{} }
 func (m *MessageRequest) String() string { return proto.CompactTextString(m) }
 func (*MessageRequest) ProtoMessage()    {}
 func (*MessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{23}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{27}
 }
 func (m *MessageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1169,7 @@ func (m *MessageResponse) Reset()         { *m = MessageResponse{} }
 func (m *MessageResponse) String() string { return proto.CompactTextString(m) }
 func (*MessageResponse) ProtoMessage()    {}
 func (*MessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{24}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{28}
 }
 func (m *MessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,6 +1198,87 @@ func (m *MessageResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MessageResponse proto.InternalMessageInfo
 
+// MessagesRequest represents a message that queries general messages.
+// Empty filters match all messages
+type MessagesRequest struct {
+	SourceChain      github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"source_chain,omitempty"`
+	DestinationChain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=destination_chain,json=destinationChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"destination_chain,omitempty"`
+	Sender           string                                                          `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Status           exported.GeneralMessage_Status                                  `protobuf:"varint,4,opt,name=status,proto3,enum=axelar.nexus.exported.v1beta1.GeneralMessage_Status" json:"status,omitempty"`
+	Pagination       *query.PageRequest                                              `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *MessagesRequest) Reset()         { *m = MessagesRequest{} }
+func (m *MessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*MessagesRequest) ProtoMessage()    {}
+func (*MessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{29}
+}
+func (m *MessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesRequest.Merge(m, src)
+}
+func (m *MessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesRequest proto.InternalMessageInfo
+
+type MessagesResponse struct {
+	Messages   []exported.GeneralMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *MessagesResponse) Reset()         { *m = MessagesResponse{} }
+func (m *MessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MessagesResponse) ProtoMessage()    {}
+func (*MessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{30}
+}
+func (m *MessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesResponse.Merge(m, src)
+}
+func (m *MessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesResponse proto.InternalMessageInfo
+
 // ParamsRequest represents a message that queries the params
 type ParamsRequest struct {
 }
@@ -1031,7 +1287,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{25}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{31}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1324,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{26}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{32}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1121,9 +1377,15 @@ func init() {
 	proto.RegisterType((*RecipientAddressResponse)(nil), "axelar.nexus.v1beta1.RecipientAddressResponse")
 	proto.RegisterType((*TransferRateLimitRequest)(nil), "axelar.nexus.v1beta1.TransferRateLimitRequest")
 	proto.RegisterType((*TransferRateLimitResponse)(nil), "axelar.nexus.v1beta1.TransferRateLimitResponse")
+	proto.RegisterType((*AddressRateLimitRequest)(nil), "axelar.nexus.v1beta1.AddressRateLimitRequest")
+	proto.RegisterType((*AddressRateLimitResponse)(nil), "axelar.nexus.v1beta1.AddressRateLimitResponse")
+	proto.RegisterType((*MessageRateLimitRequest)(nil), "axelar.nexus.v1beta1.MessageRateLimitRequest")
+	proto.RegisterType((*MessageRateLimitResponse)(nil), "axelar.nexus.v1beta1.MessageRateLimitResponse")
 	proto.RegisterType((*TransferRateLimit)(nil), "axelar.nexus.v1beta1.TransferRateLimit")
 	proto.RegisterType((*MessageRequest)(nil), "axelar.nexus.v1beta1.MessageRequest")
 	proto.RegisterType((*MessageResponse)(nil), "axelar.nexus.v1beta1.MessageResponse")
+	proto.RegisterType((*MessagesRequest)(nil), "axelar.nexus.v1beta1.MessagesRequest")
+	proto.RegisterType((*MessagesResponse)(nil), "axelar.nexus.v1beta1.MessagesResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.nexus.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.nexus.v1beta1.ParamsResponse")
 }
@@ -1131,82 +1393,99 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/query.proto", fileDescriptor_e78aa4ff0c7b81c7) }
 
 var fileDescriptor_e78aa4ff0c7b81c7 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x16, 0x95, 0x58, 0xb1, 0x47, 0x91, 0x1c, 0x33, 0xfe, 0x39, 0xb2, 0x7f, 0x81, 0x24, 0xb3,
-	0x48, 0x6c, 0xa7, 0x31, 0x05, 0xbb, 0xa7, 0xb6, 0x01, 0x5a, 0xfd, 0xb1, 0x12, 0x05, 0xb6, 0x61,
-	0x50, 0x72, 0x0a, 0xb4, 0x07, 0x75, 0x2d, 0x8e, 0x14, 0x36, 0x12, 0x57, 0xe1, 0xae, 0x62, 0x1b,
-	0xe8, 0x03, 0x14, 0x39, 0x15, 0x3d, 0xf5, 0xd0, 0x9c, 0xfa, 0x18, 0xed, 0x03, 0xf8, 0x98, 0x63,
-	0xd1, 0x83, 0xdb, 0xda, 0x2f, 0xd0, 0x73, 0x4e, 0x05, 0x77, 0x97, 0x12, 0x65, 0x09, 0x56, 0x12,
-	0x14, 0x3d, 0x49, 0xdc, 0xfd, 0xe6, 0x9b, 0x6f, 0x66, 0x3f, 0x0e, 0x17, 0xb2, 0xe4, 0x08, 0xdb,
-	0xc4, 0xcb, 0xb9, 0x78, 0xd4, 0x63, 0xb9, 0x17, 0x1b, 0x07, 0xc8, 0xc9, 0x46, 0xee, 0x79, 0x0f,
-	0xbd, 0x63, 0xb3, 0xeb, 0x51, 0x4e, 0xf5, 0x79, 0x89, 0x30, 0x05, 0xc2, 0x54, 0x88, 0xa5, 0x74,
-	0x8b, 0xd2, 0x56, 0x1b, 0x73, 0x02, 0x73, 0xd0, 0x6b, 0xe6, 0xec, 0x9e, 0x47, 0xb8, 0x43, 0x5d,
-	0x19, 0xb5, 0x34, 0xdf, 0xa2, 0x2d, 0x2a, 0xfe, 0xe6, 0xfc, 0x7f, 0x6a, 0x75, 0x6d, 0x28, 0x1b,
-	0x1e, 0x75, 0xa9, 0xc7, 0xd1, 0xee, 0xa7, 0xe5, 0xc7, 0x5d, 0x64, 0x0a, 0x3a, 0x5e, 0x58, 0x18,
-	0x71, 0xaf, 0x41, 0x59, 0x87, 0xb2, 0xdc, 0x01, 0x61, 0x28, 0x15, 0xf7, 0x61, 0x5d, 0xd2, 0x72,
-	0xdc, 0xb0, 0x9c, 0x74, 0x18, 0x1b, 0xa0, 0x1a, 0xd4, 0x09, 0xf6, 0x97, 0xc7, 0x66, 0xeb, 0x12,
-	0x8f, 0x74, 0x54, 0x3a, 0x23, 0x07, 0xb7, 0x8a, 0x4f, 0x89, 0xe3, 0xee, 0x10, 0xc7, 0xe5, 0xc4,
-	0x71, 0xd1, 0x63, 0x16, 0x3e, 0xef, 0x21, 0xe3, 0xfa, 0x3c, 0x4c, 0x35, 0xfc, 0xad, 0x94, 0x96,
-	0xd5, 0x56, 0x67, 0x2c, 0xf9, 0x60, 0x50, 0x48, 0x8d, 0x06, 0xb0, 0x2e, 0x75, 0x19, 0xea, 0x55,
-	0x88, 0x77, 0x06, 0xcb, 0x29, 0x2d, 0x7b, 0x65, 0xf5, 0x7a, 0x61, 0xe3, 0xcd, 0x69, 0x66, 0xbd,
-	0xe5, 0xf0, 0xa7, 0xbd, 0x03, 0xb3, 0x41, 0x3b, 0x39, 0xa5, 0x59, 0xfe, 0xac, 0x33, 0xfb, 0x99,
-	0x2a, 0xff, 0x09, 0x69, 0xe7, 0x6d, 0xdb, 0x43, 0xc6, 0xac, 0x30, 0x8b, 0xf1, 0x83, 0x06, 0xff,
-	0xdf, 0x26, 0x1c, 0x19, 0x2f, 0x61, 0x97, 0x32, 0x87, 0x07, 0x28, 0x25, 0xf3, 0x0e, 0x24, 0x3d,
-	0x6c, 0x38, 0x5d, 0x07, 0x5d, 0x5e, 0x27, 0xb6, 0xed, 0x29, 0xbd, 0x89, 0xfe, 0xaa, 0x1f, 0xa0,
-	0xaf, 0xc0, 0xec, 0x00, 0x26, 0xeb, 0x8a, 0x0a, 0xdc, 0x20, 0x5a, 0xd4, 0xa5, 0x7f, 0x00, 0x09,
-	0x5b, 0x26, 0x52, 0xb0, 0x2b, 0x02, 0x76, 0x5d, 0x2d, 0x0a, 0x90, 0x91, 0x87, 0xdb, 0xe3, 0x35,
-	0xa9, 0x4e, 0x2c, 0x43, 0x80, 0x0f, 0x4b, 0x8a, 0xdb, 0x03, 0xb4, 0xf1, 0xab, 0x06, 0xa9, 0x9a,
-	0x47, 0x5c, 0xd6, 0x44, 0x8f, 0x95, 0xa9, 0x27, 0x88, 0x2f, 0xed, 0xbd, 0x5e, 0x80, 0x29, 0xc6,
-	0x09, 0x47, 0xa1, 0x3c, 0xb9, 0x79, 0xdf, 0x1c, 0x32, 0x71, 0x60, 0xbc, 0xc0, 0xcd, 0x66, 0xc0,
-	0x5e, 0xf5, 0x63, 0x2c, 0x19, 0xaa, 0x97, 0x01, 0x06, 0x3e, 0x12, 0xb5, 0xc5, 0x37, 0xef, 0x9a,
-	0xf2, 0x34, 0x4c, 0xdf, 0x48, 0xa6, 0x7c, 0x4d, 0x02, 0x92, 0x3d, 0xd2, 0x42, 0xa5, 0xca, 0x0a,
-	0x45, 0x1a, 0xbf, 0x68, 0xb0, 0x38, 0x46, 0xbe, 0xaa, 0x7f, 0x1f, 0x66, 0x78, 0xb0, 0x29, 0x7c,
-	0x10, 0xdf, 0xdc, 0x98, 0xa0, 0xb6, 0xe8, 0x51, 0xc6, 0x04, 0x4b, 0x40, 0x5b, 0xb8, 0x7a, 0x72,
-	0x9a, 0x89, 0x58, 0x03, 0x26, 0xfd, 0xe1, 0x90, 0xf8, 0xa8, 0x10, 0xbf, 0x32, 0x51, 0xbc, 0xd4,
-	0x34, 0xa4, 0xfe, 0x01, 0x24, 0xcb, 0x88, 0x15, 0xb7, 0x49, 0x2f, 0xef, 0xf8, 0x3c, 0x4c, 0x11,
-	0xc6, 0x90, 0x2b, 0xaf, 0xc8, 0x07, 0xa3, 0x06, 0xb3, 0xfd, 0x68, 0x55, 0x70, 0x1e, 0xa6, 0x9b,
-	0x88, 0x75, 0xc7, 0x6d, 0x52, 0xc1, 0xe0, 0x37, 0xf5, 0xf2, 0x7a, 0x03, 0x86, 0x6b, 0x4d, 0xf9,
-	0xc7, 0xf8, 0x16, 0xf4, 0xa0, 0xf2, 0x32, 0x06, 0x3d, 0xf7, 0x9d, 0xc4, 0x68, 0xcf, 0x6b, 0x60,
-	0x3d, 0x2c, 0x2f, 0x2e, 0xd7, 0xa4, 0x63, 0x3f, 0x84, 0x39, 0x1b, 0x19, 0x57, 0xb5, 0x0d, 0x99,
-	0xfb, 0x46, 0x68, 0x43, 0x82, 0x17, 0x20, 0x46, 0x3a, 0xb4, 0xe7, 0x72, 0xe5, 0x6b, 0xf5, 0x64,
-	0x3c, 0x82, 0x9b, 0x43, 0xd9, 0x55, 0x5d, 0x1b, 0x70, 0xa5, 0x89, 0xa8, 0x4a, 0x5a, 0x1c, 0x6a,
-	0x75, 0xff, 0xe0, 0xa8, 0xe3, 0xaa, 0xa3, 0xf2, 0xb1, 0xc6, 0x63, 0x48, 0x88, 0x54, 0xfd, 0x37,
-	0xf4, 0x63, 0x88, 0xf9, 0xde, 0xeb, 0x31, 0x41, 0x93, 0xdc, 0x5c, 0x36, 0xc7, 0x0d, 0x5f, 0x53,
-	0x04, 0x55, 0x05, 0xd0, 0x52, 0x01, 0x46, 0x07, 0x92, 0x01, 0x97, 0x12, 0xf4, 0x15, 0xc4, 0x44,
-	0x81, 0xd2, 0x56, 0x33, 0x85, 0xe2, 0x9b, 0xd3, 0xcc, 0x67, 0xa1, 0xf1, 0x22, 0xa9, 0x5d, 0xe4,
-	0x87, 0xd4, 0x7b, 0xa6, 0x9e, 0xd6, 0x1b, 0xd4, 0xc3, 0xdc, 0xd1, 0x85, 0x01, 0x2d, 0x13, 0xee,
-	0x92, 0x0e, 0x5a, 0x8a, 0xd2, 0xb8, 0x03, 0x89, 0xbc, 0x7f, 0xc2, 0x13, 0x66, 0xe0, 0x2a, 0x24,
-	0x03, 0x98, 0x52, 0xe5, 0x77, 0x55, 0xac, 0x48, 0x55, 0x96, 0x7a, 0x32, 0xd6, 0x60, 0xae, 0x5f,
-	0x16, 0x5e, 0x4e, 0x6a, 0x81, 0x1e, 0x86, 0x2a, 0xe2, 0x07, 0xc1, 0x2b, 0x2f, 0x4f, 0x20, 0x3b,
-	0xa1, 0x75, 0xa8, 0x0e, 0x42, 0x06, 0x19, 0xf7, 0x61, 0x5e, 0xb6, 0xaf, 0x70, 0x2c, 0x04, 0x87,
-	0x14, 0x48, 0x5b, 0x6b, 0x61, 0x5b, 0x73, 0xf8, 0xdf, 0x05, 0xf4, 0x7f, 0xd1, 0x73, 0x02, 0xb7,
-	0xac, 0xf0, 0xa4, 0x0e, 0x8d, 0xf6, 0xc9, 0x53, 0x74, 0x74, 0x5a, 0x47, 0xc7, 0x4c, 0xeb, 0x6f,
-	0x20, 0x35, 0x9a, 0x42, 0xd5, 0xf6, 0x2f, 0x7f, 0x3e, 0x8c, 0xf2, 0x60, 0xaa, 0x5b, 0x84, 0xe3,
-	0xb6, 0xd3, 0x71, 0xf8, 0xfb, 0xcc, 0x18, 0x3e, 0x18, 0xaf, 0x21, 0x1e, 0x25, 0xfa, 0x0b, 0xb8,
-	0x19, 0x0c, 0xc5, 0xba, 0x47, 0x38, 0xd6, 0xdb, 0xfe, 0xb6, 0xf2, 0xc8, 0xca, 0x78, 0x8f, 0x8c,
-	0xb2, 0xcd, 0xf1, 0x8b, 0x4b, 0xc6, 0xdf, 0x51, 0x98, 0x1b, 0x01, 0xea, 0x25, 0x98, 0x1a, 0x24,
-	0xb8, 0x5e, 0x30, 0x7d, 0x8b, 0xfd, 0x7e, 0x9a, 0xb9, 0xfb, 0x16, 0x5f, 0xf5, 0x8a, 0xcb, 0x2d,
-	0x19, 0xac, 0x7f, 0x0a, 0xb1, 0x43, 0xc7, 0xb5, 0xe9, 0xa1, 0x1a, 0xdc, 0x8b, 0xa6, 0xbc, 0x6d,
-	0x99, 0xc1, 0x6d, 0xcb, 0x2c, 0xa9, 0xdb, 0x56, 0x61, 0xda, 0xcf, 0xf0, 0xe3, 0x1f, 0x19, 0xcd,
-	0x52, 0x21, 0xfa, 0x63, 0x98, 0x76, 0xdc, 0x06, 0xed, 0x38, 0x6e, 0x4b, 0x0c, 0xae, 0x77, 0x57,
-	0xd1, 0x8f, 0xf7, 0xb9, 0x68, 0x8f, 0xb7, 0xa8, 0xcf, 0x75, 0xf5, 0xfd, 0xb8, 0x82, 0x78, 0xfd,
-	0x73, 0x98, 0xe1, 0x4e, 0x07, 0xeb, 0x6d, 0x6c, 0xf2, 0xd4, 0xd4, 0xdb, 0xd7, 0x35, 0xed, 0x47,
-	0x6d, 0x63, 0x93, 0xfb, 0xc3, 0x64, 0x07, 0x19, 0x1b, 0x7c, 0x66, 0xf5, 0x05, 0x88, 0x3a, 0xb6,
-	0xf4, 0x48, 0x21, 0x76, 0x76, 0x9a, 0x89, 0x56, 0x4a, 0x56, 0xd4, 0xb1, 0x8d, 0xaf, 0x61, 0xb6,
-	0x8f, 0x54, 0x46, 0xd8, 0x81, 0x6b, 0x1d, 0xb9, 0xa4, 0x0e, 0x7f, 0x7d, 0xc2, 0x57, 0xe7, 0x21,
-	0xba, 0xe8, 0x91, 0xb6, 0xe2, 0x51, 0xd3, 0x22, 0xe0, 0x30, 0x66, 0x21, 0xb1, 0x27, 0x6e, 0x87,
-	0x4a, 0x8a, 0xb1, 0x0d, 0xc9, 0x60, 0x41, 0x65, 0xfc, 0x04, 0x62, 0xf2, 0x02, 0xa9, 0x12, 0xde,
-	0x1e, 0xef, 0x36, 0x19, 0xa5, 0xf8, 0x55, 0xc4, 0xbd, 0x9f, 0x34, 0x88, 0x87, 0xa6, 0xbc, 0xbe,
-	0x0e, 0xa9, 0xe2, 0xa3, 0x7c, 0x65, 0xb7, 0x5e, 0xad, 0xe5, 0x6b, 0xfb, 0xd5, 0xfa, 0xfe, 0x6e,
-	0x75, 0x6f, 0xab, 0x58, 0x29, 0x57, 0xb6, 0x4a, 0x37, 0x22, 0x4b, 0xb3, 0x2f, 0x5f, 0x65, 0xe3,
-	0xfb, 0x2e, 0xeb, 0x62, 0xc3, 0x69, 0x3a, 0x68, 0xeb, 0x6b, 0xb0, 0x30, 0x04, 0xcf, 0x17, 0x6b,
-	0x95, 0x27, 0xf9, 0xda, 0x56, 0xe9, 0x86, 0xb6, 0x94, 0x78, 0xf9, 0x2a, 0x3b, 0x93, 0x6f, 0x70,
-	0xe7, 0x05, 0xe1, 0x68, 0x8f, 0x30, 0x97, 0xb6, 0x06, 0xe0, 0xa8, 0x64, 0x2e, 0x21, 0x09, 0xe0,
-	0x4b, 0x57, 0xbf, 0xfb, 0x39, 0x1d, 0x29, 0xec, 0x9d, 0xfc, 0x95, 0x8e, 0x9c, 0x9c, 0xa5, 0xb5,
-	0xd7, 0x67, 0x69, 0xed, 0xcf, 0xb3, 0xb4, 0xf6, 0xfd, 0x79, 0x3a, 0xf2, 0xfa, 0x3c, 0x1d, 0xf9,
-	0xed, 0x3c, 0x1d, 0xf9, 0x72, 0xf3, 0x9d, 0x06, 0x9e, 0xf0, 0xca, 0x41, 0x4c, 0x58, 0xe0, 0xa3,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xcc, 0xa0, 0x3b, 0x90, 0x0c, 0x00, 0x00,
+	// 1471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x4e, 0x1b, 0xd7,
+	0x16, 0x66, 0x8c, 0x31, 0x78, 0x39, 0x18, 0x98, 0x70, 0x82, 0xc3, 0x89, 0x0c, 0x99, 0x28, 0x09,
+	0xc9, 0x09, 0x63, 0xc1, 0x39, 0xd2, 0x51, 0xdb, 0x48, 0xad, 0x8d, 0x21, 0x71, 0x04, 0x29, 0x1a,
+	0x20, 0x95, 0x5a, 0x45, 0xee, 0xe0, 0x59, 0x76, 0xa6, 0xb1, 0x67, 0x3b, 0xb3, 0xb7, 0x13, 0x22,
+	0xf5, 0x01, 0xaa, 0x5c, 0x55, 0x95, 0x2a, 0xb5, 0x52, 0x73, 0xd5, 0xdb, 0xbe, 0x41, 0xfb, 0x00,
+	0xb9, 0xcc, 0x65, 0xd5, 0x0b, 0xda, 0x92, 0xb7, 0xc8, 0x55, 0x35, 0xfb, 0x67, 0x66, 0x8c, 0x5d,
+	0x7e, 0x5c, 0xda, 0x2b, 0xcf, 0xde, 0xf3, 0xad, 0x6f, 0x7f, 0xeb, 0x67, 0xd6, 0xac, 0x31, 0xcc,
+	0xdb, 0x7b, 0xd8, 0xb4, 0xfd, 0x82, 0x87, 0x7b, 0x1d, 0x5a, 0x78, 0xba, 0xb4, 0x8b, 0xcc, 0x5e,
+	0x2a, 0x3c, 0xe9, 0xa0, 0xff, 0xdc, 0x6c, 0xfb, 0x84, 0x11, 0x7d, 0x5a, 0x20, 0x4c, 0x8e, 0x30,
+	0x25, 0x62, 0x36, 0xdf, 0x20, 0xa4, 0xd1, 0xc4, 0x02, 0xc7, 0xec, 0x76, 0xea, 0x05, 0xa7, 0xe3,
+	0xdb, 0xcc, 0x25, 0x9e, 0xb0, 0x9a, 0x9d, 0x6e, 0x90, 0x06, 0xe1, 0x97, 0x85, 0xe0, 0x4a, 0xee,
+	0xde, 0xe8, 0x3a, 0x0d, 0xf7, 0xda, 0xc4, 0x67, 0xe8, 0x84, 0xc7, 0xb2, 0xe7, 0x6d, 0xa4, 0x12,
+	0xda, 0x5f, 0x58, 0x1c, 0x71, 0xb3, 0x46, 0x68, 0x8b, 0xd0, 0xc2, 0xae, 0x4d, 0x51, 0x28, 0x0e,
+	0x61, 0x6d, 0xbb, 0xe1, 0x7a, 0x71, 0x39, 0xf9, 0x38, 0x56, 0xa1, 0x6a, 0xc4, 0x55, 0xf7, 0x2f,
+	0xf7, 0x3d, 0xad, 0x6d, 0xfb, 0x76, 0x4b, 0x1e, 0x67, 0x14, 0x60, 0x66, 0xe5, 0x91, 0xed, 0x7a,
+	0x1b, 0xb6, 0xeb, 0x31, 0xdb, 0xf5, 0xd0, 0xa7, 0x16, 0x3e, 0xe9, 0x20, 0x65, 0xfa, 0x34, 0x8c,
+	0xd4, 0x82, 0x5b, 0x39, 0x6d, 0x5e, 0x5b, 0x48, 0x5b, 0x62, 0x61, 0x10, 0xc8, 0xf5, 0x1a, 0xd0,
+	0x36, 0xf1, 0x28, 0xea, 0x5b, 0x90, 0x69, 0x45, 0xdb, 0x39, 0x6d, 0x7e, 0x78, 0xe1, 0x5c, 0x69,
+	0xe9, 0xed, 0xfe, 0xdc, 0x62, 0xc3, 0x65, 0x8f, 0x3a, 0xbb, 0x66, 0x8d, 0xb4, 0x0a, 0x52, 0xb3,
+	0xf8, 0x59, 0xa4, 0xce, 0x63, 0xe9, 0xfe, 0x03, 0xbb, 0x59, 0x74, 0x1c, 0x1f, 0x29, 0xb5, 0xe2,
+	0x2c, 0xc6, 0x57, 0x1a, 0xfc, 0x7b, 0xdd, 0x66, 0x48, 0x59, 0x19, 0xdb, 0x84, 0xba, 0x4c, 0xa1,
+	0xa4, 0xcc, 0xab, 0x90, 0xf5, 0xb1, 0xe6, 0xb6, 0x5d, 0xf4, 0x58, 0xd5, 0x76, 0x1c, 0x5f, 0xea,
+	0x1d, 0x0f, 0x77, 0x03, 0x03, 0xfd, 0x3a, 0x4c, 0x44, 0x30, 0xe1, 0x57, 0x82, 0xe3, 0x22, 0x6b,
+	0xee, 0x97, 0x7e, 0x05, 0xc6, 0x1d, 0x71, 0x90, 0x84, 0x0d, 0x73, 0xd8, 0x39, 0xb9, 0xc9, 0x41,
+	0x46, 0x11, 0x2e, 0xf5, 0xd7, 0x24, 0x23, 0x71, 0x19, 0x14, 0x3e, 0x2e, 0x29, 0xe3, 0x44, 0x68,
+	0xe3, 0x27, 0x0d, 0x72, 0xdb, 0xbe, 0xed, 0xd1, 0x3a, 0xfa, 0x74, 0x8d, 0xf8, 0x9c, 0xf8, 0xc8,
+	0xd8, 0xeb, 0x25, 0x18, 0xa1, 0xcc, 0x66, 0xc8, 0x95, 0x67, 0x97, 0x6f, 0x99, 0x5d, 0x45, 0xac,
+	0x0a, 0x4f, 0x55, 0xb3, 0xa9, 0xd8, 0xb7, 0x02, 0x1b, 0x4b, 0x98, 0xea, 0x6b, 0x00, 0x51, 0x1d,
+	0x71, 0xdf, 0x32, 0xcb, 0xd7, 0x4c, 0x91, 0x0d, 0x33, 0x28, 0x24, 0x53, 0x3c, 0x26, 0x8a, 0x64,
+	0xd3, 0x6e, 0xa0, 0x54, 0x65, 0xc5, 0x2c, 0x8d, 0x1f, 0x35, 0xb8, 0xd8, 0x47, 0xbe, 0xf4, 0x7f,
+	0x07, 0xd2, 0x4c, 0xdd, 0xe4, 0x75, 0x90, 0x59, 0x5e, 0x3a, 0x46, 0xed, 0x8a, 0x4f, 0x28, 0xe5,
+	0x2c, 0x8a, 0xb6, 0x94, 0x7c, 0xb5, 0x3f, 0x37, 0x64, 0x45, 0x4c, 0xfa, 0x9d, 0x2e, 0xf1, 0x09,
+	0x2e, 0xfe, 0xfa, 0xb1, 0xe2, 0x85, 0xa6, 0x2e, 0xf5, 0xb7, 0x21, 0xbb, 0x86, 0x58, 0xf1, 0xea,
+	0xe4, 0xe8, 0x88, 0x4f, 0xc3, 0x88, 0x4d, 0x29, 0x32, 0x59, 0x2b, 0x62, 0x61, 0x6c, 0xc3, 0x44,
+	0x68, 0x2d, 0x1d, 0x2e, 0xc2, 0x58, 0x1d, 0xb1, 0xea, 0x7a, 0x75, 0x92, 0xd3, 0x64, 0x50, 0x8f,
+	0xf6, 0x57, 0x31, 0x8c, 0xd6, 0xc5, 0x85, 0xf1, 0x39, 0xe8, 0xca, 0xf3, 0x35, 0x54, 0x31, 0x0f,
+	0x2a, 0x89, 0x92, 0x8e, 0x5f, 0xc3, 0x6a, 0x5c, 0x5e, 0x46, 0xec, 0x89, 0x8a, 0xfd, 0x0f, 0x4c,
+	0x39, 0x48, 0x99, 0xf4, 0xad, 0xab, 0xb8, 0x27, 0x63, 0x37, 0x04, 0xf8, 0x02, 0xa4, 0xec, 0x16,
+	0xe9, 0x78, 0x4c, 0xd6, 0xb5, 0x5c, 0x19, 0x77, 0xe1, 0x7c, 0xd7, 0xe9, 0xd2, 0xaf, 0x25, 0x18,
+	0xae, 0x23, 0x4a, 0x97, 0x2e, 0x76, 0x85, 0x3a, 0x4c, 0x1c, 0x71, 0x3d, 0x99, 0xaa, 0x00, 0x6b,
+	0xdc, 0x83, 0x71, 0x7e, 0x54, 0xf8, 0x84, 0xbe, 0x03, 0xa9, 0xa0, 0xf6, 0x3a, 0x94, 0xd3, 0x64,
+	0x97, 0x2f, 0x9b, 0xfd, 0x9a, 0xaf, 0xc9, 0x8d, 0xb6, 0x38, 0xd0, 0x92, 0x06, 0x46, 0x0b, 0xb2,
+	0x8a, 0x4b, 0x0a, 0xfa, 0x04, 0x52, 0xdc, 0x41, 0x51, 0x56, 0xe9, 0xd2, 0xca, 0xdb, 0xfd, 0xb9,
+	0xf7, 0x63, 0xed, 0x45, 0x50, 0x7b, 0xc8, 0x9e, 0x11, 0xff, 0xb1, 0x5c, 0x2d, 0xd6, 0x88, 0x8f,
+	0x85, 0xbd, 0x43, 0x0d, 0x5a, 0x1c, 0x78, 0xdf, 0x6e, 0xa1, 0x25, 0x29, 0x8d, 0xab, 0x30, 0x5e,
+	0x0c, 0x32, 0x7c, 0x4c, 0x0f, 0x5c, 0x80, 0xac, 0x82, 0x49, 0x55, 0x41, 0x54, 0xf9, 0x8e, 0x50,
+	0x65, 0xc9, 0x95, 0x71, 0x03, 0xa6, 0x42, 0xb7, 0xf0, 0x68, 0x52, 0x0b, 0xf4, 0x38, 0x54, 0x12,
+	0xdf, 0x56, 0x8f, 0xbc, 0xc8, 0xc0, 0xfc, 0x31, 0xa1, 0x43, 0x99, 0x08, 0x61, 0x64, 0xdc, 0x82,
+	0x69, 0x7e, 0x8b, 0x96, 0x9e, 0x73, 0xc1, 0x31, 0x05, 0xa2, 0xac, 0xb5, 0x78, 0x59, 0x33, 0xf8,
+	0xd7, 0x21, 0xf4, 0x3f, 0x11, 0x73, 0x1b, 0x66, 0xac, 0x78, 0xa7, 0x8e, 0xb5, 0xf6, 0xe3, 0xbb,
+	0x68, 0x6f, 0xb7, 0x4e, 0xf4, 0xe9, 0xd6, 0x9f, 0x41, 0xae, 0xf7, 0x08, 0xe9, 0xdb, 0x19, 0xbf,
+	0x3e, 0x8c, 0xb5, 0xa8, 0xab, 0x5b, 0x36, 0xc3, 0x75, 0xb7, 0xe5, 0xb2, 0x41, 0x7a, 0x0c, 0x83,
+	0x8b, 0x7d, 0x78, 0xa4, 0xe8, 0x8f, 0xe0, 0xbc, 0x6a, 0x8a, 0x55, 0xdf, 0x66, 0x58, 0x6d, 0x06,
+	0xb7, 0x65, 0x8d, 0x5c, 0xef, 0x5f, 0x23, 0xbd, 0x6c, 0x53, 0xec, 0xf0, 0x96, 0x51, 0x85, 0x19,
+	0x15, 0xa0, 0x93, 0x89, 0xcf, 0xc1, 0xa8, 0x2d, 0x0c, 0xa4, 0x7c, 0xb5, 0x8c, 0xdc, 0x1a, 0x8e,
+	0xbb, 0x45, 0x21, 0xd7, 0x7b, 0xc0, 0xdf, 0xed, 0x55, 0x05, 0x66, 0x36, 0x90, 0xd2, 0xe0, 0x65,
+	0xf0, 0x17, 0xbd, 0x32, 0x5e, 0x26, 0x20, 0xd7, 0xcb, 0x25, 0x1d, 0x58, 0x05, 0xe8, 0xd1, 0x7d,
+	0xad, 0xbf, 0xee, 0x1e, 0x8e, 0xb4, 0xaf, 0x2e, 0xb9, 0x26, 0xde, 0xa1, 0x83, 0xb3, 0x93, 0x96,
+	0x58, 0xe8, 0x97, 0x20, 0xed, 0x63, 0x30, 0x18, 0xb9, 0x5e, 0x83, 0xc7, 0x34, 0x69, 0x45, 0x1b,
+	0xfa, 0x07, 0x90, 0x66, 0x6e, 0x0b, 0xab, 0x4d, 0xac, 0xb3, 0x5c, 0x52, 0x76, 0x6b, 0x31, 0xcd,
+	0x9a, 0x6a, 0x9a, 0x35, 0xcb, 0x72, 0x9a, 0x2d, 0x8d, 0x05, 0x4d, 0xe2, 0x9b, 0x5f, 0xe7, 0x34,
+	0x6b, 0x2c, 0xb0, 0x5a, 0xc7, 0x3a, 0xd3, 0xcb, 0x90, 0xf1, 0xb1, 0xee, 0x36, 0x9b, 0xd5, 0x60,
+	0x2b, 0x37, 0x72, 0x72, 0x0e, 0x10, 0x76, 0xdb, 0x6e, 0x0b, 0x8d, 0xaf, 0x53, 0x30, 0xd5, 0x93,
+	0x13, 0xbd, 0x0c, 0x23, 0x51, 0x4c, 0xce, 0x95, 0xcc, 0xc0, 0xf4, 0x97, 0xfd, 0xb9, 0x6b, 0x27,
+	0x18, 0x0b, 0x2b, 0x1e, 0xb3, 0x84, 0xb1, 0xfe, 0x1e, 0xa4, 0x9e, 0xb9, 0x9e, 0x43, 0x9e, 0xe5,
+	0x12, 0x27, 0x17, 0x27, 0x4d, 0xf4, 0x7b, 0x30, 0xe6, 0x7a, 0x35, 0xd2, 0x52, 0xd1, 0x3b, 0xbd,
+	0x8a, 0xd0, 0x3e, 0xe0, 0x22, 0x1d, 0xd6, 0x20, 0x01, 0x57, 0x72, 0x30, 0x2e, 0x65, 0xdf, 0x9d,
+	0xb8, 0x91, 0x41, 0x12, 0xf7, 0x7f, 0x48, 0xb6, 0x88, 0x83, 0xb9, 0x14, 0x7f, 0xb9, 0x5e, 0xe9,
+	0x5f, 0x6f, 0x61, 0x2e, 0x36, 0x88, 0x83, 0x16, 0x37, 0xd0, 0x1f, 0x82, 0xae, 0x5c, 0xaa, 0x46,
+	0xa5, 0x35, 0x3a, 0x90, 0x43, 0x53, 0x8a, 0xc9, 0x0a, 0x4b, 0xf2, 0x21, 0xe8, 0xca, 0xcb, 0x18,
+	0xfd, 0xd8, 0x60, 0xf4, 0x8a, 0x29, 0xa2, 0xdf, 0x81, 0xe9, 0x98, 0xfa, 0xa8, 0x70, 0xd3, 0x27,
+	0x8f, 0xa1, 0x1e, 0x89, 0x56, 0x05, 0x1c, 0xd0, 0xc6, 0x54, 0x47, 0xb4, 0x70, 0x0a, 0xda, 0x48,
+	0x6c, 0xf8, 0x5c, 0x2c, 0x40, 0x56, 0x3d, 0xf2, 0xb2, 0xf3, 0x5c, 0x80, 0x84, 0xeb, 0x88, 0xb6,
+	0x53, 0x4a, 0x1d, 0xec, 0xcf, 0x25, 0x2a, 0x65, 0x2b, 0xe1, 0x3a, 0xc6, 0xa7, 0x30, 0x11, 0x22,
+	0x65, 0x5f, 0xd9, 0x80, 0xd1, 0x96, 0xd8, 0x92, 0x4d, 0x65, 0xf1, 0x98, 0xd9, 0xf2, 0x0e, 0x7a,
+	0xe8, 0xdb, 0x4d, 0xc9, 0x23, 0x67, 0x02, 0xc5, 0x61, 0x7c, 0x3b, 0x1c, 0x1e, 0x11, 0xbe, 0x6a,
+	0xeb, 0xfd, 0xc6, 0xcc, 0xb3, 0x79, 0xd1, 0x77, 0xcd, 0xaa, 0xed, 0x3f, 0x9d, 0x55, 0xcf, 0xe6,
+	0xb0, 0xbe, 0x03, 0x2f, 0x45, 0xcf, 0x41, 0x5f, 0x0d, 0xbc, 0x62, 0xa5, 0xaf, 0x87, 0x53, 0x69,
+	0x92, 0x3f, 0x38, 0xff, 0x3b, 0x55, 0x4c, 0xcd, 0xee, 0x41, 0xf5, 0xd0, 0x67, 0xd5, 0xc8, 0xc0,
+	0x9f, 0x55, 0x3f, 0x68, 0x30, 0x19, 0xe5, 0x46, 0xe6, 0xff, 0x43, 0x18, 0x93, 0xb9, 0x53, 0x1f,
+	0x53, 0x03, 0x15, 0x40, 0x48, 0x72, 0x76, 0xdf, 0x51, 0x13, 0x30, 0xbe, 0xc9, 0xff, 0x4e, 0x90,
+	0xbe, 0x18, 0xeb, 0x90, 0x55, 0x1b, 0x52, 0xfc, 0xbb, 0x90, 0x12, 0xff, 0x38, 0xc8, 0xda, 0xbd,
+	0xd4, 0xbf, 0x41, 0x09, 0x2b, 0xa9, 0x54, 0x5a, 0xdc, 0xfc, 0x4e, 0x83, 0x4c, 0xec, 0xb3, 0x40,
+	0x5f, 0x84, 0xdc, 0xca, 0xdd, 0x62, 0xe5, 0x7e, 0x75, 0x6b, 0xbb, 0xb8, 0xbd, 0xb3, 0x55, 0xdd,
+	0xb9, 0xbf, 0xb5, 0xb9, 0xba, 0x52, 0x59, 0xab, 0xac, 0x96, 0x27, 0x87, 0x66, 0x27, 0x5e, 0xbc,
+	0x9c, 0xcf, 0xec, 0x78, 0xb4, 0x8d, 0x35, 0xb7, 0xee, 0xa2, 0xa3, 0xdf, 0x80, 0x0b, 0x5d, 0xf0,
+	0xe2, 0xca, 0x76, 0xe5, 0x41, 0x71, 0x7b, 0xb5, 0x3c, 0xa9, 0xcd, 0x8e, 0xbf, 0x78, 0x39, 0x9f,
+	0x2e, 0xd6, 0x98, 0xfb, 0xd4, 0x66, 0xe8, 0xf4, 0x30, 0x97, 0x57, 0x23, 0x70, 0x42, 0x30, 0x97,
+	0xd1, 0x56, 0xf0, 0xd9, 0xe4, 0x17, 0xdf, 0xe7, 0x87, 0x4a, 0x9b, 0xaf, 0x7e, 0xcf, 0x0f, 0xbd,
+	0x3a, 0xc8, 0x6b, 0xaf, 0x0f, 0xf2, 0xda, 0x6f, 0x07, 0x79, 0xed, 0xcb, 0x37, 0xf9, 0xa1, 0xd7,
+	0x6f, 0xf2, 0x43, 0x3f, 0xbf, 0xc9, 0x0f, 0x7d, 0xbc, 0x7c, 0xaa, 0x5a, 0xe6, 0xbd, 0x6e, 0x37,
+	0xc5, 0xfb, 0xca, 0x7f, 0xff, 0x18, 0x00, 0x6a, 0xcf, 0x59, 0xc5, 0xc1, 0x12, 0x00, 0x00,
 }
 
 func (m *ChainMaintainersRequest) Marshal() (dAtA []byte, err error) {
@@ -1983,7 +2262,7 @@ func (m *TransferRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *TransferRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *AddressRateLimitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1993,66 +2272,41 @@ func (m *TransferRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddressRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransferRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddressRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeLeft, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeLeft):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Outgoing.Size()
-		i -= size
-		if _, err := m.Outgoing.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Incoming.Size()
-		i -= size
-		if _, err := m.Incoming.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
-	if err8 != nil {
-		return 0, err8
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Limit.Size()
-		i -= size
-		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddressRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2062,27 +2316,32 @@ func (m *MessageRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddressRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddressRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ID)))
+	if m.TransferRateLimit != nil {
+		{
+			size, err := m.TransferRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageResponse) Marshal() (dAtA []byte, err error) {
+func (m *MessageRateLimitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2092,30 +2351,34 @@ func (m *MessageResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessageRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessageRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MessageRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2125,20 +2388,58 @@ func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessageRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessageRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RefillTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefillTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeLeft, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeLeft):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransferRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2148,22 +2449,99 @@ func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.OutgoingRefillTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.OutgoingRefillTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x52
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.IncomingRefillTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.IncomingRefillTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x4a
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.OutgoingRemaining.Size()
+		i -= size
+		if _, err := m.OutgoingRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.IncomingRemaining.Size()
+		i -= size
+		if _, err := m.IncomingRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Mode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x30
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeLeft, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeLeft):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Outgoing.Size()
+		i -= size
+		if _, err := m.Outgoing.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Incoming.Size()
+		i -= size
+		if _, err := m.Incoming.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Limit.Size()
 		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -2171,116 +2549,345 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChainMaintainersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainMaintainersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Maintainers) > 0 {
-		for _, b := range m.Maintainers {
-			l = len(b)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *LatestDepositAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecipientAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecipientChain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DepositChain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *MessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LatestDepositAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DepositAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *MessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransfersForChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.State != 0 {
-		n += 1 + sovQuery(uint64(m.State))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TransfersForChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Transfers) > 0 {
-		for _, e := range m.Transfers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainMaintainersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainMaintainersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Maintainers) > 0 {
+		for _, b := range m.Maintainers {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LatestDepositAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipientChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DepositChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LatestDepositAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransfersForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransfersForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *FeeInfoRequest) Size() (n int) {
@@ -2516,6 +3123,80 @@ func (m *TransferRateLimitResponse) Size() (n int) {
 	return n
 }
 
+func (m *AddressRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferRateLimit != nil {
+		l = m.TransferRateLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeLeft)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RefillTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *TransferRateLimit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2532,6 +3213,17 @@ func (m *TransferRateLimit) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeLeft)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovQuery(uint64(m.Mode))
+	}
+	l = m.IncomingRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutgoingRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.IncomingRefillTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.OutgoingRefillTime)
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2559,23 +3251,70 @@ func (m *MessageResponse) Size() (n int) {
 	return n
 }
 
-func (m *ParamsRequest) Size() (n int) {
+func (m *MessagesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *ParamsResponse) Size() (n int) {
+func (m *MessagesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2595,28 +3334,941 @@ func (m *ChainMaintainersRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainMaintainersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainMaintainersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainMaintainersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainMaintainersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainMaintainersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainers = append(m.Maintainers, make([]byte, postIndex-iNdEx))
+			copy(m.Maintainers[len(m.Maintainers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestDepositAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestDepositAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestDepositAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestDepositAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestDepositAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransfersForChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransfersForChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransfersForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= exported.TransferState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransfersForChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransfersForChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransfersForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, exported.CrossChainTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeInfo == nil {
+				m.FeeInfo = &exported.FeeInfo{}
+			}
+			if err := m.FeeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainMaintainersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainMaintainersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2644,7 +4296,7 @@ func (m *ChainMaintainersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2667,7 +4319,7 @@ func (m *ChainMaintainersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainMaintainersResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,17 +4342,17 @@ func (m *ChainMaintainersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainMaintainersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainMaintainersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2710,23 +4362,24 @@ func (m *ChainMaintainersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Maintainers = append(m.Maintainers, make([]byte, postIndex-iNdEx))
-			copy(m.Maintainers[len(m.Maintainers)-1], dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2749,7 +4402,7 @@ func (m *ChainMaintainersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2772,17 +4425,17 @@ func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestDepositAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestDepositAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2792,27 +4445,64 @@ func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= ChainStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2840,11 +4530,61 @@ func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientChain = string(dAtA[iNdEx:postIndex])
+			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2872,7 +4612,7 @@ func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositChain = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2895,7 +4635,7 @@ func (m *LatestDepositAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestDepositAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *AssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,15 +4658,15 @@ func (m *LatestDepositAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestDepositAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestDepositAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2954,7 +4694,7 @@ func (m *LatestDepositAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAddr = string(dAtA[iNdEx:postIndex])
+			m.Assets = append(m.Assets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2977,7 +4717,7 @@ func (m *LatestDepositAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransfersForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3000,10 +4740,10 @@ func (m *TransfersForChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransfersForChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransfersForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3033,65 +4773,10 @@ func (m *TransfersForChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= exported.TransferState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3114,7 +4799,7 @@ func (m *TransfersForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransfersForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *ChainStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3137,49 +4822,15 @@ func (m *TransfersForChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransfersForChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransfersForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transfers = append(m.Transfers, exported.CrossChainTransfer{})
-			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3206,10 +4857,7 @@ func (m *TransfersForChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3234,7 +4882,7 @@ func (m *TransfersForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainsByAssetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3257,45 +4905,13 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainsByAssetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainsByAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
@@ -3348,7 +4964,7 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ChainsByAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3371,17 +4987,17 @@ func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainsByAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainsByAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3391,27 +5007,23 @@ func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeInfo == nil {
-				m.FeeInfo = &exported.FeeInfo{}
-			}
-			if err := m.FeeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3434,7 +5046,7 @@ func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *RecipientAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3457,15 +5069,15 @@ func (m *TransferFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecipientAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecipientAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3493,43 +5105,11 @@ func (m *TransferFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			m.DepositAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3557,7 +5137,7 @@ func (m *TransferFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.DepositChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3580,7 +5160,7 @@ func (m *TransferFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *RecipientAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3603,17 +5183,17 @@ func (m *TransferFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecipientAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecipientAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3623,24 +5203,55 @@ func (m *TransferFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RecipientAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RecipientChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3663,7 +5274,7 @@ func (m *TransferFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainsRequest) Unmarshal(dAtA []byte) error {
+func (m *TransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3686,17 +5297,17 @@ func (m *ChainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3706,11 +5317,56 @@ func (m *ChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ChainStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3732,7 +5388,7 @@ func (m *ChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3755,17 +5411,17 @@ func (m *ChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3775,23 +5431,27 @@ func (m *ChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			if m.TransferRateLimit == nil {
+				m.TransferRateLimit = &TransferRateLimit{}
+			}
+			if err := m.TransferRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3814,7 +5474,7 @@ func (m *ChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetsRequest) Unmarshal(dAtA []byte) error {
+func (m *AddressRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3837,10 +5497,10 @@ func (m *AssetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3875,6 +5535,70 @@ func (m *AssetsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3896,7 +5620,7 @@ func (m *AssetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *AddressRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3919,17 +5643,17 @@ func (m *AssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3939,23 +5663,27 @@ func (m *AssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, string(dAtA[iNdEx:postIndex]))
+			if m.TransferRateLimit == nil {
+				m.TransferRateLimit = &TransferRateLimit{}
+			}
+			if err := m.TransferRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3978,7 +5706,7 @@ func (m *AssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStateRequest) Unmarshal(dAtA []byte) error {
+func (m *MessageRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4001,10 +5729,10 @@ func (m *ChainStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4039,6 +5767,38 @@ func (m *ChainStateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4060,7 +5820,7 @@ func (m *ChainStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MessageRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4083,15 +5843,15 @@ func (m *ChainStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4118,65 +5878,89 @@ func (m *ChainStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RateLimit == nil {
+				m.RateLimit = &MessageRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChainsByAssetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeft", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainsByAssetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainsByAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeLeft, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefillTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4186,23 +5970,24 @@ func (m *ChainsByAssetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RefillTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4225,7 +6010,7 @@ func (m *ChainsByAssetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainsByAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4248,17 +6033,17 @@ func (m *ChainsByAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainsByAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainsByAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4268,79 +6053,96 @@ func (m *ChainsByAssetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecipientAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incoming", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Incoming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecipientAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecipientAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outgoing", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4350,29 +6152,30 @@ func (m *RecipientAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Outgoing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeft", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4382,79 +6185,115 @@ func (m *RecipientAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeLeft, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingRemaining", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecipientAddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.IncomingRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingRemaining", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OutgoingRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecipientAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecipientAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingRefillTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4464,29 +6303,30 @@ func (m *RecipientAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddr = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.IncomingRefillTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingRefillTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4496,23 +6336,24 @@ func (m *RecipientAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientChain = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.OutgoingRefillTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4535,7 +6376,7 @@ func (m *RecipientAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4558,47 +6399,15 @@ func (m *TransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4626,7 +6435,7 @@ func (m *TransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4649,7 +6458,7 @@ func (m *TransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,15 +6481,15 @@ func (m *TransferRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4707,10 +6516,7 @@ func (m *TransferRateLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferRateLimit == nil {
-				m.TransferRateLimit = &TransferRateLimit{}
-			}
-			if err := m.TransferRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4735,7 +6541,7 @@ func (m *TransferRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4758,17 +6564,17 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4778,30 +6584,29 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4811,30 +6616,29 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incoming", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4844,30 +6648,29 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Incoming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outgoing", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4877,28 +6680,14 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= exported.GeneralMessage_Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Outgoing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4925,7 +6714,10 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeLeft, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4950,7 +6742,7 @@ func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageRequest) Unmarshal(dAtA []byte) error {
+func (m *MessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4973,17 +6765,17 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4993,77 +6785,29 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Messages = append(m.Messages, exported.GeneralMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MessageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MessageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5090,7 +6834,10 @@ func (m *MessageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
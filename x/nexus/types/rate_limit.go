@@ -0,0 +1,329 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// SlidingWindowBuckets is the number of buckets the window of a sliding window rate limit is divided into.
+// Transfers are tracked for one extra bucket, so the limit holds for any period of the window's length.
+const SlidingWindowBuckets = 10
+
+// ValidateBasic returns an error if the rate limit mode is invalid
+func (m RateLimitMode) ValidateBasic() error {
+	switch m {
+	case FixedWindow, SlidingWindow, TokenBucket:
+		return nil
+	default:
+		return fmt.Errorf("invalid rate limit mode %s", m)
+	}
+}
+
+// RateLimitModeFromString returns the rate limit mode with the given name
+func RateLimitModeFromString(mode string) (RateLimitMode, error) {
+	switch mode {
+	case "fixed-window":
+		return FixedWindow, nil
+	case "sliding-window":
+		return SlidingWindow, nil
+	case "token-bucket":
+		return TokenBucket, nil
+	default:
+		return 0, fmt.Errorf("invalid rate limit mode %s, must be one of fixed-window, sliding-window or token-bucket", mode)
+	}
+}
+
+// NewTransferWindow returns a new transfer window without any transfers
+func NewTransferWindow(chain exported.ChainName, asset string, direction exported.TransferDirection) TransferWindow {
+	return TransferWindow{
+		Chain:     chain,
+		Asset:     asset,
+		Direction: direction,
+		Consumed:  sdk.ZeroInt(),
+	}
+}
+
+// NewAddressTransferWindow returns a new transfer window without any transfers of the given address
+func NewAddressTransferWindow(address exported.CrossChainAddress, asset string, direction exported.TransferDirection) TransferWindow {
+	transferWindow := NewTransferWindow(address.Chain.Name, asset, direction)
+	transferWindow.Address = address.Address
+
+	return transferWindow
+}
+
+// ValidateBasic returns an error if the type is invalid
+func (m TransferWindow) ValidateBasic() error {
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(m.Asset); err != nil {
+		return err
+	}
+
+	if err := m.Direction.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(m.Buckets) != 0 && len(m.Buckets) != SlidingWindowBuckets+1 {
+		return fmt.Errorf("transfer window must have either 0 or %d buckets", SlidingWindowBuckets+1)
+	}
+
+	if slices.Any(m.Buckets, sdk.Int.IsNegative) {
+		return fmt.Errorf("transfer window buckets must not be negative")
+	}
+
+	if m.Consumed.IsNil() || m.Consumed.IsNegative() {
+		return fmt.Errorf("consumed capacity must not be negative")
+	}
+
+	if err := utils.ValidateStringAllowEmpty(m.Address, utils.DefaultDelimiter); err != nil {
+		return sdkerrors.Wrap(err, "invalid address")
+	}
+
+	return nil
+}
+
+// Used returns the amount counted against the rate limit at the given time
+func (m TransferWindow) Used(rateLimit RateLimit, now time.Time) sdk.Int {
+	m = m.advance(rateLimit, now)
+
+	switch rateLimit.Mode {
+	case SlidingWindow:
+		used := sdk.ZeroInt()
+		for _, amount := range m.Buckets {
+			used = used.Add(amount)
+		}
+
+		return used
+	case FixedWindow, TokenBucket:
+		return m.Consumed
+	default:
+		panic(fmt.Errorf("unsupported rate limit mode %s for transfer windows", rateLimit.Mode))
+	}
+}
+
+// Add counts the given amount against the rate limit at the given time
+func (m TransferWindow) Add(rateLimit RateLimit, now time.Time, amount sdk.Int) TransferWindow {
+	m = m.advance(rateLimit, now)
+
+	switch rateLimit.Mode {
+	case SlidingWindow:
+		m.Buckets[len(m.Buckets)-1] = m.Buckets[len(m.Buckets)-1].Add(amount)
+	case FixedWindow, TokenBucket:
+		m.Consumed = m.Consumed.Add(amount)
+	default:
+		panic(fmt.Errorf("unsupported rate limit mode %s for transfer windows", rateLimit.Mode))
+	}
+
+	return m
+}
+
+// RefillTime returns the time until the full limit is available again
+func (m TransferWindow) RefillTime(rateLimit RateLimit, now time.Time) time.Duration {
+	m = m.advance(rateLimit, now)
+
+	switch rateLimit.Mode {
+	case FixedWindow:
+		if m.Consumed.IsZero() {
+			return 0
+		}
+
+		return time.Duration(int64(m.LastBucket)+1)*rateLimit.Window - time.Duration(now.UnixNano())
+	case SlidingWindow:
+		width := bucketWidth(rateLimit.Window)
+		for i := len(m.Buckets) - 1; i >= 0; i-- {
+			if m.Buckets[i].IsZero() {
+				continue
+			}
+
+			// a bucket stops counting once the current bucket is more than SlidingWindowBuckets ahead of it
+			bucket := m.LastBucket - uint64(len(m.Buckets)-1-i)
+			expiry := time.Duration(int64(bucket)+SlidingWindowBuckets+1) * width
+
+			return expiry - time.Duration(now.UnixNano())
+		}
+
+		return 0
+	case TokenBucket:
+		if m.Consumed.IsZero() || rateLimit.Limit.Amount.IsZero() {
+			return 0
+		}
+
+		// round up so the capacity is fully refilled after the returned time
+		refill := new(big.Int).Mul(m.Consumed.BigInt(), big.NewInt(rateLimit.Window.Nanoseconds()))
+		refill.Add(refill, new(big.Int).Sub(rateLimit.Limit.Amount.BigInt(), big.NewInt(1)))
+
+		return time.Duration(refill.Quo(refill, rateLimit.Limit.Amount.BigInt()).Int64())
+	default:
+		panic(fmt.Errorf("unsupported rate limit mode %s for transfer windows", rateLimit.Mode))
+	}
+}
+
+// advance drops transfers from the window that no longer count against the rate limit at the given time
+func (m TransferWindow) advance(rateLimit RateLimit, now time.Time) TransferWindow {
+	switch rateLimit.Mode {
+	case FixedWindow:
+		epoch := uint64(now.UnixNano() / rateLimit.Window.Nanoseconds())
+		if epoch != m.LastBucket {
+			m.Consumed = sdk.ZeroInt()
+			m.LastBucket = epoch
+		}
+
+		return m
+	case SlidingWindow:
+		current := uint64(now.UnixNano() / bucketWidth(rateLimit.Window).Nanoseconds())
+		if len(m.Buckets) != SlidingWindowBuckets+1 {
+			m.Buckets = zeroInts(SlidingWindowBuckets + 1)
+			m.LastBucket = current
+		}
+
+		if current <= m.LastBucket {
+			return m
+		}
+
+		if shift := current - m.LastBucket; shift > SlidingWindowBuckets {
+			m.Buckets = zeroInts(SlidingWindowBuckets + 1)
+		} else {
+			m.Buckets = append(append([]sdk.Int{}, m.Buckets[shift:]...), zeroInts(int(shift))...)
+		}
+		m.LastBucket = current
+
+		return m
+	case TokenBucket:
+		elapsed := now.Sub(m.UpdatedAt)
+		if elapsed <= 0 {
+			return m
+		}
+
+		// capacity refills continuously at the rate of limit per window
+		limit := rateLimit.Limit.Amount.BigInt()
+		window := big.NewInt(rateLimit.Window.Nanoseconds())
+		refilled := sdk.ZeroInt()
+		if elapsed < rateLimit.Window {
+			refilled = sdk.NewIntFromBigInt(new(big.Int).Quo(new(big.Int).Mul(limit, big.NewInt(elapsed.Nanoseconds())), window))
+		}
+
+		if elapsed >= rateLimit.Window || refilled.GTE(m.Consumed) {
+			m.Consumed = sdk.ZeroInt()
+			m.UpdatedAt = now
+
+			return m
+		}
+
+		// only account for the time it took to refill whole units, so frequent updates don't lose any capacity to rounding
+		m.Consumed = m.Consumed.Sub(refilled)
+		m.UpdatedAt = m.UpdatedAt.Add(time.Duration(new(big.Int).Quo(new(big.Int).Mul(refilled.BigInt(), window), limit).Int64()))
+
+		return m
+	default:
+		return m
+	}
+}
+
+func bucketWidth(window time.Duration) time.Duration {
+	return max(window/SlidingWindowBuckets, 1)
+}
+
+func zeroInts(n int) []sdk.Int {
+	ints := make([]sdk.Int, n)
+	for i := range ints {
+		ints[i] = sdk.ZeroInt()
+	}
+
+	return ints
+}
+
+// NewAddressRateLimit returns a new rate limit for the transfers of the given address
+func NewAddressRateLimit(address exported.CrossChainAddress, limit sdk.Coin, window time.Duration, mode RateLimitMode) AddressRateLimit {
+	return AddressRateLimit{
+		RateLimit: RateLimit{
+			Chain:  address.Chain.Name,
+			Limit:  limit,
+			Window: window,
+			Mode:   mode,
+		},
+		Address: address.Address,
+	}
+}
+
+// ValidateBasic returns an error if the type is invalid
+func (m AddressRateLimit) ValidateBasic() error {
+	if err := m.RateLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := utils.ValidateString(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "invalid address")
+	}
+
+	return nil
+}
+
+// MessageAsset is the asset general messages are counted as in the transfer windows of message rate limits
+const MessageAsset = "message"
+
+// NewMessageRateLimit returns a new rate limit for the general messages routed to the given chain,
+// or sent from the given address of the chain if the address is not empty
+func NewMessageRateLimit(chain exported.ChainName, address string, limit uint64, window time.Duration, mode RateLimitMode) MessageRateLimit {
+	return MessageRateLimit{
+		Chain:   chain,
+		Address: address,
+		Limit:   limit,
+		Window:  window,
+		Mode:    mode,
+	}
+}
+
+// ValidateBasic returns an error if the type is invalid
+func (m MessageRateLimit) ValidateBasic() error {
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if err := utils.ValidateStringAllowEmpty(m.Address, utils.DefaultDelimiter); err != nil {
+		return sdkerrors.Wrap(err, "invalid address")
+	}
+
+	if m.Window.Nanoseconds() <= 0 {
+		return fmt.Errorf("rate limit window must be positive")
+	}
+
+	if err := m.Mode.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AsRateLimit returns the equivalent rate limit on transfers of MessageAsset, with each message counting as one unit
+func (m MessageRateLimit) AsRateLimit() RateLimit {
+	return RateLimit{
+		Chain:  m.Chain,
+		Limit:  sdk.Coin{Denom: MessageAsset, Amount: sdk.NewIntFromUint64(m.Limit)},
+		Window: m.Window,
+		Mode:   m.Mode,
+	}
+}
+
+// NewMessageWindow returns a new transfer window without any messages.
+// Messages routed to the chain are tracked as outgoing, messages sent from an address of the chain as incoming.
+func NewMessageWindow(chain exported.ChainName, address string) TransferWindow {
+	direction := exported.Outgoing
+	if address != "" {
+		direction = exported.Incoming
+	}
+
+	transferWindow := NewTransferWindow(chain, MessageAsset, direction)
+	transferWindow.Address = address
+
+	return transferWindow
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
+)
+
+func TestTransferWindow(t *testing.T) {
+	chain := testutils.RandomChainName()
+	start := time.Unix(3_600_000, 0)
+
+	t.Run("fixed window resets at the end of the epoch", func(t *testing.T) {
+		rateLimit := RateLimit{Chain: chain, Limit: sdk.NewInt64Coin("uaxl", 1000), Window: time.Hour, Mode: FixedWindow}
+
+		window := NewTransferWindow(chain, "uaxl", exported.Incoming)
+		window = window.Add(rateLimit, start.Add(45*time.Minute), sdk.NewInt(1000))
+		assert.NoError(t, window.ValidateBasic())
+
+		assert.Equal(t, sdk.NewInt(1000), window.Used(rateLimit, start.Add(45*time.Minute)))
+		assert.Equal(t, 15*time.Minute, window.RefillTime(rateLimit, start.Add(45*time.Minute)))
+		assert.Equal(t, sdk.ZeroInt(), window.Used(rateLimit, start.Add(rateLimit.Window)))
+		assert.Zero(t, window.RefillTime(rateLimit, start.Add(rateLimit.Window)))
+	})
+
+	t.Run("sliding window keeps transfers for the window and one extra bucket", func(t *testing.T) {
+		rateLimit := RateLimit{Chain: chain, Limit: sdk.NewInt64Coin("uaxl", 1000), Window: time.Hour, Mode: SlidingWindow}
+		bucket := rateLimit.Window / SlidingWindowBuckets
+
+		window := NewTransferWindow(chain, "uaxl", exported.Incoming)
+		window = window.Add(rateLimit, start, sdk.NewInt(600))
+		window = window.Add(rateLimit, start.Add(30*time.Minute), sdk.NewInt(400))
+		assert.NoError(t, window.ValidateBasic())
+
+		assert.Equal(t, sdk.NewInt(1000), window.Used(rateLimit, start.Add(rateLimit.Window)))
+		assert.Equal(t, sdk.NewInt(400), window.Used(rateLimit, start.Add(rateLimit.Window+bucket)))
+		assert.Equal(t, rateLimit.Window+bucket, window.RefillTime(rateLimit, start.Add(30*time.Minute)))
+		assert.Equal(t, sdk.ZeroInt(), window.Used(rateLimit, start.Add(2*rateLimit.Window)))
+		assert.Zero(t, window.RefillTime(rateLimit, start.Add(2*rateLimit.Window)))
+	})
+
+	t.Run("token bucket refills continuously", func(t *testing.T) {
+		rateLimit := RateLimit{Chain: chain, Limit: sdk.NewInt64Coin("uaxl", 1000), Window: time.Hour, Mode: TokenBucket}
+
+		window := NewTransferWindow(chain, "uaxl", exported.Outgoing)
+		window = window.Add(rateLimit, start, sdk.NewInt(1000))
+		assert.NoError(t, window.ValidateBasic())
+
+		assert.Equal(t, sdk.NewInt(1000), window.Used(rateLimit, start))
+		assert.Equal(t, rateLimit.Window, window.RefillTime(rateLimit, start))
+		assert.Equal(t, sdk.NewInt(750), window.Used(rateLimit, start.Add(15*time.Minute)))
+		assert.Equal(t, 45*time.Minute, window.RefillTime(rateLimit, start.Add(15*time.Minute)))
+		assert.Equal(t, sdk.ZeroInt(), window.Used(rateLimit, start.Add(rateLimit.Window)))
+	})
+
+	t.Run("token bucket does not lose capacity to frequent updates", func(t *testing.T) {
+		rateLimit := RateLimit{Chain: chain, Limit: sdk.NewInt64Coin("uaxl", 10), Window: 24 * time.Hour, Mode: TokenBucket}
+
+		window := NewTransferWindow(chain, "uaxl", exported.Outgoing)
+		window = window.Add(rateLimit, start, sdk.NewInt(10))
+		now := start
+		for now.Before(start.Add(rateLimit.Window / 2)) {
+			now = now.Add(5 * time.Second)
+			window = window.Add(rateLimit, now, sdk.ZeroInt())
+		}
+
+		assert.Equal(t, sdk.NewInt(5), window.Used(rateLimit, now))
+	})
+}
@@ -37,70 +37,81 @@ func init() {
 }
 
 var fileDescriptor_fbc63daa8a033391 = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3b, 0x1c, 0x52, 0x34, 0x50, 0xd1, 0x8e, 0x22, 0xa1, 0x86, 0x74, 0x29, 0x5b, 0x87,
-	0x34, 0xbf, 0x76, 0xb1, 0x69, 0xa9, 0x28, 0xa7, 0x94, 0x28, 0x02, 0xa9, 0xa9, 0x4a, 0xc2, 0x29,
-	0x97, 0xd5, 0xc4, 0x79, 0xde, 0xae, 0x88, 0x77, 0xdc, 0x99, 0x71, 0x88, 0x15, 0x7c, 0x80, 0xbf,
-	0x80, 0xc2, 0x89, 0x03, 0x70, 0x87, 0x03, 0x47, 0x24, 0x4e, 0xc0, 0x89, 0x63, 0x25, 0x2e, 0x1c,
-	0xab, 0x04, 0xf1, 0x77, 0xa0, 0x7d, 0x3b, 0x13, 0x7b, 0x9d, 0xd9, 0x5d, 0xe7, 0x16, 0x7b, 0xbe,
-	0x6f, 0xe7, 0xf3, 0x9d, 0x7d, 0xf3, 0x7d, 0x0e, 0xf5, 0xf9, 0x11, 0x1c, 0x70, 0x19, 0xa6, 0x70,
-	0xd4, 0x57, 0xe1, 0x61, 0x73, 0x0f, 0x34, 0x6f, 0x86, 0x0a, 0xe4, 0x61, 0xd2, 0x86, 0xa0, 0x27,
-	0x85, 0x16, 0x6c, 0x36, 0xd7, 0x04, 0xa8, 0x09, 0x8c, 0x66, 0x6e, 0x36, 0x16, 0xb1, 0x40, 0x41,
-	0x98, 0xfd, 0x95, 0x6b, 0xe7, 0xe6, 0x63, 0x21, 0xe2, 0x03, 0x08, 0x79, 0x2f, 0x09, 0x79, 0x9a,
-	0x0a, 0xcd, 0x75, 0x22, 0x52, 0x65, 0x56, 0x6f, 0x38, 0x77, 0xd3, 0x47, 0x66, 0xf9, 0xa6, 0x73,
-	0xf9, 0x69, 0x1f, 0xe4, 0x20, 0x57, 0xb4, 0x9e, 0xbd, 0x4c, 0xe9, 0x96, 0x8a, 0x77, 0x72, 0x3e,
-	0xf6, 0x1b, 0xa1, 0xaf, 0x6f, 0x43, 0x9c, 0x28, 0x0d, 0xf2, 0xc3, 0x27, 0x3c, 0x49, 0xb7, 0x78,
-	0x92, 0x6a, 0x9e, 0xa4, 0x20, 0xd9, 0x9d, 0xc0, 0x85, 0x1d, 0x94, 0xc8, 0xb7, 0xe1, 0x69, 0x1f,
-	0x94, 0x9e, 0xbb, 0x7b, 0xc1, 0x2a, 0xd5, 0x13, 0xa9, 0x02, 0xbf, 0xf5, 0xd5, 0xdf, 0xff, 0x7e,
-	0xfb, 0xd2, 0xaa, 0xbf, 0x18, 0x16, 0x2c, 0x48, 0x53, 0x16, 0xb5, 0xb3, 0xba, 0xa8, 0x7b, 0x56,
-	0x78, 0x9f, 0x2c, 0xb3, 0x3f, 0x09, 0xbd, 0xbe, 0x01, 0xb2, 0x04, 0xff, 0x3d, 0x37, 0x48, 0x69,
-	0x81, 0x35, 0x70, 0xef, 0xc2, 0x75, 0xc6, 0xc2, 0x1d, 0xb4, 0x10, 0xf8, 0x4b, 0x45, 0x0b, 0xfb,
-	0x50, 0x69, 0xe2, 0x19, 0xa1, 0x57, 0xd6, 0xdb, 0x3a, 0x39, 0xe4, 0x1a, 0xf0, 0xc9, 0x6c, 0xd9,
-	0x0d, 0x50, 0x10, 0x59, 0xd8, 0x95, 0xa9, 0xb4, 0x06, 0x70, 0x11, 0x01, 0xdf, 0xf2, 0xe7, 0x8b,
-	0x80, 0xdc, 0x88, 0x73, 0xbc, 0x8c, 0xe9, 0x3b, 0x42, 0x5f, 0xdb, 0x00, 0x5e, 0xa0, 0x5a, 0x2d,
-	0x3b, 0x16, 0xee, 0xe2, 0x5a, 0x9b, 0x52, 0x6d, 0xc8, 0x96, 0x90, 0xec, 0x96, 0xef, 0x4d, 0x1e,
-	0xdd, 0x79, 0xb6, 0x1f, 0x08, 0xbd, 0x6a, 0x9b, 0x69, 0x5d, 0x29, 0xd0, 0x9b, 0x00, 0x6c, 0xad,
-	0xba, 0xe9, 0xac, 0xce, 0xd2, 0x05, 0xd3, 0xca, 0x0d, 0xde, 0x0a, 0xe2, 0x2d, 0xf8, 0x37, 0x4b,
-	0x9a, 0x93, 0x67, 0x05, 0x51, 0x07, 0x20, 0x03, 0xfc, 0x85, 0xd0, 0xd9, 0x1d, 0xd0, 0x9f, 0x4a,
-	0x9e, 0xaa, 0x0e, 0xc8, 0x6d, 0xae, 0xe1, 0x61, 0xd2, 0x4d, 0x34, 0x6b, 0xba, 0x77, 0x75, 0x69,
-	0x2d, 0x68, 0xeb, 0x22, 0x25, 0x06, 0xf6, 0x1d, 0x84, 0x5d, 0xf6, 0x17, 0x8a, 0xb0, 0x19, 0xa1,
-	0x36, 0x45, 0x91, 0xcc, 0x8e, 0xf4, 0x20, 0x2b, 0xbb, 0x4f, 0x96, 0x5b, 0xdf, 0x5f, 0xa3, 0xaf,
-	0x7e, 0x92, 0x65, 0x84, 0x4d, 0x85, 0xff, 0x08, 0x9d, 0x7d, 0xc8, 0x35, 0x28, 0xbd, 0x01, 0x3d,
-	0xa1, 0x12, 0xbd, 0xbe, 0xbf, 0x2f, 0x41, 0xa9, 0x32, 0x0b, 0x2e, 0x6d, 0x8d, 0x05, 0x77, 0x89,
-	0xb1, 0x10, 0xa3, 0x05, 0xce, 0xa2, 0xd0, 0x99, 0x67, 0x07, 0x58, 0x1b, 0xed, 0xe7, 0xc5, 0x11,
-	0xcf, 0xab, 0xc3, 0x63, 0x09, 0xed, 0xa4, 0x97, 0x40, 0x9a, 0x7f, 0x35, 0x1c, 0xff, 0x02, 0xdb,
-	0x67, 0x18, 0x1e, 0xdb, 0x9a, 0xfc, 0x33, 0xfb, 0x95, 0xd0, 0x6b, 0xf6, 0x24, 0xd5, 0xa6, 0xc8,
-	0xaf, 0x36, 0x2b, 0x69, 0x8f, 0x73, 0x42, 0x6b, 0x31, 0x9c, 0x5a, 0x6f, 0xfc, 0xad, 0xa3, 0xbf,
-	0x0f, 0xd8, 0xfb, 0x6e, 0x7f, 0xf6, 0x35, 0xa9, 0xa8, 0x23, 0x4c, 0x68, 0x84, 0xc7, 0xd6, 0x81,
-	0xd2, 0x5c, 0xc3, 0x90, 0xfd, 0x4c, 0xe8, 0xe5, 0x4d, 0x80, 0x8f, 0xd3, 0x8e, 0x60, 0x0d, 0xf7,
-	0xfe, 0x66, 0xd9, 0x52, 0x2e, 0xd4, 0xa8, 0x0c, 0xdb, 0x0e, 0xb2, 0x6d, 0xb1, 0xc0, 0xcd, 0xd6,
-	0x01, 0x88, 0x92, 0xb4, 0x23, 0x46, 0x40, 0xd8, 0xfc, 0xc3, 0xdd, 0x37, 0xd8, 0xf5, 0xd2, 0x0a,
-	0xf6, 0x82, 0xd0, 0x57, 0xec, 0x71, 0x64, 0xf7, 0xf5, 0x76, 0xf5, 0x89, 0x8d, 0x5d, 0xd5, 0xa5,
-	0x29, 0x94, 0x86, 0xfc, 0x0b, 0x24, 0x3f, 0x64, 0x8f, 0xaa, 0x4f, 0x35, 0xbb, 0xa7, 0xe1, 0xb1,
-	0x12, 0x7d, 0xd9, 0x86, 0xb1, 0xbe, 0x50, 0x3a, 0x49, 0x71, 0xd4, 0x9e, 0x7d, 0xc7, 0xbb, 0xa2,
-	0x9f, 0xea, 0xe1, 0x6e, 0x83, 0xf9, 0xf5, 0x4f, 0x64, 0x03, 0x3a, 0x83, 0x2f, 0x59, 0xb1, 0x5b,
-	0x6e, 0xe4, 0x7c, 0xd5, 0xfa, 0x6a, 0x54, 0x8b, 0x8c, 0xa5, 0x06, 0x5a, 0xf2, 0xd8, 0xbc, 0x1b,
-	0xa0, 0x9d, 0x6f, 0xf8, 0x25, 0xa1, 0x33, 0x98, 0x59, 0xa5, 0x7b, 0xe7, 0xab, 0x35, 0x7b, 0x5b,
-	0x91, 0xd9, 0x7b, 0x15, 0xf7, 0x7e, 0x9b, 0x35, 0xdc, 0x7b, 0xe3, 0x6b, 0x57, 0xb6, 0x0d, 0xd8,
-	0x37, 0x84, 0x52, 0x84, 0xdf, 0xc9, 0xfa, 0x93, 0x2d, 0x56, 0xd8, 0x43, 0x85, 0x65, 0xb9, 0x5d,
-	0x2f, 0x34, 0x3c, 0x4d, 0xe4, 0x59, 0x61, 0x4b, 0x15, 0x67, 0x11, 0xe1, 0xed, 0x38, 0x83, 0xfa,
-	0x91, 0xd0, 0x2b, 0xf9, 0x89, 0x3e, 0x18, 0xa0, 0xbb, 0xb2, 0xd9, 0x5a, 0x10, 0xd5, 0xcc, 0xd6,
-	0x09, 0xad, 0xa1, 0xbb, 0x8b, 0x74, 0x21, 0x5b, 0xab, 0x7a, 0x53, 0xd1, 0xde, 0x20, 0x9f, 0x15,
-	0xf6, 0xd6, 0xb0, 0x3f, 0x70, 0x9a, 0x99, 0x94, 0xb2, 0x29, 0x5b, 0x3a, 0xcd, 0x8a, 0xba, 0xda,
-	0x69, 0x36, 0x29, 0x37, 0xa8, 0x8f, 0x10, 0xf5, 0x23, 0xb6, 0xe9, 0x46, 0x2d, 0xa6, 0x28, 0x06,
-	0x6b, 0x31, 0x35, 0x47, 0x9f, 0x31, 0x66, 0xd9, 0x4f, 0x84, 0x5e, 0x9d, 0xf8, 0x4d, 0x54, 0xea,
-	0x61, 0x52, 0x57, 0xe3, 0xe1, 0xbc, 0xdc, 0x78, 0xb8, 0x87, 0x1e, 0x9a, 0x2c, 0xac, 0x6a, 0x86,
-	0xd1, 0x0f, 0xad, 0x51, 0x9f, 0x8e, 0x27, 0xfe, 0x68, 0x34, 0xd7, 0x24, 0xfe, 0xb9, 0xb9, 0x1c,
-	0x4e, 0xad, 0xbf, 0x58, 0xe2, 0x8f, 0x0d, 0xe6, 0xc9, 0x80, 0x65, 0x43, 0x7a, 0x79, 0x0b, 0x94,
-	0xe2, 0x31, 0x94, 0x05, 0xbe, 0x59, 0xae, 0x09, 0xfc, 0x33, 0x95, 0x41, 0x5b, 0x40, 0xb4, 0x37,
-	0xd9, 0x0d, 0x37, 0x5a, 0xd7, 0xec, 0x39, 0xa0, 0x33, 0x8f, 0xb9, 0xe4, 0xdd, 0xd2, 0x8c, 0xc9,
-	0x57, 0x6b, 0x32, 0xc6, 0x8a, 0xa6, 0xcb, 0xb7, 0x1e, 0xaa, 0x1f, 0x3c, 0xfe, 0xeb, 0xc4, 0x23,
-	0xcf, 0x4f, 0x3c, 0xf2, 0xe2, 0xc4, 0x23, 0x5f, 0x9f, 0x7a, 0x97, 0x7e, 0x3f, 0xf5, 0xc8, 0xf3,
-	0x53, 0xef, 0xd2, 0x3f, 0xa7, 0xde, 0xa5, 0xdd, 0x56, 0x9c, 0xe8, 0x27, 0xfd, 0xbd, 0xa0, 0x2d,
-	0xba, 0xe6, 0x29, 0x29, 0xe8, 0xcf, 0x85, 0xfc, 0xcc, 0x7c, 0x5a, 0x6b, 0x0b, 0x09, 0xe1, 0x91,
-	0x79, 0xb4, 0x1e, 0xf4, 0x40, 0xed, 0xcd, 0xe0, 0x3f, 0x43, 0xef, 0xfe, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0x86, 0xad, 0x8c, 0x47, 0xbd, 0x0d, 0x00, 0x00,
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x1c, 0x52, 0x34, 0xb4, 0x22, 0x0c, 0x41, 0xa8, 0x21, 0x35, 0x61, 0xeb, 0x24,
+	0xcd, 0xaf, 0xdd, 0xd8, 0xb4, 0x14, 0xc2, 0x29, 0x21, 0x8a, 0x00, 0x35, 0x55, 0x49, 0x38, 0xe5,
+	0xb2, 0x9a, 0x38, 0xcf, 0xee, 0x8a, 0x78, 0xd7, 0xdd, 0x19, 0x87, 0x58, 0xc1, 0x07, 0xf8, 0x07,
+	0xf8, 0x25, 0x21, 0x71, 0x81, 0x1b, 0x07, 0x7a, 0xe0, 0x88, 0x84, 0x84, 0x04, 0x9c, 0x38, 0x56,
+	0xe2, 0xc2, 0xb1, 0x4a, 0x10, 0x7f, 0x47, 0xb5, 0x6f, 0x67, 0x6c, 0xaf, 0x77, 0xf6, 0x47, 0x6e,
+	0xf1, 0xce, 0xf7, 0x3b, 0xf3, 0x79, 0x33, 0x6f, 0xde, 0x3c, 0x85, 0x5a, 0xfc, 0x14, 0x8e, 0x79,
+	0xe8, 0xf8, 0x70, 0xda, 0x15, 0xce, 0x49, 0xed, 0x10, 0x24, 0xaf, 0x39, 0x02, 0xc2, 0x13, 0xaf,
+	0x01, 0x76, 0x27, 0x0c, 0x64, 0xc0, 0xa6, 0x63, 0x8d, 0x8d, 0x1a, 0x5b, 0x69, 0x66, 0xa6, 0x5b,
+	0x41, 0x2b, 0x40, 0x81, 0x13, 0xfd, 0x15, 0x6b, 0x67, 0x66, 0x5b, 0x41, 0xd0, 0x3a, 0x06, 0x87,
+	0x77, 0x3c, 0x87, 0xfb, 0x7e, 0x20, 0xb9, 0xf4, 0x02, 0x5f, 0xa8, 0xd1, 0x1b, 0xc6, 0xd5, 0xe4,
+	0xa9, 0x1a, 0x9e, 0x33, 0x0e, 0x3f, 0xea, 0x42, 0xd8, 0x8b, 0x15, 0xf5, 0xef, 0xae, 0x52, 0xba,
+	0x2b, 0x5a, 0xfb, 0x31, 0x1f, 0xfb, 0x8d, 0xd0, 0x57, 0xf7, 0xa0, 0xe5, 0x09, 0x09, 0xe1, 0x7b,
+	0x0f, 0xb9, 0xe7, 0xef, 0x72, 0xcf, 0x97, 0xdc, 0xf3, 0x21, 0x64, 0xb7, 0x6d, 0x13, 0xb6, 0x9d,
+	0x21, 0xdf, 0x83, 0x47, 0x5d, 0x10, 0x72, 0xe6, 0xce, 0x25, 0x5d, 0xa2, 0x13, 0xf8, 0x02, 0xac,
+	0xfa, 0x17, 0xff, 0xfc, 0xf7, 0xed, 0x73, 0xab, 0xd6, 0xa2, 0x93, 0x08, 0x21, 0x54, 0x36, 0xb7,
+	0x11, 0xf9, 0xdc, 0xf6, 0xc0, 0xb8, 0x41, 0x96, 0xd9, 0x5f, 0x84, 0x5e, 0xdf, 0x86, 0x30, 0x03,
+	0xff, 0x2d, 0x33, 0x48, 0xa6, 0x41, 0x07, 0x70, 0xf7, 0xd2, 0x3e, 0x15, 0xc2, 0x6d, 0x0c, 0xc1,
+	0xde, 0x20, 0xcb, 0xd6, 0x52, 0x32, 0x8a, 0x23, 0xc8, 0x8c, 0x83, 0x7d, 0x4d, 0xe8, 0xb5, 0xcd,
+	0x86, 0xf4, 0x4e, 0xb8, 0x04, 0x9c, 0x99, 0x2d, 0x9b, 0x01, 0x12, 0x22, 0x0d, 0xbb, 0x52, 0x4a,
+	0xab, 0x00, 0x17, 0x11, 0xf0, 0x8d, 0x08, 0x70, 0x36, 0x09, 0xc8, 0x95, 0x3e, 0xc6, 0x63, 0xdf,
+	0x13, 0xfa, 0xe2, 0x36, 0xf0, 0x04, 0xd5, 0x6a, 0xd6, 0xb6, 0x70, 0x13, 0xd7, 0x5a, 0x49, 0xb5,
+	0x22, 0x5b, 0x42, 0xb2, 0x9b, 0x56, 0x65, 0x7c, 0xdf, 0x92, 0x60, 0xd1, 0xa1, 0xff, 0x40, 0xe8,
+	0x94, 0x4e, 0xa6, 0x4d, 0x21, 0x40, 0xee, 0x00, 0xb0, 0xb5, 0xfc, 0xa4, 0xd3, 0x3a, 0x4d, 0x67,
+	0x97, 0x95, 0x2b, 0xbc, 0x15, 0xc4, 0x9b, 0xb7, 0xe6, 0x32, 0x92, 0x93, 0x47, 0x06, 0xb7, 0x09,
+	0x10, 0x01, 0xfe, 0x42, 0xe8, 0xf4, 0x3e, 0xc8, 0x8f, 0x43, 0xee, 0x8b, 0x26, 0x84, 0x7b, 0x5c,
+	0xc2, 0x3d, 0xaf, 0xed, 0x49, 0x56, 0x33, 0xaf, 0x6a, 0xd2, 0x6a, 0xd0, 0xfa, 0x65, 0x2c, 0x0a,
+	0x76, 0x1d, 0x61, 0x97, 0xad, 0xf9, 0x24, 0x6c, 0x44, 0x28, 0x95, 0xc9, 0x0d, 0xa3, 0x2d, 0x3d,
+	0x8e, 0x6c, 0x11, 0xf1, 0x63, 0x42, 0x5f, 0xde, 0x07, 0xb9, 0x79, 0x74, 0x14, 0x82, 0x10, 0x43,
+	0xe0, 0xf5, 0xcc, 0xd5, 0xc7, 0xa5, 0x9a, 0xb7, 0x76, 0x09, 0x87, 0xc2, 0x75, 0x10, 0x77, 0xc9,
+	0xaa, 0xa6, 0x71, 0x79, 0xec, 0x31, 0xd3, 0xee, 0x82, 0x10, 0xbc, 0x05, 0x65, 0x68, 0xc7, 0xa5,
+	0xc5, 0xb4, 0x69, 0x47, 0x92, 0x36, 0xba, 0x42, 0x06, 0xe0, 0x76, 0x6c, 0x1b, 0x01, 0x66, 0x3f,
+	0x11, 0xca, 0xf6, 0x40, 0x86, 0xbd, 0x1d, 0xee, 0x1d, 0xc3, 0x91, 0x9a, 0x98, 0x39, 0x59, 0x19,
+	0x38, 0xae, 0xd4, 0xac, 0xeb, 0xe5, 0x0d, 0x0a, 0x75, 0x0d, 0x51, 0x17, 0x2d, 0x6b, 0x3c, 0x69,
+	0x65, 0xd8, 0x73, 0x9b, 0x68, 0xd1, 0xc0, 0x1b, 0x64, 0xb9, 0xfe, 0xe5, 0x2b, 0xf4, 0xea, 0x47,
+	0xd1, 0x43, 0xa1, 0x9f, 0x86, 0xff, 0x09, 0x9d, 0xbe, 0xc7, 0x25, 0x08, 0xb9, 0x0d, 0x9d, 0x40,
+	0x78, 0xfa, 0x08, 0xb3, 0xf2, 0xd8, 0xa4, 0x2d, 0xc8, 0x63, 0xb3, 0x45, 0xf1, 0xb7, 0x90, 0x9f,
+	0x33, 0xd7, 0x31, 0x3e, 0x6a, 0xc7, 0xe8, 0x75, 0x8f, 0x62, 0xb3, 0xce, 0x15, 0xe7, 0x2c, 0x84,
+	0x86, 0xd7, 0xf1, 0xc0, 0x8f, 0x3f, 0xf5, 0x47, 0x3f, 0x60, 0x0d, 0xe9, 0x3b, 0x67, 0xda, 0x13,
+	0xff, 0x66, 0xbf, 0x12, 0xfa, 0x92, 0xbe, 0x4e, 0x62, 0x27, 0x88, 0xeb, 0x3b, 0xcb, 0xa8, 0x11,
+	0x29, 0xa1, 0x0e, 0xd1, 0x29, 0xad, 0x57, 0xf1, 0x6d, 0x62, 0x7c, 0xef, 0xb2, 0x77, 0xcc, 0xf1,
+	0xe9, 0xbb, 0x2a, 0xdc, 0x66, 0xa0, 0x9e, 0x0d, 0xe7, 0x4c, 0x47, 0x20, 0x24, 0x97, 0xd0, 0x8f,
+	0xae, 0xc2, 0x95, 0x1d, 0x80, 0x0f, 0xfc, 0x66, 0xc0, 0xaa, 0xe6, 0xf5, 0xd5, 0xb0, 0xa6, 0x9c,
+	0x2f, 0x50, 0x29, 0xb6, 0x7d, 0x64, 0xdb, 0x3d, 0x78, 0x8d, 0x5d, 0x37, 0xd3, 0x35, 0x01, 0x98,
+	0x9d, 0x39, 0xe4, 0x7a, 0x7e, 0x33, 0x18, 0xd2, 0x62, 0x79, 0xec, 0xb3, 0xa7, 0x84, 0xbe, 0xa0,
+	0xb7, 0x23, 0x2a, 0xda, 0xb7, 0xf2, 0x77, 0x6c, 0xa4, 0x5e, 0x2f, 0x95, 0x50, 0x2a, 0xf2, 0xcf,
+	0x90, 0xfc, 0x84, 0xdd, 0xcf, 0xdf, 0xd5, 0xa8, 0x58, 0x3b, 0x67, 0x22, 0xe8, 0x86, 0x0d, 0x18,
+	0xc9, 0x0b, 0x21, 0x3d, 0x1f, 0xfb, 0xad, 0xc1, 0x37, 0xde, 0x0e, 0xba, 0xbe, 0xec, 0x1f, 0x54,
+	0x99, 0x55, 0x3c, 0x23, 0xeb, 0xd1, 0x49, 0x3c, 0x64, 0xc1, 0x6e, 0x9a, 0x91, 0xe3, 0x51, 0x1d,
+	0x57, 0x35, 0x5f, 0xa4, 0x42, 0xaa, 0x62, 0x48, 0x15, 0x36, 0x6b, 0x06, 0x68, 0xc4, 0x0b, 0x7e,
+	0x4e, 0xe8, 0x24, 0x3e, 0x5c, 0x99, 0x6b, 0xc7, 0xa3, 0x05, 0x6b, 0x6b, 0x91, 0x5a, 0x7b, 0x15,
+	0xd7, 0x5e, 0x60, 0x55, 0xf3, 0xda, 0x78, 0xb2, 0x42, 0x9f, 0x34, 0xfb, 0x86, 0x50, 0x8a, 0xf0,
+	0xfb, 0x92, 0x4b, 0x60, 0x8b, 0x39, 0xe1, 0xa1, 0x42, 0xb3, 0xdc, 0x2a, 0x16, 0x2a, 0x9e, 0x1a,
+	0xf2, 0xac, 0xb0, 0xa5, 0x9c, 0xbd, 0x70, 0xf1, 0x76, 0x0c, 0xa0, 0x7e, 0x24, 0xf4, 0x5a, 0xbc,
+	0xa3, 0x5b, 0x3d, 0x8c, 0x2e, 0xab, 0xc1, 0x4a, 0x88, 0x0a, 0x1a, 0xac, 0x31, 0xad, 0xa2, 0xbb,
+	0x83, 0x74, 0x0e, 0x5b, 0xcb, 0x3b, 0x29, 0xf7, 0xb0, 0x17, 0x37, 0x0c, 0x83, 0x8b, 0xf1, 0x27,
+	0xb6, 0x34, 0xaa, 0x4a, 0xe9, 0x2a, 0x9b, 0xd9, 0xd2, 0x24, 0x75, 0x85, 0x2d, 0xcd, 0xb8, 0x5c,
+	0xa1, 0xde, 0x47, 0xd4, 0xf7, 0xd9, 0x8e, 0x19, 0x35, 0x59, 0x45, 0xb1, 0xb0, 0x26, 0xab, 0xe6,
+	0xf0, 0x37, 0x96, 0x59, 0xf6, 0x33, 0xa1, 0x53, 0x63, 0x8d, 0x71, 0x66, 0x0c, 0xe3, 0xba, 0x82,
+	0x18, 0xd2, 0x72, 0x15, 0xc3, 0x5d, 0x8c, 0xa1, 0xc6, 0x9c, 0xbc, 0x64, 0x18, 0xb6, 0xda, 0xc3,
+	0x3c, 0x1d, 0xad, 0xf8, 0xc3, 0x06, 0xa2, 0xa0, 0xe2, 0xa7, 0xda, 0x07, 0xa7, 0xb4, 0xfe, 0x72,
+	0x15, 0x7f, 0xa4, 0x7d, 0x48, 0xd5, 0xd0, 0xdf, 0x09, 0x9d, 0x4a, 0xf5, 0x69, 0x19, 0xdb, 0x9c,
+	0xd5, 0xa4, 0xd9, 0x65, 0xe5, 0x0a, 0xfb, 0x43, 0xc4, 0xde, 0x66, 0x5b, 0x66, 0xec, 0x74, 0x97,
+	0x36, 0x42, 0x1d, 0x8f, 0x0d, 0xf9, 0x1f, 0x13, 0x3a, 0x95, 0xea, 0xdc, 0x32, 0xf8, 0xb3, 0xda,
+	0x36, 0xbb, 0xac, 0x5c, 0xf1, 0xbf, 0x8d, 0xfc, 0x75, 0xb6, 0x6e, 0xe6, 0x4f, 0x37, 0x6d, 0x83,
+	0x3c, 0xe9, 0xd3, 0x2b, 0xba, 0x61, 0xab, 0xe6, 0x2f, 0x9a, 0xff, 0xbc, 0x8e, 0xb7, 0x66, 0xf3,
+	0x48, 0xf4, 0x3a, 0xbb, 0x91, 0x4b, 0x14, 0x95, 0xf4, 0xe7, 0x95, 0x55, 0xb0, 0xfc, 0xa9, 0x07,
+	0x77, 0x68, 0xa1, 0x48, 0xa6, 0x10, 0x16, 0x10, 0x61, 0x8e, 0x55, 0x72, 0x11, 0x44, 0xf4, 0xa2,
+	0x3d, 0xe0, 0x21, 0x6f, 0x67, 0xbe, 0x2a, 0xf1, 0x68, 0xc1, 0xab, 0xa2, 0x45, 0xe5, 0x5e, 0xb4,
+	0x0e, 0xaa, 0xb7, 0x1e, 0xfc, 0x7d, 0x5e, 0x21, 0x4f, 0xce, 0x2b, 0xe4, 0xe9, 0x79, 0x85, 0x7c,
+	0x75, 0x51, 0x99, 0xf8, 0xe3, 0xa2, 0x42, 0x9e, 0x5c, 0x54, 0x26, 0xfe, 0xbd, 0xa8, 0x4c, 0x1c,
+	0xd4, 0x5b, 0x9e, 0x7c, 0xd8, 0x3d, 0xb4, 0x1b, 0x41, 0x5b, 0xcd, 0xe2, 0x83, 0xfc, 0x34, 0x08,
+	0x3f, 0x51, 0xbf, 0xd6, 0x1a, 0x41, 0x08, 0xce, 0xa9, 0x9a, 0x5a, 0xf6, 0x3a, 0x20, 0x0e, 0x27,
+	0xf1, 0x7f, 0x20, 0x6f, 0x3e, 0x1b, 0x00, 0x00, 0x85, 0x02, 0x27, 0xb4, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -121,6 +132,9 @@ type MsgServiceClient interface {
 	DeactivateChain(ctx context.Context, in *DeactivateChainRequest, opts ...grpc.CallOption) (*DeactivateChainResponse, error)
 	RegisterAssetFee(ctx context.Context, in *RegisterAssetFeeRequest, opts ...grpc.CallOption) (*RegisterAssetFeeResponse, error)
 	SetTransferRateLimit(ctx context.Context, in *SetTransferRateLimitRequest, opts ...grpc.CallOption) (*SetTransferRateLimitResponse, error)
+	SetAddressRateLimit(ctx context.Context, in *SetAddressRateLimitRequest, opts ...grpc.CallOption) (*SetAddressRateLimitResponse, error)
+	SetMessageRateLimit(ctx context.Context, in *SetMessageRateLimitRequest, opts ...grpc.CallOption) (*SetMessageRateLimitResponse, error)
+	RetryFailedMessage(ctx context.Context, in *RetryFailedMessageRequest, opts ...grpc.CallOption) (*RetryFailedMessageResponse, error)
 }
 
 type msgServiceClient struct {
@@ -185,6 +199,33 @@ func (c *msgServiceClient) SetTransferRateLimit(ctx context.Context, in *SetTran
 	return out, nil
 }
 
+func (c *msgServiceClient) SetAddressRateLimit(ctx context.Context, in *SetAddressRateLimitRequest, opts ...grpc.CallOption) (*SetAddressRateLimitResponse, error) {
+	out := new(SetAddressRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.MsgService/SetAddressRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetMessageRateLimit(ctx context.Context, in *SetMessageRateLimitRequest, opts ...grpc.CallOption) (*SetMessageRateLimitResponse, error) {
+	out := new(SetMessageRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.MsgService/SetMessageRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RetryFailedMessage(ctx context.Context, in *RetryFailedMessageRequest, opts ...grpc.CallOption) (*RetryFailedMessageResponse, error) {
+	out := new(RetryFailedMessageResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.MsgService/RetryFailedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	RegisterChainMaintainer(context.Context, *RegisterChainMaintainerRequest) (*RegisterChainMaintainerResponse, error)
@@ -193,6 +234,9 @@ type MsgServiceServer interface {
 	DeactivateChain(context.Context, *DeactivateChainRequest) (*DeactivateChainResponse, error)
 	RegisterAssetFee(context.Context, *RegisterAssetFeeRequest) (*RegisterAssetFeeResponse, error)
 	SetTransferRateLimit(context.Context, *SetTransferRateLimitRequest) (*SetTransferRateLimitResponse, error)
+	SetAddressRateLimit(context.Context, *SetAddressRateLimitRequest) (*SetAddressRateLimitResponse, error)
+	SetMessageRateLimit(context.Context, *SetMessageRateLimitRequest) (*SetMessageRateLimitResponse, error)
+	RetryFailedMessage(context.Context, *RetryFailedMessageRequest) (*RetryFailedMessageResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -217,6 +261,15 @@ func (*UnimplementedMsgServiceServer) RegisterAssetFee(ctx context.Context, req
 func (*UnimplementedMsgServiceServer) SetTransferRateLimit(ctx context.Context, req *SetTransferRateLimitRequest) (*SetTransferRateLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetTransferRateLimit not implemented")
 }
+func (*UnimplementedMsgServiceServer) SetAddressRateLimit(ctx context.Context, req *SetAddressRateLimitRequest) (*SetAddressRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressRateLimit not implemented")
+}
+func (*UnimplementedMsgServiceServer) SetMessageRateLimit(ctx context.Context, req *SetMessageRateLimitRequest) (*SetMessageRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageRateLimit not implemented")
+}
+func (*UnimplementedMsgServiceServer) RetryFailedMessage(ctx context.Context, req *RetryFailedMessageRequest) (*RetryFailedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedMessage not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -330,6 +383,60 @@ func _MsgService_SetTransferRateLimit_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_SetAddressRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetAddressRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.MsgService/SetAddressRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetAddressRateLimit(ctx, req.(*SetAddressRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetMessageRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetMessageRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.MsgService/SetMessageRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetMessageRateLimit(ctx, req.(*SetMessageRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RetryFailedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RetryFailedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.MsgService/RetryFailedMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RetryFailedMessage(ctx, req.(*RetryFailedMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.nexus.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -358,6 +465,18 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetTransferRateLimit",
 			Handler:    _MsgService_SetTransferRateLimit_Handler,
 		},
+		{
+			MethodName: "SetAddressRateLimit",
+			Handler:    _MsgService_SetAddressRateLimit_Handler,
+		},
+		{
+			MethodName: "SetMessageRateLimit",
+			Handler:    _MsgService_SetMessageRateLimit_Handler,
+		},
+		{
+			MethodName: "RetryFailedMessage",
+			Handler:    _MsgService_RetryFailedMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/nexus/v1beta1/service.proto",
@@ -391,7 +510,17 @@ type QueryServiceClient interface {
 	// TransferRateLimit queries the transfer rate limit for a given chain and
 	// asset. If a rate limit is not set, nil is returned.
 	TransferRateLimit(ctx context.Context, in *TransferRateLimitRequest, opts ...grpc.CallOption) (*TransferRateLimitResponse, error)
+	// AddressRateLimit queries the transfer rate limit for a given address and
+	// asset. If a rate limit is not set, nil is returned.
+	AddressRateLimit(ctx context.Context, in *AddressRateLimitRequest, opts ...grpc.CallOption) (*AddressRateLimitResponse, error)
+	// MessageRateLimit queries the rate limit on the general messages routed to
+	// a given chain, or sent from a given address if the address is set. If a
+	// rate limit is not set, nil is returned.
+	MessageRateLimit(ctx context.Context, in *MessageRateLimitRequest, opts ...grpc.CallOption) (*MessageRateLimitResponse, error)
 	Message(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// Messages queries general messages by source chain, destination chain,
+	// sender and status
+	Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
@@ -502,6 +631,24 @@ func (c *queryServiceClient) TransferRateLimit(ctx context.Context, in *Transfer
 	return out, nil
 }
 
+func (c *queryServiceClient) AddressRateLimit(ctx context.Context, in *AddressRateLimitRequest, opts ...grpc.CallOption) (*AddressRateLimitResponse, error) {
+	out := new(AddressRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/AddressRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) MessageRateLimit(ctx context.Context, in *MessageRateLimitRequest, opts ...grpc.CallOption) (*MessageRateLimitResponse, error) {
+	out := new(MessageRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/MessageRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Message(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
 	out := new(MessageResponse)
 	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/Message", in, out, opts...)
@@ -511,6 +658,15 @@ func (c *queryServiceClient) Message(ctx context.Context, in *MessageRequest, op
 	return out, nil
 }
 
+func (c *queryServiceClient) Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
+	out := new(MessagesResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/Messages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/Params", in, out, opts...)
@@ -546,7 +702,17 @@ type QueryServiceServer interface {
 	// TransferRateLimit queries the transfer rate limit for a given chain and
 	// asset. If a rate limit is not set, nil is returned.
 	TransferRateLimit(context.Context, *TransferRateLimitRequest) (*TransferRateLimitResponse, error)
+	// AddressRateLimit queries the transfer rate limit for a given address and
+	// asset. If a rate limit is not set, nil is returned.
+	AddressRateLimit(context.Context, *AddressRateLimitRequest) (*AddressRateLimitResponse, error)
+	// MessageRateLimit queries the rate limit on the general messages routed to
+	// a given chain, or sent from a given address if the address is set. If a
+	// rate limit is not set, nil is returned.
+	MessageRateLimit(context.Context, *MessageRateLimitRequest) (*MessageRateLimitResponse, error)
 	Message(context.Context, *MessageRequest) (*MessageResponse, error)
+	// Messages queries general messages by source chain, destination chain,
+	// sender and status
+	Messages(context.Context, *MessagesRequest) (*MessagesResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
@@ -587,9 +753,18 @@ func (*UnimplementedQueryServiceServer) ChainMaintainers(ctx context.Context, re
 func (*UnimplementedQueryServiceServer) TransferRateLimit(ctx context.Context, req *TransferRateLimitRequest) (*TransferRateLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferRateLimit not implemented")
 }
+func (*UnimplementedQueryServiceServer) AddressRateLimit(ctx context.Context, req *AddressRateLimitRequest) (*AddressRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressRateLimit not implemented")
+}
+func (*UnimplementedQueryServiceServer) MessageRateLimit(ctx context.Context, req *MessageRateLimitRequest) (*MessageRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageRateLimit not implemented")
+}
 func (*UnimplementedQueryServiceServer) Message(ctx context.Context, req *MessageRequest) (*MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Message not implemented")
 }
+func (*UnimplementedQueryServiceServer) Messages(ctx context.Context, req *MessagesRequest) (*MessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Messages not implemented")
+}
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -796,6 +971,42 @@ func _QueryService_TransferRateLimit_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_AddressRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).AddressRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.QueryService/AddressRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).AddressRateLimit(ctx, req.(*AddressRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_MessageRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MessageRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.QueryService/MessageRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MessageRateLimit(ctx, req.(*MessageRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Message_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MessageRequest)
 	if err := dec(in); err != nil {
@@ -814,6 +1025,24 @@ func _QueryService_Message_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Messages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Messages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.QueryService/Messages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Messages(ctx, req.(*MessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -880,10 +1109,22 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferRateLimit",
 			Handler:    _QueryService_TransferRateLimit_Handler,
 		},
+		{
+			MethodName: "AddressRateLimit",
+			Handler:    _QueryService_AddressRateLimit_Handler,
+		},
+		{
+			MethodName: "MessageRateLimit",
+			Handler:    _QueryService_MessageRateLimit_Handler,
+		},
 		{
 			MethodName: "Message",
 			Handler:    _QueryService_Message_Handler,
 		},
+		{
+			MethodName: "Messages",
+			Handler:    _QueryService_Messages_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
@@ -12,7 +12,7 @@ import (
 
 // RandRateLimit returns a random rate limit for a given chain and asset
 func RandRateLimit(chain exported.ChainName, asset string) types.RateLimit {
-	return types.RateLimit{Chain: chain, Limit: sdk.NewCoin(asset, RandInt(100000000, 200000000)), Window: time.Hour}
+	return types.RateLimit{Chain: chain, Limit: sdk.NewCoin(asset, RandInt(100000000, 200000000)), Window: time.Hour, Mode: rand.Of(types.FixedWindow, types.SlidingWindow, types.TokenBucket)}
 }
 
 // RandFee returns a random fee info for a given chain and asset
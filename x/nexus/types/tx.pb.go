@@ -414,6 +414,7 @@ type SetTransferRateLimitRequest struct {
 	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Limit  types.Coin                                                      `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit"`
 	Window time.Duration                                                   `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window"`
+	Mode   RateLimitMode                                                   `protobuf:"varint,5,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
 }
 
 func (m *SetTransferRateLimitRequest) Reset()         { *m = SetTransferRateLimitRequest{} }
@@ -485,6 +486,249 @@ func (m *SetTransferRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetTransferRateLimitResponse proto.InternalMessageInfo
 
+// SetAddressRateLimitRequest represents a message to set rate limits on the
+// transfers from and to a single address. Anyone can link new deposit
+// addresses, so limiting a deposit address does not limit its depositors; EVM
+// deposits are also limited by the address that sent the tokens to the deposit
+// address
+type SetAddressRateLimitRequest struct {
+	Sender  github_com_cosmos_cosmos_sdk_types.AccAddress                   `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Address string                                                          `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   types.Coin                                                      `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit"`
+	Window  time.Duration                                                   `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
+	Mode    RateLimitMode                                                   `protobuf:"varint,6,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
+}
+
+func (m *SetAddressRateLimitRequest) Reset()         { *m = SetAddressRateLimitRequest{} }
+func (m *SetAddressRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAddressRateLimitRequest) ProtoMessage()    {}
+func (*SetAddressRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e92eae487d1107, []int{12}
+}
+func (m *SetAddressRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAddressRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAddressRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAddressRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAddressRateLimitRequest.Merge(m, src)
+}
+func (m *SetAddressRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAddressRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAddressRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAddressRateLimitRequest proto.InternalMessageInfo
+
+type SetAddressRateLimitResponse struct {
+}
+
+func (m *SetAddressRateLimitResponse) Reset()         { *m = SetAddressRateLimitResponse{} }
+func (m *SetAddressRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAddressRateLimitResponse) ProtoMessage()    {}
+func (*SetAddressRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e92eae487d1107, []int{13}
+}
+func (m *SetAddressRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAddressRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAddressRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAddressRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAddressRateLimitResponse.Merge(m, src)
+}
+func (m *SetAddressRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAddressRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAddressRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAddressRateLimitResponse proto.InternalMessageInfo
+
+// SetMessageRateLimitRequest represents a message to set rate limits on the
+// general messages routed to a chain, or sent from a single address of the
+// chain if the address is set
+type SetMessageRateLimitRequest struct {
+	Sender  github_com_cosmos_cosmos_sdk_types.AccAddress                   `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Address string                                                          `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   uint64                                                          `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Window  time.Duration                                                   `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
+	Mode    RateLimitMode                                                   `protobuf:"varint,6,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
+}
+
+func (m *SetMessageRateLimitRequest) Reset()         { *m = SetMessageRateLimitRequest{} }
+func (m *SetMessageRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMessageRateLimitRequest) ProtoMessage()    {}
+func (*SetMessageRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e92eae487d1107, []int{14}
+}
+func (m *SetMessageRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMessageRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMessageRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetMessageRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMessageRateLimitRequest.Merge(m, src)
+}
+func (m *SetMessageRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMessageRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMessageRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMessageRateLimitRequest proto.InternalMessageInfo
+
+type SetMessageRateLimitResponse struct {
+}
+
+func (m *SetMessageRateLimitResponse) Reset()         { *m = SetMessageRateLimitResponse{} }
+func (m *SetMessageRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*SetMessageRateLimitResponse) ProtoMessage()    {}
+func (*SetMessageRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e92eae487d1107, []int{15}
+}
+func (m *SetMessageRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMessageRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMessageRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetMessageRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMessageRateLimitResponse.Merge(m, src)
+}
+func (m *SetMessageRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMessageRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMessageRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMessageRateLimitResponse proto.InternalMessageInfo
+
+// RetryFailedMessageRequest represents a message to route a failed general
+// message again. Only the sender of the message, the access control role and
+// maintainers of its chains may retry it
+type RetryFailedMessageRequest struct {
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	ID     string                                        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// required if the destination is a cosmos chain
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *RetryFailedMessageRequest) Reset()         { *m = RetryFailedMessageRequest{} }
+func (m *RetryFailedMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryFailedMessageRequest) ProtoMessage()    {}
+func (*RetryFailedMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e92eae487d1107, []int{16}
+}
+func (m *RetryFailedMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryFailedMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryFailedMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryFailedMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryFailedMessageRequest.Merge(m, src)
+}
+func (m *RetryFailedMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryFailedMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryFailedMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryFailedMessageRequest proto.InternalMessageInfo
+
+type RetryFailedMessageResponse struct {
+}
+
+func (m *RetryFailedMessageResponse) Reset()         { *m = RetryFailedMessageResponse{} }
+func (m *RetryFailedMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*RetryFailedMessageResponse) ProtoMessage()    {}
+func (*RetryFailedMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4e92eae487d1107, []int{17}
+}
+func (m *RetryFailedMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryFailedMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryFailedMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryFailedMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryFailedMessageResponse.Merge(m, src)
+}
+func (m *RetryFailedMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryFailedMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryFailedMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryFailedMessageResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RegisterChainMaintainerRequest)(nil), "axelar.nexus.v1beta1.RegisterChainMaintainerRequest")
 	proto.RegisterType((*RegisterChainMaintainerResponse)(nil), "axelar.nexus.v1beta1.RegisterChainMaintainerResponse")
@@ -498,49 +742,65 @@ func init() {
 	proto.RegisterType((*RegisterAssetFeeResponse)(nil), "axelar.nexus.v1beta1.RegisterAssetFeeResponse")
 	proto.RegisterType((*SetTransferRateLimitRequest)(nil), "axelar.nexus.v1beta1.SetTransferRateLimitRequest")
 	proto.RegisterType((*SetTransferRateLimitResponse)(nil), "axelar.nexus.v1beta1.SetTransferRateLimitResponse")
+	proto.RegisterType((*SetAddressRateLimitRequest)(nil), "axelar.nexus.v1beta1.SetAddressRateLimitRequest")
+	proto.RegisterType((*SetAddressRateLimitResponse)(nil), "axelar.nexus.v1beta1.SetAddressRateLimitResponse")
+	proto.RegisterType((*SetMessageRateLimitRequest)(nil), "axelar.nexus.v1beta1.SetMessageRateLimitRequest")
+	proto.RegisterType((*SetMessageRateLimitResponse)(nil), "axelar.nexus.v1beta1.SetMessageRateLimitResponse")
+	proto.RegisterType((*RetryFailedMessageRequest)(nil), "axelar.nexus.v1beta1.RetryFailedMessageRequest")
+	proto.RegisterType((*RetryFailedMessageResponse)(nil), "axelar.nexus.v1beta1.RetryFailedMessageResponse")
 }
 
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/tx.proto", fileDescriptor_c4e92eae487d1107) }
 
 var fileDescriptor_c4e92eae487d1107 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x95, 0x3d, 0x6f, 0x13, 0x31,
-	0x18, 0xc7, 0xe3, 0xb4, 0x0d, 0xc5, 0x30, 0x45, 0x85, 0x5e, 0x4b, 0xb9, 0xa4, 0x41, 0x42, 0x61,
-	0x88, 0x4f, 0x29, 0x62, 0x81, 0x01, 0xe5, 0x45, 0x45, 0x95, 0x00, 0xa1, 0x83, 0x05, 0x18, 0x90,
-	0x73, 0xf7, 0xdc, 0xd5, 0x6a, 0x62, 0x1f, 0xb6, 0xd3, 0x84, 0x8d, 0x8f, 0xc0, 0xc8, 0xf7, 0x40,
-	0x8c, 0x6c, 0x08, 0x65, 0x42, 0x19, 0x99, 0x02, 0x24, 0xdf, 0xa2, 0x13, 0x8a, 0xcf, 0x09, 0xb4,
-	0x85, 0x4a, 0x48, 0x74, 0x28, 0xd3, 0xd9, 0x7a, 0x5e, 0xfc, 0xff, 0xfd, 0xcf, 0xf2, 0x83, 0xaf,
-	0xd2, 0x3e, 0xb4, 0xa9, 0xf4, 0x38, 0xf4, 0xbb, 0xca, 0xdb, 0xaf, 0xb6, 0x40, 0xd3, 0xaa, 0xa7,
-	0xfb, 0x24, 0x91, 0x42, 0x8b, 0xfc, 0x4a, 0x1a, 0x26, 0x26, 0x4c, 0x6c, 0x78, 0x7d, 0x23, 0x16,
-	0x22, 0x6e, 0x83, 0x47, 0x13, 0xe6, 0x51, 0xce, 0x85, 0xa6, 0x9a, 0x09, 0xae, 0xd2, 0x9a, 0x75,
-	0xd7, 0x46, 0xcd, 0xae, 0xd5, 0x8d, 0xbc, 0xb0, 0x2b, 0x4d, 0x82, 0x8d, 0xaf, 0xc4, 0x22, 0x16,
-	0x66, 0xe9, 0x4d, 0x57, 0xb3, 0xaa, 0x40, 0xa8, 0x8e, 0x50, 0x5e, 0x8b, 0x2a, 0x98, 0xeb, 0x08,
-	0x04, 0x9b, 0x55, 0xdd, 0x38, 0x24, 0x14, 0xfa, 0x89, 0x90, 0x1a, 0xc2, 0x9f, 0x8a, 0x5f, 0x25,
-	0x30, 0x13, 0x40, 0x6c, 0x6a, 0x02, 0xb2, 0xc3, 0x94, 0x62, 0x82, 0x9f, 0x98, 0x5f, 0xfa, 0x8c,
-	0xb0, 0xeb, 0x43, 0xcc, 0x94, 0x06, 0xd9, 0xd8, 0xa5, 0x8c, 0x3f, 0xa0, 0x8c, 0x6b, 0xca, 0x38,
-	0x48, 0x1f, 0x5e, 0x76, 0x41, 0xe9, 0xfc, 0x0e, 0xce, 0x29, 0xe0, 0x21, 0x48, 0x07, 0x15, 0x51,
-	0xf9, 0x62, 0xbd, 0x7a, 0x30, 0x2a, 0x54, 0x62, 0xa6, 0x77, 0xbb, 0x2d, 0x12, 0x88, 0x8e, 0x67,
-	0xc5, 0xa7, 0x9f, 0x8a, 0x0a, 0xf7, 0xec, 0x01, 0xb5, 0x20, 0xa8, 0x85, 0xa1, 0x04, 0xa5, 0x7c,
-	0xdb, 0x20, 0xff, 0x1c, 0xe7, 0x82, 0xe9, 0x21, 0xca, 0xc9, 0x16, 0x17, 0xca, 0xe7, 0xeb, 0x8d,
-	0x83, 0x51, 0xe1, 0xee, 0x2f, 0xad, 0x52, 0xf1, 0x1c, 0x74, 0x4f, 0xc8, 0x3d, 0xbb, 0xab, 0x04,
-	0x42, 0x82, 0xd7, 0x3f, 0x02, 0x4f, 0x8c, 0xd8, 0x87, 0xb4, 0x03, 0xbe, 0x6d, 0x79, 0x7b, 0xf1,
-	0xf5, 0x7b, 0x07, 0x95, 0x36, 0x71, 0xe1, 0x8f, 0x3c, 0x2a, 0x11, 0x5c, 0x41, 0x69, 0x88, 0x70,
-	0xb1, 0x09, 0xf2, 0x7f, 0xa2, 0xbe, 0x86, 0x37, 0x4f, 0x20, 0xb2, 0xdc, 0x1f, 0x11, 0x5e, 0xa9,
-	0x05, 0x9a, 0xed, 0x53, 0x0d, 0x26, 0xe7, 0x2c, 0xb2, 0x2e, 0x94, 0x56, 0xf1, 0xa5, 0x23, 0x14,
-	0x96, 0xef, 0x13, 0xc2, 0x97, 0x9b, 0x40, 0xcf, 0x3e, 0xe1, 0x1a, 0x5e, 0x3d, 0xc6, 0x61, 0x19,
-	0xdf, 0x21, 0xbc, 0x3a, 0xbb, 0xdf, 0x35, 0xa5, 0x40, 0x6f, 0x03, 0x9c, 0x02, 0xe4, 0x3d, 0xbc,
-	0x1c, 0x01, 0xbc, 0x60, 0x3c, 0x12, 0x4e, 0xb6, 0x88, 0xca, 0x17, 0xb6, 0xae, 0x93, 0x43, 0xcf,
-	0xe1, 0x9c, 0xc1, 0x3e, 0x2a, 0x64, 0x1b, 0x60, 0x87, 0x47, 0xa2, 0xbe, 0x38, 0x18, 0x15, 0x32,
-	0xfe, 0xb9, 0x28, 0xdd, 0x1a, 0xa0, 0x6c, 0x69, 0x1d, 0x3b, 0xc7, 0x45, 0x5b, 0xa2, 0x0f, 0x59,
-	0x7c, 0xe5, 0x31, 0xe8, 0x27, 0x92, 0x72, 0x15, 0x81, 0xf4, 0xa9, 0x86, 0xfb, 0xac, 0xc3, 0xf4,
-	0x29, 0x50, 0x3d, 0xc5, 0x4b, 0xc6, 0x67, 0x83, 0xf4, 0x8f, 0xfe, 0x5c, 0xda, 0x31, 0x7f, 0x0b,
-	0x2f, 0xb5, 0xa7, 0xaa, 0x9d, 0x05, 0xe3, 0xd6, 0x1a, 0x49, 0xf5, 0x90, 0xe9, 0x93, 0x3e, 0xf7,
-	0xa8, 0x21, 0x18, 0xb7, 0x06, 0xa5, 0xd9, 0xf9, 0x3b, 0x38, 0xd7, 0x63, 0x3c, 0x14, 0x3d, 0x67,
-	0xd1, 0xd6, 0xa5, 0x03, 0x84, 0xcc, 0x06, 0x08, 0x69, 0xda, 0x01, 0x52, 0x5f, 0x9e, 0xd6, 0xbd,
-	0xfd, 0x5a, 0x40, 0xbe, 0x2d, 0xb1, 0x97, 0xc5, 0xc5, 0x1b, 0xbf, 0xb7, 0x2f, 0xf5, 0xb7, 0xfe,
-	0x68, 0xf0, 0xdd, 0xcd, 0x0c, 0xc6, 0x2e, 0x1a, 0x8e, 0x5d, 0xf4, 0x6d, 0xec, 0xa2, 0x37, 0x13,
-	0x37, 0x33, 0x9c, 0xb8, 0x99, 0x2f, 0x13, 0x37, 0xf3, 0x6c, 0xeb, 0xaf, 0xf8, 0x8d, 0xb3, 0xad,
-	0x9c, 0x11, 0x77, 0xf3, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0xe3, 0x80, 0xdd, 0x40, 0x07,
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x95, 0x4f, 0x4f, 0x13, 0x4d,
+	0x1c, 0xc7, 0x3b, 0xdb, 0x3f, 0xc0, 0x3c, 0xe4, 0x39, 0x34, 0x7d, 0x60, 0xe9, 0x03, 0xdb, 0x52,
+	0x12, 0x53, 0x0f, 0xec, 0x06, 0x8c, 0x31, 0xd1, 0x83, 0x69, 0x69, 0x30, 0x24, 0x62, 0xcc, 0xe2,
+	0x45, 0x3d, 0x98, 0xe9, 0xee, 0xaf, 0x65, 0x42, 0x3b, 0x53, 0x77, 0xa6, 0x50, 0x6e, 0xbe, 0x03,
+	0x3d, 0x7a, 0xf2, 0x4d, 0x18, 0xdf, 0x81, 0x31, 0x9c, 0x0c, 0x47, 0x4f, 0x55, 0xcb, 0xdd, 0xa3,
+	0x07, 0x4e, 0xa6, 0xb3, 0xb3, 0xe5, 0x4f, 0x2b, 0x89, 0x04, 0x62, 0xe0, 0xd4, 0x9d, 0xcc, 0xef,
+	0x37, 0xf3, 0xfd, 0x7e, 0x3f, 0xd3, 0x19, 0x3c, 0x47, 0x3a, 0xd0, 0x20, 0x81, 0xc3, 0xa0, 0xd3,
+	0x16, 0xce, 0xf6, 0x52, 0x15, 0x24, 0x59, 0x72, 0x64, 0xc7, 0x6e, 0x05, 0x5c, 0xf2, 0x74, 0x26,
+	0x9c, 0xb6, 0xd5, 0xb4, 0xad, 0xa7, 0xb3, 0xb3, 0x75, 0xce, 0xeb, 0x0d, 0x70, 0x48, 0x8b, 0x3a,
+	0x84, 0x31, 0x2e, 0x89, 0xa4, 0x9c, 0x89, 0xb0, 0x27, 0x6b, 0xe9, 0x59, 0x35, 0xaa, 0xb6, 0x6b,
+	0x8e, 0xdf, 0x0e, 0x54, 0x81, 0x9e, 0xcf, 0xd4, 0x79, 0x9d, 0xab, 0x4f, 0xa7, 0xff, 0x15, 0x75,
+	0x79, 0x5c, 0x34, 0xb9, 0x70, 0xaa, 0x44, 0xc0, 0x40, 0x87, 0xc7, 0x69, 0xd4, 0x75, 0xf3, 0x84,
+	0x50, 0xe8, 0xb4, 0x78, 0x20, 0xc1, 0x3f, 0x52, 0xbc, 0xdb, 0x82, 0x48, 0x40, 0x7e, 0xb4, 0xa7,
+	0x63, 0x15, 0xb6, 0xae, 0x68, 0x41, 0xd0, 0xa4, 0x42, 0x50, 0xce, 0xce, 0x5c, 0xb1, 0xf0, 0x19,
+	0x61, 0xcb, 0x85, 0x3a, 0x15, 0x12, 0x82, 0x95, 0x4d, 0x42, 0xd9, 0x3a, 0xa1, 0x4c, 0x12, 0xca,
+	0x20, 0x70, 0xe1, 0x65, 0x1b, 0x84, 0x4c, 0xaf, 0xe1, 0x94, 0x00, 0xe6, 0x43, 0x60, 0xa2, 0x3c,
+	0x2a, 0x4e, 0x96, 0x97, 0x0e, 0xbb, 0xb9, 0xc5, 0x3a, 0x95, 0x9b, 0xed, 0xaa, 0xed, 0xf1, 0xa6,
+	0xa3, 0xed, 0x85, 0x3f, 0x8b, 0xc2, 0xdf, 0xd2, 0x1b, 0x94, 0x3c, 0xaf, 0xe4, 0xfb, 0x01, 0x08,
+	0xe1, 0xea, 0x05, 0xd2, 0xcf, 0x71, 0xca, 0xeb, 0x6f, 0x22, 0x4c, 0x23, 0x1f, 0x2f, 0x4e, 0x94,
+	0x57, 0x0e, 0xbb, 0xb9, 0xfb, 0xc7, 0x96, 0x0a, 0xc5, 0x33, 0x90, 0x3b, 0x3c, 0xd8, 0xd2, 0xa3,
+	0x45, 0x8f, 0x07, 0xe0, 0x74, 0x4e, 0xc5, 0x63, 0x2b, 0xb1, 0x8f, 0x48, 0x13, 0x5c, 0xbd, 0xe4,
+	0xdd, 0xc4, 0xab, 0x0f, 0x26, 0x2a, 0xcc, 0xe3, 0xdc, 0x6f, 0xfd, 0x88, 0x16, 0x67, 0x02, 0x0a,
+	0xfb, 0x08, 0xe7, 0x2b, 0x10, 0x5c, 0x27, 0xd7, 0x0b, 0x78, 0xfe, 0x0c, 0x47, 0xda, 0xf7, 0x47,
+	0x84, 0x33, 0x25, 0x4f, 0xd2, 0x6d, 0x22, 0x41, 0xd5, 0x5c, 0x45, 0xaf, 0xf1, 0xc2, 0x34, 0xfe,
+	0xef, 0x94, 0x0b, 0xed, 0xef, 0x13, 0xc2, 0x53, 0x15, 0x20, 0x57, 0xdf, 0xe1, 0x0c, 0x9e, 0x1e,
+	0xf2, 0xa1, 0x3d, 0xbe, 0x47, 0x78, 0x3a, 0x3a, 0xdf, 0x25, 0x21, 0x40, 0xae, 0x02, 0x5c, 0x82,
+	0xc9, 0x07, 0x78, 0xbc, 0x06, 0xf0, 0x82, 0xb2, 0x1a, 0x37, 0x8d, 0x3c, 0x2a, 0xfe, 0xb3, 0x7c,
+	0xc3, 0x3e, 0x71, 0x61, 0x0e, 0x3c, 0xe8, 0x4b, 0xc5, 0x5e, 0x05, 0x58, 0x63, 0x35, 0x5e, 0x4e,
+	0xec, 0x75, 0x73, 0x31, 0x77, 0xac, 0x16, 0x0e, 0x95, 0x21, 0xa3, 0x90, 0xc5, 0xe6, 0xb0, 0x68,
+	0xed, 0xe8, 0xa7, 0x81, 0xff, 0xdf, 0x00, 0xf9, 0x24, 0x20, 0x4c, 0xd4, 0x20, 0x70, 0x89, 0x84,
+	0x87, 0xb4, 0x49, 0xe5, 0x25, 0xb8, 0x7a, 0x8a, 0x93, 0x2a, 0x67, 0x65, 0xe9, 0x82, 0xc8, 0x85,
+	0x2b, 0xa6, 0x6f, 0xe3, 0x64, 0xa3, 0xaf, 0xda, 0x8c, 0xab, 0xb4, 0x66, 0xec, 0x50, 0x8f, 0xdd,
+	0xbf, 0xf4, 0x07, 0x19, 0xad, 0x70, 0xca, 0x74, 0x40, 0x61, 0x75, 0xfa, 0x1e, 0x4e, 0xed, 0x50,
+	0xe6, 0xf3, 0x1d, 0x33, 0xa1, 0xfb, 0xc2, 0x27, 0xc6, 0x8e, 0x9e, 0x18, 0xbb, 0xa2, 0x9f, 0x98,
+	0xf2, 0x78, 0xbf, 0xef, 0xed, 0xd7, 0x1c, 0x72, 0x75, 0x4b, 0xfa, 0x0e, 0x4e, 0x34, 0xb9, 0x0f,
+	0x66, 0x32, 0x8f, 0x8a, 0xff, 0x2e, 0x2f, 0xd8, 0xa3, 0x5e, 0x34, 0x7b, 0x90, 0xe7, 0x3a, 0xf7,
+	0xc1, 0x55, 0x0d, 0xfa, 0x94, 0x59, 0x78, 0x76, 0x74, 0xee, 0x1a, 0xcc, 0xeb, 0x38, 0xce, 0x6e,
+	0x80, 0x8c, 0x42, 0xbc, 0x9a, 0x5c, 0x4c, 0x3c, 0x46, 0xc2, 0xdd, 0x14, 0x99, 0x09, 0x37, 0x1a,
+	0x1e, 0x11, 0x4b, 0x9c, 0x93, 0x58, 0xf2, 0xfc, 0xc4, 0x52, 0xe7, 0x21, 0x86, 0x0a, 0x73, 0xea,
+	0x9f, 0x32, 0x0c, 0x44, 0x03, 0xfb, 0x61, 0x28, 0x60, 0xeb, 0x20, 0x04, 0xa9, 0xc3, 0xb5, 0x03,
+	0x96, 0x39, 0x0e, 0x2c, 0xf1, 0xf7, 0x79, 0xc4, 0x35, 0x8f, 0xe1, 0xbc, 0x35, 0x8f, 0x77, 0x08,
+	0xcf, 0xb8, 0x20, 0x83, 0xdd, 0x55, 0x42, 0x1b, 0xe0, 0x47, 0x75, 0x17, 0x8f, 0x63, 0x0a, 0x1b,
+	0xd4, 0xd7, 0x2c, 0x52, 0xbd, 0x6e, 0xce, 0x58, 0xab, 0xb8, 0x06, 0xf5, 0xfb, 0x59, 0xb6, 0xc8,
+	0x6e, 0x83, 0x13, 0x5f, 0x65, 0x39, 0xe9, 0x46, 0x43, 0x7d, 0x9e, 0x66, 0x71, 0x76, 0x94, 0xbe,
+	0x50, 0x7e, 0xf9, 0xf1, 0xde, 0x77, 0x2b, 0xb6, 0xd7, 0xb3, 0xd0, 0x7e, 0xcf, 0x42, 0xdf, 0x7a,
+	0x16, 0x7a, 0x73, 0x60, 0xc5, 0xf6, 0x0f, 0xac, 0xd8, 0x97, 0x03, 0x2b, 0xf6, 0x6c, 0xf9, 0x8f,
+	0x78, 0x2b, 0xe9, 0xd5, 0x94, 0x62, 0x72, 0xeb, 0xd7, 0x00, 0xac, 0x59, 0x43, 0x78, 0x9b, 0x0b,
 	0x00, 0x00,
 }
 
@@ -875,6 +1135,11 @@ func (m *SetTransferRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x28
+	}
 	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
 	if err2 != nil {
 		return 0, err2
@@ -933,93 +1198,335 @@ func (m *SetTransferRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetAddressRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RegisterChainMaintainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SetAddressRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAddressRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Chains) > 0 {
-		for _, s := range m.Chains {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterChainMaintainerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetAddressRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeregisterChainMaintainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Chains) > 0 {
-		for _, s := range m.Chains {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *SetAddressRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeregisterChainMaintainerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetAddressRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivateChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetMessageRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMessageRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMessageRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Chains) > 0 {
-		for _, s := range m.Chains {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err6 != nil {
+		return 0, err6
 	}
-	return n
-}
-
-func (m *ActivateChainResponse) Size() (n int) {
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMessageRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMessageRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMessageRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryFailedMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryFailedMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryFailedMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryFailedMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryFailedMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryFailedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisterChainMaintainerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Chains) > 0 {
+		for _, s := range m.Chains {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterChainMaintainerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeregisterChainMaintainerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Chains) > 0 {
+		for _, s := range m.Chains {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeregisterChainMaintainerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ActivateChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Chains) > 0 {
+		for _, s := range m.Chains {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActivateChainResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1098,6 +1605,9 @@ func (m *SetTransferRateLimitRequest) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
 	n += 1 + l + sovTx(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
 	return n
 }
 
@@ -1110,13 +1620,782 @@ func (m *SetTransferRateLimitResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SetAddressRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SetAddressRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetMessageRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *SetMessageRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RetryFailedMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryFailedMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterChainMaintainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterChainMaintainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterChainMaintainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterChainMaintainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterChainMaintainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterChainMaintainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterChainMaintainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterChainMaintainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RegisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterAssetFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1139,10 +2418,10 @@ func (m *RegisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterChainMaintainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterAssetFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterChainMaintainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterAssetFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1181,9 +2460,9 @@ func (m *RegisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1193,23 +2472,24 @@ func (m *RegisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			if err := m.FeeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1232,7 +2512,7 @@ func (m *RegisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterAssetFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,10 +2535,10 @@ func (m *RegisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterChainMaintainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterAssetFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterChainMaintainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterAssetFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1282,7 +2562,7 @@ func (m *RegisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeregisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
+func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1305,10 +2585,10 @@ func (m *DeregisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeregisterChainMaintainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTransferRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeregisterChainMaintainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTransferRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1347,7 +2627,7 @@ func (m *DeregisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,8 +2655,93 @@ func (m *DeregisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1398,7 +2763,7 @@ func (m *DeregisterChainMaintainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeregisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
+func (m *SetTransferRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,10 +2786,10 @@ func (m *DeregisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeregisterChainMaintainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTransferRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeregisterChainMaintainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTransferRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1448,7 +2813,7 @@ func (m *DeregisterChainMaintainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateChainRequest) Unmarshal(dAtA []byte) error {
+func (m *SetAddressRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,17 +2836,148 @@ func (m *ActivateChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAddressRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAddressRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1491,31 +2987,30 @@ func (m *ActivateChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1525,24 +3020,11 @@ func (m *ActivateChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= RateLimitMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1564,7 +3046,7 @@ func (m *ActivateChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateChainResponse) Unmarshal(dAtA []byte) error {
+func (m *SetAddressRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1587,10 +3069,10 @@ func (m *ActivateChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAddressRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAddressRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1614,7 +3096,7 @@ func (m *ActivateChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateChainRequest) Unmarshal(dAtA []byte) error {
+func (m *SetMessageRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,10 +3119,10 @@ func (m *DeactivateChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMessageRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMessageRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1679,7 +3161,7 @@ func (m *DeactivateChainRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1707,113 +3189,13 @@ func (m *DeactivateChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex]))
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeactivateChainResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateChainResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegisterAssetFeeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAssetFeeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAssetFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1823,29 +3205,46 @@ func (m *RegisterAssetFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1872,10 +3271,29 @@ func (m *RegisterAssetFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1897,7 +3315,7 @@ func (m *RegisterAssetFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterAssetFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *SetMessageRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,10 +3338,10 @@ func (m *RegisterAssetFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAssetFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMessageRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAssetFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMessageRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1947,7 +3365,7 @@ func (m *RegisterAssetFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *RetryFailedMessageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1970,10 +3388,10 @@ func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetTransferRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryFailedMessageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetTransferRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryFailedMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2012,7 +3430,7 @@ func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2040,46 +3458,13 @@ func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2089,23 +3474,24 @@ func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2129,7 +3515,7 @@ func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetTransferRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *RetryFailedMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2152,10 +3538,10 @@ func (m *SetTransferRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetTransferRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryFailedMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetTransferRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryFailedMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
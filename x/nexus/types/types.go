@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -159,6 +160,10 @@ func (m RateLimit) ValidateBasic() error {
 		return fmt.Errorf("rate limit window must be positive")
 	}
 
+	if err := m.Mode.ValidateBasic(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -188,3 +193,32 @@ func NewTransferEpoch(chain exported.ChainName, asset string, epoch uint64, dire
 		Direction: direction,
 	}
 }
+
+// MessageFilter selects general messages. Empty fields match all messages
+type MessageFilter struct {
+	SourceChain      exported.ChainName
+	DestinationChain exported.ChainName
+	Sender           string
+	Status           exported.GeneralMessage_Status
+}
+
+// Matches returns true if the message passes all filters
+func (f MessageFilter) Matches(msg exported.GeneralMessage) bool {
+	return (f.SourceChain == "" || f.SourceChain.Equals(msg.GetSourceChain())) &&
+		(f.DestinationChain == "" || f.DestinationChain.Equals(msg.GetDestinationChain())) &&
+		(f.Sender == "" || strings.EqualFold(f.Sender, msg.GetSourceAddress())) &&
+		(f.Status == exported.NonExistent || msg.Is(f.Status))
+}
+
+// ValidateBasic returns an error if the given FailedMessage is invalid
+func (m FailedMessage) ValidateBasic() error {
+	if err := utils.ValidateString(m.ID); err != nil {
+		return sdkerrors.Wrap(err, "invalid message id")
+	}
+
+	if m.FailedAt < 0 {
+		return fmt.Errorf("failed at height must be >=0")
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -32,6 +33,39 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RateLimitMode determines how transfers are accounted against a rate limit
+type RateLimitMode int32
+
+const (
+	// limits the amount transferred within fixed epochs of the window
+	FixedWindow RateLimitMode = 0
+	// limits the amount transferred within any period of the window
+	SlidingWindow RateLimitMode = 1
+	// allows bursts up to the limit, with capacity refilling continuously at
+	// the rate of limit per window
+	TokenBucket RateLimitMode = 2
+)
+
+var RateLimitMode_name = map[int32]string{
+	0: "RATE_LIMIT_MODE_FIXED_WINDOW",
+	1: "RATE_LIMIT_MODE_SLIDING_WINDOW",
+	2: "RATE_LIMIT_MODE_TOKEN_BUCKET",
+}
+
+var RateLimitMode_value = map[string]int32{
+	"RATE_LIMIT_MODE_FIXED_WINDOW":   0,
+	"RATE_LIMIT_MODE_SLIDING_WINDOW": 1,
+	"RATE_LIMIT_MODE_TOKEN_BUCKET":   2,
+}
+
+func (x RateLimitMode) String() string {
+	return proto.EnumName(RateLimitMode_name, int32(x))
+}
+
+func (RateLimitMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ecc98625accd1ae9, []int{0}
+}
+
 type MaintainerState struct {
 	Address        github_com_cosmos_cosmos_sdk_types.ValAddress                   `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"address,omitempty"`
 	MissingVotes   utils.Bitmap                                                    `protobuf:"bytes,2,opt,name=missing_votes,json=missingVotes,proto3" json:"missing_votes"`
@@ -155,6 +189,7 @@ type RateLimit struct {
 	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Limit  types.Coin                                                      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
 	Window time.Duration                                                   `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	Mode   RateLimitMode                                                   `protobuf:"varint,4,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
 }
 
 func (m *RateLimit) Reset()         { *m = RateLimit{} }
@@ -190,6 +225,92 @@ func (m *RateLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RateLimit proto.InternalMessageInfo
 
+// AddressRateLimit limits the transfers of an asset from and to a single
+// address of the rate limit's chain. Anyone can link new deposit addresses, so
+// limiting a deposit address does not limit its depositors; EVM deposits are
+// also limited by the address that sent the tokens to the deposit address
+type AddressRateLimit struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	Address   string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AddressRateLimit) Reset()         { *m = AddressRateLimit{} }
+func (m *AddressRateLimit) String() string { return proto.CompactTextString(m) }
+func (*AddressRateLimit) ProtoMessage()    {}
+func (*AddressRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecc98625accd1ae9, []int{4}
+}
+func (m *AddressRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRateLimit.Merge(m, src)
+}
+func (m *AddressRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRateLimit proto.InternalMessageInfo
+
+// MessageRateLimit limits the number of general messages routed to a
+// destination chain, or sent from a single source address if the address is
+// set
+type MessageRateLimit struct {
+	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Address string                                                          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   uint64                                                          `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Window  time.Duration                                                   `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window"`
+	Mode    RateLimitMode                                                   `protobuf:"varint,5,opt,name=mode,proto3,enum=axelar.nexus.v1beta1.RateLimitMode" json:"mode,omitempty"`
+}
+
+func (m *MessageRateLimit) Reset()         { *m = MessageRateLimit{} }
+func (m *MessageRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MessageRateLimit) ProtoMessage()    {}
+func (*MessageRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecc98625accd1ae9, []int{5}
+}
+func (m *MessageRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRateLimit.Merge(m, src)
+}
+func (m *MessageRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRateLimit proto.InternalMessageInfo
+
 type TransferEpoch struct {
 	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Amount    types.Coin                                                      `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -201,7 +322,7 @@ func (m *TransferEpoch) Reset()         { *m = TransferEpoch{} }
 func (m *TransferEpoch) String() string { return proto.CompactTextString(m) }
 func (*TransferEpoch) ProtoMessage()    {}
 func (*TransferEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecc98625accd1ae9, []int{4}
+	return fileDescriptor_ecc98625accd1ae9, []int{6}
 }
 func (m *TransferEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,62 +351,186 @@ func (m *TransferEpoch) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferEpoch proto.InternalMessageInfo
 
+// TransferWindow tracks the transfers of an asset for sliding window and
+// token bucket rate limits, and for all rate limits of a single address
+type TransferWindow struct {
+	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Asset     string                                                          `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Direction exported.TransferDirection                                      `protobuf:"varint,3,opt,name=direction,proto3,enum=axelar.nexus.exported.v1beta1.TransferDirection" json:"direction,omitempty"`
+	// sliding window: amounts transferred per bucket, ending with the bucket at
+	// index last_bucket
+	Buckets []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,rep,name=buckets,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"buckets"`
+	// fixed window: the current epoch
+	LastBucket uint64 `protobuf:"varint,5,opt,name=last_bucket,json=lastBucket,proto3" json:"last_bucket,omitempty"`
+	// fixed window: amount transferred in the current epoch
+	// token bucket: capacity consumed as of updated_at
+	Consumed  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=consumed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"consumed"`
+	UpdatedAt time.Time                              `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// only set if the transfers of a single address are tracked
+	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *TransferWindow) Reset()         { *m = TransferWindow{} }
+func (m *TransferWindow) String() string { return proto.CompactTextString(m) }
+func (*TransferWindow) ProtoMessage()    {}
+func (*TransferWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecc98625accd1ae9, []int{7}
+}
+func (m *TransferWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferWindow.Merge(m, src)
+}
+func (m *TransferWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferWindow proto.InternalMessageInfo
+
+// FailedMessage tracks the retries of a general message that failed at least
+// once
+type FailedMessage struct {
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Retries uint64 `protobuf:"varint,2,opt,name=retries,proto3" json:"retries,omitempty"`
+	// block height at which the message failed most recently
+	FailedAt int64 `protobuf:"varint,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+}
+
+func (m *FailedMessage) Reset()         { *m = FailedMessage{} }
+func (m *FailedMessage) String() string { return proto.CompactTextString(m) }
+func (*FailedMessage) ProtoMessage()    {}
+func (*FailedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecc98625accd1ae9, []int{8}
+}
+func (m *FailedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedMessage.Merge(m, src)
+}
+func (m *FailedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedMessage proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("axelar.nexus.v1beta1.RateLimitMode", RateLimitMode_name, RateLimitMode_value)
 	proto.RegisterType((*MaintainerState)(nil), "axelar.nexus.v1beta1.MaintainerState")
 	proto.RegisterType((*ChainState)(nil), "axelar.nexus.v1beta1.ChainState")
 	proto.RegisterType((*LinkedAddresses)(nil), "axelar.nexus.v1beta1.LinkedAddresses")
 	proto.RegisterType((*RateLimit)(nil), "axelar.nexus.v1beta1.RateLimit")
+	proto.RegisterType((*AddressRateLimit)(nil), "axelar.nexus.v1beta1.AddressRateLimit")
+	proto.RegisterType((*MessageRateLimit)(nil), "axelar.nexus.v1beta1.MessageRateLimit")
 	proto.RegisterType((*TransferEpoch)(nil), "axelar.nexus.v1beta1.TransferEpoch")
+	proto.RegisterType((*TransferWindow)(nil), "axelar.nexus.v1beta1.TransferWindow")
+	proto.RegisterType((*FailedMessage)(nil), "axelar.nexus.v1beta1.FailedMessage")
 }
 
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/types.proto", fileDescriptor_ecc98625accd1ae9) }
 
 var fileDescriptor_ecc98625accd1ae9 = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x96, 0xb6, 0xb6, 0xc3, 0x8f, 0x96, 0x0d, 0x87, 0x42, 0xc8, 0xb6, 0x36, 0x9a, 0xe0,
-	0x81, 0x59, 0xc1, 0x18, 0x0f, 0x1e, 0x94, 0x05, 0x63, 0x02, 0x48, 0xcc, 0x6a, 0x48, 0xe4, 0xd2,
-	0x4c, 0x77, 0x1f, 0x65, 0x42, 0x77, 0xa7, 0x99, 0x99, 0x42, 0xfd, 0x13, 0xbc, 0x79, 0xe4, 0x4f,
-	0xe2, 0x26, 0x37, 0x8d, 0x07, 0x54, 0xf8, 0x2f, 0x38, 0x99, 0x9d, 0x99, 0xed, 0x02, 0x21, 0x01,
-	0xa3, 0x9e, 0xba, 0xf3, 0xfa, 0xbe, 0xef, 0x7d, 0xef, 0xcd, 0x37, 0x0f, 0x35, 0xc9, 0x10, 0x7a,
-	0x84, 0xbb, 0x31, 0x0c, 0x07, 0xc2, 0x3d, 0x58, 0xea, 0x80, 0x24, 0x4b, 0xae, 0xfc, 0xd8, 0x07,
-	0x81, 0xfb, 0x9c, 0x49, 0x66, 0xcf, 0xe8, 0x0c, 0xac, 0x32, 0xb0, 0xc9, 0x98, 0x73, 0xba, 0x8c,
-	0x75, 0x7b, 0xe0, 0xaa, 0x9c, 0xce, 0x60, 0xd7, 0x0d, 0x07, 0x9c, 0x48, 0xca, 0x62, 0x8d, 0x9a,
-	0x9b, 0xe9, 0xb2, 0x2e, 0x53, 0x9f, 0x6e, 0xf2, 0x65, 0xa2, 0x4e, 0xc0, 0x44, 0xc4, 0x84, 0xdb,
-	0x21, 0x02, 0x46, 0xc5, 0x02, 0x46, 0x53, 0xd4, 0xa3, 0x2b, 0x6a, 0x60, 0xd8, 0x67, 0x5c, 0x42,
-	0x78, 0x93, 0xac, 0xb9, 0xfb, 0x26, 0x75, 0x20, 0x69, 0x2f, 0x13, 0xde, 0xa1, 0x32, 0x22, 0x7d,
-	0x9d, 0xd2, 0xfa, 0x92, 0x47, 0xd5, 0x37, 0x84, 0xc6, 0x92, 0xd0, 0x18, 0xf8, 0x3b, 0x49, 0x24,
-	0xd8, 0x1b, 0xe8, 0x1e, 0x09, 0x43, 0x0e, 0x42, 0xd4, 0xad, 0xa6, 0xb5, 0x30, 0xe1, 0x2d, 0x5d,
-	0x9c, 0x36, 0x16, 0xbb, 0x54, 0xee, 0x0d, 0x3a, 0x38, 0x60, 0x91, 0x6b, 0x14, 0xea, 0x9f, 0x45,
-	0x11, 0xee, 0x9b, 0xaa, 0xdb, 0xa4, 0xb7, 0xa2, 0x81, 0x7e, 0xca, 0x60, 0xbf, 0x46, 0x93, 0x11,
-	0x15, 0x82, 0xc6, 0xdd, 0xf6, 0x01, 0x93, 0x20, 0xea, 0xf9, 0xa6, 0xb5, 0x30, 0xbe, 0x3c, 0x8f,
-	0xcd, 0xc8, 0x94, 0xb6, 0x74, 0x64, 0xd8, 0x53, 0xda, 0xbc, 0xc2, 0xf1, 0x69, 0x23, 0xe7, 0x4f,
-	0x18, 0xe0, 0x76, 0x82, 0xb3, 0x37, 0x50, 0x95, 0xc6, 0x01, 0xe3, 0x1c, 0x02, 0x69, 0xa8, 0xc6,
-	0xee, 0x4c, 0x35, 0x35, 0x82, 0x6a, 0xb2, 0x0f, 0xa8, 0x18, 0xec, 0x11, 0x1a, 0xd7, 0x0b, 0x4d,
-	0x6b, 0xa1, 0xe2, 0xad, 0x5e, 0x9c, 0x36, 0x5e, 0x5c, 0x6a, 0x50, 0x13, 0xc6, 0x20, 0x0f, 0x19,
-	0xdf, 0x37, 0xa7, 0xc5, 0x80, 0x71, 0x70, 0x87, 0xd7, 0xe6, 0x8e, 0x57, 0x13, 0x9a, 0x2d, 0x12,
-	0x81, 0xaf, 0x19, 0x5b, 0x47, 0x79, 0x84, 0x54, 0x50, 0x0f, 0xf3, 0x65, 0x5a, 0xc9, 0x52, 0x62,
-	0x1f, 0xe0, 0x2b, 0x56, 0x19, 0xd1, 0xa4, 0xaa, 0x15, 0xd2, 0x88, 0xd6, 0x40, 0x7b, 0x1e, 0x55,
-	0x48, 0x20, 0xe9, 0x01, 0x91, 0x10, 0xaa, 0x96, 0xcb, 0x7e, 0x16, 0xb0, 0x3d, 0x54, 0x22, 0x42,
-	0x80, 0x14, 0xf5, 0x62, 0x73, 0xec, 0x0e, 0x05, 0x56, 0x92, 0x64, 0x53, 0xc0, 0x20, 0xed, 0x1d,
-	0x34, 0x1d, 0x8d, 0x3c, 0xd0, 0x16, 0x89, 0x6e, 0x51, 0x2f, 0x29, 0xba, 0x87, 0xf8, 0x26, 0x6b,
-	0xe3, 0x6b, 0x96, 0xf1, 0x4a, 0x09, 0x5f, 0xdd, 0xf2, 0x6b, 0xd1, 0xd5, 0x3f, 0xc4, 0x7a, 0xa1,
-	0x5c, 0xa8, 0x15, 0xd7, 0x0b, 0xe5, 0x7c, 0x6d, 0xac, 0xf5, 0xd5, 0x42, 0xd5, 0x4d, 0x1a, 0xef,
-	0x43, 0x68, 0x6c, 0x02, 0xc2, 0x6e, 0xa3, 0x6a, 0x08, 0x7d, 0x26, 0xa8, 0x6c, 0x5f, 0x36, 0xdd,
-	0xf8, 0xf2, 0xe3, 0xdb, 0x26, 0xc5, 0x99, 0x10, 0x6a, 0x5c, 0x86, 0x2c, 0xbd, 0x6a, 0x43, 0x67,
-	0xa2, 0x76, 0x80, 0xa6, 0x39, 0x04, 0xb4, 0x4f, 0x21, 0xce, 0x4a, 0xe4, 0xff, 0xaa, 0x44, 0x6d,
-	0x44, 0x68, 0xe2, 0xad, 0xef, 0x16, 0xaa, 0xf8, 0x44, 0xc2, 0x26, 0x8d, 0xa8, 0xcc, 0xdc, 0x65,
-	0xfd, 0x6b, 0x77, 0xd9, 0x4f, 0x51, 0xb1, 0x97, 0xd4, 0x30, 0x1d, 0xcc, 0x62, 0xfd, 0x08, 0x71,
-	0xb2, 0x2d, 0x32, 0xdd, 0x2c, 0xf3, 0x90, 0xca, 0xb6, 0x9f, 0xa3, 0xd2, 0x21, 0x8d, 0x43, 0x76,
-	0x68, 0xde, 0xcc, 0x2c, 0xd6, 0xbb, 0x09, 0xa7, 0xbb, 0x09, 0xaf, 0x99, 0xdd, 0xe4, 0x95, 0x13,
-	0xdc, 0xd1, 0x8f, 0x86, 0xe5, 0x1b, 0x48, 0xeb, 0x53, 0x1e, 0x4d, 0xbe, 0xe7, 0x24, 0x16, 0xbb,
-	0xc0, 0x5f, 0xf5, 0x59, 0xb0, 0xf7, 0x3f, 0x1b, 0x7c, 0x86, 0x4a, 0x24, 0x62, 0x83, 0xf8, 0xce,
-	0x1d, 0x9a, 0x74, 0x7b, 0x06, 0x15, 0x21, 0x11, 0xa7, 0x3a, 0x2c, 0xf8, 0xfa, 0x60, 0x6f, 0xa1,
-	0x4a, 0x48, 0x93, 0x77, 0x4f, 0x99, 0x7e, 0xec, 0x53, 0xb7, 0xde, 0x7a, 0xda, 0xea, 0x5a, 0x8a,
-	0xf3, 0x33, 0x0a, 0xef, 0xed, 0xf1, 0x2f, 0x27, 0x77, 0x7c, 0xe6, 0x58, 0x27, 0x67, 0x8e, 0xf5,
-	0xf3, 0xcc, 0xb1, 0x3e, 0x9f, 0x3b, 0xb9, 0x93, 0x73, 0x27, 0xf7, 0xed, 0xdc, 0xc9, 0xed, 0x2c,
-	0xff, 0xd1, 0x10, 0xd4, 0xd2, 0xec, 0x94, 0xd4, 0x15, 0x3c, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0x46, 0x48, 0x7e, 0x36, 0x66, 0x06, 0x00, 0x00,
+	// 1096 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x73, 0xdb, 0x44,
+	0x18, 0xb6, 0x64, 0xd9, 0xb1, 0x37, 0x5f, 0xce, 0x4e, 0x86, 0x71, 0x4d, 0x46, 0x36, 0xe6, 0x63,
+	0x02, 0x33, 0x91, 0x49, 0x98, 0x4e, 0x0f, 0x1c, 0x20, 0x8a, 0x9d, 0xe2, 0x7c, 0x32, 0x8a, 0x69,
+	0xa0, 0x07, 0x34, 0x6b, 0x69, 0xe3, 0xec, 0xc4, 0xd2, 0x7a, 0xb4, 0xeb, 0x24, 0xfc, 0x03, 0xe8,
+	0xa9, 0x33, 0x5c, 0x7a, 0xe9, 0x09, 0x0e, 0xfc, 0x02, 0x7e, 0x43, 0x6e, 0xf4, 0x06, 0xc3, 0xc1,
+	0x40, 0x72, 0xe0, 0x3f, 0xf4, 0xc4, 0xec, 0x6a, 0x65, 0xd7, 0x69, 0x98, 0xa4, 0x2d, 0xe5, 0x64,
+	0xef, 0xea, 0x7d, 0x9e, 0xf7, 0xeb, 0x79, 0x5f, 0x09, 0x54, 0xd0, 0x29, 0xee, 0xa2, 0xa8, 0x16,
+	0xe2, 0xd3, 0x3e, 0xab, 0x1d, 0x2f, 0xb7, 0x31, 0x47, 0xcb, 0x35, 0xfe, 0x4d, 0x0f, 0x33, 0xab,
+	0x17, 0x51, 0x4e, 0xe1, 0x7c, 0x6c, 0x61, 0x49, 0x0b, 0x4b, 0x59, 0x94, 0xcc, 0x0e, 0xa5, 0x9d,
+	0x2e, 0xae, 0x49, 0x9b, 0x76, 0xff, 0xa0, 0xe6, 0xf7, 0x23, 0xc4, 0x09, 0x0d, 0x63, 0x54, 0xa9,
+	0x7c, 0xf9, 0x39, 0x27, 0x01, 0x66, 0x1c, 0x05, 0x3d, 0x65, 0x30, 0xdf, 0xa1, 0x1d, 0x2a, 0xff,
+	0xd6, 0xc4, 0x3f, 0x75, 0x6b, 0x7a, 0x94, 0x05, 0x94, 0xd5, 0xda, 0x88, 0xe1, 0x61, 0x34, 0x1e,
+	0x25, 0x09, 0xed, 0xfb, 0x63, 0xe1, 0xe2, 0xd3, 0x1e, 0x8d, 0x38, 0xf6, 0xaf, 0x8a, 0xbb, 0xf4,
+	0x96, 0x32, 0xed, 0x73, 0xd2, 0x1d, 0x65, 0xd6, 0x26, 0x3c, 0x40, 0x2a, 0x86, 0xea, 0x2f, 0x3a,
+	0x98, 0xdd, 0x46, 0x24, 0xe4, 0x88, 0x84, 0x38, 0xda, 0xe3, 0x88, 0x63, 0xb8, 0x09, 0x26, 0x90,
+	0xef, 0x47, 0x98, 0xb1, 0xa2, 0x56, 0xd1, 0x16, 0xa7, 0xec, 0xe5, 0xa7, 0x83, 0xf2, 0x52, 0x87,
+	0xf0, 0xc3, 0x7e, 0xdb, 0xf2, 0x68, 0x50, 0x53, 0x11, 0xc6, 0x3f, 0x4b, 0xcc, 0x3f, 0x52, 0x5e,
+	0xef, 0xa1, 0xee, 0x6a, 0x0c, 0x74, 0x12, 0x06, 0x78, 0x17, 0x4c, 0x07, 0x84, 0x31, 0x12, 0x76,
+	0xdc, 0x63, 0xca, 0x31, 0x2b, 0xea, 0x15, 0x6d, 0x71, 0x72, 0x65, 0xc1, 0x52, 0x35, 0x95, 0xb1,
+	0x25, 0x35, 0xb5, 0x6c, 0x19, 0x9b, 0x6d, 0x9c, 0x0d, 0xca, 0x29, 0x67, 0x4a, 0x01, 0xef, 0x09,
+	0x1c, 0xdc, 0x04, 0xb3, 0x24, 0xf4, 0x68, 0x14, 0x61, 0x8f, 0x2b, 0xaa, 0xf4, 0x8d, 0xa9, 0x66,
+	0x86, 0xd0, 0x98, 0xec, 0x2b, 0x90, 0xf1, 0x0e, 0x11, 0x09, 0x8b, 0x46, 0x45, 0x5b, 0xcc, 0xdb,
+	0x6b, 0x4f, 0x07, 0xe5, 0x4f, 0x9e, 0x49, 0x30, 0x26, 0x0c, 0x31, 0x3f, 0xa1, 0xd1, 0x91, 0x3a,
+	0x2d, 0x79, 0x34, 0xc2, 0xb5, 0xd3, 0x4b, 0x75, 0xb7, 0xd6, 0x04, 0xcd, 0x0e, 0x0a, 0xb0, 0x13,
+	0x33, 0x56, 0x1f, 0xe9, 0x00, 0xc8, 0xcb, 0xb8, 0x98, 0x9f, 0x26, 0x9e, 0x34, 0x19, 0xec, 0x3b,
+	0xd6, 0x98, 0x96, 0x86, 0x34, 0x49, 0xd4, 0x12, 0xa9, 0x82, 0x8e, 0x81, 0x70, 0x01, 0xe4, 0x91,
+	0xc7, 0xc9, 0x31, 0xe2, 0xd8, 0x97, 0x29, 0xe7, 0x9c, 0xd1, 0x05, 0xb4, 0x41, 0x16, 0x31, 0x86,
+	0x39, 0x2b, 0x66, 0x2a, 0xe9, 0x1b, 0x38, 0x58, 0x15, 0xc6, 0xca, 0x81, 0x42, 0xc2, 0xfb, 0x60,
+	0x2e, 0x18, 0x6a, 0xc0, 0x65, 0x22, 0x6e, 0x56, 0xcc, 0x4a, 0xba, 0x77, 0xad, 0xab, 0xb4, 0x6f,
+	0x5d, 0x92, 0x8c, 0x9d, 0x15, 0x7c, 0x45, 0xcd, 0x29, 0x04, 0xe3, 0x0f, 0xd8, 0x86, 0x91, 0x33,
+	0x0a, 0x99, 0x0d, 0x23, 0xa7, 0x17, 0xd2, 0xd5, 0x5f, 0x35, 0x30, 0xbb, 0x45, 0xc2, 0x23, 0xec,
+	0x2b, 0x99, 0x60, 0x06, 0x5d, 0x30, 0xeb, 0xe3, 0x1e, 0x65, 0x84, 0xbb, 0xcf, 0x8a, 0x6e, 0x72,
+	0xe5, 0xc3, 0xeb, 0x2a, 0x15, 0x51, 0xc6, 0x64, 0xb9, 0x14, 0x59, 0xd2, 0x6a, 0x45, 0xa7, 0x6e,
+	0xa1, 0x07, 0xe6, 0x22, 0xec, 0x91, 0x1e, 0xc1, 0xe1, 0xc8, 0x85, 0xfe, 0x4a, 0x2e, 0x0a, 0x43,
+	0x42, 0x75, 0x5f, 0xfd, 0x5e, 0x07, 0x79, 0x07, 0x71, 0xbc, 0x45, 0x02, 0xc2, 0x47, 0xea, 0xd2,
+	0xfe, 0x6b, 0x75, 0xc1, 0xdb, 0x20, 0xd3, 0x15, 0x3e, 0x54, 0x06, 0xb7, 0xac, 0x78, 0x08, 0x2d,
+	0xb1, 0x2d, 0x46, 0x71, 0xd3, 0x91, 0x86, 0xa4, 0x35, 0xfc, 0x18, 0x64, 0x4f, 0x48, 0xe8, 0xd3,
+	0x13, 0x35, 0x33, 0xb7, 0xac, 0x78, 0x39, 0x59, 0xc9, 0x72, 0xb2, 0xea, 0x6a, 0x79, 0xd9, 0x39,
+	0x81, 0x7b, 0xf4, 0x47, 0x59, 0x73, 0x14, 0x04, 0xde, 0x01, 0x46, 0x40, 0x7d, 0x2c, 0x67, 0x65,
+	0x66, 0xe5, 0xed, 0xab, 0x15, 0x31, 0xcc, 0x7e, 0x9b, 0xfa, 0xd8, 0x91, 0x80, 0x6a, 0x04, 0x0a,
+	0xc9, 0x3e, 0x18, 0xd6, 0xa6, 0x0e, 0x40, 0x84, 0x38, 0x76, 0xe3, 0x2c, 0xe2, 0x56, 0x97, 0xaf,
+	0xa1, 0x54, 0xb9, 0xe4, 0xa3, 0x21, 0x4b, 0x71, 0xb4, 0xa2, 0x44, 0x21, 0xf2, 0xc3, 0x7d, 0x23,
+	0x3a, 0x51, 0xd8, 0xc6, 0x8c, 0xa1, 0x0e, 0xfe, 0x5f, 0x1a, 0xf2, 0xaf, 0x91, 0xc0, 0xf9, 0xa4,
+	0x55, 0xa2, 0xe4, 0xc6, 0xf3, 0x9d, 0x30, 0x5e, 0xbe, 0x13, 0x99, 0x17, 0xed, 0xc4, 0x77, 0x3a,
+	0x98, 0x6e, 0x45, 0x28, 0x64, 0x07, 0x38, 0x6a, 0xf4, 0xa8, 0x77, 0xf8, 0x3a, 0x4b, 0x72, 0x07,
+	0x64, 0x51, 0x40, 0xfb, 0xe1, 0x8d, 0x45, 0xaa, 0xcc, 0x45, 0xc5, 0xb0, 0x08, 0x2e, 0xa9, 0x98,
+	0x3c, 0xc0, 0x1d, 0x90, 0xf7, 0x89, 0x58, 0xdd, 0x84, 0x86, 0x4a, 0x83, 0xd7, 0x0d, 0x6e, 0x92,
+	0x6a, 0x3d, 0xc1, 0x39, 0x23, 0x8a, 0xea, 0xdf, 0x69, 0x30, 0x93, 0x18, 0xec, 0xc7, 0x75, 0x7d,
+	0x8d, 0xc5, 0x98, 0x07, 0x19, 0xb9, 0x65, 0x95, 0x3a, 0xe2, 0xc3, 0x78, 0x4e, 0xe9, 0x57, 0xce,
+	0x09, 0x7e, 0x06, 0x26, 0xda, 0x7d, 0xef, 0x48, 0xbc, 0x06, 0x8c, 0x4a, 0x7a, 0x71, 0xca, 0xb6,
+	0x44, 0x61, 0x7f, 0x1f, 0x94, 0xdf, 0xbb, 0xc1, 0x6b, 0xbb, 0x19, 0x72, 0x27, 0x81, 0xc3, 0x32,
+	0x98, 0xec, 0x22, 0xc6, 0xdd, 0xf8, 0x2c, 0x95, 0x66, 0x38, 0x40, 0x5c, 0xd9, 0xf2, 0x06, 0x6e,
+	0x80, 0x9c, 0x47, 0x43, 0xd6, 0x0f, 0xb0, 0x5f, 0xcc, 0x56, 0xb4, 0x97, 0xf0, 0x35, 0xc4, 0xc3,
+	0x35, 0x00, 0xfa, 0x3d, 0x5f, 0xbc, 0xc7, 0x5c, 0xc4, 0x8b, 0x13, 0x52, 0x2d, 0xa5, 0xe7, 0x06,
+	0xa2, 0x95, 0x7c, 0x37, 0xc5, 0x13, 0xf1, 0x50, 0x4c, 0x44, 0x5e, 0xe1, 0x56, 0xc7, 0x76, 0x41,
+	0x6e, 0x7c, 0x17, 0x7c, 0x0d, 0xa6, 0xd7, 0x11, 0xe9, 0x62, 0x5f, 0x2d, 0x04, 0xf8, 0x06, 0xd0,
+	0x89, 0xaf, 0x9a, 0x9c, 0x3d, 0x1f, 0x94, 0xf5, 0x66, 0xdd, 0xd1, 0x89, 0x2f, 0x28, 0x22, 0xcc,
+	0x23, 0xa2, 0x3e, 0x4f, 0x0c, 0x27, 0x39, 0xc2, 0x37, 0x41, 0xfe, 0x40, 0x52, 0x88, 0x00, 0x45,
+	0xa3, 0xd2, 0x4e, 0x2e, 0xbe, 0x58, 0xe5, 0x1f, 0xfc, 0xac, 0x81, 0xe9, 0xb1, 0x69, 0x83, 0xcb,
+	0x60, 0xc1, 0x59, 0x6d, 0x35, 0xdc, 0xad, 0xe6, 0x76, 0xb3, 0xe5, 0x6e, 0xef, 0xd6, 0x1b, 0xee,
+	0x7a, 0xf3, 0xcb, 0x46, 0xdd, 0xdd, 0x6f, 0xee, 0xd4, 0x77, 0xf7, 0x0b, 0xa9, 0xd2, 0xec, 0x83,
+	0xc7, 0x95, 0xc9, 0x75, 0x72, 0x8a, 0x7d, 0xa5, 0xbd, 0xdb, 0xc0, 0xbc, 0x0c, 0xd9, 0xdb, 0x6a,
+	0xd6, 0x9b, 0x3b, 0x77, 0x13, 0x90, 0x56, 0x9a, 0x7b, 0xf0, 0xb8, 0x32, 0xbd, 0xd7, 0x25, 0x3e,
+	0x09, 0x3b, 0x0a, 0x76, 0x85, 0xa7, 0xd6, 0xee, 0x66, 0x63, 0xc7, 0xb5, 0xbf, 0x58, 0xdb, 0x6c,
+	0xb4, 0x0a, 0x7a, 0xec, 0xa9, 0x45, 0x8f, 0x70, 0x18, 0x77, 0xae, 0x94, 0xfb, 0xf6, 0x07, 0x33,
+	0xf5, 0xd3, 0x8f, 0xa6, 0x66, 0x7f, 0x7e, 0xf6, 0x97, 0x99, 0x3a, 0x3b, 0x37, 0xb5, 0x27, 0xe7,
+	0xa6, 0xf6, 0xe7, 0xb9, 0xa9, 0x3d, 0xbc, 0x30, 0x53, 0x4f, 0x2e, 0xcc, 0xd4, 0x6f, 0x17, 0x66,
+	0xea, 0xfe, 0xca, 0x0b, 0x49, 0x5f, 0xf6, 0xb5, 0x9d, 0x95, 0xdd, 0xfa, 0xe8, 0x9f, 0x01, 0x00,
+	0x8c, 0x9b, 0xd6, 0x81, 0x4d, 0x0b, 0x00, 0x00,
 }
 
 func (m *MaintainerState) Marshal() (dAtA []byte, err error) {
@@ -479,6 +724,11 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
 	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
 	if err6 != nil {
 		return 0, err6
@@ -507,6 +757,101 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AddressRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x28
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.Limit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TransferEpoch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -557,73 +902,199 @@ func (m *TransferEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TransferWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MaintainerState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.MissingVotes.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.IncorrectVotes.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+
+func (m *TransferWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransferWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Chain.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Activated {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err11 != nil {
+		return 0, err11
 	}
-	if len(m.MaintainerStates) > 0 {
-		for _, e := range m.MaintainerStates {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Consumed.Size()
+		i -= size
+		if _, err := m.Consumed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *LinkedAddresses) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	if m.LastBucket != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastBucket))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = m.DepositAddress.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.RecipientAddress.Size()
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Buckets[iNdEx].Size()
+				i -= size
+				if _, err := m.Buckets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Direction != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Retries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MaintainerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MissingVotes.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.IncorrectVotes.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Activated {
+		n += 2
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.MaintainerStates) > 0 {
+		for _, e := range m.MaintainerStates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LinkedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositAddress.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RecipientAddress.Size()
 	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
@@ -642,6 +1113,49 @@ func (m *RateLimit) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *AddressRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTypes(uint64(m.Limit))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
 	return n
 }
 
@@ -666,6 +1180,62 @@ func (m *TransferEpoch) Size() (n int) {
 	return n
 }
 
+func (m *TransferWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTypes(uint64(m.Direction))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LastBucket != 0 {
+		n += 1 + sovTypes(uint64(m.LastBucket))
+	}
+	l = m.Consumed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovTypes(uint64(m.Retries))
+	}
+	if m.FailedAt != 0 {
+		n += 1 + sovTypes(uint64(m.FailedAt))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1268,6 +1838,25 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1289,7 +1878,7 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferEpoch) Unmarshal(dAtA []byte) error {
+func (m *AddressRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,17 +1901,17 @@ func (m *TransferEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1332,29 +1921,30 @@ func (m *TransferEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1364,49 +1954,535 @@ func (m *TransferEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RateLimitMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Buckets = append(m.Buckets, v)
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBucket", wireType)
 			}
-			m.Direction = 0
+			m.LastBucket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1416,7 +2492,225 @@ func (m *TransferEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				m.LastBucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Consumed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
+			}
+			m.FailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
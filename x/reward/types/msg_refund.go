@@ -74,6 +74,23 @@ func (m RefundMsgRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
 
+// SetSender replaces the sender of the refund request and, if it can be replaced, the sender of the inner message as well
+func (m *RefundMsgRequest) SetSender(sender sdk.AccAddress) {
+	m.Sender = sender
+
+	inner, ok := m.InnerMessage.GetCachedValue().(interface{ SetSender(sdk.AccAddress) })
+	if !ok {
+		return
+	}
+
+	inner.SetSender(sender)
+	messageAny, err := cdctypes.NewAnyWithValue(m.GetInnerMessage())
+	if err != nil {
+		panic(err)
+	}
+	m.InnerMessage = messageAny
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage
 func (m RefundMsgRequest) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	if m.InnerMessage != nil {
@@ -25,6 +25,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	evmQueryCmd.AddCommand(
 		GetCmdGetProxy(queryRoute),
 		GetCmdGetOperator(queryRoute),
+		GetCmdLaneProxies(),
 		GetParams(),
 	)
 
@@ -82,6 +83,33 @@ func GetCmdGetOperator(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// GetCmdLaneProxies returns the lane proxy addresses of some operator address
+func GetCmdLaneProxies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lane-proxies [operator address]",
+		Short: "Fetch the lane proxy addresses associated with [operator address]",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.LaneProxies(cmd.Context(), &types.LaneProxiesRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParams returns the snapshot params
 func GetParams() *cobra.Command {
 	cmd := &cobra.Command{
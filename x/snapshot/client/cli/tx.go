@@ -27,6 +27,8 @@ func GetTxCmd() *cobra.Command {
 	snapshotTxCmd.AddCommand(
 		GetCmdRegisterProxy(),
 		GetCmdDeregisterProxy(),
+		GetCmdRegisterLaneProxy(),
+		GetCmdDeregisterLaneProxy(),
 		GetCmdSendTokens(),
 	)
 
@@ -77,6 +79,54 @@ func GetCmdDeregisterProxy() *cobra.Command {
 	return cmd
 }
 
+// GetCmdRegisterLaneProxy returns the command to register a lane proxy
+func GetCmdRegisterLaneProxy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-lane-proxy [proxy address]",
+		Short: "Register an additional proxy account for a validator principal, so vald can broadcast from multiple accounts in parallel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrSnapshot, "proxy invalid")
+			}
+
+			msg := types.NewRegisterLaneProxyRequest(sdk.ValAddress(clientCtx.FromAddress), addr)
+			return legacyTx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeregisterLaneProxy returns the command to deregister a lane proxy
+func GetCmdDeregisterLaneProxy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-lane-proxy [proxy address]",
+		Short: "Deregister a lane proxy account of the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrSnapshot, "proxy invalid")
+			}
+
+			msg := types.NewDeregisterLaneProxyRequest(sdk.ValAddress(clientCtx.FromAddress), addr)
+			return legacyTx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdSendTokens returns the command to send stake to a number of addresses
 func GetCmdSendTokens() *cobra.Command {
 	cmd := &cobra.Command{
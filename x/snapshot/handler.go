@@ -22,6 +22,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.DeactivateProxyRequest:
 			res, err := server.DeactivateProxy(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.RegisterLaneProxyRequest:
+			res, err := server.RegisterLaneProxy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.DeregisterLaneProxyRequest:
+			res, err := server.DeregisterLaneProxy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg))
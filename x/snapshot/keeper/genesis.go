@@ -13,6 +13,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, proxiedValidator := range genState.ProxiedValidators {
 		k.setProxiedValidator(ctx, proxiedValidator)
 	}
+
+	for _, laneProxy := range genState.LaneProxies {
+		k.setLaneProxy(ctx, laneProxy)
+	}
 }
 
 // ExportGenesis returns the reward module's genesis state.
@@ -20,5 +24,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return types.NewGenesisState(
 		k.GetParams(ctx),
 		k.getProxiedValidators(ctx),
+		k.getLaneProxies(ctx, lanePrefix),
 	)
 }
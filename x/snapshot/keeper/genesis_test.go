@@ -43,7 +43,7 @@ func setup() (sdk.Context, Keeper, *mock.StakingKeeperMock, *mock.BankKeeperMock
 
 func TestExportGenesis(t *testing.T) {
 	ctx, keeper, staking, bank, _ := setup()
-	keeper.InitGenesis(ctx, types.NewGenesisState(types.DefaultParams(), []types.ProxiedValidator{}))
+	keeper.InitGenesis(ctx, types.NewGenesisState(types.DefaultParams(), []types.ProxiedValidator{}, []types.ProxiedValidator{}))
 
 	staking.BondDenomFunc = func(sdk.Context) string {
 		return bondDenom
@@ -59,6 +59,7 @@ func TestExportGenesis(t *testing.T) {
 	validators := make([]sdk.ValAddress, proxiedValidatorCount)
 	proxies := make([]sdk.AccAddress, proxiedValidatorCount)
 	expectedProxiedValidators := make([]types.ProxiedValidator, proxiedValidatorCount)
+	expectedLaneProxies := make([]types.ProxiedValidator, proxiedValidatorCount)
 
 	for i := 0; i < int(proxiedValidatorCount); i++ {
 		validators[i] = rand.ValAddr()
@@ -74,14 +75,18 @@ func TestExportGenesis(t *testing.T) {
 			err := keeper.DeactivateProxy(ctx, validators[i])
 			assert.NoError(t, err)
 		}
+
+		expectedLaneProxies[i] = types.NewProxiedValidator(validators[i], rand.AccAddr(), true)
+		assert.NoError(t, keeper.AddLaneProxy(ctx, validators[i], expectedLaneProxies[i].Proxy))
 	}
 
 	actual := keeper.ExportGenesis(ctx)
-	expected := types.NewGenesisState(types.DefaultParams(), expectedProxiedValidators)
+	expected := types.NewGenesisState(types.DefaultParams(), expectedProxiedValidators, expectedLaneProxies)
 
 	assert.NoError(t, actual.Validate())
 	assert.Equal(t, expected.Params, actual.Params)
 	assert.ElementsMatch(t, expected.ProxiedValidators, actual.ProxiedValidators)
+	assert.ElementsMatch(t, expected.LaneProxies, actual.LaneProxies)
 }
 
 func TestInitGenesis(t *testing.T) {
@@ -89,16 +94,19 @@ func TestInitGenesis(t *testing.T) {
 
 	proxiedValidatorCount := rand.I64Between(10, 100)
 	expectedProxiedValidators := make([]types.ProxiedValidator, proxiedValidatorCount)
+	expectedLaneProxies := make([]types.ProxiedValidator, proxiedValidatorCount)
 	for i := 0; i < int(proxiedValidatorCount); i++ {
 		active := rand.Bools(0.5).Next()
 		expectedProxiedValidators[i] = types.NewProxiedValidator(rand.ValAddr(), rand.AccAddr(), active)
+		expectedLaneProxies[i] = types.NewProxiedValidator(expectedProxiedValidators[i].Validator, rand.AccAddr(), true)
 	}
 
-	expected := types.NewGenesisState(types.DefaultParams(), expectedProxiedValidators)
+	expected := types.NewGenesisState(types.DefaultParams(), expectedProxiedValidators, expectedLaneProxies)
 	keeper.InitGenesis(ctx, expected)
 	actual := keeper.ExportGenesis(ctx)
 
 	assert.NoError(t, actual.Validate())
 	assert.Equal(t, expected.Params, actual.Params)
 	assert.ElementsMatch(t, expected.ProxiedValidators, actual.ProxiedValidators)
+	assert.ElementsMatch(t, expected.LaneProxies, actual.LaneProxies)
 }
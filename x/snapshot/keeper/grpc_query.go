@@ -4,8 +4,11 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 var _ types.QueryServiceServer = Querier{}
@@ -32,3 +35,17 @@ func (q Querier) Params(c context.Context, req *types.ParamsRequest) (*types.Par
 		Params: params,
 	}, nil
 }
+
+// LaneProxies returns the lane proxies of the given validator
+func (q Querier) LaneProxies(c context.Context, req *types.LaneProxiesRequest) (*types.LaneProxiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.LaneProxiesResponse{
+		LaneProxies: slices.Map(q.keeper.GetLaneProxies(ctx, validator), sdk.AccAddress.String),
+	}, nil
+}
@@ -18,6 +18,9 @@ import (
 const (
 	operatorPrefix = "operator_"
 	proxyPrefix    = "proxy_"
+	// lanePrefix maps lane proxies to their validator, laneOfPrefix lists the lane proxies of each validator
+	lanePrefix   = "lane_proxy_"
+	laneOfPrefix = "lanes_of_"
 )
 
 // Keeper represents the snapshot keeper
@@ -88,6 +91,20 @@ func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sd
 		)
 	}
 
+	if lane, ok := k.getLaneProxy(ctx, proxy); ok {
+		return fmt.Errorf("account %s is already registered as lane proxy of validator %s", proxy.String(), lane.Validator.String())
+	}
+
+	if err := k.validateProxyBalance(ctx, proxy); err != nil {
+		return err
+	}
+
+	k.setProxiedValidator(ctx, types.NewProxiedValidator(operator, proxy, true))
+
+	return nil
+}
+
+func (k Keeper) validateProxyBalance(ctx sdk.Context, proxy sdk.AccAddress) error {
 	minBalance := k.GetMinProxyBalance(ctx)
 	denom := k.staking.BondDenom(ctx)
 	if balance := k.bank.SpendableBalance(ctx, proxy, denom); balance.Amount.LT(minBalance) {
@@ -95,11 +112,108 @@ func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sd
 			proxy.String(), minBalance.String(), denom, balance.String())
 	}
 
-	k.setProxiedValidator(ctx, types.NewProxiedValidator(operator, proxy, true))
+	return nil
+}
+
+// AddLaneProxy registers an additional proxy address for a given operator. Lane proxies can broadcast messages in the
+// principal's name while the principal's proxy is active, so the principal can broadcast from multiple accounts in parallel.
+func (k Keeper) AddLaneProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) error {
+	if bytes.Equal(operator, proxy) {
+		return fmt.Errorf("lane proxy address cannot be the same as the operator address")
+	}
+
+	proxiedValidator, ok := k.getProxiedValidator(ctx, operator)
+	if !ok || !proxiedValidator.Validator.Equals(operator) {
+		return fmt.Errorf("validator %s has no proxy registered", operator.String())
+	}
+
+	if _, ok := k.getProxiedValidator(ctx, proxy); ok {
+		return fmt.Errorf("account %s is already registered as proxy or operator", proxy.String())
+	}
+
+	if lane, ok := k.getLaneProxy(ctx, proxy); ok {
+		if lane.Validator.Equals(operator) {
+			return nil
+		}
+
+		return fmt.Errorf(
+			"validator mismatch, expected %s, got %s",
+			lane.Validator.String(),
+			operator.String(),
+		)
+	}
+
+	if count := len(k.GetLaneProxies(ctx, operator)); count >= types.MaxLaneProxies {
+		return fmt.Errorf("validator %s already has the max number of %d lane proxies", operator.String(), types.MaxLaneProxies)
+	}
+
+	if err := k.validateProxyBalance(ctx, proxy); err != nil {
+		return err
+	}
+
+	k.setLaneProxy(ctx, types.NewProxiedValidator(operator, proxy, true))
+
+	return nil
+}
+
+// RemoveLaneProxy removes a lane proxy of the given operator
+func (k Keeper) RemoveLaneProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) error {
+	lane, ok := k.getLaneProxy(ctx, proxy)
+	if !ok || !lane.Validator.Equals(operator) {
+		return fmt.Errorf("account %s is not a lane proxy of validator %s", proxy.String(), operator.String())
+	}
+
+	ctx.KVStore(k.storeKey).Delete([]byte(lanePrefix + proxy.String()))
+	ctx.KVStore(k.storeKey).Delete([]byte(laneOfPrefix + operator.String() + "_" + proxy.String()))
 
 	return nil
 }
 
+// GetLaneProxies returns the lane proxies of the given operator
+func (k Keeper) GetLaneProxies(ctx sdk.Context, operator sdk.ValAddress) []sdk.AccAddress {
+	var proxies []sdk.AccAddress
+	for _, lane := range k.getLaneProxies(ctx, laneOfPrefix+operator.String()+"_") {
+		proxies = append(proxies, lane.Proxy)
+	}
+
+	return proxies
+}
+
+func (k Keeper) getLaneProxy(ctx sdk.Context, proxy sdk.AccAddress) (types.ProxiedValidator, bool) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(lanePrefix + proxy.String()))
+	if bz == nil {
+		return types.ProxiedValidator{}, false
+	}
+
+	var lane types.ProxiedValidator
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &lane)
+
+	return lane, true
+}
+
+func (k Keeper) getLaneProxies(ctx sdk.Context, prefix string) []types.ProxiedValidator {
+	var lanes []types.ProxiedValidator
+
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(prefix))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var lane types.ProxiedValidator
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &lane)
+
+		lanes = append(lanes, lane)
+	}
+
+	return lanes
+}
+
+func (k Keeper) setLaneProxy(ctx sdk.Context, lane types.ProxiedValidator) {
+	bz := k.cdc.MustMarshalLengthPrefixed(&lane)
+
+	ctx.KVStore(k.storeKey).Set([]byte(lanePrefix+lane.Proxy.String()), bz)
+	ctx.KVStore(k.storeKey).Set([]byte(laneOfPrefix+lane.Validator.String()+"_"+lane.Proxy.String()), bz)
+}
+
 // DeactivateProxy deactivates the proxy address for a given operator
 func (k Keeper) DeactivateProxy(ctx sdk.Context, operator sdk.ValAddress) error {
 	val := k.staking.Validator(ctx, operator)
@@ -164,6 +278,23 @@ func (k Keeper) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddres
 	return nil
 }
 
+// GetOperatorForLane returns the principal address for a given proxy or lane proxy address. Returns nil if not set.
+// Lane proxies only act in the principal's name while the principal's proxy is active,
+// so this must only be used to authorize the messages vald broadcasts from its lanes.
+func (k Keeper) GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+	if operator := k.GetOperator(ctx, proxy); operator != nil {
+		return operator
+	}
+
+	if lane, ok := k.getLaneProxy(ctx, proxy); ok {
+		if _, active := k.GetProxy(ctx, lane.Validator); active {
+			return lane.Validator
+		}
+	}
+
+	return nil
+}
+
 // GetProxy returns the proxy address for a given operator address. Returns nil if not set.
 // The bool value denotes wether or not the proxy is active and to be included in the next snapshot
 func (k Keeper) GetProxy(ctx sdk.Context, operator sdk.ValAddress) (addr sdk.AccAddress, active bool) {
@@ -214,6 +214,103 @@ func TestKeeper_DeregisterProxy(t *testing.T) {
 	}).Repeat(20))
 }
 
+func TestKeeper_LaneProxies(t *testing.T) {
+	var (
+		ctx              sdk.Context
+		snapshotKeeper   keeper.Keeper
+		principalAddress sdk.ValAddress
+		proxy            sdk.AccAddress
+		lane             sdk.AccAddress
+		bank             *mock.BankKeeperMock
+	)
+
+	setup := func() {
+		encCfg := appParams.MakeEncodingConfig()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		snapSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("paramsKey"), sdk.NewKVStoreKey("tparamsKey"), "snap")
+		validators := genValidators(t, 10, 100)
+		principalAddress = validators[rand.I64Between(0, 10)].GetOperator()
+		proxy = rand.AccAddr()
+		lane = rand.AccAddr()
+		bank = &mock.BankKeeperMock{
+			SpendableBalanceFunc: func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+				return sdk.NewCoin("uaxl", sdk.NewInt(5000000))
+			},
+		}
+
+		snapshotKeeper = keeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("staking"), snapSubspace, newMockStaker(validators...), bank, &mock.SlasherMock{})
+		snapshotKeeper.SetParams(ctx, types.DefaultParams())
+		funcs.MustNoErr(snapshotKeeper.ActivateProxy(ctx, principalAddress, proxy))
+	}
+
+	t.Run("lane proxies act in the validator's name while its proxy is active", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.Nil(t, snapshotKeeper.GetOperatorForLane(ctx, lane))
+		assert.NoError(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, lane))
+		assert.Equal(t, []sdk.AccAddress{lane}, snapshotKeeper.GetLaneProxies(ctx, principalAddress))
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperatorForLane(ctx, lane))
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperatorForLane(ctx, proxy))
+
+		funcs.MustNoErr(snapshotKeeper.DeactivateProxy(ctx, principalAddress))
+		assert.Nil(t, snapshotKeeper.GetOperatorForLane(ctx, lane))
+
+		funcs.MustNoErr(snapshotKeeper.ActivateProxy(ctx, principalAddress, proxy))
+		assert.NoError(t, snapshotKeeper.RemoveLaneProxy(ctx, principalAddress, lane))
+		assert.Nil(t, snapshotKeeper.GetOperatorForLane(ctx, lane))
+		assert.Empty(t, snapshotKeeper.GetLaneProxies(ctx, principalAddress))
+	}).Repeat(20))
+
+	t.Run("lane proxies are not the operator's principal proxy", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.NoError(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, lane))
+		assert.Nil(t, snapshotKeeper.GetOperator(ctx, lane))
+		assert.Equal(t, principalAddress, snapshotKeeper.GetOperator(ctx, proxy))
+	}).Repeat(20))
+
+	t.Run("validator without proxy", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.Error(t, snapshotKeeper.AddLaneProxy(ctx, rand.ValAddr(), lane))
+	}).Repeat(20))
+
+	t.Run("account already registered", testutils.Func(func(t *testing.T) {
+		setup()
+
+		assert.Error(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, proxy))
+		assert.Error(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, sdk.AccAddress(principalAddress)))
+
+		assert.NoError(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, lane))
+		assert.NoError(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, lane))
+		assert.Error(t, snapshotKeeper.ActivateProxy(ctx, rand.ValAddr(), lane))
+
+		other := rand.ValAddr()
+		funcs.MustNoErr(snapshotKeeper.ActivateProxy(ctx, other, rand.AccAddr()))
+		assert.Error(t, snapshotKeeper.AddLaneProxy(ctx, other, lane))
+		assert.Error(t, snapshotKeeper.RemoveLaneProxy(ctx, other, lane))
+	}).Repeat(20))
+
+	t.Run("too many lane proxies", testutils.Func(func(t *testing.T) {
+		setup()
+
+		for i := 0; i < types.MaxLaneProxies; i++ {
+			assert.NoError(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, rand.AccAddr()))
+		}
+		assert.Error(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, lane))
+	}).Repeat(5))
+
+	t.Run("insufficient funds in lane proxy", testutils.Func(func(t *testing.T) {
+		setup()
+
+		bank.SpendableBalanceFunc = func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+			return sdk.NewCoin("uaxl", sdk.NewInt(4999999))
+		}
+
+		assert.Error(t, snapshotKeeper.AddLaneProxy(ctx, principalAddress, lane))
+	}).Repeat(20))
+}
+
 func TestKeeper(t *testing.T) {
 	var (
 		ctx     sdk.Context
@@ -88,3 +88,45 @@ func (s msgServer) DeactivateProxy(c context.Context, req *types.DeactivateProxy
 
 	return &types.DeactivateProxyResponse{}, nil
 }
+
+func (s msgServer) RegisterLaneProxy(c context.Context, req *types.RegisterLaneProxyRequest) (*types.RegisterLaneProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.Keeper.AddLaneProxy(ctx, req.Sender, req.ProxyAddr); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeModule),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeRegisterLaneProxy),
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
+			sdk.NewAttribute(types.AttributeAddress, req.ProxyAddr.String()),
+		),
+	)
+
+	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s registered lane proxy %s", req.Sender.String(), req.ProxyAddr.String()))
+	return &types.RegisterLaneProxyResponse{}, nil
+}
+
+func (s msgServer) DeregisterLaneProxy(c context.Context, req *types.DeregisterLaneProxyRequest) (*types.DeregisterLaneProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.Keeper.RemoveLaneProxy(ctx, req.Sender, req.ProxyAddr); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSnapshot, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeModule),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeDeregisterLaneProxy),
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender.String()),
+			sdk.NewAttribute(types.AttributeAddress, req.ProxyAddr.String()),
+		),
+	)
+
+	s.Keeper.Logger(ctx).Info(fmt.Sprintf("validator %s deregistered lane proxy %s", req.Sender.String(), req.ProxyAddr.String()))
+	return &types.DeregisterLaneProxyResponse{}, nil
+}
@@ -12,6 +12,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterProxyRequest{}, "snapshot/RegisterProxy", nil)
 	cdc.RegisterConcrete(&DeactivateProxyRequest{}, "snapshot/DeactivateProxy", nil)
+	cdc.RegisterConcrete(&RegisterLaneProxyRequest{}, "snapshot/RegisterLaneProxy", nil)
+	cdc.RegisterConcrete(&DeregisterLaneProxyRequest{}, "snapshot/DeregisterLaneProxy", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -19,6 +21,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&RegisterProxyRequest{},
 		&DeactivateProxyRequest{},
+		&RegisterLaneProxyRequest{},
+		&DeregisterLaneProxyRequest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
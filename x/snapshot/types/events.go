@@ -11,6 +11,8 @@ const (
 	AttributeAddress              = "address"
 	AttributeRegisterProxy        = "registerProxy"
 	AttributeDeactivateProxy      = "deactivateProxy"
+	AttributeRegisterLaneProxy    = "registerLaneProxy"
+	AttributeDeregisterLaneProxy  = "deregisterLaneProxy"
 	AttributeParticipants         = "participants"
 	AttributeParticipantsStake    = "participantsStake"
 	AttributeNonParticipants      = "nonParticipants"
@@ -2,22 +2,24 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState is the constructor for GenesisState
-func NewGenesisState(params Params, proxiedValidators []ProxiedValidator) *GenesisState {
+func NewGenesisState(params Params, proxiedValidators []ProxiedValidator, laneProxies []ProxiedValidator) *GenesisState {
 	return &GenesisState{
 		Params:            params,
 		ProxiedValidators: proxiedValidators,
+		LaneProxies:       laneProxies,
 	}
 }
 
 // DefaultGenesisState returns a genesis state with default parameters
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []ProxiedValidator{})
+	return NewGenesisState(DefaultParams(), []ProxiedValidator{}, []ProxiedValidator{})
 }
 
 // Validate performs a validation check on the genesis parameters
@@ -26,10 +28,24 @@ func (m GenesisState) Validate() error {
 		return getValidateError(err)
 	}
 
+	proxies := make(map[string]bool)
 	for _, proxiedValidator := range m.ProxiedValidators {
 		if err := proxiedValidator.Validate(); err != nil {
 			return getValidateError(err)
 		}
+
+		proxies[proxiedValidator.Proxy.String()] = true
+	}
+
+	for _, laneProxy := range m.LaneProxies {
+		if err := laneProxy.Validate(); err != nil {
+			return getValidateError(err)
+		}
+
+		if proxies[laneProxy.Proxy.String()] {
+			return getValidateError(fmt.Errorf("proxy %s is registered more than once", laneProxy.Proxy.String()))
+		}
+		proxies[laneProxy.Proxy.String()] = true
 	}
 
 	return nil
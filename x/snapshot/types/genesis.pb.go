@@ -27,6 +27,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params            Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	ProxiedValidators []ProxiedValidator `protobuf:"bytes,2,rep,name=proxied_validators,json=proxiedValidators,proto3" json:"proxied_validators"`
+	LaneProxies       []ProxiedValidator `protobuf:"bytes,3,rep,name=lane_proxies,json=laneProxies,proto3" json:"lane_proxies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,24 +72,25 @@ func init() {
 }
 
 var fileDescriptor_7c3280b492b2bcbd = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
+	// 288 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xac, 0x48, 0xcd,
 	0x49, 0x2c, 0xd2, 0x2f, 0xce, 0x4b, 0x2c, 0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0x54, 0x70, 0x99, 0x5a, 0x90, 0x58, 0x94,
-	0x98, 0x0b, 0x35, 0x54, 0x4a, 0x19, 0x97, 0xaa, 0x92, 0xca, 0x82, 0x54, 0xa8, 0x22, 0xa5, 0xb5,
-	0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xb7, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xd9, 0x72, 0xb1, 0x41,
-	0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x36, 0xbd, 0x00, 0xb0,
-	0x32, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x9a, 0x84, 0xe2, 0xb8, 0x84, 0x0a, 0x8a,
-	0xf2, 0x2b, 0x32, 0x53, 0x53, 0xe2, 0xcb, 0x12, 0x73, 0x32, 0x53, 0x12, 0x4b, 0xf2, 0x8b, 0x8a,
-	0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0x71, 0x1b, 0x05, 0xd1, 0x12, 0x06, 0xd3, 0x01,
-	0x35, 0x54, 0xb0, 0x00, 0x4d, 0xbc, 0xd8, 0x29, 0xf8, 0xc4, 0x43, 0x39, 0x86, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x87, 0xd8, 0x95, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0x0d, 0xe5, 0xe9, 0x26,
-	0xe7, 0x17, 0xa5, 0xea, 0x57, 0x20, 0x82, 0x04, 0x1c, 0x14, 0x49, 0x6c, 0xe0, 0xb0, 0x30, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xc1, 0x7d, 0x41, 0xae, 0x01, 0x00, 0x00,
+	0x98, 0x0b, 0x35, 0x54, 0x4a, 0x19, 0x97, 0xaa, 0x92, 0xca, 0x82, 0x54, 0xa8, 0x22, 0xa5, 0x46,
+	0x26, 0x2e, 0x1e, 0x77, 0x88, 0x5b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb9, 0xd8, 0x20,
+	0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1, 0x70, 0x9b, 0x5e, 0x00, 0x58,
+	0x99, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x4d, 0x42, 0x71, 0x5c, 0x42, 0x05, 0x45,
+	0xf9, 0x15, 0x99, 0xa9, 0x29, 0xf1, 0x65, 0x89, 0x39, 0x99, 0x29, 0x89, 0x25, 0xf9, 0x45, 0xc5,
+	0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x9a, 0xb8, 0x8d, 0x82, 0x68, 0x09, 0x83, 0xe9, 0x80,
+	0x1a, 0x2a, 0x58, 0x80, 0x26, 0x5e, 0x2c, 0x14, 0xc4, 0xc5, 0x93, 0x93, 0x98, 0x97, 0x1a, 0x0f,
+	0x91, 0x29, 0x96, 0x60, 0x26, 0xcf, 0x64, 0x6e, 0x90, 0x21, 0x10, 0xb9, 0x62, 0xa7, 0xe0, 0x13,
+	0x0f, 0xe5, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
+	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d,
+	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x62, 0x4b, 0x5e, 0x6a, 0x49, 0x79,
+	0x7e, 0x51, 0x36, 0x94, 0xa7, 0x9b, 0x9c, 0x5f, 0x94, 0xaa, 0x5f, 0x81, 0x08, 0x66, 0x70, 0xf0,
+	0x26, 0xb1, 0x81, 0xc3, 0xd7, 0x18, 0x30, 0x00, 0xb2, 0x39, 0xd9, 0xc0, 0x02, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +113,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LaneProxies) > 0 {
+		for iNdEx := len(m.LaneProxies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LaneProxies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ProxiedValidators) > 0 {
 		for iNdEx := len(m.ProxiedValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -163,6 +179,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LaneProxies) > 0 {
+		for _, e := range m.LaneProxies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,6 +290,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaneProxies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaneProxies = append(m.LaneProxies, ProxiedValidator{})
+			if err := m.LaneProxies[len(m.LaneProxies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,3 +17,6 @@ const (
 	// RestRoute to be used for rest routing
 	RestRoute = ModuleName
 )
+
+// MaxLaneProxies is the max number of lane proxies a validator can register in addition to its proxy
+const MaxLaneProxies = 20
@@ -220,12 +220,90 @@ func (m *ParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
 
+// LaneProxiesRequest represents a message that queries the lane proxies of a
+// validator
+type LaneProxiesRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *LaneProxiesRequest) Reset()         { *m = LaneProxiesRequest{} }
+func (m *LaneProxiesRequest) String() string { return proto.CompactTextString(m) }
+func (*LaneProxiesRequest) ProtoMessage()    {}
+func (*LaneProxiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044ba28efe73d39e, []int{3}
+}
+func (m *LaneProxiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneProxiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneProxiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneProxiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneProxiesRequest.Merge(m, src)
+}
+func (m *LaneProxiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneProxiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneProxiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneProxiesRequest proto.InternalMessageInfo
+
+type LaneProxiesResponse struct {
+	LaneProxies []string `protobuf:"bytes,1,rep,name=lane_proxies,json=laneProxies,proto3" json:"lane_proxies,omitempty"`
+}
+
+func (m *LaneProxiesResponse) Reset()         { *m = LaneProxiesResponse{} }
+func (m *LaneProxiesResponse) String() string { return proto.CompactTextString(m) }
+func (*LaneProxiesResponse) ProtoMessage()    {}
+func (*LaneProxiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044ba28efe73d39e, []int{4}
+}
+func (m *LaneProxiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneProxiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneProxiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneProxiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneProxiesResponse.Merge(m, src)
+}
+func (m *LaneProxiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneProxiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneProxiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneProxiesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "axelar.snapshot.v1beta1.QueryValidatorsResponse")
 	proto.RegisterType((*QueryValidatorsResponse_TssIllegibilityInfo)(nil), "axelar.snapshot.v1beta1.QueryValidatorsResponse.TssIllegibilityInfo")
 	proto.RegisterType((*QueryValidatorsResponse_Validator)(nil), "axelar.snapshot.v1beta1.QueryValidatorsResponse.Validator")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.snapshot.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.snapshot.v1beta1.ParamsResponse")
+	proto.RegisterType((*LaneProxiesRequest)(nil), "axelar.snapshot.v1beta1.LaneProxiesRequest")
+	proto.RegisterType((*LaneProxiesResponse)(nil), "axelar.snapshot.v1beta1.LaneProxiesResponse")
 }
 
 func init() {
@@ -233,42 +311,44 @@ func init() {
 }
 
 var fileDescriptor_044ba28efe73d39e = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd4, 0x4c,
-	0x10, 0xc6, 0xcf, 0xf9, 0x73, 0x79, 0xb3, 0x79, 0x43, 0xc0, 0x47, 0x12, 0xeb, 0x0a, 0x27, 0x4a,
-	0x28, 0x42, 0x81, 0xad, 0x24, 0x82, 0x02, 0x89, 0x82, 0x08, 0x21, 0x52, 0x20, 0x82, 0x13, 0x51,
-	0xa4, 0xb1, 0xd6, 0xe7, 0xb9, 0xcb, 0x12, 0x7b, 0xc7, 0xd9, 0x59, 0x87, 0xb8, 0xe1, 0x03, 0x50,
-	0xf1, 0x8d, 0x68, 0x53, 0x5e, 0x49, 0x85, 0xe0, 0xee, 0x8b, 0x20, 0xaf, 0xed, 0xcb, 0x49, 0x70,
-	0x05, 0x9d, 0xf7, 0xf9, 0x3d, 0x3b, 0x33, 0x9e, 0x99, 0x65, 0xbb, 0xfc, 0x06, 0x12, 0xae, 0x7c,
-	0x92, 0x3c, 0xa3, 0x0b, 0xd4, 0xfe, 0xf5, 0x7e, 0x04, 0x9a, 0xef, 0xfb, 0x57, 0x39, 0xa8, 0xc2,
-	0xcb, 0x14, 0x6a, 0xb4, 0x37, 0x2b, 0x93, 0xd7, 0x98, 0xbc, 0xda, 0xd4, 0x7d, 0x38, 0xc0, 0x01,
-	0x1a, 0x8f, 0x5f, 0x7e, 0x55, 0xf6, 0xee, 0xa3, 0x59, 0x31, 0x33, 0xae, 0x78, 0x4a, 0x95, 0x6b,
-	0xe7, 0xcb, 0x22, 0xdb, 0x7c, 0x5f, 0x26, 0xf9, 0xc0, 0x13, 0x11, 0x73, 0x8d, 0x8a, 0x02, 0xa0,
-	0x0c, 0x25, 0x81, 0x7d, 0xce, 0xd8, 0xf5, 0x44, 0x75, 0xac, 0xed, 0xf9, 0xbd, 0x95, 0x83, 0xe7,
-	0xde, 0x8c, 0x2a, 0xbc, 0x19, 0x51, 0xbc, 0x89, 0x14, 0x4c, 0x45, 0xeb, 0x7e, 0x9b, 0x63, 0x9d,
-	0x33, 0xa2, 0xe3, 0x24, 0x81, 0x81, 0x88, 0x44, 0x22, 0x74, 0x71, 0x2c, 0xfb, 0x68, 0xbb, 0x8c,
-	0x69, 0x4c, 0x23, 0xd2, 0x28, 0x21, 0x76, 0xac, 0x6d, 0x6b, 0xef, 0xbf, 0x60, 0x4a, 0xb1, 0x37,
-	0x58, 0xfb, 0x23, 0x17, 0x09, 0xc4, 0xce, 0x9c, 0x61, 0xf5, 0xc9, 0x3e, 0x64, 0x1b, 0xa9, 0x20,
-	0x82, 0x38, 0xd4, 0x88, 0x61, 0xca, 0x65, 0x11, 0x46, 0x09, 0xf6, 0x2e, 0xc9, 0x99, 0x37, 0xbe,
-	0x4e, 0x45, 0xcf, 0x10, 0xdf, 0x72, 0x59, 0x1c, 0x19, 0x64, 0x7b, 0xac, 0x23, 0x31, 0xcc, 0x14,
-	0xde, 0x14, 0xa1, 0x82, 0x81, 0x20, 0x0d, 0x0a, 0x62, 0x67, 0xc1, 0xdc, 0x78, 0x20, 0xf1, 0xa4,
-	0x24, 0xc1, 0x04, 0xd8, 0xbb, 0x6c, 0x55, 0x13, 0x85, 0x94, 0x53, 0x06, 0x32, 0x86, 0xd8, 0x59,
-	0x34, 0xce, 0xff, 0x35, 0xd1, 0x69, 0xa3, 0xd9, 0xcf, 0xd8, 0x66, 0x15, 0x51, 0x48, 0xca, 0xfb,
-	0xa2, 0x27, 0x40, 0xea, 0xb0, 0x9f, 0xcb, 0x98, 0x9c, 0xb6, 0xb1, 0xaf, 0x1b, 0x7c, 0x7c, 0x47,
-	0x5f, 0x97, 0xb0, 0x2c, 0x86, 0x34, 0x4f, 0x20, 0x2c, 0x53, 0x5c, 0x00, 0x57, 0x3a, 0x02, 0xae,
-	0x9d, 0xa5, 0xaa, 0x18, 0x83, 0xce, 0x88, 0xde, 0x34, 0xa0, 0x3b, 0xb4, 0xd8, 0xf2, 0xa4, 0xb7,
-	0xf6, 0x63, 0x76, 0x1f, 0x33, 0x50, 0xe5, 0x77, 0xc8, 0xe3, 0x58, 0x01, 0x91, 0xe9, 0xde, 0x72,
-	0xb0, 0xd6, 0xe8, 0x2f, 0x2b, 0xd9, 0x76, 0xd8, 0x52, 0x8a, 0x52, 0x5c, 0x82, 0x32, 0x3d, 0x5c,
-	0x0e, 0x9a, 0xa3, 0xfd, 0x99, 0xad, 0x97, 0xc9, 0xc5, 0xd4, 0x50, 0x42, 0x21, 0xfb, 0x68, 0x7a,
-	0xb8, 0x72, 0xf0, 0xea, 0x9f, 0x67, 0xff, 0x97, 0x09, 0x1f, 0x2d, 0xdc, 0xfe, 0xd8, 0x6a, 0x05,
-	0x1d, 0xfd, 0x27, 0xda, 0x59, 0x63, 0xab, 0x27, 0x66, 0x39, 0x03, 0xb8, 0xca, 0x81, 0xf4, 0xce,
-	0x3b, 0x76, 0xaf, 0x11, 0xea, 0x9d, 0x7c, 0xc1, 0xda, 0xd5, 0xfe, 0x9a, 0xbf, 0x5b, 0x39, 0xd8,
-	0x9a, 0x59, 0x53, 0x75, 0xb1, 0x4e, 0x57, 0x5f, 0x3a, 0x3a, 0xbd, 0xfd, 0xe5, 0xb6, 0x6e, 0x47,
-	0xae, 0x35, 0x1c, 0xb9, 0xd6, 0xcf, 0x91, 0x6b, 0x7d, 0x1d, 0xbb, 0xad, 0xe1, 0xd8, 0x6d, 0x7d,
-	0x1f, 0xbb, 0xad, 0xf3, 0xa7, 0x03, 0xa1, 0x2f, 0xf2, 0xc8, 0xeb, 0x61, 0xea, 0x57, 0x61, 0x25,
-	0xe8, 0x4f, 0xa8, 0x2e, 0xeb, 0xd3, 0x93, 0x1e, 0x2a, 0xf0, 0x6f, 0xee, 0x9e, 0x94, 0x2e, 0x32,
-	0xa0, 0xa8, 0x6d, 0x9e, 0xd2, 0xe1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x95, 0x11, 0x60,
-	0xc6, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x4f, 0x13, 0x5d,
+	0x14, 0xee, 0xf0, 0x51, 0xde, 0xde, 0xc2, 0x8b, 0xde, 0x0a, 0x4c, 0x1a, 0x33, 0x60, 0x71, 0x81,
+	0x0b, 0xa7, 0xa1, 0x44, 0x63, 0x4c, 0x5c, 0x48, 0x8c, 0x91, 0x44, 0x23, 0x0e, 0xc4, 0x05, 0x9b,
+	0xc9, 0x9d, 0xce, 0x69, 0xb9, 0x32, 0xbd, 0x67, 0xb8, 0xe7, 0x16, 0xe9, 0xc6, 0x1f, 0xe0, 0xca,
+	0x7f, 0xe4, 0x96, 0x25, 0x4b, 0x57, 0x46, 0xe1, 0x8f, 0x98, 0xb9, 0x33, 0xd3, 0x62, 0xb4, 0x0b,
+	0x77, 0xf7, 0x3c, 0xcf, 0x73, 0x3e, 0xee, 0xf9, 0x60, 0x9b, 0xe2, 0x1c, 0x12, 0xa1, 0xdb, 0xa4,
+	0x44, 0x4a, 0xc7, 0x68, 0xda, 0x67, 0xdb, 0x11, 0x18, 0xb1, 0xdd, 0x3e, 0x1d, 0x82, 0x1e, 0xf9,
+	0xa9, 0x46, 0x83, 0x7c, 0x2d, 0x17, 0xf9, 0xa5, 0xc8, 0x2f, 0x44, 0xcd, 0x3b, 0x7d, 0xec, 0xa3,
+	0xd5, 0xb4, 0xb3, 0x57, 0x2e, 0x6f, 0xde, 0x9f, 0x16, 0x33, 0x15, 0x5a, 0x0c, 0x28, 0x57, 0xb5,
+	0x3e, 0xcf, 0xb3, 0xb5, 0x77, 0x59, 0x92, 0xf7, 0x22, 0x91, 0xb1, 0x30, 0xa8, 0x29, 0x00, 0x4a,
+	0x51, 0x11, 0xf0, 0x23, 0xc6, 0xce, 0xc6, 0xa8, 0xeb, 0x6c, 0xcc, 0x6e, 0xd5, 0x3b, 0x4f, 0xfd,
+	0x29, 0x55, 0xf8, 0x53, 0xa2, 0xf8, 0x63, 0x28, 0xb8, 0x11, 0xad, 0xf9, 0x75, 0x86, 0x35, 0x0e,
+	0x89, 0xf6, 0x92, 0x04, 0xfa, 0x32, 0x92, 0x89, 0x34, 0xa3, 0x3d, 0xd5, 0x43, 0xee, 0x31, 0x66,
+	0x70, 0x10, 0x91, 0x41, 0x05, 0xb1, 0xeb, 0x6c, 0x38, 0x5b, 0xff, 0x05, 0x37, 0x10, 0xbe, 0xca,
+	0xaa, 0x1f, 0x84, 0x4c, 0x20, 0x76, 0x67, 0x2c, 0x57, 0x58, 0x7c, 0x87, 0xad, 0x0e, 0x24, 0x11,
+	0xc4, 0xa1, 0x41, 0x0c, 0x07, 0x42, 0x8d, 0xc2, 0x28, 0xc1, 0xee, 0x09, 0xb9, 0xb3, 0x56, 0xd7,
+	0xc8, 0xd9, 0x43, 0xc4, 0x37, 0x42, 0x8d, 0x76, 0x2d, 0xc5, 0x7d, 0xd6, 0x50, 0x18, 0xa6, 0x1a,
+	0xcf, 0x47, 0xa1, 0x86, 0xbe, 0x24, 0x03, 0x1a, 0x62, 0x77, 0xce, 0x7a, 0xdc, 0x56, 0xb8, 0x9f,
+	0x31, 0xc1, 0x98, 0xe0, 0x9b, 0x6c, 0xc9, 0x10, 0x85, 0x34, 0xa4, 0x14, 0x54, 0x0c, 0xb1, 0x3b,
+	0x6f, 0x95, 0x8b, 0x86, 0xe8, 0xa0, 0xc4, 0xf8, 0x63, 0xb6, 0x96, 0x47, 0x94, 0x8a, 0x86, 0x3d,
+	0xd9, 0x95, 0xa0, 0x4c, 0xd8, 0x1b, 0xaa, 0x98, 0xdc, 0xaa, 0x95, 0xaf, 0x58, 0x7a, 0x6f, 0xc2,
+	0xbe, 0xcc, 0xc8, 0xac, 0x18, 0x32, 0x22, 0x81, 0x30, 0x4b, 0x71, 0x0c, 0x42, 0x9b, 0x08, 0x84,
+	0x71, 0x17, 0xf2, 0x62, 0x2c, 0x75, 0x48, 0xf4, 0xaa, 0x24, 0x9a, 0x97, 0x0e, 0xab, 0x8d, 0x7b,
+	0xcb, 0x1f, 0xb0, 0x5b, 0x98, 0x82, 0xce, 0xde, 0xa1, 0x88, 0x63, 0x0d, 0x44, 0xb6, 0x7b, 0xb5,
+	0x60, 0xb9, 0xc4, 0x9f, 0xe7, 0x30, 0x77, 0xd9, 0xc2, 0x00, 0x95, 0x3c, 0x01, 0x6d, 0x7b, 0x58,
+	0x0b, 0x4a, 0x93, 0x7f, 0x62, 0x2b, 0x59, 0x72, 0x79, 0x63, 0x28, 0xa1, 0x54, 0x3d, 0xb4, 0x3d,
+	0xac, 0x77, 0x5e, 0xfc, 0xf3, 0xec, 0xff, 0x32, 0xe1, 0xdd, 0xb9, 0x8b, 0xef, 0xeb, 0x95, 0xa0,
+	0x61, 0xfe, 0xa4, 0x5a, 0xcb, 0x6c, 0x69, 0xdf, 0x2e, 0x67, 0x00, 0xa7, 0x43, 0x20, 0xd3, 0x7a,
+	0xcb, 0xfe, 0x2f, 0x81, 0x62, 0x27, 0x9f, 0xb1, 0x6a, 0xbe, 0xbf, 0xf6, 0x77, 0xf5, 0xce, 0xfa,
+	0xd4, 0x9a, 0x72, 0xc7, 0x22, 0x5d, 0xe1, 0xd4, 0xea, 0x30, 0xfe, 0x5a, 0x28, 0xc8, 0x06, 0x2b,
+	0xa1, 0x4c, 0xc3, 0xef, 0xb2, 0xda, 0x78, 0x35, 0x8b, 0xae, 0x4d, 0x80, 0xd6, 0x13, 0xd6, 0xf8,
+	0xcd, 0xa7, 0xa8, 0xe4, 0x1e, 0x5b, 0x4c, 0x84, 0x02, 0xbb, 0x3e, 0x12, 0xf2, 0xfb, 0xa8, 0x05,
+	0xf5, 0x64, 0x22, 0xdd, 0x3d, 0xb8, 0xf8, 0xe9, 0x55, 0x2e, 0xae, 0x3c, 0xe7, 0xf2, 0xca, 0x73,
+	0x7e, 0x5c, 0x79, 0xce, 0x97, 0x6b, 0xaf, 0x72, 0x79, 0xed, 0x55, 0xbe, 0x5d, 0x7b, 0x95, 0xa3,
+	0x47, 0x7d, 0x69, 0x8e, 0x87, 0x91, 0xdf, 0xc5, 0x41, 0x3b, 0xff, 0x84, 0x02, 0xf3, 0x11, 0xf5,
+	0x49, 0x61, 0x3d, 0xec, 0xa2, 0x86, 0xf6, 0xf9, 0xe4, 0x80, 0xcd, 0x28, 0x05, 0x8a, 0xaa, 0xf6,
+	0x70, 0x77, 0x7e, 0x0d, 0x00, 0x9f, 0xca, 0xcb, 0x2e, 0x34, 0x04, 0x00, 0x00,
 }
 
 func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
@@ -504,6 +584,68 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LaneProxiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneProxiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneProxiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaneProxiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneProxiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneProxiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LaneProxies) > 0 {
+		for iNdEx := len(m.LaneProxies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LaneProxies[iNdEx])
+			copy(dAtA[i:], m.LaneProxies[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.LaneProxies[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -599,6 +741,34 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *LaneProxiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LaneProxiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LaneProxies) > 0 {
+		for _, s := range m.LaneProxies {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1159,6 +1329,170 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LaneProxiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneProxiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneProxiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneProxiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneProxiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneProxiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaneProxies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaneProxies = append(m.LaneProxies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
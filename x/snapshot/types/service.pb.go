@@ -37,30 +37,37 @@ func init() {
 }
 
 var fileDescriptor_795649fbc059ac6e = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x4b, 0x32, 0x41,
-	0x18, 0xc7, 0x1d, 0x0f, 0x1e, 0x96, 0xf7, 0xe5, 0x85, 0xe5, 0x85, 0x17, 0xe4, 0x65, 0x4b, 0x2b,
-	0x05, 0xc9, 0x9d, 0x34, 0xba, 0x74, 0x8c, 0xae, 0x81, 0xe9, 0xad, 0x4b, 0x8c, 0xdb, 0xc3, 0xb8,
-	0xa4, 0xfb, 0x8c, 0x33, 0xa3, 0xad, 0x57, 0x3f, 0x41, 0xe0, 0xad, 0x63, 0x9f, 0xa4, 0x63, 0xa7,
-	0x10, 0xba, 0x74, 0x0c, 0xb7, 0x0f, 0x12, 0xee, 0xce, 0x1a, 0x29, 0x4b, 0xde, 0x76, 0x99, 0xdf,
-	0xff, 0x79, 0x7e, 0xfc, 0x67, 0xac, 0x03, 0x16, 0x42, 0x9f, 0x49, 0xaa, 0x02, 0x26, 0x54, 0x0f,
-	0x35, 0x1d, 0x37, 0xba, 0xa0, 0x59, 0x83, 0x2a, 0x90, 0x63, 0xdf, 0x03, 0x57, 0x48, 0xd4, 0x68,
-	0xff, 0x4b, 0x30, 0x37, 0xc5, 0x5c, 0x83, 0x15, 0xff, 0x72, 0xe4, 0x18, 0x33, 0x74, 0xf9, 0x95,
-	0xe0, 0xc5, 0xff, 0x1c, 0x91, 0xf7, 0x81, 0x32, 0xe1, 0x53, 0x16, 0x04, 0xa8, 0x99, 0xf6, 0x31,
-	0x50, 0xe6, 0x74, 0x37, 0x6b, 0xa7, 0x0e, 0x0d, 0xb1, 0x97, 0x45, 0x0c, 0x47, 0x20, 0x27, 0x09,
-	0xd4, 0x7c, 0xc9, 0x5b, 0xd6, 0x85, 0xe2, 0x9d, 0x44, 0xd4, 0x7e, 0x20, 0xd6, 0xef, 0x36, 0x70,
-	0x5f, 0x69, 0x90, 0x2d, 0x89, 0xe1, 0xc4, 0xae, 0xbb, 0x19, 0xd6, 0xee, 0x37, 0xae, 0x0d, 0xc3,
-	0x11, 0x28, 0x5d, 0x74, 0xb7, 0xc5, 0x95, 0xc0, 0x40, 0x41, 0xb9, 0x36, 0x7d, 0xfd, 0x98, 0xe5,
-	0xf7, 0xcb, 0x3b, 0x74, 0xdd, 0x56, 0x1a, 0xfe, 0x5a, 0x2c, 0x03, 0xa7, 0xa4, 0x66, 0x3f, 0x12,
-	0xeb, 0xcf, 0x39, 0x30, 0x4f, 0xfb, 0x63, 0xa6, 0x21, 0xd1, 0xa3, 0x99, 0xfb, 0xd6, 0xc8, 0x54,
-	0xf0, 0x68, 0xfb, 0x80, 0x51, 0x3c, 0x8c, 0x15, 0x2b, 0xe5, 0xd2, 0x86, 0xe2, 0xcd, 0x2a, 0xb1,
-	0x92, 0x6c, 0xce, 0x88, 0xf5, 0xeb, 0x72, 0x59, 0x70, 0x5a, 0xe9, 0x94, 0x58, 0x85, 0x16, 0x93,
-	0x6c, 0xa0, 0xec, 0x4a, 0xe6, 0xee, 0x04, 0x48, 0x1d, 0xab, 0x3f, 0x72, 0x46, 0xad, 0x1a, 0xab,
-	0x95, 0xec, 0xcd, 0xf6, 0xd2, 0xbb, 0x16, 0x71, 0xe0, 0xac, 0xf3, 0xbc, 0x70, 0xc8, 0x7c, 0xe1,
-	0x90, 0xf7, 0x85, 0x43, 0xee, 0x23, 0x27, 0xf7, 0x14, 0x39, 0x64, 0x1e, 0x39, 0xb9, 0xb7, 0xc8,
-	0xc9, 0x5d, 0x9d, 0x70, 0x5f, 0xf7, 0x46, 0x5d, 0xd7, 0xc3, 0x81, 0x19, 0x14, 0x80, 0xbe, 0x43,
-	0x79, 0x6b, 0xfe, 0xea, 0x1e, 0x4a, 0xa0, 0xe1, 0xd7, 0x74, 0x3d, 0x11, 0xa0, 0xba, 0x85, 0xf8,
-	0x09, 0x1d, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x64, 0x6b, 0xb5, 0xff, 0x02, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd4, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xc0, 0xf1, 0xb8, 0x12, 0x1d, 0x0c, 0x08, 0x61, 0x90, 0x90, 0x22, 0x74, 0xd0, 0xa3, 0xa4,
+	0xa2, 0x3f, 0xce, 0x5c, 0x02, 0x03, 0x8c, 0xa8, 0x23, 0x48, 0xa5, 0xdd, 0x58, 0x90, 0x73, 0x7d,
+	0xba, 0x5a, 0x5c, 0xed, 0xab, 0xed, 0x1c, 0x17, 0x21, 0x96, 0xfe, 0x05, 0x48, 0x6c, 0x8c, 0x30,
+	0xb2, 0xc1, 0xc4, 0xc6, 0xc8, 0x58, 0x89, 0x85, 0x11, 0xe5, 0xf8, 0x43, 0x50, 0xee, 0xec, 0xb4,
+	0x34, 0x35, 0x4d, 0xb6, 0x44, 0xf9, 0x3a, 0xef, 0x73, 0x4f, 0x4e, 0xf0, 0x5d, 0x56, 0x42, 0xc6,
+	0x14, 0xd5, 0x82, 0xe5, 0x7a, 0x4f, 0x1a, 0x5a, 0xc4, 0x7d, 0x30, 0x2c, 0xa6, 0x1a, 0x54, 0xc1,
+	0x13, 0x88, 0x72, 0x25, 0x8d, 0x24, 0x37, 0x9a, 0x2c, 0x72, 0x59, 0x64, 0xb3, 0xf6, 0xf5, 0x54,
+	0xa6, 0xb2, 0x6e, 0xe8, 0xf8, 0x55, 0x93, 0xb7, 0x6f, 0xa6, 0x52, 0xa6, 0x19, 0x50, 0x96, 0x73,
+	0xca, 0x84, 0x90, 0x86, 0x19, 0x2e, 0x85, 0xb6, 0x9f, 0xde, 0xf6, 0xcd, 0x34, 0xa5, 0x2d, 0xee,
+	0xf8, 0x8a, 0x83, 0x01, 0xa8, 0x61, 0x13, 0x75, 0xbf, 0x5c, 0xc0, 0xf8, 0x99, 0x4e, 0x77, 0x1a,
+	0x28, 0xf9, 0x80, 0xf0, 0xe5, 0x6d, 0x48, 0xb9, 0x36, 0xa0, 0xb6, 0x94, 0x2c, 0x87, 0x64, 0x23,
+	0xf2, 0xa8, 0xa3, 0x7f, 0xba, 0x6d, 0x38, 0x18, 0x80, 0x36, 0xed, 0x68, 0xd6, 0x5c, 0xe7, 0x52,
+	0x68, 0x08, 0x57, 0x0f, 0x7f, 0xfe, 0x79, 0xbf, 0xb0, 0x1c, 0xde, 0xa2, 0xa7, 0xb5, 0xca, 0xf6,
+	0x2f, 0xf3, 0xf1, 0x81, 0xc7, 0x68, 0x95, 0x7c, 0x44, 0xf8, 0xca, 0x26, 0xb0, 0xc4, 0xf0, 0x82,
+	0x19, 0x68, 0x78, 0xd4, 0x3b, 0xef, 0x54, 0xe9, 0x80, 0xf7, 0x67, 0x3f, 0x60, 0x89, 0xeb, 0x35,
+	0xb1, 0x13, 0x2e, 0x4d, 0x11, 0x77, 0x27, 0x27, 0x8e, 0x91, 0x9f, 0x11, 0xbe, 0xea, 0x1e, 0xf5,
+	0x29, 0x13, 0x96, 0x19, 0x9f, 0xbb, 0x96, 0x49, 0xeb, 0xa0, 0xdd, 0x79, 0x8e, 0x58, 0x2a, 0xad,
+	0xa9, 0xf7, 0xc2, 0x65, 0xff, 0x36, 0x33, 0x26, 0x4e, 0x68, 0xbf, 0x22, 0x7c, 0x6d, 0x13, 0xd4,
+	0x94, 0xb7, 0xf7, 0x9f, 0x2d, 0x29, 0x9f, 0xf8, 0xc1, 0x7c, 0x87, 0xac, 0x39, 0xae, 0xcd, 0x6b,
+	0x61, 0xe7, 0x8c, 0xf5, 0x9e, 0xad, 0xee, 0x7e, 0x5b, 0xc0, 0x97, 0x9e, 0x8f, 0x2f, 0xb1, 0xbb,
+	0xb6, 0x87, 0x08, 0x2f, 0x6e, 0x31, 0xc5, 0xf6, 0x35, 0xe9, 0x78, 0x11, 0x4d, 0xe0, 0xb0, 0x2b,
+	0xe7, 0x76, 0xd6, 0xb7, 0x52, 0xfb, 0x96, 0xc8, 0xf4, 0x0d, 0x75, 0xbf, 0xa7, 0xbc, 0x99, 0xfc,
+	0x09, 0xe1, 0x8b, 0xee, 0xf1, 0x38, 0x68, 0xb2, 0xe6, 0x9d, 0x70, 0xa2, 0x72, 0x9c, 0xf5, 0xd9,
+	0x62, 0x6b, 0x7a, 0x54, 0x9b, 0x7a, 0x24, 0xf6, 0x9a, 0x26, 0x0b, 0xe3, 0xa0, 0xe9, 0x9b, 0x82,
+	0x65, 0x7c, 0x97, 0x19, 0xa9, 0xde, 0x3e, 0xd9, 0xf9, 0x31, 0x0a, 0xd0, 0xd1, 0x28, 0x40, 0xbf,
+	0x47, 0x01, 0x7a, 0x57, 0x05, 0xad, 0xef, 0x55, 0x80, 0x8e, 0xaa, 0xa0, 0xf5, 0xab, 0x0a, 0x5a,
+	0x2f, 0x1e, 0xa6, 0xdc, 0xec, 0x0d, 0xfa, 0x51, 0x22, 0xf7, 0xed, 0x57, 0x0b, 0x30, 0xaf, 0xa5,
+	0x7a, 0x65, 0xdf, 0x6d, 0x24, 0x52, 0x01, 0x2d, 0x8f, 0xe7, 0x99, 0x61, 0x0e, 0xba, 0xbf, 0x58,
+	0xff, 0x99, 0xf4, 0xfe, 0x0e, 0x00, 0x7b, 0x95, 0xf1, 0x0e, 0x09, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -80,6 +87,12 @@ type MsgServiceClient interface {
 	RegisterProxy(ctx context.Context, in *RegisterProxyRequest, opts ...grpc.CallOption) (*RegisterProxyResponse, error)
 	// DeactivateProxy defines a method for deregistering a proxy account.
 	DeactivateProxy(ctx context.Context, in *DeactivateProxyRequest, opts ...grpc.CallOption) (*DeactivateProxyResponse, error)
+	// RegisterLaneProxy defines a method for registering an additional proxy
+	// account, so a validator can broadcast from multiple accounts in parallel.
+	RegisterLaneProxy(ctx context.Context, in *RegisterLaneProxyRequest, opts ...grpc.CallOption) (*RegisterLaneProxyResponse, error)
+	// DeregisterLaneProxy defines a method for removing an additional proxy
+	// account.
+	DeregisterLaneProxy(ctx context.Context, in *DeregisterLaneProxyRequest, opts ...grpc.CallOption) (*DeregisterLaneProxyResponse, error)
 }
 
 type msgServiceClient struct {
@@ -108,6 +121,24 @@ func (c *msgServiceClient) DeactivateProxy(ctx context.Context, in *DeactivatePr
 	return out, nil
 }
 
+func (c *msgServiceClient) RegisterLaneProxy(ctx context.Context, in *RegisterLaneProxyRequest, opts ...grpc.CallOption) (*RegisterLaneProxyResponse, error) {
+	out := new(RegisterLaneProxyResponse)
+	err := c.cc.Invoke(ctx, "/axelar.snapshot.v1beta1.MsgService/RegisterLaneProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DeregisterLaneProxy(ctx context.Context, in *DeregisterLaneProxyRequest, opts ...grpc.CallOption) (*DeregisterLaneProxyResponse, error) {
+	out := new(DeregisterLaneProxyResponse)
+	err := c.cc.Invoke(ctx, "/axelar.snapshot.v1beta1.MsgService/DeregisterLaneProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// RegisterProxy defines a method for registering a proxy account that can act
@@ -115,6 +146,12 @@ type MsgServiceServer interface {
 	RegisterProxy(context.Context, *RegisterProxyRequest) (*RegisterProxyResponse, error)
 	// DeactivateProxy defines a method for deregistering a proxy account.
 	DeactivateProxy(context.Context, *DeactivateProxyRequest) (*DeactivateProxyResponse, error)
+	// RegisterLaneProxy defines a method for registering an additional proxy
+	// account, so a validator can broadcast from multiple accounts in parallel.
+	RegisterLaneProxy(context.Context, *RegisterLaneProxyRequest) (*RegisterLaneProxyResponse, error)
+	// DeregisterLaneProxy defines a method for removing an additional proxy
+	// account.
+	DeregisterLaneProxy(context.Context, *DeregisterLaneProxyRequest) (*DeregisterLaneProxyResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -127,6 +164,12 @@ func (*UnimplementedMsgServiceServer) RegisterProxy(ctx context.Context, req *Re
 func (*UnimplementedMsgServiceServer) DeactivateProxy(ctx context.Context, req *DeactivateProxyRequest) (*DeactivateProxyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateProxy not implemented")
 }
+func (*UnimplementedMsgServiceServer) RegisterLaneProxy(ctx context.Context, req *RegisterLaneProxyRequest) (*RegisterLaneProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLaneProxy not implemented")
+}
+func (*UnimplementedMsgServiceServer) DeregisterLaneProxy(ctx context.Context, req *DeregisterLaneProxyRequest) (*DeregisterLaneProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterLaneProxy not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -168,6 +211,42 @@ func _MsgService_DeactivateProxy_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_RegisterLaneProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterLaneProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RegisterLaneProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.snapshot.v1beta1.MsgService/RegisterLaneProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RegisterLaneProxy(ctx, req.(*RegisterLaneProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DeregisterLaneProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterLaneProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DeregisterLaneProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.snapshot.v1beta1.MsgService/DeregisterLaneProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DeregisterLaneProxy(ctx, req.(*DeregisterLaneProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.snapshot.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -180,6 +259,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateProxy",
 			Handler:    _MsgService_DeactivateProxy_Handler,
 		},
+		{
+			MethodName: "RegisterLaneProxy",
+			Handler:    _MsgService_RegisterLaneProxy_Handler,
+		},
+		{
+			MethodName: "DeregisterLaneProxy",
+			Handler:    _MsgService_DeregisterLaneProxy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/snapshot/v1beta1/service.proto",
@@ -190,6 +277,7 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	LaneProxies(ctx context.Context, in *LaneProxiesRequest, opts ...grpc.CallOption) (*LaneProxiesResponse, error)
 }
 
 type queryServiceClient struct {
@@ -209,9 +297,19 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 	return out, nil
 }
 
+func (c *queryServiceClient) LaneProxies(ctx context.Context, in *LaneProxiesRequest, opts ...grpc.CallOption) (*LaneProxiesResponse, error) {
+	out := new(LaneProxiesResponse)
+	err := c.cc.Invoke(ctx, "/axelar.snapshot.v1beta1.QueryService/LaneProxies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	LaneProxies(context.Context, *LaneProxiesRequest) (*LaneProxiesResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -221,6 +319,9 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServiceServer) LaneProxies(ctx context.Context, req *LaneProxiesRequest) (*LaneProxiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaneProxies not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -244,6 +345,24 @@ func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_LaneProxies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LaneProxiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).LaneProxies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.snapshot.v1beta1.QueryService/LaneProxies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).LaneProxies(ctx, req.(*LaneProxiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.snapshot.v1beta1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -252,6 +371,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
 		},
+		{
+			MethodName: "LaneProxies",
+			Handler:    _QueryService_LaneProxies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/snapshot/v1beta1/service.proto",
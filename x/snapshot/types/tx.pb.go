@@ -173,39 +173,193 @@ func (m *DeactivateProxyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeactivateProxyResponse proto.InternalMessageInfo
 
+type RegisterLaneProxyRequest struct {
+	Sender    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"sender,omitempty"`
+	ProxyAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proxy_addr,json=proxyAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proxy_addr,omitempty"`
+}
+
+func (m *RegisterLaneProxyRequest) Reset()         { *m = RegisterLaneProxyRequest{} }
+func (m *RegisterLaneProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterLaneProxyRequest) ProtoMessage()    {}
+func (*RegisterLaneProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d4087e3f7de267, []int{4}
+}
+func (m *RegisterLaneProxyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterLaneProxyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterLaneProxyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterLaneProxyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLaneProxyRequest.Merge(m, src)
+}
+func (m *RegisterLaneProxyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterLaneProxyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLaneProxyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLaneProxyRequest proto.InternalMessageInfo
+
+type RegisterLaneProxyResponse struct {
+}
+
+func (m *RegisterLaneProxyResponse) Reset()         { *m = RegisterLaneProxyResponse{} }
+func (m *RegisterLaneProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterLaneProxyResponse) ProtoMessage()    {}
+func (*RegisterLaneProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d4087e3f7de267, []int{5}
+}
+func (m *RegisterLaneProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterLaneProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterLaneProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterLaneProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLaneProxyResponse.Merge(m, src)
+}
+func (m *RegisterLaneProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterLaneProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLaneProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLaneProxyResponse proto.InternalMessageInfo
+
+type DeregisterLaneProxyRequest struct {
+	Sender    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"sender,omitempty"`
+	ProxyAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proxy_addr,json=proxyAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proxy_addr,omitempty"`
+}
+
+func (m *DeregisterLaneProxyRequest) Reset()         { *m = DeregisterLaneProxyRequest{} }
+func (m *DeregisterLaneProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeregisterLaneProxyRequest) ProtoMessage()    {}
+func (*DeregisterLaneProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d4087e3f7de267, []int{6}
+}
+func (m *DeregisterLaneProxyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterLaneProxyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterLaneProxyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterLaneProxyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterLaneProxyRequest.Merge(m, src)
+}
+func (m *DeregisterLaneProxyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterLaneProxyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterLaneProxyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterLaneProxyRequest proto.InternalMessageInfo
+
+type DeregisterLaneProxyResponse struct {
+}
+
+func (m *DeregisterLaneProxyResponse) Reset()         { *m = DeregisterLaneProxyResponse{} }
+func (m *DeregisterLaneProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeregisterLaneProxyResponse) ProtoMessage()    {}
+func (*DeregisterLaneProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d4087e3f7de267, []int{7}
+}
+func (m *DeregisterLaneProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterLaneProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterLaneProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterLaneProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterLaneProxyResponse.Merge(m, src)
+}
+func (m *DeregisterLaneProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterLaneProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterLaneProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterLaneProxyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*RegisterProxyRequest)(nil), "axelar.snapshot.v1beta1.RegisterProxyRequest")
 	proto.RegisterType((*RegisterProxyResponse)(nil), "axelar.snapshot.v1beta1.RegisterProxyResponse")
 	proto.RegisterType((*DeactivateProxyRequest)(nil), "axelar.snapshot.v1beta1.DeactivateProxyRequest")
 	proto.RegisterType((*DeactivateProxyResponse)(nil), "axelar.snapshot.v1beta1.DeactivateProxyResponse")
+	proto.RegisterType((*RegisterLaneProxyRequest)(nil), "axelar.snapshot.v1beta1.RegisterLaneProxyRequest")
+	proto.RegisterType((*RegisterLaneProxyResponse)(nil), "axelar.snapshot.v1beta1.RegisterLaneProxyResponse")
+	proto.RegisterType((*DeregisterLaneProxyRequest)(nil), "axelar.snapshot.v1beta1.DeregisterLaneProxyRequest")
+	proto.RegisterType((*DeregisterLaneProxyResponse)(nil), "axelar.snapshot.v1beta1.DeregisterLaneProxyResponse")
 }
 
 func init() { proto.RegisterFile("axelar/snapshot/v1beta1/tx.proto", fileDescriptor_d9d4087e3f7de267) }
 
 var fileDescriptor_d9d4087e3f7de267 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x4e, 0x2a, 0x41,
-	0x14, 0x86, 0x77, 0x6f, 0x6e, 0x48, 0xee, 0xe4, 0x56, 0x04, 0x05, 0x89, 0x59, 0x09, 0x95, 0x0d,
-	0x3b, 0x21, 0xc6, 0xc6, 0x0e, 0x62, 0x63, 0x47, 0x30, 0xb1, 0xb0, 0x31, 0xc3, 0xce, 0xc9, 0x32,
-	0x01, 0xe6, 0x8c, 0x73, 0x06, 0x5c, 0x3a, 0x1f, 0xc1, 0x97, 0xb1, 0xf0, 0x0d, 0x28, 0x29, 0xad,
-	0x8c, 0xc2, 0x5b, 0x58, 0x19, 0x76, 0x87, 0x60, 0xb4, 0xb2, 0xb0, 0xda, 0x3d, 0x99, 0x2f, 0xdf,
-	0xff, 0x4f, 0xce, 0xb0, 0x86, 0xc8, 0x60, 0x2c, 0x2c, 0x27, 0x2d, 0x0c, 0x0d, 0xd1, 0xf1, 0x59,
-	0x7b, 0x00, 0x4e, 0xb4, 0xb9, 0xcb, 0x62, 0x63, 0xd1, 0x61, 0xb9, 0x5a, 0x10, 0xf1, 0x96, 0x88,
-	0x3d, 0x51, 0xaf, 0xa4, 0x98, 0x62, 0xce, 0xf0, 0xcd, 0x5f, 0x81, 0xd7, 0x0f, 0x53, 0xc4, 0x74,
-	0x0c, 0x5c, 0x18, 0xc5, 0x85, 0xd6, 0xe8, 0x84, 0x53, 0xa8, 0xc9, 0x9f, 0xc6, 0x3e, 0xce, 0x80,
-	0x9d, 0x28, 0x22, 0x85, 0x9a, 0x43, 0x66, 0xd0, 0x3a, 0x90, 0xbb, 0xe4, 0xb9, 0x01, 0xcf, 0x37,
-	0x9f, 0x42, 0x56, 0xe9, 0x43, 0xaa, 0xc8, 0x81, 0xed, 0x59, 0xcc, 0xe6, 0x7d, 0xb8, 0x9d, 0x02,
-	0xb9, 0xf2, 0x05, 0x2b, 0x11, 0x68, 0x09, 0xb6, 0x16, 0x36, 0xc2, 0xe3, 0xff, 0xdd, 0xf6, 0xfb,
-	0xcb, 0x51, 0x2b, 0x55, 0x6e, 0x38, 0x1d, 0xc4, 0x09, 0x4e, 0x78, 0x82, 0x34, 0x41, 0xf2, 0x9f,
-	0x16, 0xc9, 0x91, 0xd7, 0x5e, 0x89, 0x71, 0x47, 0x4a, 0x0b, 0x44, 0x7d, 0x2f, 0x28, 0xf7, 0x18,
-	0x33, 0x1b, 0xf5, 0x8d, 0x90, 0xd2, 0xd6, 0xfe, 0xfc, 0x48, 0xd7, 0x49, 0x92, 0xad, 0xee, 0x5f,
-	0x2e, 0xd9, 0x4c, 0x67, 0x7f, 0xef, 0x1f, 0x6b, 0x61, 0xb3, 0xca, 0xf6, 0xbe, 0x54, 0x27, 0x83,
-	0x9a, 0xa0, 0xa9, 0xd8, 0xfe, 0x39, 0x88, 0xc4, 0xa9, 0x99, 0x70, 0xf0, 0x4b, 0xb7, 0xf2, 0x1d,
-	0x0e, 0x58, 0xf5, 0x5b, 0x54, 0xd1, 0xa2, 0x7b, 0xb9, 0x78, 0x8b, 0x82, 0xc5, 0x2a, 0x0a, 0x97,
-	0xab, 0x28, 0x7c, 0x5d, 0x45, 0xe1, 0xc3, 0x3a, 0x0a, 0x96, 0xeb, 0x28, 0x78, 0x5e, 0x47, 0xc1,
-	0xf5, 0xe9, 0xa7, 0xd4, 0x62, 0x67, 0x1a, 0xdc, 0x1d, 0xda, 0x91, 0x9f, 0x5a, 0x09, 0x5a, 0xe0,
-	0xd9, 0xee, 0xdd, 0xe4, 0x45, 0x06, 0xa5, 0x7c, 0x6d, 0x27, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xfc, 0x88, 0xa9, 0x21, 0x57, 0x02, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xb1, 0x6f, 0x1a, 0x31,
+	0x14, 0xc6, 0xcf, 0x55, 0x85, 0x54, 0xab, 0x13, 0xa2, 0xe5, 0x80, 0xf6, 0x8a, 0x98, 0xba, 0x70,
+	0x16, 0xaa, 0xba, 0x74, 0x03, 0xb1, 0x54, 0xea, 0x80, 0xa8, 0x94, 0x21, 0x4b, 0x64, 0xee, 0x9e,
+	0x0e, 0x0b, 0xf0, 0x73, 0x6c, 0x43, 0x8e, 0x2d, 0x7f, 0x42, 0xfe, 0x99, 0x0c, 0x19, 0xa2, 0xac,
+	0x8c, 0x8c, 0x99, 0xa2, 0x04, 0xfe, 0x8b, 0x4c, 0x11, 0x77, 0x46, 0x44, 0x09, 0x4b, 0x86, 0x0c,
+	0x4c, 0xf6, 0x93, 0x3f, 0x7d, 0xdf, 0xef, 0xb3, 0xf4, 0x68, 0x9d, 0xa7, 0x30, 0xe6, 0x9a, 0x19,
+	0xc9, 0x95, 0x19, 0xa2, 0x65, 0xb3, 0xd6, 0x00, 0x2c, 0x6f, 0x31, 0x9b, 0x86, 0x4a, 0xa3, 0xc5,
+	0x62, 0x39, 0x57, 0x84, 0x5b, 0x45, 0xe8, 0x14, 0xd5, 0x52, 0x82, 0x09, 0x66, 0x1a, 0xb6, 0xb9,
+	0xe5, 0xf2, 0xea, 0xb7, 0x04, 0x31, 0x19, 0x03, 0xe3, 0x4a, 0x30, 0x2e, 0x25, 0x5a, 0x6e, 0x05,
+	0x4a, 0xe3, 0x5e, 0x43, 0x17, 0xa7, 0x40, 0x4f, 0x84, 0x31, 0x02, 0x25, 0x83, 0x54, 0xa1, 0xb6,
+	0x10, 0xef, 0x92, 0xe7, 0x0a, 0x9c, 0xbe, 0x71, 0x45, 0x68, 0xa9, 0x0f, 0x89, 0x30, 0x16, 0x74,
+	0x4f, 0x63, 0x3a, 0xef, 0xc3, 0xe9, 0x14, 0x8c, 0x2d, 0xfe, 0xa5, 0x05, 0x03, 0x32, 0x06, 0xed,
+	0x93, 0x3a, 0xf9, 0xf9, 0xb9, 0xd3, 0x7a, 0xbc, 0xfb, 0xd1, 0x4c, 0x84, 0x1d, 0x4e, 0x07, 0x61,
+	0x84, 0x13, 0x16, 0xa1, 0x99, 0xa0, 0x71, 0x47, 0xd3, 0xc4, 0x23, 0x67, 0x7b, 0xc4, 0xc7, 0xed,
+	0x38, 0xd6, 0x60, 0x4c, 0xdf, 0x19, 0x14, 0x7b, 0x94, 0xaa, 0x8d, 0xf5, 0x09, 0x8f, 0x63, 0xed,
+	0x7f, 0x78, 0x93, 0x5d, 0x3b, 0x8a, 0xb6, 0x76, 0x9f, 0x32, 0x93, 0xcd, 0xf4, 0xe7, 0xe3, 0xf9,
+	0xa5, 0x4f, 0x1a, 0x65, 0xfa, 0xe5, 0x05, 0xba, 0x51, 0x28, 0x0d, 0x34, 0x04, 0xfd, 0xda, 0x05,
+	0x1e, 0x59, 0x31, 0xe3, 0x16, 0xde, 0xa9, 0x95, 0x63, 0xa8, 0xd0, 0xf2, 0xab, 0x28, 0x47, 0x71,
+	0x4d, 0xa8, 0xbf, 0xe5, 0xfb, 0xc7, 0x25, 0x1c, 0xda, 0xf7, 0xd6, 0x68, 0x65, 0x0f, 0xbe, 0x2b,
+	0x77, 0x43, 0x68, 0xb5, 0x0b, 0xfa, 0x80, 0xeb, 0x7d, 0xa7, 0xb5, 0xbd, 0x05, 0xf2, 0x82, 0x9d,
+	0xff, 0x8b, 0x87, 0xc0, 0x5b, 0xac, 0x02, 0xb2, 0x5c, 0x05, 0xe4, 0x7e, 0x15, 0x90, 0x8b, 0x75,
+	0xe0, 0x2d, 0xd7, 0x81, 0x77, 0xbb, 0x0e, 0xbc, 0xe3, 0xdf, 0xcf, 0xc2, 0xf3, 0x8d, 0x93, 0x60,
+	0xcf, 0x50, 0x8f, 0xdc, 0xd4, 0x8c, 0x50, 0x03, 0x4b, 0x77, 0x5b, 0x9f, 0xf1, 0x0c, 0x0a, 0xd9,
+	0xd2, 0xfd, 0x7a, 0x1a, 0x00, 0x0d, 0xbe, 0x1b, 0x9c, 0x15, 0x04, 0x00, 0x00,
 }
 
 func (m *RegisterProxyRequest) Marshal() (dAtA []byte, err error) {
@@ -321,6 +475,126 @@ func (m *DeactivateProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RegisterLaneProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterLaneProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterLaneProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyAddr) > 0 {
+		i -= len(m.ProxyAddr)
+		copy(dAtA[i:], m.ProxyAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProxyAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterLaneProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterLaneProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterLaneProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterLaneProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterLaneProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterLaneProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyAddr) > 0 {
+		i -= len(m.ProxyAddr)
+		copy(dAtA[i:], m.ProxyAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProxyAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterLaneProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterLaneProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterLaneProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -380,6 +654,58 @@ func (m *DeactivateProxyResponse) Size() (n int) {
 	return n
 }
 
+func (m *RegisterLaneProxyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProxyAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterLaneProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeregisterLaneProxyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProxyAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterLaneProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -688,6 +1014,342 @@ func (m *DeactivateProxyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegisterLaneProxyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterLaneProxyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterLaneProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyAddr = append(m.ProxyAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProxyAddr == nil {
+				m.ProxyAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterLaneProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterLaneProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterLaneProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterLaneProxyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterLaneProxyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterLaneProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyAddr = append(m.ProxyAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProxyAddr == nil {
+				m.ProxyAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterLaneProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterLaneProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterLaneProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,7 +33,7 @@ func NewMsgServerImpl(keeper Keeper, s types.Snapshotter, staker types.StakingKe
 func (s msgServer) HeartBeat(c context.Context, req *types.HeartBeatRequest) (*types.HeartBeatResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	participant := s.snapshotter.GetOperator(ctx, req.Sender)
+	participant := s.snapshotter.GetOperatorForLane(ctx, req.Sender)
 	if participant.Empty() {
 		return nil, fmt.Errorf("sender %s is not a registered proxy", req.Sender.String())
 	}
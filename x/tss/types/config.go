@@ -7,13 +7,24 @@ type TssConfig struct {
 	Host        string        `mapstructure:"tofnd-host"`
 	Port        string        `mapstructure:"tofnd-port"`
 	DialTimeout time.Duration `mapstructure:"tofnd-dial-timeout"`
+	// StandbyHost and StandbyPort define a hot standby tofnd that requests fail over to while the primary is unavailable.
+	// It must be set up with the same mnemonic as the primary. No standby if the host is empty
+	StandbyHost         string        `mapstructure:"tofnd-standby-host"`
+	StandbyPort         string        `mapstructure:"tofnd-standby-port"`
+	HealthCheckInterval time.Duration `mapstructure:"tofnd-health-check-interval"`
+	MaxBackoff          time.Duration `mapstructure:"tofnd-max-backoff"`   // Upper bound of the delay between reconnection attempts
+	RetryBackoff        time.Duration `mapstructure:"tofnd-retry-backoff"` // Delay before the first retry of a keygen or sign request, increasing linearly with each retry
 }
 
 // DefaultConfig returns the default tss configuration
 func DefaultConfig() TssConfig {
 	return TssConfig{
-		Host:        "localhost",
-		Port:        "50051",
-		DialTimeout: 15 * time.Second,
+		Host:                "localhost",
+		Port:                "50051",
+		DialTimeout:         15 * time.Second,
+		StandbyPort:         "50051",
+		HealthCheckInterval: 30 * time.Second,
+		MaxBackoff:          30 * time.Second,
+		RetryBackoff:        time.Second,
 	}
 }
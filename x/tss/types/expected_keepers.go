@@ -12,7 +12,7 @@ import (
 
 // Snapshotter provides access to the snapshot functionality
 type Snapshotter interface {
-	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 }
 
 // Nexus provides access to the nexus functionality
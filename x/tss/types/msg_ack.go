@@ -43,3 +43,8 @@ func (m HeartBeatRequest) GetSignBytes() []byte {
 func (m HeartBeatRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// SetSender replaces the sender of the heartbeat
+func (m *HeartBeatRequest) SetSender(sender sdk.AccAddress) {
+	m.Sender = sender
+}
@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/axelarnetwork/axelar-core/x/vote/types"
@@ -21,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	voteQueryCmd.AddCommand(
+		GetCmdPoll(),
 		GetParams(),
 	)
 
@@ -28,6 +31,38 @@ func GetQueryCmd() *cobra.Command {
 
 }
 
+// GetCmdPoll returns the state of the poll with the given ID
+func GetCmdPoll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poll [poll-id]",
+		Short: "Returns the state of the poll with the given ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid poll id")
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.Poll(cmd.Context(), &types.PollRequest{PollID: pollID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParams returns the vote params
 func GetParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,9 +2,16 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
 	"github.com/axelarnetwork/axelar-core/x/vote/types"
 )
 
@@ -22,6 +29,36 @@ func NewGRPCQuerier(k Keeper) Querier {
 	}
 }
 
+// Poll returns the state of the poll with the given ID
+func (q Querier) Poll(c context.Context, req *types.PollRequest) (*types.PollResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pollID := exported.PollID(req.PollID)
+	metadata, ok := q.keeper.getPollMetadata(ctx, pollID)
+	if !ok {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrVote, fmt.Sprintf("poll [%s] not found", pollID.String())).Error())
+	}
+
+	voted := make(map[string]struct{})
+	for _, talliedVote := range q.keeper.getTalliedVotes(ctx, pollID) {
+		for voter := range talliedVote.IsVoterLate {
+			voted[voter] = struct{}{}
+		}
+	}
+
+	voters := maps.Keys(voted)
+	sort.Strings(voters)
+
+	return &types.PollResponse{
+		State:       metadata.State,
+		ExpiresAt:   metadata.ExpiresAt,
+		CompletedAt: metadata.CompletedAt,
+		GracePeriod: metadata.GracePeriod,
+		Module:      metadata.Module,
+		Voted:       voters,
+	}, nil
+}
+
 // Params returns the reward module params
 func (q Querier) Params(c context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	utilstestutils "github.com/axelarnetwork/axelar-core/utils/testutils"
+	snapshottestutils "github.com/axelarnetwork/axelar-core/x/snapshot/exported/testutils"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/keeper"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestQuerier_Poll(t *testing.T) {
+	var (
+		ctx     sdk.Context
+		k       keeper.Keeper
+		querier keeper.Querier
+		pollID  exported.PollID
+	)
+
+	givenQuerier := Given("vote querier", func() {
+		ctx, k, _, _, _ = setup()
+		querier = keeper.NewGRPCQuerier(k)
+	})
+
+	givenQuerier.
+		When("poll does not exist", func() {}).
+		Then("should return error NotFound", func(t *testing.T) {
+			res, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.PollRequest{PollID: uint64(rand.PosI64())})
+
+			assert.Nil(t, res)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.NotFound, s.Code())
+		}).
+		Run(t)
+
+	givenQuerier.
+		When("a poll is initialized", func() {
+			votingThreshold := utilstestutils.RandThreshold()
+			snapshot := snapshottestutils.Snapshot(uint64(rand.I64Between(2, 100)), votingThreshold)
+			pollID = funcs.Must(k.InitializePoll(ctx, exported.NewPollBuilder("evm", votingThreshold, snapshot, 100).GracePeriod(5)))
+		}).
+		Then("should return the pending poll", func(t *testing.T) {
+			res, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.PollRequest{PollID: uint64(pollID)})
+
+			assert.NoError(t, err)
+			assert.Equal(t, exported.Pending, res.State)
+			assert.Equal(t, int64(100), res.ExpiresAt)
+			assert.Equal(t, int64(5), res.GracePeriod)
+			assert.Equal(t, "evm", res.Module)
+			assert.Empty(t, res.Voted)
+		}).
+		Run(t, 5)
+}
@@ -27,7 +27,7 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServiceServer {
 func (s msgServer) Vote(c context.Context, req *types.VoteRequest) (*types.VoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	voter := s.snapshotter.GetOperator(ctx, req.Sender)
+	voter := s.snapshotter.GetOperatorForLane(ctx, req.Sender)
 	if voter == nil {
 		return nil, fmt.Errorf("account %v is not registered as a validator proxy", req.Sender.String())
 	}
@@ -24,7 +24,7 @@ type Voter interface {
 
 // Snapshotter provides snapshot functionality
 type Snapshotter interface {
-	GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 }
 
 // StakingKeeper provides functionality of the staking module
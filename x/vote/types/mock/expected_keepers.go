@@ -315,8 +315,8 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 //		// make and configure a mocked types.Snapshotter
 //		mockedSnapshotter := &SnapshotterMock{
-//			GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-//				panic("mock out the GetOperator method")
+//			GetOperatorForLaneFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+//				panic("mock out the GetOperatorForLane method")
 //			},
 //		}
 //
@@ -325,26 +325,26 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 //	}
 type SnapshotterMock struct {
-	// GetOperatorFunc mocks the GetOperator method.
-	GetOperatorFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
+	// GetOperatorForLaneFunc mocks the GetOperatorForLane method.
+	GetOperatorForLaneFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// GetOperator holds details about calls to the GetOperator method.
-		GetOperator []struct {
+		// GetOperatorForLane holds details about calls to the GetOperatorForLane method.
+		GetOperatorForLane []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 			// Proxy is the proxy argument value.
 			Proxy sdk.AccAddress
 		}
 	}
-	lockGetOperator sync.RWMutex
+	lockGetOperatorForLane sync.RWMutex
 }
 
-// GetOperator calls GetOperatorFunc.
-func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
-	if mock.GetOperatorFunc == nil {
-		panic("SnapshotterMock.GetOperatorFunc: method is nil but Snapshotter.GetOperator was just called")
+// GetOperatorForLane calls GetOperatorForLaneFunc.
+func (mock *SnapshotterMock) GetOperatorForLane(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
+	if mock.GetOperatorForLaneFunc == nil {
+		panic("SnapshotterMock.GetOperatorForLaneFunc: method is nil but Snapshotter.GetOperatorForLane was just called")
 	}
 	callInfo := struct {
 		Ctx   sdk.Context
@@ -353,17 +353,17 @@ func (mock *SnapshotterMock) GetOperator(ctx sdk.Context, proxy sdk.AccAddress)
 		Ctx:   ctx,
 		Proxy: proxy,
 	}
-	mock.lockGetOperator.Lock()
-	mock.calls.GetOperator = append(mock.calls.GetOperator, callInfo)
-	mock.lockGetOperator.Unlock()
-	return mock.GetOperatorFunc(ctx, proxy)
+	mock.lockGetOperatorForLane.Lock()
+	mock.calls.GetOperatorForLane = append(mock.calls.GetOperatorForLane, callInfo)
+	mock.lockGetOperatorForLane.Unlock()
+	return mock.GetOperatorForLaneFunc(ctx, proxy)
 }
 
-// GetOperatorCalls gets all the calls that were made to GetOperator.
+// GetOperatorForLaneCalls gets all the calls that were made to GetOperatorForLane.
 // Check the length with:
 //
-//	len(mockedSnapshotter.GetOperatorCalls())
-func (mock *SnapshotterMock) GetOperatorCalls() []struct {
+//	len(mockedSnapshotter.GetOperatorForLaneCalls())
+func (mock *SnapshotterMock) GetOperatorForLaneCalls() []struct {
 	Ctx   sdk.Context
 	Proxy sdk.AccAddress
 } {
@@ -371,9 +371,9 @@ func (mock *SnapshotterMock) GetOperatorCalls() []struct {
 		Ctx   sdk.Context
 		Proxy sdk.AccAddress
 	}
-	mock.lockGetOperator.RLock()
-	calls = mock.calls.GetOperator
-	mock.lockGetOperator.RUnlock()
+	mock.lockGetOperatorForLane.RLock()
+	calls = mock.calls.GetOperatorForLane
+	mock.lockGetOperatorForLane.RUnlock()
 	return calls
 }
 
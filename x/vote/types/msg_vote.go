@@ -75,3 +75,8 @@ func (m VoteRequest) GetSignBytes() []byte {
 func (m VoteRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Sender}
 }
+
+// SetSender replaces the sender of the vote
+func (m *VoteRequest) SetSender(sender sdk.AccAddress) {
+	m.Sender = sender
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -97,29 +98,124 @@ func (m *ParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
 
+type PollRequest struct {
+	PollID uint64 `protobuf:"varint,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+}
+
+func (m *PollRequest) Reset()         { *m = PollRequest{} }
+func (m *PollRequest) String() string { return proto.CompactTextString(m) }
+func (*PollRequest) ProtoMessage()    {}
+func (*PollRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff53be307f54353, []int{2}
+}
+func (m *PollRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollRequest.Merge(m, src)
+}
+func (m *PollRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollRequest proto.InternalMessageInfo
+
+// PollResponse contains the state of a poll. Polls are only found until their
+// grace period has passed
+type PollResponse struct {
+	State       exported.PollState `protobuf:"varint,1,opt,name=state,proto3,enum=axelar.vote.exported.v1beta1.PollState" json:"state,omitempty"`
+	ExpiresAt   int64              `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CompletedAt int64              `protobuf:"varint,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	GracePeriod int64              `protobuf:"varint,4,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	Module      string             `protobuf:"bytes,5,opt,name=module,proto3" json:"module,omitempty"`
+	// Addresses of the participants that voted
+	Voted []string `protobuf:"bytes,6,rep,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (m *PollResponse) Reset()         { *m = PollResponse{} }
+func (m *PollResponse) String() string { return proto.CompactTextString(m) }
+func (*PollResponse) ProtoMessage()    {}
+func (*PollResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff53be307f54353, []int{3}
+}
+func (m *PollResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollResponse.Merge(m, src)
+}
+func (m *PollResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.vote.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.vote.v1beta1.ParamsResponse")
+	proto.RegisterType((*PollRequest)(nil), "axelar.vote.v1beta1.PollRequest")
+	proto.RegisterType((*PollResponse)(nil), "axelar.vote.v1beta1.PollResponse")
 }
 
 func init() { proto.RegisterFile("axelar/vote/v1beta1/query.proto", fileDescriptor_0ff53be307f54353) }
 
 var fileDescriptor_0ff53be307f54353 = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xac, 0x48, 0xcd,
-	0x49, 0x2c, 0xd2, 0x2f, 0xcb, 0x2f, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x0a, 0xd8, 0xcc, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0xa8, 0x50, 0xe2,
-	0xe7, 0xe2, 0x0d, 0x00, 0xf3, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94, 0xbc, 0xb9, 0xf8,
-	0x60, 0x02, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x96, 0x5c, 0x6c, 0x10, 0x2d, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0x1c, 0xa0, 0x07, 0xd1, 0xe4, 0xc4, 0x72, 0xe2,
-	0x9e, 0x3c, 0x43, 0x10, 0x54, 0x83, 0x93, 0xff, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0x0f, 0x31, 0x32, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x1b, 0xca, 0xd3, 0x4d, 0xce,
-	0x2f, 0x4a, 0xd5, 0xaf, 0x80, 0xb8, 0xbe, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x6a,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x41, 0x5f, 0x2f, 0x25, 0x01, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0x24, 0x31, 0xca, 0xa6, 0x14, 0xc9, 0x54, 0xc8, 0x2a, 0xc2, 0x31, 0xe6, 0x80,
+	0x2f, 0xd8, 0x4a, 0x38, 0x71, 0xe0, 0x40, 0xc4, 0xa5, 0xe2, 0x40, 0x64, 0x6e, 0x5c, 0xa2, 0x8d,
+	0x3d, 0x32, 0x16, 0xeb, 0xce, 0x76, 0xbd, 0x2e, 0xee, 0x5b, 0xf0, 0x58, 0x39, 0xf6, 0xc8, 0xa9,
+	0x2a, 0xce, 0x8b, 0x20, 0xef, 0x6e, 0x0b, 0x95, 0x72, 0xdb, 0xf9, 0xf7, 0xfb, 0xff, 0x99, 0xd1,
+	0xd0, 0x39, 0xeb, 0x80, 0x33, 0x99, 0x5e, 0xa2, 0x82, 0xf4, 0x72, 0xb1, 0x05, 0xc5, 0x16, 0xe9,
+	0x45, 0x0b, 0xf2, 0x2a, 0x11, 0x12, 0x15, 0x7a, 0xcf, 0x0c, 0x90, 0x0c, 0x40, 0x62, 0x81, 0xd3,
+	0x93, 0x12, 0x4b, 0xd4, 0xff, 0xe9, 0xf0, 0x32, 0xe8, 0x69, 0x78, 0x28, 0x4b, 0x30, 0xc9, 0xea,
+	0xc6, 0x12, 0xf1, 0xff, 0x04, 0x74, 0x02, 0xa5, 0x82, 0xe2, 0x1e, 0x55, 0x57, 0x02, 0x2c, 0x19,
+	0x3d, 0xa5, 0x4f, 0xd6, 0xda, 0x99, 0xc1, 0x45, 0x0b, 0x8d, 0x8a, 0x3e, 0xd3, 0xe3, 0x3b, 0xa1,
+	0x11, 0x78, 0xde, 0x80, 0xf7, 0x9e, 0xba, 0x26, 0xdc, 0x27, 0x21, 0x89, 0x67, 0xcb, 0x17, 0xc9,
+	0x81, 0x51, 0x13, 0x63, 0x5a, 0x8d, 0x77, 0x37, 0x73, 0x27, 0xb3, 0x86, 0x68, 0x49, 0x67, 0x6b,
+	0xe4, 0xdc, 0x66, 0x7b, 0xaf, 0xe9, 0x63, 0x81, 0x9c, 0x6f, 0xaa, 0x42, 0x47, 0x8d, 0x57, 0xb4,
+	0xbf, 0x99, 0xbb, 0x03, 0x71, 0xf6, 0x29, 0x73, 0x87, 0xaf, 0xb3, 0x22, 0xba, 0x25, 0xf4, 0xc8,
+	0x98, 0x6c, 0xff, 0x0f, 0x74, 0xd2, 0x28, 0xa6, 0x40, 0x7b, 0x8e, 0x97, 0x6f, 0x1e, 0xb4, 0xbf,
+	0x5b, 0xee, 0xdf, 0x1c, 0xc8, 0xf9, 0xd7, 0x01, 0xcf, 0x8c, 0xcb, 0x7b, 0x49, 0x29, 0x74, 0xa2,
+	0x92, 0xd0, 0x6c, 0x98, 0xf2, 0x1f, 0x85, 0x24, 0x1e, 0x65, 0x53, 0xab, 0x7c, 0x54, 0xde, 0x2b,
+	0x7a, 0x94, 0x63, 0x2d, 0x38, 0x28, 0x28, 0x06, 0x60, 0xa4, 0x81, 0xd9, 0xbd, 0x66, 0x90, 0x52,
+	0xb2, 0x1c, 0x36, 0x02, 0x64, 0x85, 0x85, 0x3f, 0x36, 0x88, 0xd6, 0xd6, 0x5a, 0xf2, 0x9e, 0x53,
+	0xb7, 0xc6, 0xa2, 0xe5, 0xe0, 0x4f, 0x42, 0x12, 0x4f, 0x33, 0x5b, 0x79, 0x27, 0x74, 0x32, 0x8c,
+	0x59, 0xf8, 0x6e, 0x38, 0x8a, 0xa7, 0x99, 0x29, 0x56, 0x5f, 0x76, 0x7f, 0x02, 0x67, 0xd7, 0x07,
+	0xe4, 0xba, 0x0f, 0xc8, 0x6d, 0x1f, 0x90, 0x5f, 0xfb, 0xc0, 0xb9, 0xde, 0x07, 0xce, 0xef, 0x7d,
+	0xe0, 0x7c, 0x5b, 0x94, 0x95, 0xfa, 0xde, 0x6e, 0x93, 0x1c, 0xeb, 0xd4, 0xac, 0x7a, 0x0e, 0xea,
+	0x27, 0xca, 0x1f, 0xb6, 0x7a, 0x9b, 0xa3, 0x84, 0xb4, 0x33, 0xc7, 0xd5, 0xb7, 0xdc, 0xba, 0xfa,
+	0x98, 0xef, 0xfe, 0x0e, 0x00, 0x9c, 0xc5, 0x7f, 0x27, 0x66, 0x02, 0x00, 0x00,
 }
 
 func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
@@ -178,6 +274,93 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PollID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voted) > 0 {
+		for iNdEx := len(m.Voted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voted[iNdEx])
+			copy(dAtA[i:], m.Voted[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Voted[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompletedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -209,6 +392,49 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *PollRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PollID != 0 {
+		n += 1 + sovQuery(uint64(m.PollID))
+	}
+	return n
+}
+
+func (m *PollResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedAt))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.GracePeriod))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voted) > 0 {
+		for _, s := range m.Voted {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +574,265 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollID", wireType)
+			}
+			m.PollID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= exported.PollState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voted = append(m.Voted, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,26 +35,28 @@ func init() {
 }
 
 var fileDescriptor_030f863ebca64631 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4b, 0xc3, 0x40,
-	0x14, 0x80, 0x7b, 0x22, 0x1d, 0x0e, 0x17, 0xa3, 0x38, 0xd4, 0x7a, 0xda, 0x76, 0x13, 0xbc, 0xa3,
-	0x75, 0x73, 0x74, 0x17, 0x7f, 0x81, 0x83, 0xdb, 0xb5, 0x3c, 0xce, 0x60, 0x9b, 0x97, 0xde, 0xbd,
-	0xa6, 0xe9, 0xea, 0xe0, 0x2c, 0xf8, 0x0f, 0x39, 0x3a, 0x16, 0x5c, 0x1c, 0x25, 0xf1, 0x0f, 0x91,
-	0xe4, 0x92, 0x41, 0x08, 0xdd, 0xee, 0xf8, 0x3e, 0xde, 0xfb, 0x78, 0xbc, 0xa7, 0x53, 0x98, 0x6a,
-	0xab, 0x12, 0x24, 0x50, 0xc9, 0x70, 0x0c, 0xa4, 0x87, 0xca, 0x81, 0x4d, 0xc2, 0x09, 0xc8, 0xd8,
-	0x22, 0x61, 0xb0, 0xe7, 0x15, 0x59, 0x28, 0xb2, 0x52, 0x3a, 0xfb, 0x06, 0x0d, 0x96, 0x5c, 0x15,
-	0x2f, 0xaf, 0x76, 0xba, 0x06, 0xd1, 0x4c, 0x41, 0xe9, 0x38, 0x54, 0x3a, 0x8a, 0x90, 0x34, 0x85,
-	0x18, 0xb9, 0x9a, 0x36, 0xed, 0xa2, 0xb4, 0xa2, 0xc7, 0x4d, 0x74, 0xbe, 0x00, 0xbb, 0xf2, 0xc2,
-	0x68, 0xc9, 0xf9, 0x95, 0x33, 0xf7, 0xbe, 0x2d, 0x08, 0xf9, 0xf6, 0x03, 0x12, 0x04, 0x27, 0xb2,
-	0x21, 0x4f, 0x16, 0xe8, 0x0e, 0xe6, 0x0b, 0x70, 0xd4, 0xe9, 0x6d, 0x30, 0x5c, 0x8c, 0x91, 0x83,
-	0x7e, 0xf7, 0xe5, 0xeb, 0xf7, 0x7d, 0xeb, 0xa0, 0xbf, 0xab, 0xfe, 0x45, 0x20, 0xc1, 0x05, 0x3b,
-	0x1d, 0xbd, 0x32, 0xbe, 0x73, 0x5b, 0x84, 0xd4, 0xbb, 0x13, 0xde, 0xbe, 0xd1, 0x56, 0xcf, 0x5c,
-	0xd0, 0x6f, 0x9c, 0xed, 0x61, 0xbd, 0x7f, 0xb0, 0xd1, 0xa9, 0x0a, 0x06, 0x65, 0xc1, 0x51, 0x70,
-	0xa8, 0x9a, 0xce, 0x10, 0x97, 0xf2, 0xe5, 0xf5, 0x67, 0x26, 0xd8, 0x3a, 0x13, 0xec, 0x27, 0x13,
-	0xec, 0x2d, 0x17, 0xad, 0x8f, 0x5c, 0xb0, 0x75, 0x2e, 0x5a, 0xdf, 0xb9, 0x68, 0x3d, 0x0e, 0x4d,
-	0x48, 0x4f, 0x8b, 0xb1, 0x9c, 0xe0, 0xac, 0x1a, 0x12, 0x01, 0x2d, 0xd1, 0x3e, 0x57, 0xbf, 0xb3,
-	0x09, 0x5a, 0x50, 0xa9, 0x9f, 0x4c, 0xab, 0x18, 0xdc, 0xb8, 0x5d, 0x5e, 0xf6, 0xfc, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0xc9, 0x97, 0x66, 0xfb, 0x06, 0x02, 0x00, 0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0xc0, 0x9b, 0x52, 0x3a, 0x1c, 0x2e, 0x9e, 0xe2, 0x50, 0xeb, 0x69, 0x5b, 0x5c, 0x14, 0x73,
+	0xb4, 0x6e, 0x8e, 0xee, 0x62, 0x55, 0x70, 0x70, 0x91, 0x6b, 0x7d, 0xc4, 0x60, 0x9a, 0x97, 0xde,
+	0xbd, 0xa6, 0x29, 0x22, 0x88, 0x9f, 0x40, 0xf0, 0x0b, 0x39, 0x3a, 0x16, 0x5c, 0x1c, 0xa5, 0xf1,
+	0x83, 0x48, 0xfe, 0x74, 0x10, 0xce, 0x2e, 0x21, 0xc7, 0xef, 0xc7, 0xfb, 0x3d, 0xee, 0x58, 0x4b,
+	0x25, 0x10, 0x28, 0x2d, 0x63, 0x24, 0x90, 0x71, 0x77, 0x00, 0xa4, 0xba, 0xd2, 0x80, 0x8e, 0xfd,
+	0x21, 0xb8, 0x91, 0x46, 0x42, 0xbe, 0x51, 0x28, 0x6e, 0xa6, 0xb8, 0xa5, 0xd2, 0xd8, 0xf4, 0xd0,
+	0xc3, 0x9c, 0xcb, 0xec, 0xaf, 0x50, 0x1b, 0x4d, 0x0f, 0xd1, 0x0b, 0x40, 0xaa, 0xc8, 0x97, 0x2a,
+	0x0c, 0x91, 0x14, 0xf9, 0x18, 0x9a, 0x25, 0xb5, 0xb5, 0x28, 0x29, 0xe9, 0xae, 0x8d, 0x8e, 0x27,
+	0xa0, 0x67, 0x85, 0xd0, 0x9b, 0x32, 0x76, 0x66, 0xbc, 0xab, 0x62, 0x37, 0xee, 0xb3, 0xda, 0x35,
+	0x12, 0xf0, 0x3d, 0xd7, 0xb2, 0x9e, 0x9b, 0xa1, 0x4b, 0x18, 0x4f, 0xc0, 0x50, 0xa3, 0xb5, 0xc2,
+	0x30, 0x11, 0x86, 0x06, 0xda, 0xcd, 0x97, 0xcf, 0x9f, 0xb7, 0xea, 0xd6, 0x89, 0x73, 0xd0, 0x5e,
+	0x97, 0x7f, 0xf6, 0x40, 0x82, 0xde, 0x73, 0x95, 0xad, 0x5d, 0x64, 0x8b, 0x2c, 0xdb, 0x09, 0xab,
+	0xf5, 0x31, 0x08, 0xfe, 0x69, 0x67, 0x68, 0x75, 0xbb, 0x30, 0xca, 0xf6, 0x61, 0xde, 0xde, 0xe7,
+	0x1d, 0x69, 0xbb, 0x80, 0x08, 0x83, 0x40, 0x3e, 0x66, 0xdf, 0x5b, 0xff, 0xee, 0x89, 0xc7, 0xac,
+	0xde, 0x57, 0x5a, 0x8d, 0x0c, 0x6f, 0xdb, 0x27, 0xe7, 0x70, 0x59, 0xef, 0xac, 0x74, 0xca, 0x7e,
+	0x27, 0xef, 0xef, 0xf0, 0x6d, 0x7b, 0x3f, 0x97, 0x4f, 0xcf, 0x3f, 0x16, 0xc2, 0x99, 0x2f, 0x84,
+	0xf3, 0xbd, 0x10, 0xce, 0x6b, 0x2a, 0x2a, 0xef, 0xa9, 0x70, 0xe6, 0xa9, 0xa8, 0x7c, 0xa5, 0xa2,
+	0x72, 0xd3, 0xf5, 0x7c, 0xba, 0x9f, 0x0c, 0xdc, 0x21, 0x8e, 0xca, 0x21, 0x21, 0xd0, 0x14, 0xf5,
+	0x43, 0x79, 0x3a, 0x1a, 0xa2, 0x06, 0x99, 0x14, 0x93, 0x69, 0x16, 0x81, 0x19, 0xd4, 0xf3, 0x37,
+	0x3d, 0xfe, 0x1d, 0x00, 0x49, 0x02, 0xb6, 0x0f, 0x80, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -141,6 +143,9 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
+	// Poll returns the state of the poll with the given ID.
+	// If no poll is found, it returns the grpc NOT_FOUND error.
+	Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
@@ -152,6 +157,15 @@ func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
 	return &queryServiceClient{cc}
 }
 
+func (c *queryServiceClient) Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error) {
+	out := new(PollResponse)
+	err := c.cc.Invoke(ctx, "/axelar.vote.v1beta1.QueryService/Poll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, "/axelar.vote.v1beta1.QueryService/Params", in, out, opts...)
@@ -163,6 +177,9 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
+	// Poll returns the state of the poll with the given ID.
+	// If no poll is found, it returns the grpc NOT_FOUND error.
+	Poll(context.Context, *PollRequest) (*PollResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
@@ -170,6 +187,9 @@ type QueryServiceServer interface {
 type UnimplementedQueryServiceServer struct {
 }
 
+func (*UnimplementedQueryServiceServer) Poll(ctx context.Context, req *PollRequest) (*PollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
+}
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -178,6 +198,24 @@ func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
 }
 
+func _QueryService_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Poll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.vote.v1beta1.QueryService/Poll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Poll(ctx, req.(*PollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -200,6 +238,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.vote.v1beta1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Poll",
+			Handler:    _QueryService_Poll_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,